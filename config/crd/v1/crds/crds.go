@@ -29,17 +29,19 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VAo\xe46\x0f\xbdϯ \xf6;\xec\xe5\xb3g\xb7\xbd\x14\xbem\xd3\x16\b\x9a\x04A\x12\xe4Nۜ\x19mdI\x95\xa8I\xa7E\xff{A\xc9\xcexl'\xb3Y\xa0\xbaY\xa2\x1e\xc9G>ZEQ\xacЩG\xf2AYS\x01:E\x7f2\x19\xf9\n\xe5\xd3O\xa1Tv\xbd\xff\xbczR\xa6\xad\xe0\"\x06\xb6\xdd\x1d\x05\x1b}C\xbf\xd0F\x19\xc5ʚUG\x8c-2V+\x004\xc62\xcav\x90O\x80\xc6\x1a\xf6Vk\xf2ŖL\xf9\x14k\xaa\xa3\xd2-\xf9\x04>\xb8\xde\x7f*?\xffP~Z\x01\x18쨂\x1a\x9b\xa7\xe8<9\x1b\x14[\xaf(\x94{\xd2\xe4m\xa9\xec*8j\x04}\xebmt\x15\x1c\x0f\xf2\xed\xdes\x8e\xfa\xe7\x04t7\x00\x1dґV\x81\x7f_<\xbeR\x81\x93\x89\xd3ѣ^\n$\x1d\ae\xb6Q\xa3\x9f\x19\x88\x83\xd0XG\x15\xdcH,\x0e\x1bjW\x00}\xa6)\xb6\x02\xb0m\x13w\xa8o\xbd2L\xfe\xc2\xea\xd8\r\x9c\x15\xf05Xs\x8b\xbc\xab\xa0\x1c\xd8-\x1bO\x89\xd8\a\xd5Q`\xec\\\xb2\x1d\b\xfb\xb2\xa5\xfe\x9b\x0f\xe2\xbcE\xa69\x980W\x1ec}88:A9\x12\x01\xa3\xb3\x8c\x18\xd8+\xb3]\x1d\x8d\xf7\x9f3\x15͎:\xacz[\xeb\xc8|\xb9\xbd|\xfc\xf1\xfed\x1b\xc0y\xebȳ\x1aʓר\xfdF\xbb\x00-\x85\xc6+ǩ9>\n`\xb6\x82V\xfa\x8e\x02\xf0\x8e\x06N\xa9\xedc\x00\xbb\x01ީ\x00\x9e\x9c\xa7@&w\xe2\t0\x88\x11\x1a\xb0\xf5Wj\xb8\x84{\xf2\x02\x03ag\xa3n\xa5]\xf7\xe4\x19<5vk\xd4_/\xd8\x01\xd8&\xa7\x1a\x99\xfa\x1e9\xaeTC\x83\x1a\xf6\xa8#\xfd\x1fд\xd0\xe1\x01<\x89\x17\x88f\x84\x97LB\t\xd7\xd6\x13(\xb3\xb1\x15\xec\x98]\xa8\xd6\xeb\xad\xe2Av\x8d\xed\xbah\x14\x1f\xd6IA\xaa\x8el}X\xb7\xb4'\xbd\x0ej[\xa0ov\x8a\xa9\xe1\xe8i\x8dN\x15)t\x93\xa4Wv\xed\xff|/\xd4\xf0\xf1$\xd6Y-\xf3Jby\xa3\x02\xa2\x16P\x01\xb0\xbf\x9a\xb38\x12-[\xc2\xceݯ\xf7\x0f0\xb8NŘ\xb2\x9fx?^\f\xc7\x12\ba\xcal\xc8\xe7\"n\xbc\xed\x12&\x99\xd6Ye8}4Z\x91\x99\xd2\x1fb\xdd)\x96\xba\xff\x11)\xb0Ԫ\x84\x8b4\x8b\xa0&\x88N\xd4Жpi\xe0\x02;\xd2\x17\x18\xe8?/\x800\x1d\n!\xf6\xdbJ0\x1e\xa3S\xe3\xcc\xda\xe8`\x18\x81\xaf\xd4k:\xd6\xee\x1d5R>aP\xae\xaa\x8dj\x926`c=\xe0̾<\x81^\x96\xae\xac<\xfc\xee\xd9z\xdcҕ͘S\xa3\xc5\xd8&w\x86\xe0d\xb2d\x19Ӳ\xe1\f\x1b\x80w\xc8#\xfd2*\xf32\x06\x16\xf3y\xa3\b\xa9\x10(r6h\x1a\xfa-u\x94i\x0egr\xba^\xb8\")\xed\xec3\xd8\r\x93\x19\x83\xf6\xb1.dR\x13\xf8h\xde\x15\xec\xe90?\x13\xe6݉1(\xd3J\x1b\xf4\xd3T\x9c\f\xd4K]ɴ\xe0O\xff\x9b\xe3E&vsw\x05<Y\xa7pa\xdfS`\xd5,\x1c|\xf8\xf0\xbe|\x05\xe6\xb2\x15\xa1m\x14\xf9\xb3\x19\x9f\x9a\x0f}\xb6\x89Z\xf7XEc;\x87\xacjM\xcb.e\x89LTF9\xe4Y\xf7\xfd\xfd\xb5\x97\x7f=\xbd\xbc\x0e\xced\xf0xj=\x16J\xdeH\xa1d!\xbeU/\x18\xb4\x11\xc0ٶ\x0f\xa2\xbf\x17$\xbfw\xe4 -\xae<M\xfe\x18\xc5\xf28\x98\xd8,\xa9kb2\xad\xf1\xe4x\xc2\xdf7\x8dKF\x8e\xe1=\x033]\x18\xc8n\xa2\xf7d\xb8\x87I/\x88\xef\x1e\x99\x1a\x03\x8fƅ\xbc\xe6\xcet\xc0\xd5\xfc\xc6\x10\x98\x80\x01\xcb\xc6x\xbe<\xe3\xf4\xaf\x9b\x8a\xb64Y6\xd6w\xc8\xf9\xb9X\b\xd0\xcc\xc2D\xad\xb1\xd6T\x01\xfb8?~k\x8eR\b\xb8=\x97\xddu\xb6ʏ\x8b\xfe\n`m#\xbfB=\xef\xe6Q\xc0\x99r\x9c\x89\xd4\xed0\x9c\x8b\xf3Vl\x96\x1ab\xf2\xbfz+\x84\xd7f\xe6\r=/\xec\xde\x11\xb6s\x1d\x17pcy\xf9\xe8\xd5\f\x17U1\xdb\f\xf2\x0ekGu\x0eY\xc8\xe3\x9dX\xbf\xbc++\xf8\xfb\x9fտ\x01\x00\x00\xff\xff]6D7C\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=M\x93\xdb:rw\xff\n\x94r\xf0\xee\xd6H^'\x97\xd4ܼc;Q\xbd\xf7\xfc\xa6<\xb3\xdeK.\x10ْ\xf0\x06\x04\xb8\x00\xa8\xb16\x95\xff\x9eB\x03\xe0'H\x82\xb2\xe6ś2/\xf6P@\xa3\xd1\xdd\xe8\x0ft\x03\\\xafׯhɾ\x80\xd2L\x8a[BK\x06_\r\b\xfb\x97\xde<\xfd\xbb\xde0\xf9\xe6\xf4\xf6\xd5\x13\x13\xf9-\xb9\xab\xb4\x91\xc5gвR\x19\xbc\x87=\x13\xcc0)^\x15`hN\r\xbd}E\b\x15B\x1aj_k\xfb'!\x99\x14FI\xceA\xad\x0f 6O\xd5\x0ev\x15\xe39(\x04\x1e\x86>\xfdy\xf3\xf6_7\x7f~E\x88\xa0\x05ܒ\x1d͞\xaaRoN\xc0A\xc9\r\x93\xaft\t\x99\x05yP\xb2*oI\xf3\x83\xeb\xe2\x87s\xa8\xfe\x05{\xe3\vδ\xf9\xa9\xf5\xf2g\xa6\r\xfeP\xf2JQ^\x8f\x84\xef4\x13\x87\x8aS\x15\u07be\"Dg\xb2\x84[\xf2\xc9\x0eQ\xd2\f\xf2W\x84x\xacqȵG\xf8\xf4\xd6AȎPP\x87\v!\xb2\x04\xf1\xee~\xfb\xe5\xdf\x1e:\xaf\t\xc9Ag\x8a\x95\x06\xe7\xee\x10#L\x13J\xbeഈ\xf2T&\xe6H\rQP*\xd0 \x8c&\xe6\b$\xa3\xa5\xa9\x14\x10\xb9'?U;P\x02\f\xe8\x1a4!\x19\xaf\xb4\x01E\xb4\xa1\x06\b5\x84\x92R2a\b\x13İ\x02\xc8\x1f\xde\xddo\x89\xdc\xfd\x06\x99ф\x8a\x9cP\xadeƨ\x81\x9c\x9c$\xaf\np}\xff\xb8\xa9\xa1\x96J\x96\xa0\f\vtvOKxZo{\xd3{m)\xe0Z\x91\xdcJ\r\xb8ix*B\xee\x89f\xe7c\x8eL7\xd3E9\xea\x00&\xb6\x11\x15\x1e\xf9\ry\x00e\xc1\x10}\x94\x15ϭ\xb0\x9d@Y\x82e\xf2 \xd8?jؚ\x18\x89\x83rj\xc0\v@\xf30a@\t\xcaɉ\xf2\nn\x90$\x05=\x13\x05v\x14R\x89\x16<l\xa27\xe4\x17\xa9\x800\xb1\x97\xb7\xe4hL\xa9o\u07fc90\x13\x16M&\x8b\xa2\x12̜ߠ\xfc\xb3]e\xa4\xd2or8\x01\x7f\xa3\xd9aMUvd\x062\xcb\xc87\xb4dkD]\xe0\xc2\xd9\x14\xf9\xbf\x04\x01Я;\xb8\x9a\xb3\x15Fm\x14\x13\x87\xd6\x0f(\xf5\x13\x1c\xb0\v\xc0ɗ\xeb\xeaf\xd1\x10ھ\xb2\xd4\xf9\xfc\xe1\xe1\xb1-{L\xf7\xa9\x8fto\td\xc3\x02K0&\xf6\xa0\x1c\x13\xf7J\x16\b\x13D\xee\xa4\x0fE\x973\x10}\xf2\xebjW0c\xf9\xfe\xf7\n\xb4\x15r\xb9!w\xa8I\xc8\x0eHU\xe6V27d+\xc8\x1d-\x80\xdfQ\r/\xce\x00Ki\xbd\xb6\x84McA[\t\xf6\x1b;\xaa\xb5~\b\xbal\x84_N!<\x94\x90u\x16\x8c\xed\xc5\xf6,\xc3eA\xf6R5\xfa©\xabM\ad|\xc9\xda'\xd3\xecA\xd0R\x1f\xa5yd\x05\xc8\xca\xf4[\xf4\x10\xba{\xd8\xf6:\x04d<j\xa8V*\r\xb9]gϔ\x19\x8b\xde\x00&\xb1\x80\xc8\x17\xd40\x01\x1ej\x9aJ\x13S)\x81\xab\xf43\xd0\xfc\xfc(\xff\xaa\x81\xe4\x15\nk\xa6\x00\xa7|Cv\xb0\x97\n\"p\x15\xd8\xfe\xb61(e\t\xa3\x11%Y\x99\ry<\x82%#\xad\xb8\xf1r\xcf4y\xfbgR0Q\x19\xd8\f\xa0\x8d0\x18\x89B\r-\xe4\t\xd4\f\xbd\xdeSC\x7f\xb1\xedzd\xb2\xfd\t\x02\xb03\xddy\x92\xed\xce\xf6\xc7Ȭ<W\xc9v߂\xc84Y\xad\x88Td\xe5L\xe0\xea\x06A[\xa3j\xd6L\xb4ƈ@|f\x9c\x87q\x97\xcd\xdc\x11\xd0\xf1N?ʏ\xda\t\xe9\x1c!F\xba\xb5\xe8\xf2|\x04s\x04EJ\x19\x8cO\x04\xef=\xe3@\xf4Y\x1b(<U\x82\xca\x0fD\xc4\xe5\xc0\xb9\a\xa1-Q=\xce\xc3y\x8a\x8as\xba\xe3pK\x8c\xaa\x86\xc392\xec\xa4\xe4@\xfb\xe6\xa7O\x87Ϡ\r\xcbf\xa8\xb0\xea\x93\xc1\xf5\x8a\x10A\xf9\x1fpn1\x89hV\x99\xa1O@h\xa0\x865\x8b\x9c\xb7\x88ء\x00\xf9/A\xde[\x9d\x9dYM:Ėx\x9d̀\xa3\x9d\x10\x92p)\x0e\xa0\xdch\xd6\x1e\x06\xc9Q`e+'VU*\xe0V\xe7\x93}e\xb5\xe8\x90΄\xd8U<*\x03Lh\x034߬\xae\xc9 \xf8\x9a\xf1*\x87\xfc\xce9A\x0f\xd6}˃\xd3:Є=F}\x98\xec\xec-(g\x19\xfa^\xde\xcdZ\xa3\x87\x18cVcH\xcf%8'\xd52\xcec\xd8X\xc8\xd62\xd7`l\x93՟V7\x96\x9f\x11\xa0\xddQ\xbbchB\x15\xd4\x14\x88k\xbe\bH(Js\x1er\x8f\x19(\"\x04\x9bT\x13\x89\xac\xa3J\xd1\xf3\b\xe3jO\xfb2֍u\xef1O\x84f\xbf3\xfb\xfa\xe3.d`\x04\"\xd3\xdf+\x03\x17\xb3Lc\xb4H\x99\xb0\xac\xb2\x81[\x87S\x1a\x83\xa1\xc8t,ͬ\xafȄ\x83\x87qNØ\xef\x85.K%yLtk\x89\xf1\"i#D\x1a\xf5\x8a\xbec\xa2\x1c\xa5|\x9a#\xc4\x7f\xda6M\xacA2܀ ;8\xd2\x13\x93\xcaO\xbd\xf1\x03\xe0+d\x95\x89\xaeejH\xce\xf6{P\x16Ny\xa4\x1a\xb4\v7\xc7\t2\xee>\x93\x96r\x88\xfe؛G\xc3H+\xa98\xf31ԭ#зh᱈Z\x0f\x17-g\xceN,\xaf(G#JE\xe6\xe6Ck\xbcb\xc6x\x82\xc9\x03\x9c\x9d\x89\x0e\x98[Nt\xc2\x11)\xc0\xba\xa0\x85\x8d\xc1\x86McF\xc6=c\xd3\xdeQ\xebgH'\xa2\xaa\xe2\xa0\xfdPαkt\xc0\xcd(\xe8\x9a#.~\xe7t\a\x9ch\xe0\x90\x19\xa9\xe2\xe4\x98c\xb2{R\xf4\xda\b\x15#\x1a\xae\x1b\x104\x13\x9b\x00I0\xa8:\xb2\xec\xe8\xdc4+A\b\x87\xe4\x124\xaerZ\x96<b\x01\x9ag\x92\xf3~\x90\xa9\x85\xde<3K\xbe\x0f/\xb6\xf8\x9b'A76ό\x96\xecR\xb6\x16\ab\xe4\xe4\xb4\xff\x7f\x126\xa8\xfd\v\x84v;\xe8z]\xa1\xb5$e\xa0\xd1aB\xcf\xe5\x860\x13\xde\xceA\xb4AN3\xfe?1c\x96K\xfc\xb6\xdf\xf3\xaa\x12?ɕ9\x88\x96+\xf5\xf0\xff\x84LAc\xf1\xe0mE2C~n\xf7\xba!l_3$\xbf!{\xc6\r\xa8\x1eg\xbei\xbd\\\x83\x18)\xf6\xce>\x055\xd9\xf1\xc3W\xeby\xe9&\x97\x93H\x97~g\xe7\xbf\x06\x7f\xbek\x98g\xe0\x12\xdc\x14f\n\n\xb7\xd9\xfc\x88\xd4lޠG\xf5\xee\xd3\xfb\xd8nV\xf7I\x90\xbc\xc1D\xde\xf5\x90m\x0f\xed\x9d\xf2\xd4ixק\x8eo\\\x1a\xe1\x86P\xf2\x04g\xe7\xb1PA,s\xa8\x1dh$\xd2\x19\x12\a\xf3\x19(dOpF0>A1\xdb;U\x14\xdc\xf3\x04\xe7\x94f=\x02Z\x9c\x98\xf6\x89\x17KI\xfb\x02\t\x81\xfb\xd9\xe9\xc4#\x98l\n\xbah~r$]\x91\x84'\xd0\xfe\x82i\xd6lk%ꐱ\xaf\xb5c\x91]\x05GV&NԚ9\xdcJ\x90\xfb:\xdd\xf4\x85r\x96\xd7\x039\xb9ߊqo\xb8\xfb|\x92f+nȇ\xafL\xfb\x8c\xdf{\t\xfa\x934\xf8\xe6E\xc8\xe9\x10\xbf\x80\x98\xae#./\xe1Զ\xa5C;o\x95 \xdc\xeeٺ\b\xaff\x0f\xd3d+l\xdc\xe2\xe9\x81YH7ܴ}\xe8>E\xa511%\xa4X\xbb\xad\x97\xd8H\x8e؉ \xa5\xeapd\x88Z=\xe8\xc8^O\xfcy\xb4\x96\xc4\xf5wyUN3\xc8C^\x05\xb3\x81\xd4\xc0\x81e\xa4\x00u\x982\x1c\xed\xa7\xb4\xfa=\r\x85D\xad랅\x12\x96f\xda\xc3\xe3Uwt\xf3\xbb\xfb\xac\xed\xcaMh\x15\x98=\xdbt$\t8\xdet~Fhb\xd1\xff\x98\xa5.\xcds,Ӡ\xfc~\x81\xc6_\xc0\x8b\xa1\xedw\x889\vYPLN\xfc\xb75s(\xd0\xffCJ\xcaT\xc2\x1a~\x87\xe5\x18\x1c:}\xfd.V{\x18;\x02\xd3\xc4\xf2\xf7D\xf90\xbd\x1c\x99\x9c\xb4\xba\x05\xb83\xe4r?\xf0Xn\xc8\xf3QjgS1)2\v\x92i\xb2z\x82\xb3OƵ\xf5\xc0j+V\xce\xc0/V7\xb5\xb7 \x05?\x93\x15\xf6]}\x8b\x13\x94(\x89\x89;\xae\x9f\xea\xf2\x93uA˵\x97^#\v\x96\x8d\xf6\xc3r\x99T\x17\xdbƠ\xc1\x83\xb0\x1d\xeb\x1a\x11\xeb\x1eO\xcd6I~K\xa9#\x99\xef\x11T\xee\xa56nG\xb2\xe3\xce.\xd9\xfd\"N\xf6\xfc\xae\x17\xa1{W\xa5#U\xa8\xbf\xb0겷Qk\xb9\xad\xa75\xb3\xcb\x19\xf8\x9d4\a\xd4\x06d\xabf\xe5;=\xbcr9\v\x1c\x84f\xe8\x94\xcc\xc2-\x95\xcc@G\xb3\xc5͓\xa0\xe5g6\x17\xeb\x8dE\xea\x02\x1fW\xdc0\xbd\x99\x19\x9etG\xd6\x12ia\b\xf0\xe1kk\xd7\xd3*\r\xfb\xf7\x9c\xf0-ŋ\xe0Z/\nگ\xe2IB\xf1\xce\xf5\f\xcb\xc4\x03r!\x85:T\xa8\"\xd2=O/H߃y/\x98\xd8\xe2\x00\xe4\xed\xd5݁Z\xb9\xc6j9bO\x8f\xe4\xbeoC\xf4\xfa\x85\x18)\xe6\x88=\xa5\xc4\x1d\x7f\x05\x1d\xce\r\xf7ǭ\x83\x99\bRH\xd3ކ\xb0pK\x99\xbf\xd6dϔ6mDS\x85\"^+\x12{\x96F\\\xe2\x83R\x17\x05\\\xbf\xba\x9e\xad\r\xb0\xa3|\x0e\xb5P\xa3\xc5\x13\xb1\a\x93I@؞0C@d\xb2\x12\xb8mc\x97:\x0e\xe1X\xe0\x14t2\xc9\xd2\x14\x84}@TE\x1a\x01\xd6(uLL\xeeﴛ\x7f\xa4,\x96\x81\x1e>\v\xd9f\xc6J\xc6bO\x87m\xa1v\xac]\xd4VЯ\xac\xa8\nB\vK\xfa\xd4pi\xef*\xce:\x1c\xaf\xeb\xce\x10.\x9a\x11#\xed\xa2*9\x98\xd4\x15\xe9*\xcc\xec2\xd1,\x87\xda0{)\x90\x82P\xb2\xa7\x8c\x8f\x94\xbb\f\x9fE\xb4]\x12\xa3xeq\xbd\xe0#m\xf05\x92\"a\x037\xd1ɜ\xd6֥Jw\x15\xef\x15\xa4\xb9gs\x9b\xd9\xc1=+\x15\x93X\xa7we\x0f͋\x18\x15\xe7\x1f.\xda\xe0\xf9\xe1\xa2\xcd<?\\\xb4\xd1燋6\xff\xfcp\xd1\xfc\xf3\xc3E\v\xcf\x0f\x17퇋6\xd5lJ[\xcfa\xe4NǍ\xfc8\x8bEBZ{\n\xc5\t\xf8\xbe\n\xc3\xd7y\xa7Vfn\xe3\xbd\"u\xfcɵ\xe1\xbaeJ\xeaRM\xbb@\x82x\xbb\xc3>3ś\xdfP/\x1f\x06\xbd\xa8^~;\xd9\xf9J\xf5\xf2\x1eþ\xd7}\xa5j\xf90\xffe\xd5\xf27\xbeT\xa3\x00\x1a\xb6\xe7].>\x1f\x1b\xb27\xda\x00\xf0\xef\\\x7f;\xa8\x0f\xbb\x8c\xf1/^m?\xc2\xfc\xc4\xc2\xf8՟V\xdf\x1f\xa5\x17\xd3v\x94\x9a\x032E&\x15\x8e\xf9ڸ\xb2]\xdc\xd5-\xa4\xfb>\x85s\xa94\xa6V\xccO\xd1k\xa8eZ\x04\xfb^\x17\xb3\x81\xe2\xd7\xd2ۊ\xb4\x13\x9d\xdbH\x97\xb93\x9d\x91\xf9\xe0&\x80>\x8b쨤\x90\x95\xf6\xfb\x06\x16\xfa;ܾ\xf0\xa9P,\x03KT\xb0o\xc9QV\x91\x8a\xed\t\xda\xcd\xd4\xef\x8dW\xed\xf9\x1c5\x18zz\xbb\xe9\xfeb\xa4\xaf\xe1#\xcf\xcc\x1c#x>\x1fA`v]\x1c\xda\x05\xf9a\xc1\xf9\x03\xe7}A\"R\x11\xc1\xf8\x98\xc1\xaaO\xe5wLӯ\xa5\xdb$Zl\xf9\xa778Ҫ\xfc.\xae\xed\xeb\xd6\xee\x8d8\x81K\x93\xd9\xe9G\x18ҫ\xf7\xa6\xcb\xed\x96\xd4\xec\xf5+\xf2F\x81\xceW\xea\xa5\xecM\xcdT\xe5]P\x8b\x97X\x87\xfdͩ\xf7\x94j\xbb\x8bj\xecfK\x95\x13+\xeb\xba5s\xd3 \x17\xd4\xd3%\x11g\xbevnqŜ\xafP\x9b\x9cGr\x9d\\\xa4\x02n\x12\xf0hu\xdcT\xdd\xdb̾\xf7\xb0&.\xbd\xdam\x124V\xc2\xcd\u05f8]\xaf\x92\xfd\x1aQ\xf6\xb8\xaa\x99\xadS\x9b\x8d§\xf1\x9b\xadD[R\x7f6K\xb1\vk\xcd\xeaZ\xb2\x91q\x97V\x98u+\xc8F\x80\xa6ԕ\x8dԍ\x8d@\x9c\xac&K\xad\x16\x1b\x81=cv'\xa5d\xf2\xc7%Ub\xf1KTȬ5俗\xfc]J\x06\xa9:\xce\xe5\\@\xf3k\xaf\xb9\xe5|\U0003199d\u0558\x9f\xca\xccq\xb9\xb3ZTܰ\x92cz\xf1\xc4\xf2h\xccn\x8ep\xae/\x86\xf8M\xe2qMw\x99\t\xf9\xf5s-̛\x9e\xcbM5y\x06\xce\t\x8d\x89\xe2`晻\a(\x93k\xb0\x16\xc2.O\x7f兿.\xe8\xc6\xc9;\x9eH\x8de`\xcc\x11\n\ve\xfcړQU>\xedN:\xcf\x17\xdf\xfd\xbd\x02u&x=K\xed_̜\x87r\xcbR\xdbX((\n\xafm\xdc\xedS=7\xbbY\x9e\xe4\x9dp\x06/\n\xb6\x87#±\x1a\x82\u05fc\xb6\xca\xd0F\r#M\xe3\x1b\xb1\xb2\xee\x1d\xf9}\xceSM=L\xf4\xb2\x81\xc6\xf2Pc\xd6ȿH\xb8qy\xc01\x012\xf5pPZB|\xf60\xd0K\x05\x1es\xa1G\xb2ϕv\xd8\xe7%\x0e\xf9,8ܳ \x04Y\x16\x84$\x93)\xe5\x10ϋ\x84\"/\x18\x8c\xbcD8rY@2\x03\xb2w8'\xe5\xd8MR\xb1Gr\xbe3\xa5Xc>%9}\x9c&\xe1\x18MB\xb2r\x0eӄ\xe32ˎ\xc9$\xd0\xf0\x85B\x95\x17\nV^\"\\yـe6d\x99\x95\x9c\x99\x9f\x97\x1do\xb9x\xf3^\xaa\x1c\xd4d\xae#U4'\x85\xb2\x17_t\xc7\xec\xed\xfc\x87;\xe5l\xab\x8e+\x1b۰\xaeO\xbdg\xe4'&|\x1e\xd5\na\xcb\xeew\x120\x8d#\x12\xdf\xffo\xbc<\x7fۨ\xcb\xdah(\xa9\xc2\f\xeb\xee\xecJ+\xf4\x86|\xa0ٱ\a\xfd\x18\x8d+\xf6R\x15ԐU\x9d\xf2z\xe3\x80ۿW\x1bB>\xca:i߾IF\xb3\xa2\xe4g\x1b7D`\xae\xda .\x13\x88\xa8\xf0\x85\xf1\xef%gY\xc4ӊ^.\xe4\x1a\x0f\xae\x84\xc0+\x8f\xb2v껴\r\xe3\x8e\x16:e\xdd\xeb\x15\xf7\x92s\xf9\xbc0\x1c\xa7%\xfb\x0f\xbc\xa5y~\x0f\xe7\xdd\xfd\x16\x9b\x06I\xc1\u06dd\xeb\n\xa1\x1a\xe9\x1dX\x8b\xd9Lgl\xc5o\xf7\x1d\x88\x91J\xbb\xfaO\x94\xd6\xdab\xb3\xb1[\x97\\՟\xd54\xf7[\x87\xdd\x06\x85\x85\x8a3\x91X\xeba\x8eL\xe5\xeb\x92*svu\x0575\x0e\xe3\xfb8\xc1nN\xed\xb6\x8c\x9a\x97\xe1u\xbfQچ[\x7f1\x99w.\xbb\xa9\xd0>E/\xc1c\xfc(\xdf\xec!\xbe+\xe21\ue0ac\x91R\x91\xd7Ѣ\xa4\xab\xedbi\x7f\xb5\xed/\xf2\x04\uf8fbY\x1d\xf2<\xf4\x9aGʉ\x02Dw\xb9\xeb\xd4\xed\xa0x9\xe7e\xba(^\x1f\x14\x86\xf6\xd7w&\xceŷ\x8eL%\xdc\\\x1a\xe0\xea\xf8\xae\x8d]^\xf7_0\xb4\xaaU\x98wv|\xf0\x14\xb6\xae\xfa\x17\xdc\xfd\xe5\xfa5R\xdaHE\x0f\xf0\xb3tW/\xcfѠۺs\xef\xb6wyB\xcdbX\r\xb1P\xc0_\x02\xdd\x03֔\"\x0f\xae\xc1\xb5X.\xbc\xd5\xd7\x18>3\x99\xc7ǟ\xdd\x04\f+`\xf3\xber\xb9|\xab\xed4Xj\x86\x89\xb9N;\xfb\xdfc\xc4^\x10\xbcO\xb6ş\x16\xde\n\xb0\xdc\x19\xcb\xde\x16a\x7f\xea\\$\x1dH4'\xa2_\xe2\xbdZ\xfbK-&9\xd7#*\xa1cpZw\xe9\xe3\xce+\x1eW\xbe\ueb4bc\xce\xe4\xd8m\xe3x\xc3\xf6\xfc}\xe3\xee\"n\xffu\x01_4_)\xbc2\xd1_ҍW\f^t\xe5\xf8\xae\xae\v\xa9\xabN\xf4;cl\xa0\x1c\xd3\xdc\x11\xf4F\xfa\xd6\x06N\x1aʉ\xa8\x8a\x1d\xfa\xac1\x95Rw\xc1\x8a\x95\xc9R\x15\xe7\x80L0Α\x9a\t\x03\x87\xc1\xa6{l\xaew\xbe\xc6\xf9\x92\xb9\xd6}\xd3窫,\x03\xad\xf7\x15\xe7纾z\xc9\xc4c\xd6\xe5J\xa4\xf8H\x19\xbf\x88\x0e\xae\xe3\b\x11\xdc\xdcF\xf5h\x12\x9b}Q'\x88<,ށ)\xb0\x0f\x9e:XF\a\xcf\x02_k\xa5\r-\xe6nN\xbf\x1b\xf6\xc0\xcfZ\xa8\xbcU\x9dU_\xff\xfdLu\xc3\xe6\x98Oـs=\xd1\x05\xb5\xd0 'p\x02A\xa4\xc0\xaay\xbc\x8b\xd3}z\xa5\xdf'\x02\xb5\rŗ\xe5W%\x974\x0f\x06.D\x92\xfes\x1d\x8fh\xbe\xd5\t\xd4k=\x01\xb3\xbe\xd0=B\x84\xa1d\xba\xd0\xee\xd6\xfaF\xb0\x8e\x02M2\xfdQ]\x9bi\xd6\xd5\xf3\xc9J\xeb\xeea;\xd6sT\x82C\x83\x18\xff\x06\x1fN\xf8F%5\x9cY\xaa\x8a\x1a\xcelNAu\xd4Qdr\x8d\x82\xba\xfa4q\xad\xce\u07b8\x8c\x8d\x9c\a\x80ǋ\xc2u\xfa\xee|Q\x01Z\xd3C\xb8j\xf9\xd9:`\a\x10\x80\x9b\x11\x91\xd9\xf8\xed\xdd\xe6<J\xf7\xa2a\x97\x87\xa2\x99\xa9\xa8\x1f T\xfb\xb5Z\xbd\x8e)`.\x0f\xeeC\b,|\x00'x\xa6\vi\xf2\xb5d*œ\xfdP7\xb4\xb4\xc1\x1422\xa2\xf9`\x11pv`\xd6\r\xb4L:P\xb5\xa3\aXg\x92s@];\xc4\xeb%\x17\xab?\xf5\xf3\x19\xa8\x9e\x9d\xda\xc7v[\x9f\xafp\xdcv\xf7\xf4QW\xf8\x8a߯1LA\xf3A\xa8\x01B\x12\a^\xe4\xb9:*D?\x9d4Ĵ\xdd6,0\xafW\xfd\xae\x96\xff\x92ҍ\x8f\x85\xe2\xc1zA\x7f\x93\xea\x86\x14L\xd8\x7f\xa8\xc8]B!t^\x84?ޠ=\x83\xf7\xbdmS\x1f\xbel\xf9\x91\x10\x16\xc4X\xa4\x16?p\xb7&\x9f`\x18X\xb83t\x90c\n-\xf6\xbd(\xdbd+\xee\x95<(\xd0\xc3U\xb5&\x7f\xa3\xcc0q\xf8(\xd5=\xaf\x0eL4\xfeƢ\xc6\xf7T\x19F9?;|b\x882A9\xfbG\x8c;\xed\x1f\xe7\x01\xd5\xea6\xf2[\x02\x1ac?\xbc\akjGÍ\xb8 x\xba\xceɂo\xd6l\xf93\xe1d\x17\xcf\xeb\xedde:ʯQ\x9e\x11A\x0ecn\xc8'i d\x92Y\x17\xa65\x17\xa0\xcd\x1a\xf6{\xa9\x8c\xcb0\xacׄ\xed}\xf8\x12\xdb\"\xa6\x8cc%\x8c\xfb\xe0\x14a\xa6)\x1dl\xd6\x1b\xeeL(T\x1bxCnA\xcfn\xff\x90f\x99\x8d\x8e\xe1\x8d6\x94G4\xf27Ujc\x9ch\xd7\v\xe4\x7fM\xd9Vݶ\xdb\xd7{o\xb5=Fp\x8erx\xb6\xd6Y\xa3\xa8m&\xb8\xaf\x04\x82<+f\x8c\xb5\x00\xedR!b\xac\xce\xe7\x9ch\xab\x05G\xaeY\x9f\xb2E\xf8\xbb\xf5\x16\xb6\xe3\xa9\xcb\xee\x86D\xddx\xcc\xd9\xf0\x93\xc3\xef+\xed\x90\x04#\xd3r\a\x89\x98\x0e}-+\xb3#\x15\a+TJV\x87c\x90\xcb\x11[>\x027\xaf,R\xa4D\r\xa1C\x99\x86\xa9\x94h\xa5r\xea\xf3b\r\xba4{\x1a\xc5ԧ\xa2\xc3G\x0f\xdf\xf8\x8b\xd3\xd7{%\x8b\xb5\xe7\x05VW\xdc\xf8\xf4\x8ab\xd2\x06\xec\xe6\x18%9q\xdfT\xf27\x14\xa3\x18\x94%\bB\xb5\xc7'\xe1b\x89i\xb6N\xec\xa6jC\x95I\x8d\x83\x1e:\x8dgB \x84\x1c\xc7\xf7\xc1\xa7\x8f\xdc\x05\x1bw\xfe\x93b5\xe0\x1b\xa2\x99\b\xdf[t\xc9)'\n\xdaFF\nps-Z83\x88i:\x11L\x17\xfd\xdf7x9\xd56\xf1C\x8a\x17\xfc\xa5\u05fcw\x88\n?\xaeU7\xf1\x9ek\x84\x1e\x7f`{W˓Y\xac\xff\xf8\x7f~8\xea\x94\xe4e\xbd\x9et\xb0\xd0w\xaa=\xa5\x99Oi\xdds\xb0\x9e\x8f\x06\xe8\xfan\xaf\x179\xe9\xa7\xcb\xc2\xcekƜ\xe1S\xa0\u05c9\xc4N\x97E\x9b/\x16j^wv\xcf\x14??8\xb7\xc6\xfe\xe6\x9bEbM\x0f!\x12mF\xa6Qǟ\xb3\xd1f+\xd8\f8\x8e|-\xa8\x17\x80^)܌ځ\xc1KT\xa0ykm\xfb\x91\xfc\x9b\xff\r\x00\x00\xff\xff\x9a\xfbL\xe1\xa9x\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\xe3\xb8\x11\xbe\xebWt\xed\x1e|YQ3\x9bKJ\x97\x94FN\xaa\xa6\xe2\x89]#ǹ\xe4\xb0\x10Д\xb0\x06\x01\x06\x0fi\x94T\xfe{\xaa\x01\xf0!\x92\xb2\xe4\xa9$\x8b\x8bM\xb2\xd1\xe8\xfe\xfa\r\xcd\xe7\xf3\x19\xab\xe5\vZ'\x8d^\x02\xab%~\xf3\xa8\xe9\xc9\x15\xaf\xbfw\x854\x8b\xc3\xc7٫\xd4b\t\xeb༩\xbe\xa23\xc1r\xbc\xc7Rj\xe9\xa5ѳ\n=\x13̳\xe5\f\x80im<\xa3\u05ce\x1e\x01\xb8\xd1\xde\x1a\xa5\xd0\xcew\xa8\x8bװ\xc5m\x90J\xa0\x8d̛\xa3\x0f\x1f\x8a\x8f?\x17\x1ff\x00\x9aU\xb8\x84-㯡v\xdeX\xb6CexbY\x1cP\xa15\x8543W#\xa7\x13vքz\t݇\xc4!\x9f\x9e$\xff\x14\x99m\x12\xb3\x87\xcc,~W\xd2\xf9?_\xa6y\x90\xceG\xbaZ\x05\xcb\xd4%\xb1\"\x89\xdb\x1b\xeb\xff\xd2\x1d=\x87\xadS\xe9\x8bԻ\xa0\x98\xbd\xb0}\x06ษq\tqw\xcd8\x8a\x19@\x86&r\x9b\x03\x13\"\x82\xcdԓ\x95ڣ]\x1b\x15*ݞ%\xd0q+k\x1f\xc1L\xba@V\x06\x1am\xc0y\xe6\x83\x03\x17\xf8\x1e\x98\x83ՁIŶ\n\x17\x7fլ\xf9?\xf2\x03\xf8\xd5\x19\xfd\xc4\xfc~\tE\xdaU\xd4{暯\xc9FO\xbd7\xfeD\n8o\xa5\xdeM\x89\xf4\xc0\x9c\x7faJ\x8a(ɳ\xac\x10\xa4\x03\xbfGP\xccy\xf0\xf4\x82\x9e\x12B@\x10!4\b\xc1\x91\xb9|\x0e\xc0!q\x89\x18MK\xaaFg\x9d\x89M\xa2\xc0ˀK\x92\x9f\xded\xe9{l\x1b\xff.\xb8Ŗ\xa5\xf3\xac\xaa\xcf\xf8\xaevx\x89\xd9\x19\x14\xf7X\xb2\xa0|_U\xb2\x92\xea\xfb\xe5\xb9Z5\xf2B\xa4]g'ޟ\xbdK\xa7n\x8dQ\xc8\x12\x97Du\xf8\x98\xbc\x90\xef\xb1b\xcbLljԫ\xa7\xcf/\xbfۜ\xbd\x86)G\x1a\x04\x05\x19\x8e\xf5l\xb3G\x8b\xf0\x12\xe3/\xd9\xcde\xd5Z\x9e\x00f\xfb+r\xdf\x19\xb1\xb6\xa6F\xebe\x13,i\xf5rQ\xef\xed@\xa6;\x12;Q\x81\xa0$\x84ɏr\xbc\xa0Ț\x82)\xc1\xef\xa5\x03\x8b\xb5E\x87\xda\xf7\xe1m\x05+\x81\xe9,^\x01\x1b\xb4Ćb9(A\xb9\xeb\x80փEnvZ\xfe\xb3\xe5\xed\xc0\x9b\xec\xbc\x1e\x9d\x1f\xf0\x8c\xf1\xa9\x99\"W\r\xf8\x130-\xa0b'\xb0H\xa7@\xd0=~\x91\xc4\x15\xf0\x85\xfc]\xea\xd2,a\xef}햋\xc5N\xfa&\asSUAK\x7fZ\xc4t*\xb7\xc1\x1b\xeb\x16\x02\x0f\xa8\x16N\xee\xe6\xcc\xf2\xbd\xf4\xc8}\xb0\xb8`\xb5\x9cG\xd1uJ\x9a\x95\xf8\xd1\xe6\xac\xed\xee\xced\x1dEmZ1k\xbea\x01ʘ\xc9\v\xd2֤E\a4\xbd\"t\xbe\xfeq\xf3\f\xcd\xd1\xd1\x18C\xf4#\xee\xddFי\x80\x00\x93\xbaD\x9b\x8cXZSE\x9e\xa8Em\xa4\xf6\xf1\x81+\x89z\b\xbf\v\xdbJz\xb2\xfb?\x02:O\xb6*`\x1d\v\x13l\x11B\x1d㾀\xcf\x1a֬B\xb5f\x0e\xff\xe7\x06 \xa4ݜ\x80\xbd\xcd\x04\xfd\x9a:$N\xa8\xf5>4\xb5\xf0\x82\xbd&\xa3xS#?\x8b\x1f\x81NZ\xf2p\xcf<Ƹ\x18\xe0\x9aC\xfcr1m\xd6tp\xd3b\x9c\xa3s_\x8c\xc0ᗁȫ\x96\xf0L\xc6\x1am%],\x8bP\x1a;\xac\x18\xac\xcd\xc0\xfd\xd5d\xaab\xf4\ru\xa8Ƃ\xcc\xe1+2\xf1\xa8\xd5\xe9§\xbfY\xe9\xc7\a]0$\xad$\xe2\xe6\xa4\xf9\x13Zi\xc4\x15\xe5?\r\xc8[\b\xf6\xe6\betk\xedՉr\x90;i>ζ\xcdZ=}n2o\n\xa0\x1co\x19\xab\x02V9rM\t\x1f@HG\r\x80\x8bL\xc7`\xe9\xa0b\x83\xb0\x04oû\xd4\xe7F\x97r7V\xba\xdf\xd3\\\xf2\x98+\xac\aȭ\xe3I\x94\x9a\xc8;jk\x0eR\xa0\x9dS|\xc8R\xf2,I\xb0\xa9r\x95\x12\x95pcM/DYTŢ\xa0\xa8f\xea\x8a\r\xd7-a쀙\xd4Ƀ;\x061\xd9\xd8*\x97T\xedQ\x8b\xb6\x1b9\x93\xc6Ĭ\xe5P\xc0Q\xfa}J\x87j*\xee\xe0\xcdأ\xf5\x8a\xa7\xa9\xd7\x03ٟ\xf7H\x94\xa9\x80\"8\xe4\x16}\xf46T\xe4>\xe4J\x05\xc0\x97\xe0bB\x1d\xe6\x89f\xc5F\xad\xd9\xfd\x8a\xa71\xd0p\u0378\xb9\x85\xb9.\xf2\x1d\xb5\u038d\xc0\x16K\xb4\xa8\xfddR\xa7\x01\xc4j\xf4\x18\xf3\xba0\xdcQJ\xe7X{\xb70\a\xb4\a\x89\xc7\xc5\xd1\xd8W\xa9ws\x02|\x9e#h\x11Ǌŏ\xf1\xcf\x05\x95\x9f\x1f\xef\x1f\x97\xb0\x12\x02\x8cߣ%\xab\x95A5\x8e\xd6\xebo~\x8a5\xf6'\bR\xfc\xe1\xee{p1u\x8a\x9c\x1b\xb0\xd9D\xef?Q\xa3\x16\x85\"\x886\xc9*\xc6\x02UJ2v\x95\xad\x99r͔#Nu\x98\xfdE\x89\x89*\xc8TF}\xc5q2}#\xcc\x00\xbe\xcd;C\xcd+V\xcf\x135\xf3\xa6\x92|6\xd46\xb6\xc1W\"\xb2i\xbb\xa5\x16\x92S\xdbv\x1eI\xcd8\"κ\xf3\t\x18\x86\xfd\xfa\xa5\xfc1\rSR7W\xcf+\x12?\xf6i\xbb!.%\xb3\\\x11\x1dzj\xb7\x1ch\xa4\x8a\xc9\xec\x18\xe7\x98B\xb8њb\xd7\x1b`mb\xbcsÊ\xf0\xce|\xb2\r\xfc\x15'\x80\x1f\xa9\xf2)\x126\x18\xa7m$Kp\x18S\xf551\xe0zDp\xb6F{\x8b,\xeb\x15\x11\xb6E\x95\xc1z\x05۠\x85\xc2F\xa2\xe3\x1e5\xcd\x13\xb2<M\x9fE\xeb\xf9aӠ\x1a\xfb\x91<\x114\xd8N\xeb\x902\xfe\x12\xb6\xa7\x89\x0e\xe2\x06%k\x8b\xa5\xfcv\x83\x92O\x91\xb0\x01\xbcf~\x0fR;)\x10\xd8\x04\xfc\xa9\xb5\xbb\xa0h\xdb-<\xe6\x9c\xf3\x1d\xe6y+7$qޓ\x1e\x1a\x8c\xaf\xc4\xcfS&kQh\x9es\x9d8\xef\x1c/\xc5\xf1\xa4F\x87\xf6z\xe1O\xa9\x1f\xe3\x13\x85\xf5L\x98\x97\xf1\x8e7\xfa\xba\xe6\x92c*\x98\xa9\x8b0֢\xab\x8d\x164j\xdd\xd6\xd5u\"\xff\xf7z\xbbi\xb3\xceϳ\xdc\xe0[c\x85\x9b\x06\x9bx\xa1\xf3\xee\xd1&]s\xf5\a\a\xb3u4Wv\xd3\xcd@\xc7\xff\xcbP\xf3Co\xaa\xa1\xe9YCб\xaf\x8b\xfdA\x01\x7f\xd7pO\x930U'\xb1$\xb9\xed\x94\x03H\a\xda\x1ci{\x8f_d\x01F\xa7\xeaN\xb3\x1d\xd3\"\x8f\xce\xf1\xd3Q*E\xf5\xddbe\x0e\x93\xf5\x9d\xdaR\x8b\xea\x04̑\xeb\x1c~.>\x14?\xfcf3\x93b\xce\xd3\b\x84\xe2+\x1e\xe4\xf8Nh\x8c\xee\xc3hG\x13\xf8m8\xd0\xc3/\xcdh\xbd\xb0\x99\xec\x97\t0J\xa9\xa8s\x9c\xc8\x13]\xc70\xbe\xbd\xfc\xb4y\xb8s\xb1\xe1G\xed\xa7\x9a\xc4#Z\x8c\xf3\x15\n\xea\xf9M\xbe\xc5\bΣ\x9dp\x80\xd6z\xd1栌\xde\r\x02'\xad|\xa7A\xfd\\r(cA\xa0\xa7Ҥw\xc0\xf7Lﰻ\xb3\xca\xf2\xbf-)\xb9\xcf\xc0g:\x0f\x91\xfa\x92{\xdcd\xd1g9\xd5ԏ\xee\x8b;\xe2\xe9\xbb\xe2F\xfaƲ\x17\x87\xa2+\xb8\x8f\xe8\x9b*M\xa0\xce}w\x7fܭ\xef\x1f\x86Ǘ\xd37 \xf1ޛ\xf37nA\xe0\xc8\\w\x87\xfe\xdb\xe1PQ\xb7z\xb5\x05\xfe\x92\xa8\xd2ec\xde\x02lk\x82\x7f+2\xef\xa6\x1c:\xff8\xf0\x1e\x19\xe3O\x1eך\f\xa2i,\u0083\xa5\xc1\xb3\xbbC\x8bIa\xaa\xb6\xdc~\x19\xb5\x1a\xfc2\xd3\xff6\xfe\xdd\xe6\x06\xbd&k\xed\xe8e\xaa\x97=\xbbf\x90\xfbo¶\xbdW^¿\xfe=\xfbO\x00\x00\x00\xff\xff\x80.\x12\xd3P\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96M\x93\xdb6\x0f\xc7\xef\xfe\x14\x98y\x0e\xb9<\x92\xb3\xed\xa5\xa3[\xb3\xc9a\xa7mƳ\x9bɝ&a\x8bY\x8ad\x01\xd0[\xb7\xd3\xef\xde!)\xf9E\xb67\xdbCy\x13\t\x02\x7f\xfe@\x80j\x9af\xa1\xa2\xfd\x8a\xc46\xf8\x0eT\xb4\xf8\x87\xa0\xcf_\xdc>\xffĭ\r\xcb\xdd\xdd\xe2\xd9z\xd3\xc1}b\t\xc3#rH\xa4\xf1#n\xac\xb7b\x83_\f(\xca(Q\xdd\x02@y\x1fD\xe5iΟ\x00:x\xa1\xe0\x1cR\xb3E\xdf>\xa75\xae\x93u\x06\xa98\x9fB\xef\u07b7w?\xb4\xef\x17\x00^\r\u0601A\x87\x82k\xa5\x9fS$\xfc=!\v\xb7;tH\xa1\xb5a\xc1\x11u\xf6\xbf\xa5\x90b\aǅ\xba\x7f\x8c]u\x7f,\xae>\x14W\x8f\xd5UYu\x96\xe5\x97[\x16\xbf\xda\xd1*\xbaD\xca]\x17T\f\xd8\xfamr\x8a\xae\x9a,\x00X\x87\x88\x1d|β\xa2\xd2h\x16\x00㱋\xcc\x06\x941\x05\xa4r+\xb2^\x90\xee\x83K\xc3\x04\xb0\x01\x83\xac\xc9F)\xa0\xbe\xf4X\x8e\ba\x03\xd2#\xd4p \x01\xd68*0e\x1f\xc07\x0e~\xa5\xa4\xef\xa0ͼ\xdaj\x9a\x85\x8c\x06\x15\xf5\x87\xf9\xb4\xec\xb3`\x16\xb2~{K\x02\x8b\x92ē\x88\x12\xd7\x06\x0ft\xc2\xf7\\@\xb1oc\xaf\xf8<\xfaSY\xb8\x15\xb9\xda\xec\xee*i\xdd㠺\xd16D\xf4?\xaf\x1e\xbe\xfe\xf8t6\r\xe7Z\xaf\xa4\x16,\x83\x9a\x94fp\x95\x1a\x04\x8f\x10\b\x86@\x13Un\x0fN#\x85\x88$v\xbaZu\x9c\x14\xcf\xc9\xecL»\xac\xb2Z\x81\xc9U\x83\\\xa0\x8d\x97\x00\xcdx\xb0\n\xd32\x10FBF_\xeb\xe8\xcc1d#\xe5!\xac\xbf\xa1\x96\x16\x9e\x90\xb2\x1b\xe0>$gr\xb1\xed\x90\x04\bu\xd8z\xfb\xe7\xc17\xe7s\xe6\xa0N\xc91?\xd3(\x97\xce+\a;\xe5\x12\xfe\x1f\x9470\xa8=\x10\xe6(\x90\xfc\x89\xbfb\xc2-\xfc\x961Y\xbf\t\x1d\xf4\"\x91\xbb\xe5rkej\x1a:\fC\xf2V\xf6\xcbR\xffv\x9d$\x10/\r\xee\xd0-\xd9n\x1bE\xba\xb7\x82Z\x12\xe1RE\xdb\x14\xe9\xbe4\x8ev0\xff\xa3\xb1\xcd\xf0\xbb3\xad\x17\x17\xa4\x8eR\xe8\xafd \x97yM{\xddZOq\x04\x9d\xa72\x9d\xc7OO_`\n]\x921\xa7_\xb8\x1f7\xf21\x05\x19\x98\xf5\x1b\xa4\x9a\xc4\r\x85\xa1\xf8Dob\xb0^ʇv\x16\xfd\x1c?\xa7\xf5`\x85\xa7+\x99s\xd5\xc2}餹\xa8S4Jд\xf0\xe0\xe1^\r\xe8\xee\x15\xe3\x7f\x9e\x80L\x9a\x9b\f\xf6m)8}\x04\xe6ƕ\xda\xc9\xc2Ծo\xe4\xebJ\xd1>E\xd49\x83\x19b\xdem7V\x97\xf2\x80M x\xe9\xad\ue9e2\x9d\xd1=\x14x{\xb6p\xbd\xa0\xf38\xb6\xc9\xf9\xca\xcd\xc3Cɝ%\x9c\xdd\xc2\x06.z\xee\xeb\\J3\xfc\x97dj'\x1e\xd9\xe8D\x84^N\xfa\xb3\xba\xb6\xe9\xad,\x90(\xd0\xc5\xecLԧbT^ze=\x83\xf2\xfbq#H\xaf\x04^\x90r\x19\xe8\x90r\x9fA\x03&]\xf0\x1b\xb1\x9c\xbe%\x91\x82F\xe6\xf6\xc2\xce\n\x0eW4\xbd\x92\x9d<|rN\xad\x1dv \x94\xf0Ff\x15\x91\xda\xcf\xd6ʛ\xf5\x1d\x04\xabls-\a\x87w\xfa\xbbI(\xb8}\x1a.#5\xf0\x19_\xae\xcc>\xf8\x15\x85-!ϯ|^\\Uz\x87\x9f\x817P\xbaz)/&9\xf7;sB\x91%\x90ڞr\xe5\xb4>\xf4\xef\x0e\xfe\xfa{\xf1O\x00\x00\x00\xff\xff\x045\f\xc6i\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VOs۶\x13\xbd\xebS\xec\xcc\xef\x90_gB*i/\x1d\xddZ%\aO\xd3\xd4c\xa5\xbeC\xe0\x8aD\r\x02(v!\xc7\xfd\xf4\x9d\x05H\xfd\xa5d\xf9P\xde\b,\x16\x0fo\xdf>\xa0\xaa\xaa\x99\n\xe6\x11#\x19\xef\x16\xa0\x82\xc1\xef\x8cN\xfe\xa8~\xfa\x99j\xe3\xe7ۏ\xb3'\xe3\x9a\x05,\x13\xb1\xef\x1f\x90|\x8a\x1a?\xe1\xc68\xc3ƻY\x8f\xac\x1a\xc5j1\x03P\xceyV2L\xf2\v\xa0\xbd\xe3\xe8\xad\xc5X\xb5\xe8꧴\xc6u2\xb6\xc1\x98\x93\x8f[o?\xd4\x1f\x7f\xac?\xcc\x00\x9c\xeaq\x01\x8d\x7fv֫&\xe2\xdf\t\x89\xa9ޢ\xc5\xe8k\xe3g\x14PK\xee6\xfa\x14\x16\xb0\x9f(k\x87}\v\xe6OC\x9a\x87\x92&\xcfXC\xfc\xdb\xd4\xec\x173D\x04\x9b\xa2\xb2\xe7 \xf2$\x19\xd7&\xab\xe2\xd9\xf4\f\x80\xb4\x0f\xb8\x80\xaf\x02#(\x8d\xcd\f`8b\x86U\r\xa7\xdb~,\xa9t\x87\xbd*x\x01|@\xf7\xcb\xfd\xdd\xe3O\xab\xa3a\x80\x06IG\x138\x13u\x82\x19\f\x81\x82\x01\x01\xb0߁\x02\xe5@E6\x1b\xa5\x196\xd1\xf7\xb0V\xfa)\x85]V\x00\xbf\xfe\v5\x03\xb1\x8f\xaa\xc5\xf7@Iw\xa0$_\t\x05\xeb[\xd8\x18\x8b\xf5nQ\x88>`d3\xb2\\\xbe\x03\r\x1d\x8c\x9e\x00\x7f'g+QЈx\x90\x80;\x1c\xf9\xc1f\xa0\x03\xfc\x06\xb83\x04\x11CDBW\xe4t\x94\x18$H\xb9\xe1\x045\xac0J\x1a\xa0\xce'ۈ\xe6\xb6\x18\x19\"j\xdf:\xf3\xcf.7\tC\xb2\xa9U<\xcaa\xff\x19\xc7\x18\x9d\xb2\xb0U6\xe1{P\xae\x81^\xbd@\xc4\xccSr\a\xf9r\b\xd5\xf0\xbb\x8f\b\xc6m\xfc\x02:\xe6@\x8b\xf9\xbc5<\xf6\x8e\xf6}\x9f\x9c\xe1\x97yn\x03\xb3N\xec#\xcd\x1bܢ\x9d\x93i+\x15ug\x185\xa7\x88s\x15L\x95\xa1\xbb\xdc?u\xdf\xfc/\x0e\xddF\uf3b0\xf2\x8bȌ8\x1a\xd7\x1eLd\xcd_\xa9\x80\xa8\xbe\b\xa6,-\xa7\xd8\x13-C\xc2\xce\xc3\xe7\xd57\x18\xb7\xce\xc58e\xbf(g\xb7\x90\xf6%\x10\u008c\xdb`,E\xccʓ\x9c\xe8\x9a\xe0\x8d\xe3\xfc\xa3\xadAwJ?\xa5uo\x98F1K\xadjXfC\x815B\n\x8dblj\xb8s\xb0T=ڥ\"\xfc\xcf\v LS%\xc4\xdeV\x82C/<\r.\xac\x1dL\x8cNv\xa1^'\xad\xbe\n\xa8\xa5zB\xa0\xac4\x1b\xa3sk\xc0\xc6GP\xfb\xce\x1f\b\xac\x8f2Own\x06\xa7b\x8b|:z\x82\xe5[\x0e\x92\xed\x9f;ul4\xffǺ\xad\xc5+h\x00R\xdc\xe3\x87\xfa,\xe3e\f0\xa9\xdeI$\xa3\x88\x85\x06\xe1U\xac@L\xea\x10\xd3\xf9\xd6\xf2\xa1K\xfd\xf4\x06\x15\xfc\x9a1\x7f\xf1\xed\xd5\xf9\xa5w,r\xbf\x1a\xf4\xe8m\xeaq\xe5T\xa0ο\x12{\xc7\xd8\xff\x110\x96\x1b\xf3j\xe8x\xf1\xeen\xa9+\x81\xc9^\xdc\xf7\x01\xc5\xef\xf1\xf2I\x87\x80\x9b\xb2܀i\x88\xbc\xe9\xa0\xcb\xd5\xdd[(\xbc\x10~\xb5H\x17\xdav\xfc\xf2\xf5\xfc\xba\x06\xe5\x82\x1f5(Kʝ\x85 \xaf\x9b萑\xf6\xf6\xf9l\xb8\x9b\xcc\b\xf0\xdc\x19\xdd\xe5\x85Y\xc0\xe2\xccD^\x9b\xecso\x87/}o\"N4Q\x95\x9bkbX\xc0\x9f\r_p\xabK\x1bT\x83\x83\xdc\xe4x\xac8\xd1\x1b</ǏT\xeb\x14#:\x1e\xb2\xe47\xc0\xe9\x82[Mot\x8a?\x1f\xbe\xbc\xe2|\x9f\xf6\x91\xf91\xab\x8c+hBĊL+/\x17\x99\x13\xef˞tNF\xf9\x8e_R\xc7DMV\x14\xbf\aS\x1a\xe6\x15\x88\x9fw\x81Šѕ\xcb\xf7\xf4\xad\x98\x13\"凍V\xa7O*\xf9\xd6\b\rZdl`\xfdRn\x9a\x17b\xec\xcfqo|\xec\x15/@.\xe5\x8ä́\x8c\\\xb2V\xad-.\x80c\xba\xa4\xb2Ƀ\x87N\xd1D\x1b\x1e\x9d\xf9^b\xa6\x84\xb1kƫʀ\x8b\xf7A\x05_\xf1yb\xf4>z\x8dDx\xdeF\x17O2\xd9\x04g\x83$/\xa7急\xe1A>\x8c\xfc\x1b\x00\x00\xff\xff\x02\x83F4\xa5\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\x1c\xb7\x11\xbe\xf3Wt\xc9\a\xc6U\x9aYKI\xa5R{\x93\xc98\xc5ĦX\xa2\xa4\x8b\xcb\a\xec\xa0w\x06\xe6\f\x80\x00\x98\xa56.\xff\xf7T\xe31;\x0f\xec.Ɋ\x9c\xb9\x90\x8bG\xe3C\xbf\xbbQ\x14\xc5\x05\xd3\xe23\x1a+\x94\\\x03\xd3\x02\xbf8\x94\xf4˖\x0f\x7f\xb3\xa5P\xabݛ\x8b\a!\xf9\x1a\xaez\xebT\xf7\x01\xad\xeaM\x85\u05f8\x15R8\xa1\xe4E\x87\x8eq\xe6\xd8\xfa\x02\x80I\xa9\x1c\xa3aK?\x01*%\x9dQm\x8b\xa6\xa8Q\x96\x0f\xfd\x067\xbdh9\x1aO<\x1d\xbd\xfb\xae|\xf3\xb6\xfc\xee\x02@\xb2\x0eנ\x15ߩ\xb6\xefpê\x87^\xdbr\x87-\x1aU\nua5VD\xbb6\xaa\xd7k8L\x84\xbd\xf1܀\xf9N\xf1Ϟ\xcc\xf7\x9e\x8c\x9fi\x85u\xff\xca\xcd\xfe(\xac\xf3+t\xdb\x1b\xd6.A\xf8I+dݷ\xcc,\xa6/\x00l\xa54\xae\xe1\x96`hV!\xbf\x00\x88W\xf4\xb0\n`\x9c{\xa6\xb1\xf6\xce\b\xe9\xd0\\\x11\x85Ĭ\x028\xda\xca\b\xed<S\xee\x14\x87\x00\x10\x02B\xb0\x8e\xb9ނ\xed\xab\x06\x98\x85[|\\\xdd\xc8;\xa3j\x836\xc0\x03\xf8\xd5*y\xc7\\\xb3\x862,/u\xc3,\xc6\xd9\xc0\xde{?\x11\x87ܞ@[g\x84\xacs0>\x8a\x0e\xe1\xb1A\t\xae\x11\x16\xc2m\xe1\x91Y\x82c\x9c\xbfe\xfe`?Oۭc\x9d\x9e \xb82\xc8\x0e[\x03\x04\xce\x1c\xe6\x00\f\xfc\x04\xb5\x05\xd7 q\xde+\x16\x13R\xc8\xda\x0f\x05I\x80S\xb0A\x0f\x119\xf4:\x83LcUj\xc5K\x99\x88N`\xdd\xceF\xcf\xf1\x86\xd6\xff\xafQM\x00\xdd)\xfe\x02(\xcf:7,\x9e\x9c\xfay<t\xeeਛ\x06\xb5\xb2\xc2)\xb3\a\xc1Q:\xb1\x15h`\xab\xccXm\x8e@\xa0\xbd7æ\t\x94\x0f\a\xb27\xd7O\xd5\xd8\x06\xfd\x9aĎ^\xb7\x8aq4Đ\x86I\xde\"\xe9\x1a\x03g\x98\xb4\xdb\xe1\xc09\xaa\xb4\xed\xe3^O\xd9\xf3)\xd1\x1b\xcd<G<\x91c\xf7N\x19V#\xfc\xa8*\xef2\xc9\xc8\fN\xac\xcc6\xaao9l\xd2)\x00\xd6)\x9359B\x1cvE\xba\x89\xec\xcc\xf2\xa7g\x1eG?\xa2\x9d<|Y\x91\xd5\n%\xf36\xfd\xaeƼ=\x87\xe9ݛ\xe0@\xab\x06;\xb6\x8e+\x95F\xf9\xee\xee\xe6\xf3\x9f\xef'\xc3\x00\xda(\x8dƉ\xe4\xd0\xc37\nW\xa3Q\x98\xb2\xfa\x92\b\x86U\xc0)N\xa1\rV\x11ƐG\fA\x1c\u0092\xea\x1a\xb4(ݘ%\xe9S[`\x12\xd4\xe6W\xac\\\t\xf7h\x88L\x12L\xa5\xe4\x0e\x8d\x03\x83\x95\xaa\xa5\xf8\xcf@ے\xaeѡ-s\x18\xe3\xca\xe1\xf3\xae_\xb2\x16v\xac\xed\xf150ɡc{0H\xa7@/G\xf4\xfc\x12[\xc2O\xca \b\xb9Ukh\x9c\xd3v\xbdZ\xd5¥0]\xa9\xae\xeb\xa5p\xfb\x95\x8f\xb8b\xd3;e\xec\x8a\xe3\x0eە\x15u\xc1L\xd5\b\x87\x95\xeb\r\xae\x98\x16\x85\x87.}\xa8.;\xfe\x8d\x89\x81\xdd^N\xb0.\x14#|>\xbc\x9e\x90\x00\x05X\x10\x16X\xdc\x1anq`tr\x90\x1f\xfe~\xff\x11\xd2\xd1^\x18s\xee{\xbe\x1f6ڃ\b\x88aBn1:\x98\xadQ\x9d\xa7\x89\x92k%\xa4\xf3?\xaaV\xa0\x9c\xb3\xdf\xf6\x9bN8\x92\xfb\xbf{\xb4\x8edU\u0095\xcf]\xc8a\xf6\x9a4\x97\x97p#\xe1\x8au\xd8^1\x8b_]\x00\xc4i[\x10c\x9f&\x82q\xda5_\x1c\xb86\x9aHI\xd3\x11y\xcd2\xa1{\x8d\x15I\x8f\x18H;\xc5VD\x0fE\xee\x9c͗\x97\x13\xc2yå/\xeb\x9d\xe6\x8b \x17\\f{\x1269\xf2\xa9\xc9a\x86\x95\v\xa2\x00\xed\xdc\xcb\x0e{Ƒ\xcbF\a[.(\x1c\x11\x03}Rq<s\x8f[\xc51\a\x9b\xb6\x82kX\xd0V\xca\xf8\xc8\x1f\xf5R.O\xa1O\xc9g\x01ӊ\x9f\xc1\x15Od`p\x8b\x06e\x85\xc9q\x9dJg2\xc8Ɖ\xc6\x12\xe3q\xa5\x80\x13^=\x8b\xf8\xdd\xddM\xf2䉉\x11\xbb[\x9e{\x86?\xf4m\x05\xb6\xdc\a\xba\xf3g_\xdel\xc3aާ9\x05\f\xb4\xc0\x90\x98\x0eA\x02\x84\xb4\x0e\x19\a\xb5\xcdR\xa4*\t\xc8\xf0\r\xc6\x1d\xaf\x83\a\x8b\xae\xf2\x10Z\x88\xf7\xc0\xc8w\n\x0e\xff\xbc\x7f\x7f\xbb\xfaG\x8e\xf5\xc3-\x80U\x15Z\x9f\x97;\xecP\xba\xd7C\xa9\xc0\xd1\n\x83\x9c\x12\x7f,;&\xc5\x16\xad+\xe3\x19h\xec\xcfo\x7f\xc9s\x0f\xe0\ae\x00\xbf\xb0N\xb7\xf8\x1aD\xe0\xf8\xe0\x96\x93\xd2\b\x1b\xd81P\x84G\xe1\x1a1\x0f\xa6\x03\aH\xbd\xe2\xb5\x1f\xfdu\x1d{@P\xf1\xba=B+\x1ep\r\xaf|Zs\x80\xf9\x1b\xd9\xce\ufbceP\xfdS0\xedW\xb4\xe8U\x007\xc4\xe1\xb1\xd1\x1d@\x06\xcb3\xa2\xae\xf1\x90U\xcd?\x1fT\xc8U\x7f\v\xca\x10\a\xa4\x1a\x91\xf0\x84Iz\xc1Q\"_\x80\xfe\xf9\xed/G\x11O\xf9\x05Br\xfc\x02oA\xc4bK+\xfem\t\x1f\xbdv\xec\xa5c_褪Q\x16\x8fqV\xc9v\x1f\xf2\xdc\x1d\x82UT\xbaa\xdb\x16!\x0f\xe2\xf0\xc8\xf6ą$8\xd27\x06\x9a\x19wR[S\xf6\xf3\xf1\xfd\xf5\xfbu@F\nU{OLQs+(\x9b\xa14&\xc4b\xaf\x8d\x8b`\x9e>\xdb\a\xf5q\n\xaa\x86\xc9\x1a\xc3}\x11\xb6=E\xc7\xf2\xf2%v\xbcLIҗIM\xe6\x8e\xe3\xff\x16ܟx9\x9fA?\xe1r\xe3*\xe3\xe4\xe5\x1e\xfa\r\x1a\x89\x0e\xfd\xfd\xb8\xaa,]\xadB\xed\xecJ\xed\xd0\xec\x04>\xae\x1e\x95y\x10\xb2.H5\x8b\xa0\x03v\xe5\v\xe7\xd57\xfeϋ\xef\xe2k\xec\xa7^hR\xfb\x7f\xcd[\xd19v\xf5\xa2K\xa5\x1c\xf6\xe9q\xec\xf2>fV\xf3\xbdd\x16\x8f\x8d\xa8\x9aT\x9cD\x1f{Ę\x04e\xc2<\xb8f&\xf7_]\x95\x89\xa1\xbd!D\xfb\"v\xf7\n&9\xfdo\x85u4\xfe\"\x0e\xf6\xe2I\xe6\xfb\xe9\xe6\xfa\x8fQ\xf0^\xbc\xc8V\x8f$\xe0\xe1\xfbR\x1c`\x15\x1d\xd3EX͜\xeaD5[=퉜I\xe3>L\x16\xa7D3\x93\xdf\x0ek\x9e\x95G:Vg\x12\xb7q3\xf3Tzw\x92_\xd3\xc6\r\xab-0\x83\xc0\xa0c\x9a\xe4\xfc\x80\xfb\"$\x04\x9a\t\x8a\xe6\x14\xb0\x87\xae\b0\xad[\x91\r\xdc1\xecǔ5r\x82\xcarV\xdbcw\xcfJm\xdc\x05:#\x85O\xa3\xa5I\x06g\xfaP\xae\xc9\xd9\xf5\xa4;\xb5D\x8b\xb2\xef\x96P\nxPZ\xb0̸A\xeb\x16\xfaE\x13\xaf\x96y\xc9\ta\x05^\x9e\xe1AlXgJ\x9d(\x8a\x90\x17\x0e\xe5\x8e\xefQ\xe6\xea\x89\xe3\xc5\xc4Q\x88T\xcfS\x96;\x85X\xe4\v\xcf\xd9\x1a*\xc4fCZ\xf1\x8b9#3}\xca49风\x91.\xabq\xdf\x1e\x7fF=\x1e\xda\xfe\x91\xa7\xc1\xfb\xba\xf4\x18@\xa5\xc7K+\xf2JQV?\xe9\xe8\x9d\x11\xef\xd5r\x87o~\x19\x1e\xd5]td\xbd\xa3G\x82xF\xae\xa4\x86\x11\xb9\xb0\xd3\xc7;\xa2\x86ܧ\xdcT\x11l\x99h\x91Cz\t\x9a\xef\xc9P\x1dS\xd9\xe0\x96\x82C0\xbdT\xc8FxCZ\xdb X\xdfU\xba\xb4'h\xf6\x16\xb9\xef\x80d\x98\xb0Lu\xb7\xcat̅.h\x91%*\xfb\xb6e\x9b\x16\xd7\xe0L\xbf\x9c>a\x89\x1dZ\xcb\xeas\xa6\xf8SX\x15\xea\xfb\xb8\x05\xd8F\xf5n(\xf0'\xee\xf1\xd2F\x9dz^\x8f![:OՙQicc\x8a߶~\xcf\xd8\x11\x1c^\t=\xaa\r\xe6S\x84\x97\xf8\x04\x00\xff\xfcu\x0e!\xad\xc9\x19\xd8\xe0\xbdNZ\x18\x9cpʷ\xf8\x98\x19]<ۍ'\xaf\x92\xc9d\xe6~\xf0\xd6\xf0\xac\xfbǃα .\x83F\xb5ɘ\x95c-Ⱦ۠!>l\xf6\x0e\xedԝ\xe7\xba9\xbe\n<\xb0q\xb4?\xc9/P\x8a\x85mŤﺒu9\x05\\Xݲ}\x86p\xba\x88\xcf\xf4ȸ\xc8\x05\x1c\xf49\x19\xb5F㧞ۅ\U00098b95<R\x97${\x16\xd2\xfd\xf5/'\xf2B!\x1dֳ\xe0\x10牝\xdf\xd3)_\xe7\x84\x13I\x8c\x95L\xdbF\xb9\x9b\xeb3Zp?,Lְx\xccÁZT\x85\x9c\xa8\x06\xdf\xf2,S\x9d>\x18\x9f\x83:Y|&\nŧ\xea\\\f\xbaG\xcd\fY\xba\x7fA\xb8\x9a?q\xbd\x06+|[\x942ϐ\x8a\x86\xa6\x85\xa5\xe0D\xa9\x952\x98q\x99\xb0\f+\x93 2\x85\xffGƏ\xac\x9e,\x06=r>\xa2\x1d[\xeb\xe3\x91~3<\x1b\xad\xe1\xb7\xdf/\xfe\x1b\x00\x00\xff\xffÊ\xc5\x01R\"\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x93۶\x11\x7fק\xd8q\x1e\xae\x991\xa9\xd8\xedt:z\xb3\xef\x9aε\xc9Yc\x9d\xfd\x92\xc9\x03D\xacHD$\x80\x02\xa0tj&߽\xb3\x00A\xf1\x9f\xa4\xd3M.\xe1\x8b}\xc0b\xf1\xc3\x0f\xfb\x0f\xab$IfL\x8b\xafh\xacPr\x01L\v|r(\xe9/\x9bn\xffaS\xa1\xe6\xbbw\xb3\xad\x90|\x01\xb7\xb5u\xaa\xfa\x8cV\xd5&\xc3;\xdc\b)\x9cPrV\xa1c\x9c9\xb6\x98\x010)\x95c4l\xe9O\x80LIgTY\xa2Ir\x94\xe9\xb6^\xe3\xba\x16%G\xe3\x95ǭwߥ\xefާ\xdf\xcd\x00$\xabp\x01Z\xf1\x9d*\xeb\n\rZ\xa7\f\xdat\x87%\x1a\x95\n5\xb3\x1a3R\x9e\x1bU\xeb\x05\x1c'\xc2\xe2f\xe3\x00z\xa9\xf8W\xaf\xe7s\xd0\xe3\xa7Ja\xdd\x7f&\xa7\x7f\x10\xd6y\x11]ֆ\x95\x138\xfc\xac\x152\xafKf\xc6\xf33\x00\x9b)\x8d\vx (\x9ae\xc8g\x00\xcd9=\xb4\x04\x18\xe7\x9e9V.\x8d\x90\x0e\xcd-\xa9\x88\x8c%\xc0\xd1fFh\xe7\x99i\xf5\x80ڀ+\x90\xb6\xf4\xac2!\x85\xcc\xfdP\x80\x00N\xc1\x1a\xa1A½2\x80_\xac\x92K\xe6\x8a\x05\xa4D\\\xaa\x15Oe\xd4\xd9\xc8\x04\xce\x1f\x06\xa3\xee@\xe7\xb0\xce\b\x99\x9fB\xf6;\x83\xea\xe1Y*\xfeL$\x8f\x05z\x99\x88\xa6֥b\x1c\rm^0\xc9K\x042Pp\x86I\xbbAs\x02E\\\xf6x\xd0}$_\xa2\xbe\xce\xcc5\xec\\CE\x90\xedm\xff\xb5;tiߥ\xe2\xcd\x02h\x8c\x1a\xacc\xae\xb6`\xeb\xac\x00f\xe1\x01\xf7\xf3{\xb94*7h\xed\x04\f/\x9e\xea\x82\xd9>\x8e\x95\x9fx]\x1c\x1be*\xe6\x16 \xa4\xfb\xfb\xdfNck\x16\xa5N9V~<8\xb4=\xa4\x8f\xc3ဖ\x9c-o\xae\xffO\x81\xbb&HwJ\xf6y\xfd8\x18\x9d\x02\xdbQ\x1a\xe3m\x9a\x19\xf4\xa1\xf6QTh\x1d\xabtO뇼\xaf\x8f3\x17\x06\xc2\xf4\xee]\beY\x81\x15[4\x92J\xa3\xfc\xb0\xbc\xff\xfa\xd7Uo\x18@\x1b\xa5\xd18\x11\xa3k\xf8:ɣ3\n}foHa\x90\x02NY\x03mp\x8a0\x86\xbc\xc1\x10\x9cEX0\xa8\rZ\x94!\x8f\xf4\x14\x03\t1\tj\xfd\vf.\x85\x15\x1aR\x03\xb6Pu\xe9#\xd0\x0e\x8d\x03\x83\x99ʥ\xf8_\xabے\xefѦ%s\u0604\xf8\xe3\xe7c\xb0d%\xecXY\xe3[`\x92C\xc5\x0e`\x90v\x81Zv\xf4y\x11\x9b\u008fd!Bn\xd4\x02\n\xe7\xb4]\xcc\xe7\xb9p1if\xaa\xaaj)\xdca\xee\xf3\x9fX\xd7N\x19;\xe7\xb8\xc3rnE\x9e0\x93\x15\xc2a\xe6j\x83s\xa6E\xe2\xa1K\x9f8ӊ\x7fc\x9a4kozXGN\x17>\x9f\xeb\xce\xdc\x00%;\x10\x16X\xb34\x9c\xe2Ht\fٟ\xff\xb9z\x84\xb8\xb5\xbf\x8c!\xfb\x9e\xf7\xe3B{\xbc\x02\"L\xc8\r\x05]\xbačQ\x95\u05c9\x92k%\xa4\xf3\x7fd\xa5@9\xa4\xdf\xd6\xebJ8\xba\xf7\xff\xd6h\x1d\xddU\n\xb7\xbe\x92\xa0xYk\xb2\\\x9e½\x84[Vay\xcb,\xbe\xfa\x05\x10\xd36!b\x9fw\x05\xdd\"h(\x1cX\xebL\xc4\n\xe6\xc4}\r\xab\x92\x95ƌ\xae\x8f\x18\xa4\xa5b#2\xef\x1b\x14~\x80\x8d\xe4Ӟ\xeaiץoͲm\xadWN\x19\x96\xe3\x0f*\xe8\x1c\n\r\xb0}\x9cZ\x13\xc1\xc9N\xce\v\xca\xc1\x06ɑR\x802.\xde\x17h\xb0\xbbƠVV8e\x0e\xa48d\xcbt\xa4\xe1\xc4E\xf8#+~\xe1\x18\x14\xee\xbdC\x18ܠA\x99a\x8c\x10\xe7*\x99\x89St\x12\xfa\x18\xe2i\xea\xe1L\xf4\x9c\x04\xfcay\x1f#fd\xb8\x81\xee\xc6\xfb^\xa0\x87\xbe\x8d\xc0\x92\xfb\x84ry\xef\x9b\xfbM\xd8\xcc\xc7\x0e\xa7\x80\x81\x16\x18*\xd26\x18\x83\x90\xd6!\xe3\xa06\x93\x1a\xe9m\x00\xe4`\x06\x9b\x15oC\xa4hB\xd21\x84\x13\xf5\xc0(F\t\x0e\xff^}z\x98\xffk\x8a\xf9\xf6\x14\xc0\xb2\f\xad\xf5\xf9\x1a+\x94\xeem\x9b\xb39Za\x90S\xe1\x82iŤؠui\xb3\a\x1a\xfb\xd3\xfb\x9f\xa7\xd9\x03\xf8^\x19\xc0'V\xe9\x12߂\b\x8c\xb7\xe1/ڌ\xb0\x81\x8eV#\xec\x85+\xc40i\xb5\f\x90u5\xc7\xde\xfb\xe3:\xb6EP\xcdqk\x84Rlq\x01o|%x\x84\xf9+9\xd6ooNh\xfdKp\xa07$\xf4&\x80k\xf3]\xd7#\x8f ]\xc1\x1c8#\xf2\x1c\x8f\x85\xe8\xf0\xf3\xc1\x9bBⷠ\f1 UG\x85WL\xb7\x17\xe2\x11\xf2\x11\xe8\x9f\xde\xff|\x12q\x9f/\x10\x92\xe3\x13\xbc\a!\x037Z\xf1oSx\xf4\xd6q\x90\x8e=\xd1NY\xa1,\x9ebV\xc9\xf2\x10\xaa\xfd\x1d\x82U\x15\xc2\x1e\xcb2\t\xf5\x06\x87=;\x10\v\xf1\xe2\xc8\xde\x18hf\xdcYk\x8dU\xc6㧻O\x8b\x80\x8c\f*\xf7\xf1\x8e\xb2\xd3FP\xd5@\xe5B\xc8y\xde\x1aGI3~\xb6\x0e\xe6\xe3\x14d\x05\x939\x86\xf3\"lj\xcaB\xe9\xcdK\xfcx\x9c\xfa\xe37Q\x02\f\x03ǟ\x96D\x9fy8_\xa9>\xe3pݷ\xd6\xd9\xc3m\xeb5\x1a\x89\x0e\xfd\xf9\xb8\xca,\x1d-C\xed\xec\\\xed\xd0\xec\x04\xee\xe7{e\xb6B\xe6\t\x99f\x12l\xc0\xce\xfd\x93y\xfe\x8d\xff\xe7\xc5g\xf1\xaf\xeb\xe7\x1e\xa8\xf7\xe8\x7f\xcdS\xd1>v\xfe\xa2C\xc5Z\xf1\xf9y\xecf\xd5\x140õ\xe4\x16\xfbBdE|\x0441\xf6\x843\t\xaa8y\b\xcdL\x1e^ݔ\x89\xd0\xda\x10\xa2C\xd2\xf4\xb4\x12&9\xfd\xdf\n\xebh\xfcE\f\xd6\xe2Y\xee\xfb\xe5\xfe\xee\x8f1\xf0Z\xbc\xc8WO\x14\xba\xe1{J\x8e\xb0\x92\x8a\xe9$H3\xa7*\x91\r\xa4\xa9\xf6\xbb\xe7D\xfcF\xa0\xb9P\xc5}\xee\t\xc7*t\xa2\x8ale\xae*#\xadd\xda\x16\xca\xdd\xdf]\xc0\xb1j\x05#\x86\xe3u5\xc5c\xd45h\x02]\x87\xc7\xfb\xcb\xc3\xe9@\xd2\a\u0557\x8eȔ\x11\xb9O[\xad\xef\xfbW\x84d\x15\xeb6\xff\xba_Ŵ\x162\xbf\nk\xb7\x97v\x01藎hDy\xa1\x9b\xe7\x8a)\x9c\xbd\x1e\xdf\x18-ʺ\x1aCI`\xab\xb4`\x13\xe3tG#\xfb\xa4\x897\xe3\xba\xe6\f\x13\xc1\x00.pд\x9e&\xdeQ\x8d\xfd\x84\xbaҏ\xd0\xdb\xc5[\xd1t@\xbe֮\xe8\xd9MEr\x1fa2\xfd:\x1c\xc8h\xc5gCҺ.9\x98<:\xd4p\xa2o\xab\x83\xd9^K\xb4{\x9a\xf1\xc3\xda\xf7ۮyZ\x87\x1e_\xc3{\x88\xf0.v\xfe\xe8y\xf3\xe2\xc7u\xa6\xe8\xe9\xd0k\xcf]\xb0\x81\xdb\xf1\n\xdf\xc92\xbc\xf1\tQ\xa1\x7f\xb1\x86\xf6\xe4\x9eٸ\xc9\xd4}CG_X\xea\xb3*\xa9C\xee\v{zwl\x98(\x91C\xfb+\x8bo\xa5[\xdfҹ\x99\xaac\xa3\xa2\xda\"\xf7qc\x02\xf4x]\xec\x92r\xe60!\x15#\tY\x97%[\x97\xb8\x00g\xea\xf1\xf4\x19\xf7\xaa\xd0Z\x96_\xf2\xaf\x1f\x83Tx\xf37K\x80\xadU\xed\xdaG\x7f\xe3h\r\x157\xb6\xb1\x82\xeb\x1a\x0f\x05\xb3\x97\xa0,If\xca\xe2Z\x97?orp&\x94=\xe0~btԵ\xeeN\xdeF\x13\x9a\x98\xfb\xde[\xc7U\x044\x1b]\xe2\xa0\x11\x83B\x95Ѻ\x95\xa3\xa4TWk4D\x84o\x95GFb\xe0\x98\xea\xa2\xf8\xd7בɣ\x86\x18\v\x83\xaa\xe6=\x991雊d\xbfN\x01\x17V\x97\xec0\xa17\x9e\xc4\x17Xd\xbe\xe4GG\x8b\x89^H\xee\xef\xe7\xae\xed\xfe\xb4?\x05L\x97\x7fS?,L\xddB\xf7W\x82\xc1|\xfb\x1b\xc8\xeb\xecp\xa6䳎\x19\xf7ܰ\xb7\xea\t_\x8ax^\xf5t\xbc놮q\xa0\xeao\xf3GƨI\xa2F\x83\x1e9\xef\xe8n:\xa7ݑz\xdd\xfe.\xb0\x80_\x7f\x9b\xfd?\x00\x00\xff\xffg\b\x17r\xc1\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc<Ms\xe3:rw\xff\x8a\xae\xc9a\x92*K\xdeI.)\xdf\x1c\xbfy\xb5\xaeݝq\xd9S\xf3\xce\x10ْ\xb0\x06\x01>\x00\x94GI忧\x1a\x00\xbfD\x90\x04e\xeb\xedlx\x13\x054П\xe8/p\xb5Z]\xb1\x92\x7fGm\xb8\x92\xb7\xc0J\x8e?,J\xfae\xd6/\xffi\xd6\\\xdd\x1c>]\xbdp\x99\xdf\xc2}e\xac*\x9eШJg\xf8\vn\xb9\xe4\x96+yU\xa0e9\xb3\xec\xf6\n\x80I\xa9,\xa3׆~\x02dJZ\xad\x84@\xbdڡ\\\xbfT\x1b\xdcT\\\xe4\xa8\x1d\xf0z\xe9ß֟\xfe}\xfd\xa7+\x00\xc9\n\xbc\x05\x8d\xc6*\x8df}@\x81Z\xad\xb9\xba2%f\x04s\xa7UU\xdeB\xfb\x87\x9f\x13\xd6\xf3{}\xf2\xd3\xdd\x1b\xc1\x8d\xfdK\xf7\xed_\xb9\xb1\xee\x9fRT\x9a\x89v1\xf7\xd2p\xb9\xab\x04\xd3\xcd\xeb+\x00\x93\xa9\x12o\xe1\v-S\xb2\f\xf3+\x80\xb0u\xb7\xec*\xec\xfa\xf0Ƀ\xc8\xf6X0\xbf\x1f\x00U\xa2\xbc{|\xf8\xfe\x1fϽ\xd7\x009\x9aL\xf3\xd2:\x02\x84\xbd\x017\xc0\xe0\xbbÍ6\xe0h\rv\xcf,h,5\x1a\x94ր\xdd#\xb0\xb2\x14<s\xa4n \x02\xa8m3\xcb\xc0V\xab\xa2\x85\xb6a\xd9KU\x82U\xc0\xc02\xbdC\v\x7f\xa96\xa8%Z4\x90\x89\xcaX\xd4\xeb\x06V\xa9U\x89\xda\xf2\x9a\xb0\xfe\xe9\x88K\xe7\xed\t.\x1f\t]?\nr\x92\x13\xf4[\x0e$\xc3<P\x88vk\xf7ܴ\xa8\x9d\xa2\x13Pb\x12\xd4\xe6\xef\x98\xd95<\xa3&0`\xf6\xaa\x129\x89\xd7\x015\x11'S;\xc9\xff\xbb\x81m\bQZT0\x8b\x81\xdf\xedåE-\x99\x80\x03\x13\x15^\x03\x939\x14\xec\b\x1ai\x15\xa8d\a\x9e\x1bb\xd6\xf07\xc7\x1e\xb9U\xb7\xb0\xb7\xb64\xb777;nk5\xc9TQT\x92\xdb㍓x\xbe\xa9\xac\xd2\xe6&\xc7\x03\x8a\x1b\xc3w+\xa6\xb3=\xb7\x98\xd9J\xe3\r+\xf9\xcam]:UY\x17\xf9\xbf4l\xfb\xd8۫=\x92\xe4\x19\xab\xb9\xdcu\xfepb>\xc1\x01\x12x/K~\xaaǢ%4\xbd\"\xea<}~\xfe֕3nN\xa9\xef\xe8\xde\x11\xbe\x96\x05D0.\xb7\xa8=\x13\x9d\xb4\x11L\x94y\xa9\xb8\xb4\xeeG&8\xcaS\xf2\x9bjSpK|\xff\xbdBC\x02\xad\xd6p\xefl\al\x10\xaa2g\x16\xf35<H\xb8g\x05\x8a{f\xf0\xe2\f J\x9b\x15\x116\x8d\x05]\xb3w:\xd8S\xad\xf3Gm\xbcF\xf8\x15\xb4\xff\xb9Ĭ\xa714\x8do\x83\x9a\xc3V\xe9\x9eq\xa0)\xeb\x1eи\xd2\xd2㵟,\xd8\xe9?'[\xf9\xaff \xc9\x0fm\xa2\x92\xfc\xf7\n\x9d\x89\xf3\x1a\x8b\x03\x932\x00\t\xf5\xfe\x9cX\xac\a\xff\x8fД\x1e\xfc\x91\x89*Ǽ\xb1\xb6\x03\\Nv\xfcy0\xc1\x9d:\x8cK\x92\x7f2\xff\xb4m\xd9\xfeK\xe64\xb2c\xa6\x11H\x02\xb9\xf4\xf0\x80K\x87l\x94\xd2\xf4p\x8bEds\x93\xd8\x01\xc8J\b\xb6\x11x\vVW8B\x19\xa65;\x8e\x10\xa6>\x82S\xe9Ҍ\x0f\x06A\xf0\f\xbb\a\x85'\x8d?d\x98\x1e\xee\b~r\xaapC\xe6\xac\xc6\xf2Q\t\x9e\x1dgI\x13\x9bT\xab[P\xbeZ\x827\xb8g\a\xaet\x04%\xd2H\x1a\xfa\xd2\x1e\xa4\xad1Ud\xcb\x02\x90\xfc<\x84\xa3\xc4\xda+\xf52\xc7\xfb?Ә\xd6jC朷\x06\x95\xc0\xedp\x88n\x10\xf0\af\x95\x8dl\x13 \xaf\xdc\x01\xa24\x94\xca\xd8q\xbe\x8f\xdb\x1e\xf0\xe6`LhaJh\x06\x98\x05SYs\x8e\x10\xed\x99M%\x91\xf6Z\x10\xe7ڱZU~\xec\xe9\xf9֡x\x9c\"\xb0a\x06sPA\xea+\x81&\xac\x95;\xf6\xb7v\xe5z\x14t\x83\xbc\xf74\x04۠\x00\x83\x023\xab\xf4\x90\x92)\xf4\xf4O\x8a\xad\x1c\xa1c\xc4j\xf6ſEl\x02$\x90\x98\xbf\xeey\xb6\xf7N\x00ɦ\x83\x03\xb9B\xe3\f\a9\xaa\xc71$a\x8e\xf7a\x91)\xd3\xd1>3:u\n/fN\xda'\xc1ܶό\xe1\x1d\x18\x96\xf0>zr\xb6\xcf\xffO\xc2\xd6'\xc9\x19B\xfb0\x98\xfa\xbeB\xeb\x82*r\xf6\x1f\xb6\x80Ei\x8f\xd7\xc0m\xfdv\x0e\"\x13\xa2\xb3\xfe?1c\x96K\xfc\xc3\xe9\xccw\x95\xf8I\xae\xccA$\xae4\xcb\xff\x132\xc5\x1d\x16\xcf\xe1\xacHf\xc8_\xbb\xb3\xae\x81o\x1b\x86\xe4װ\xe5¢>\xe1̛\xf4\xe5=\x88\x91r\xde\xd1S0\x9b\xed?\xff \xcfƴy\xa6D\xba\x9cN\xf6.q\x1d#\xf4\x0f\xe6\x19\xb8\xe0\xc2W\xae\xb1\xf0a\xf17G\xcd\xf6\x8d\x8b'\xee\xbe\xfc\x82\xf9\x14y M\xf2\x06\x88ܝl\xb6\xbbt\xf0\xf3S\xd1\b\xaeO\x133\xf9\x84\xc750x\xc1\xa3\xf7X\x98\x04b\x0e\xb3\xceߍFOC\xe2\xb8̋w\x8f\xf1\xe8\xc0\x84T\xca\xec\xecTQ\xf0\xcf\vF\xdc\xfd\xd8\xd3# \xed)\x04\xb8\x9e\x92\xf4\xc2\x11\xc2\x05\xde\xe9\xc4\x03\x97\x16\xabm\xd1<r\x90nHꧦ\xfd\x19h6l\xeb\xa4\x0f\x1dc?\x1a\xcf\"҂=/\x13\x11u\xd9C\x83N[\xea\xc4\xd8w&x\xde,\xe4\xe5\xfeA\x8e{\xc3\xfd狲\x0f\xf2\xdaGd\xc6I\xc9/\n\xcd\x17eݛ\x8b\x90\xd3o\xfc\fb\xfa\x89N\xbd\xa47\xdbD\x87n\x86-A\xb8\xfd\xf3\xe0\x13)\r{\xb8\x81\aI\x81K\xa0\x87˗\xfa\xe5\xa6χ\xfeSTƥФ\x92+wT\xaec+yb'\x82T\xbaǑ\xe1֚E\xfd\x82\x89`\xbf\xd1I\xe2\xe7\xfb\f\xb0`\x19\xe6u\xb4\xe9\xf2\x96\xcc\xe2\x8egP\xa0\xdeM\x1d\x1cݧ$\xfb\x9e\xb6\x85D\xab럅\x12\x96v\xb4\xd7O0\xdd\xf9\xfcfV\xa4\xb9\t\xa3jf\xcf\x0e\x1dIW\x8e\x0f\x9d\xc7\xc8\x1d\xb1\xce\xff\x98\xa5.\xcbsWBb\xe2q\x81\xc5_\xc0\x8b\xe1\xd9\xef7\xe6OȂ\x95\xa4\xbf\xffCǜ\x13\xe8\xff\x85\x92q\x9d\xa0\xc3w\xaeL$\xb077$ƺ\xcb\xd0\n\xdc\x00\xf1\xf7\xc0\xc40\x11\x1eAN\x91mA\xe1\x0fr\xb5\x1dx,\xd7\xf0\xbaWƟ\xa9[\x8e\"\x96\xb2\xe9?\xdc\xc0\x87\x17<~\xb8\x1e\u0601\x0f\x0f\xf2\x83?\xe0\x17\x9b\x9b\xc6[PR\x1cმ\xfb\xe1-NP\xa2$&\x0e\xfb\xb1jSr\xab\x82\x95\xab \xbdV\x15<\x1b\x9d'\xa3\xe9\xf1\xf6\xe9\x89S7E\xde\xe6ƃ{<\x85m\x92\xfc\x96\xca\xd8?\xc7\x13}#\xfby\xacg\xf4}\xdaH\xbel\xd6\xd7\x0f\xb9\xaf\xc6\x18\x93\a\xb8\xb5\xa8C\xf2\xcf\x1b\xe8:rxcL5\x97\xdck\x12{\xacI\xc8\x12\x81g\xa4ɗJR\xb6\xb8\xc4\xdb$\xba,\xf4\xd3?\xff\xe8\xe4&I\xb3\xe9w\x17\x91\xf7\xf6\x863U\x14\xec\xb48\x98\xb4\xd5{?\xb3\x96\xe9\x00\xc8s_\xef*\xa7\xcf\xe9nb-C\xae,\xf8\xca\xed\x9eK`\xb5\xd9@\x1d\x04\x8aA\xa9\xe6-\x98\x7f\xf6\xcc\xc0\x06Q69\xf5\x9f\xe1\x9c/\xb8|p\v\xc0\xa7w\xf7\v\xa0%\xd7Y\xec\xacI\xdd0\xb4y\xe1N\xaaT\x97J\xe5\xf0\xbaG\x8d=\xa9\x18&\xca\xc9\xd3L\x04)\x95\xed\xe6#\bn\xa9\xf2\x8f\x06\xb6\\\x1b\xdb\xddh\xaa\xc0U&U\x1c\x16r\x98\xb0\xfb\xc6\vT\x95=\x83\a\x9f\xdbٽ\xban\xc1~\xf0\xa2*\x80\x15\xaaJp\n\xfcC\xe7\v/\x9a\xe2k\xe0\xc0+㶩C\xb9̌UĥR\xa0Me\xf1\x06\xb7d\x8e2%\r\xcfQ\xd7\xcd\x01\x9e\xb3\\\x91\xe2n\x19\x17U\xac\xec\x13{\x96\x86\xb7\xf2\xb3\xd6gE\xb7_\xfd\xccN\xb6q\xaf^\xfb\x04J&\xc1\x9e\x1d\x10\xf8\x16\xb8\x05\x94\x19\xf1\x05\xb57\xd9n\x89@\fG\x9ad\xb1L3\xf0\xf4\xa0\xac\x8a4\x02\xac\x9cfs9\x99L\xeb\x0e\xff\x95qq\t\xb6\x91䝯\x1a\xbf\xb5\xb3\xff\x10\xd5h\x8cJ\xfa\x11\xb6AxB\x96\x1fk\xfd`\xd6R\x88\xebd@\x81\xaed\xd7\"^@3\x96ąa\x17\xef\x19\xf0q\xc9\x13\x18{R\a\xe0\xb6\xeb\xed\x10\x88\x8bz;\xb4@sН\x93\xd2y\xe8\x01\xa0\xa3\xb2v\x9c\xdd\xde\x1b\xa9Y\xe0\xf9l\x90\x02[\xcc}\xb2\x8c\x8e\xcf\xe0G\xfb\x96\xa7\x91\xf2y\x14\xbb\xe5\xaeK\x12g\xeb\xa7\x17%\xb9\xf4\xa0>\u0a92/R\xbdʕ\x8b.\xcdl\xde\xfet\a\xe9\xe9\xaaw]ޞm\x89\xfeH+ԗ\xd7t\x99\xaa\x0f\xf4\vX\x99d\xb9Y\x14\xa1OI\xc1\x9c]\xf3\xed\xb4#\x7f\xce\xeebj\xfd\x89ɡ\xf8y\xef\xfb`S{\xac\x1e\xe2\xb3:\x0e\xc9\xeb\x1e\xed\x1eu\xdd`\xbbr\xbd\xc41;\xdd\xd6H[ߺi\xba\"\xf9\xa9\xdd3\xdf\fx҆\x15w\xbee%\xc45\t6\xab\x84\xf5-\xb1\xba\x8a\bQR3\xd2F)\x81\xec\xb4=7\xa5\x9a?W\xc3\xef\xf7\xa555\xf4\xba1MՋD0\xf4\xbc\xf4ݧ\xdd\x02q\xbf\x18\xef\xd2P\xf5N\xff\xe1-k\tu\xf6\x99\xea\xfat#\xdf\x14\xbd\x86bӥX+\x83a\\\xe8\xf0\xfc\xb9\xc8g\xb1\xf8Z\x06=\x18u@\xfb\x14\x8cL9iQp\x96\x9b\xc2HW\xecf<f\\H\vC\x8a\x8a \xdeeN\x13U\rظ\xf4gж\xd0q\xcd\r|\x82\xbd\xaa\"m^\x13ԙ)\xfa\x8f\x97\xfaCb\x1b-;|Z\xf7\xff\xb1*\x14\xfe]6&\x82\xdd\xeb\xbeɭ8oE\xe6\xfc\xc0\U000ca25e\x92uĢ\x95\x1eP\x1a$\x17\xb1\x9a\x1f\x89U=\xbf'F\xf0\xb5\xf4\xb9\xff\xc5\xe6h\xdaEL\xeb\x0f8\xbb+\xa0_\xf5\x1f9\xa4\x96\xa6\xc1\xd3\xdb\x1f\xd3\xeb\xfeӅ\xfa%\xd5\xfe\xd3Z\xfe(\xd0\xf9\x1a\x7f\x8aw?S\xcf?\xa3\x8a\x9f\xd8\xc1\xf5\xe6\xa4}J\x9d\xfe\xac\xea\xfcl\x93SbM\xbe_m\x9f\x06\xb9\xa0\x12\x9fD\x9c\xf9\xaa\xfb\xe2Z{\xa8mO\xe2\x91\\a\x8f\xd4\xce'\x01\x8f\xd6է*\xe6\xd3$\x8fT\xd3\xd3\xeb䓠]\r}\xbe:\xfe~=p\xef\x11\x05\x8c\x9b\x9a\xd9\n\xf7\x9b\xa2\x84\x84\x1a\xf6\x92\xca\xf5,\xc5άR7U\xe8\x91u\x97֦\xfb\xb5\xe7\x11\xa0)\x15鑊\xf3\b\xc4\xc9:tj\x9dy\x04\xf6̱;)%\x93\x7f.\xa9/7a\xc8\xdfXYr\xb9\x1b\xcaI\xaa4MJҠ8\xdd]\xb3'J\xddh\xa1\x17gŖ\xf47E#1Y\x9d\xd6\xe3Ҫ5\xdc\xc9\xe3\x00\xaekS\x8f\xc6 \xfd\xabD\xb4\xadW.D\xf7\xbe\x8c\x03\xdb\x05\x15n\x9e\x99xf\x80\x06\x8ey\xd8Q\x16*\xdd\xf3\x8e\xe7B\xb0\xaf'û\x89\xc2io;\xe6hs\xbb?\xd3\xdb.*ay\x19U\xf9R\xab\x03wi\xc7=\x1e\x1bz\xfe]\xb9\x9b*\x9b\xa3\x83\xf4\xf5\xa9\xd1\xc6\xf5I\xe0\xc0b:\xf4\x8aB\x003C\xf43\x7fY3S+w\xff\x8a8Y\xcbC\xb8\xd4y\xed46\x16\xb1\xcb\xfa\x1aaA`܅O\x13Ɉ\x8c\x9eE\xd3\xfe\xb0w\xddݻ\xdf+\xd4GP\aWg\x0e\x0e\xd2L+\xb8\xb7+\x86\xe2\xb7\xda\xd2\x05s\xe9\xaf\b\x9f\xc4\t\xad}\x81;\xe9O\xec(ؓ=:8d\xe2\xda؈\xac9\x85=#C\xa3P\xa5jf\xc7\xe5a\xf2`J\xed\xa3\xbel\xa4\xb4<V\x9a\xf5R.\x12/\x9d\x1f1M\x80L\xed\x8bN\xab\x89\xcc\xf6A_*r\x9a\x8b\x9d\x92\x9dƴ>\xe7K\xf47/\xe8k^\x10C-\x8b\xa2\x92ɔҿ|\x91X\xea\x82\xd1\xd4%\xe2\xa9\xf3\"\xaa\x19\x90'}\xc9)\x1d\xc7Ie\xbc\xe4\x9aMJ\x95m\xber<\xddI\x9c\xd0A\x9cP\r\x9a\xdbiB\xa7\xf0\xb2\x0e\xe1\x04\x1a^(ֺP\xb4u\x89x\xeb\xb2\x11\xd7l\xcc5+93\x7f/\xeb\xec=\xbb\xc8P\x97\xa3\xbf\xa8\x1c\x1f\x95\xb6s\x01\xc2\xe3\xe9\xf8H\t\xb0\x134)\x91\x83\xac\x87\xc6*\r\xe4\xfb\a\xbf\xff<\xa4\xe2պ\xb0\xfe\xe3\xf79|\x9e\x9a\x81ӈ\x90\v[\xc7s\x11<h\xbe\xc3\xc5HV\x9a}$\x1c|;2ϖ\xd9*\x11\x1f?\xb6\x87\x12\xcf\xf6\x9d\xba\xd7+\xd6\xe5W\xdd\xf9\x86\xd4ɞ\xc8A\xf2\x80\x9c\xfe\xb9\xb3Srq\xdd\xf1\xc8\xff\x98ZG\xe2\x1d\xf5\xb3o\xa7{\xf2\x8c\xe8\xb1\v1)\n\xace\xa1\xa5\xcb\x19U\x8eY\u06ddp\x91t\xfa\xc0I\xbc\xde|\xf6\xc5\xe6ybE\b5v\xa79\xe5\xde\xf2?\x94\x9e\x13f\xdad{\xcc+\x81\t_\x1bz\xee\f\x9d\xff\xdeP\r8\xa6\x93\xaa\xff\xc5!\xa2k\xa7ŘN\xba\xfe\x97\x8d\x02\xd1\x03dbO\x04j\x17\xa4?\xd2\xfd'P2:\xc0M\x95eh̶\x12u\x99<\xd3\xc8,\xe6\xf5\xf0h\x9bh\x8dÂzpܛ[u\xbe\xe9t\x95\xc0\x19\x131\x93\x13&2c\xa5\xadt\x10\xf3\xac\xd2ڡ\xec\xffS\xdb\xc1w\xa8z`ǍV\xe8c\nUxcYQ\xceH\xc8\xfdp\x86\xfbڛ\xce;u\xfb\xee\xe7z\x9ar|,\x9f\xc5L\xd3J\x95\xaf;\xb0=\x18\x17\x0e\x10h\xcc\x01\x0f(AI\xd7\xf8\x8c\xf9T\xdf\xde7\x17/\xeb\x03ꏦ\x81\xe3:\t\xb6Jóe\xda6[\x1fJ\xc4V\xe9\x82\xd9[ș\xc5\x15\xcd>\uf10c\x7fJK\xeb\xf9Ԧ\xeb\xa0\x0e\xfe\xafk{v\xec\x15\"\xf4=\x17h\f\xdb\xd5߇zE\x8d\xb0CI$\x9e\xfaZP\xdb:\x1e4\xb8i8!j\xb1\xccV,,\xe0O\xca&\xe9\x1bK\x18\xfaO\xd0\xd1\x10\xb6\x1b\xd5\x1b.-\xee\x06\xe9\xd6ж\xfe\x84̜~\xb2p@\x88_\xbbcC\xb0\xeci\xe0\xef\xc73\xdf\x1d\xe2\xbepg\xb9\xc6)\x0fA\xb9\x95\x17\xf5|\x94{f\xe6\xcc\xe5#\x8di\xeest\x94\xb2\xb1\x94O#{\x8a\xf7\x97\xaf\xe0\v\xbeF\xde\xfe\xea\x84\xde%@⪴\x82\a\xf9\xa8Վb\x92ȟ\xbf1n\xb9\xdc\xfd\xaa\xf4\xa3\xa8v\\6\xbd7\xcb\x06?2m9\x13\xe2\xe8\xf7\x13\x99{_+s\xe4\xbf\xf9\xd9#\x7fL1)\xe0<\x1b\r\xf8am0ťWtw\x9bb\xa3*\xdbՊ\x8f\xa6U\x98x&\xd7A[\xc3\x17e\xb1N\xd2\xf1>Pn`\x83Ʈp\xbbU\xda\xfa\xe0m\xb5\x02\xbe\r\x86:\x16d0.\x9c\xaf\xe1?\xb8H\x0eHSVnN>\xa5\x81\xc9#h\xa7\x15\xceI)\xd8\xd1\xf7&\xb2,\xab\xc8\x0e\xdc\x18\xcbb\aڛ\\[\xe7\xdc\x04i\x1e\xc9c\fZ\xbc\x9a\xf1ͽ̪ؠv\xdd\xdc\xf4\xb7'\x9d\xbb\xe8\xe1MP\xb4@\x01\xee:H\xe7\x9e\x19\x18R\xe7x<=e|\xdc\xff\xca2\xf10\xee\xa8\xf5;\x91\x9b\xc15\x02n\xfa\x10\x8dާ\xe5ƛ\x90\xb8\xa9\xa7\x12ϲ=\x93;\x12\x1f\xad\xaaݾ\x16\xc11K=\x96?\xa9ܗ\xedJ\xa7\xa9\xa6Nv\xdbJ\xcbN\xae&\xa4\xbf\xf3v\xbbS@\xa7I8\xe1g\xea\xb6\x15\xaf\xb5\x19w\xfe\x92FLfb\xde\xce\xc8\xe4\x11\xfaG\xf6Ϛ)\xcc\x1ce6\xdd\x1f\xe8\xdb\xc0\xf9\xc45\x80)bD\xf1m,\xe09\xf86\x93\xd3\xf1m\xbd^ql}\xa9%ȏ\xfb\xd9\xef@\x0eo\xd2ϡ\x85\x9f9\xa6x\x0e\xbf\xc8\xce\x17\xb1;d\x1bP\x92\x83\xe9\xaa\xc0\x83\x9c\x06\xd4n\xdb2Z\x98\x9e\x979\x17t\xf5\x06\xbf͛v\v\x93/\xfd\xf3z\xc1\x87ƍ\xf9\x9c\xe2\x0f\x7f?\x19~\xd2lM\x9eq\v1\xf8\xb0\x11\xe2\xfc+\xdf\xd6\xdf\xe8\xde\b\xfc\xb7\xc1\x88?\xb8i\xfa\x95i\xc9\xe5n\x0e\xf9\xdf°H8\x10 D\x02\x82\b\x12M\x88\xb0( \xa879\xf2\x19\xda&HxCH\x10=N\x06/\x9d \xe7\x1d\"\x87\x95\u009b\xff\v\x00\x00\xff\xff\x97{a\x8a9_\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Mw$)rw\xfd\n\x9e|\x18\xdbOU=m_\xfctk\xab{\xecz;ӭ\xd7\xd2\xf6\xc9\x17*3J\xc5(\x13r\x81,uy\xdf\xfew\xbf\b \xbf*\xc9$K\x92gfW\\\xba\x95\x05A\x10\x11\xc4\a\x04\xb0Z\xad.x%\xbe\x816B\xc9k\xc6+\x01\xdf-H\xfcˬ\x1f\xffì\x85zwx\x7f\xf1(d~\xcdnjcU\xf9\x15\x8c\xaau\x06\x1fa'\xa4\xb0Bɋ\x12,Ϲ\xe5\xd7\x17\x8cq)\x95\xe5\xf8\xd9\xe0\x9f\x8ceJZ\xad\x8a\x02\xf4\xea\x01\xe4\xfa\xb1\xde¶\x16E\x0e\x9a\x80\x87\xae\x0f?\xae\xdf\xff\xdb\xfa\xc7\v\xc6$/ᚙl\x0fy]\x80Y\x1f\xa0\x00\xad\xd6B]\x98\n2\x04\xfa\xa0U]]\xb3\xf6\a\xd7\xc8w落\xf3\xed\xe9S!\x8c\xfdS\xef\xf3\xcf\xc2X\xfa\xa9*j͋N\x7f\xf4\xd5\b\xf9P\x17\\\xb7\xdf/\x183\x99\xaa\xe0\x9a}Ʈ*\x9eA~\xc1\x98ǟ\xba^1\x9e\xe7D\x11^\xdcj!-\xe8\x1bU\xd4e\xa0Ċ\xe5`2-*K#\xbe\xb3\xdcֆ\xa9\x1d\xb3{\xe8\xf6\x83\xe5W\xa3\xe4-\xb7\xfbk\xb66To]\xed\xb9\t\xbf:\x129\x00\xfe\x93=\"n\xc6j!\x1f\xc6z\xfb\xc0n\xb4\x92\f\xbeW\x1a\f\xa2\xccrb\xa0|`O{\x90\xcc*\xa6kI\xa8\xfc'\xcf\x1e\xebj\x04\x91\n\xb2\xf5\x00O\x8fI\xff\xe3\x1c.\xf7{`\x057\x96YQ\x02\xe3\xbeC\xf6\xc4\r\xe1\xb0S\x9aٽ0\xf34A =l\x1d:?\x0f?;\x84rn\xc1\xa3\xd3\x01\x15\x84w\x9di \xb9\xbd\x17%\x18\xcb\xcb>\xcc\x0f\x0f\x90\x00\x8cHT\xf1ڐp\xb4\xado\xbb\x9f\x1c\x80\xadR\x05py\xd1V:\xbcw\xb2\x97\xed\xa1\xe4\u05fe\xb2\xaa@~\xb8\xdd|\xfb\xf7\xbb\xdeg6\x90%O)&\f\xe3\xec\x1bM\f\xa6\xfdLev\xcf-Ӏ\x9c\ai\xb1F\xa5a\x15\xa8\x9b7 \x19S\x9aU\xa0\x85\xcaE\x16\xb8B\x8d\xcd^\xd5Eζ\x80\fZ7\r*\xad*\xd0V\x84\xa9\xe7JG\xa3t\xbe\x0e0\xfe\x01\a\xe5j9I\x04C\xc2\xe7'\x14\xe4\x9e\x0en~\b\xd3\xe2OL\xea\x01fX\x89K\xa6\xb6\xbfBf\xd7\xec\x0e4\x82\tXgJ\x1e@#\x052\xf5 \xc5\xff6\xb0\rJ\xbd%a\xb4\xe0\xf5A[h\x02K^\xb0\x03/j\xb8b\\\xe6\xac\xe4G\xa6\x01{a\xb5\xec\xc0\xa3*f\xcd~Q\x1a\x98\x90;u\xcd\xf6\xd6V\xe6\xfaݻ\aa\x83&\xcdTY\xd6R\xd8\xe3;R\x8ab[[\xa5ͻ\x1c\x0eP\xbc3\xe2a\xc5u\xb6\x17\x162[kx\xc7+\xb1\"\xd4%i\xd3u\x99\xffS\xe0\xa8\xf9\xa1\x87\xeb\xc9|s\x85\x14\xe1\x04\aP#:\x81qM\xdd(ZB\xe3'\xa4\xce\xd7Ow\xf7]a\x12fH}\xa2{G\xc2Z\x16 \xc1\x84܁\x9f\xd1;\xadJ\x82\t2\xaf\x94\x90\x96\xfe\xc8\n\x01rH~SoKa\x91\xef\x7f\xa9\xc1X\xe4՚ݐyA9\xac+\x9c\x81\xf9\x9am$\xbb\xe1%\x147\xdc\xc0\xab3\x00)mVH\xd84\x16t-㰲\xa3Z\xe7\x87`\xde\"\xfc\ns\xfc\xae\x82\xac7e\xb0\x9d؉\x8c&\x06i\xcfF\x05\f4\xa8+㳖~!55\xfc:\xc0\xc3\xe9\xb2\xd0+\x18\xb4\x1fvO\x1cn\xcd\x18ʕ\x83\x86:E\xaa!wǴ`\x87\x12\x1e\xca\f&}\xad\x97j\xdfN`2\xaf\xea\xd6\x11\x1cO\xb8J?AY\xa1ژA\xf1\xdeWC\x14\x91>y\xe35\x05\xc3\x1fԬ\xf2ڕ\x9d(7\xean\x0fȷ\x83Ƚ\xf6:\xe1*\x9b\xe4,\x96̈;\xc9+\xb3W\x16m\x9c\xaa\xedX\xad\xc1\x00n\xee6\x83F\x1d\xce#VdÉ\xd1V\xb1'.N9\xed\n\xca\xe5\xcd݆}C\x97\b\x02L\xe6,9\xb3\xb5\x96\xa4\x8e\xbf\x02Ϗ\xf7\xea\xcf\x06X^\x93V\nv\xf9*\x02x\v;\x9c\xf4\x1a\x10\x066\x00\xadq\x0e\x18BM\xd5vM\x0eG\x0e;^\x17\xd6+9a\xd8\xfb\x1fY)dm\xe1\x94\xefl\x9a\xf7D$ny\xa9\x0e\xa0\x13h\xf8\x91[\xfe\v\xd6\x1d\x90\x0ea0\x02\xe2\xd9Od\xdc\x1e#\x03\xc5&['\xa9l\xb3\xeb@\x15\x86]^\xe2<\xbbt.\xf1啫[\x8b®\x84\xa4~\"0]\xefO\xa2(B\xff\xe7Q\xc3\x11\xd7\xf1\xd6ܫ\x9f\x8c\x13\xeb\x14\xe2D\x9a\x8e(\x98J\xe5\xec@\xf5b2&\n`\xe6h,\x94\x9eR\x1dυ\x88Kڱ(<\x18öǀ\xfb\xf8\xb8e]\x14|[\xc05\xb3\xba\x1e\xefvJ\x91\x8d\xd1\xe6+\x18+\xb2\x04\xca\\\x0eI\xe3Z\x8e\x10F\xd3\x0f\x11\xa2\f(\x80.\x0f\x7fD\xb7\xdbS\b}\xa7\xa2\xe8\x10w\x9e*\x8c\xfd\x8fd\x1f\xd1\xdcgh\x84\xaf\xbdq\x17P\x90C!\x15+\x94|\x00\xedzD\xc7)H\x98\x06\x94\xb8<\x02\x15-\xad\x86\x02]\x06\xb6\xab\xd1\b\xaf\x19j\x82\xa8\x8c\bi,\xf0|}\xf9Z̃\xefYQ\xe7\x90\xdf\x14\xb5\xb1\xa0\xef0\x04\xccC\b<\xaae\aL\xfc4\t\xc0\xbb_\x85\xc8\x00\xf9\x90\xb9J+\x8a4cDj=\xb1c\x05.\xf0E\xa6zL[\x17\xab\xa3*\fX\xacr\xf9\xaf\x971%\x8a\x12\xd0\xef\xbdߏa\\CC\x8d\x9eF\x8d@l\xf4,\x94\x95=\x8eˑ\xb0PF\x888\xabr\x16\xb0\x97k\xcdǔj\x18N\x13џ\xcf\xde\x18\x88\x01\x83e\xa8\xf6\x1b\xb1x\xd8\xff?\"\x93\xcfb\xab\xa1u,.$\xb2\xb3\x10\xc6\xf6\xb89\f\x88\x1a\xcc0vF\x9ab\xd0\"\xa4\x83\x89ʭü\xdf3\xcdΙ\t1\xd1o$͋\xf3\x9eǄ\xea\x0fH\xb0\xbdR\x8f)D\xfao\xac\xd7\x06\xca,\xa3%U\xb6\x85=?\b\xa5\xcdp\xb5\x05\xbeCVۨ\x9e\xe0\x96\xe5b\xb7\x03\x8d\xb0h\x81\xb0YO\x9c\"\xd6t\x98\xc0:\n(Za0\xae\x96\xe9\xc8<\xa2Fl(\x14\x8eE\xa12B\x1c\xbdx\xb2\xee\xb98\x88\xbc\xe6\x05\x19z.37>\xde\xe0\x17sOf\x04\xe2\x04\x7f\xe7N\x84Q \x97zQ\xb6\x92\x80\xeeu\xa9t\xcc\xf3t\xe5\x14L\x9c\f[N\xc1q,$m\x8b\xae\v0\x1e\x15\xe7\xc0\xb6z\xe7\xaa\xe5\x94[\xa0*\xf8\x16\nf\xa0\x80\xcc*\x1d'O\x8a\x10\xb8\x92\xaa?#\x94\x1dѤ\xfd hV\x89\xb6\x05\x03̽\xc8\xf6\xce\xddD)#X,W`Hc\xf0\xaa*\"V\xa8-\xb3\x92\xe1;\x9bS\x1amIP\x1fC\xb81EҖD\x1dܖ\x19mܧz#6oD\xef\xa1)\x9f%웓\xe6//\xecHn\x01\x86\x9c>\U000bab98\xb0\xe1k\nԞ\x1fh\xfe\xce\x18w\xdel\xd9\f[\xbf\xf8ly\x11\xae5h\xfc\x9d0\x8d\x8c՝\xb7U\x8b\x18\xf6s\xb7\xe5\x15\x13\xbb\x86a\xf9\x15ۉ\xc2\x02\xf9Rs\x88v\x1c\x9dYν$\x81Rm/\x96\x92\xdbl\xff\xa9Y\xd6Nh1\xa0\xd5\x10\x80\xf3\xcbC\fC<H\x00\xc9\x1a\xa7\x82vd\x84\x86\xd2\xed\xf4\xdc\xd3\xfch\xbf\x90\a\xf8\xe1\xf3\xc7\xd8Jb\xbf$J\xeaɠ>\f<\x9d.\n4\xc0$\x90\x9dA\x91\x9b\xd6\xc4xn?\xef\x8aq\xf6\bG\xe7Y\x8d.\x0f\x8d\x15d-o@j\xa0\xcdER#\x8fp$P~\xb70\t\xde\x12Qq\xe5\x11\x8e\xa9U\aDE\xfc\xfc>\x85\xa3.~\xa0Q\xa4L\xa5\xb64D\xf5s\x87Y\x956X\xb6L)\x85\x12(~\xe6\xb0\x1b\x86\xf5\xb6\xc8\x1f\xe1\xf8\x83q\xec\xc3Y\xb3\x17\xd5\x02\n\xa0¦%\x19\xb5k\xf6\x86\xbf\xf1B\xe4Mg4O\x16@\xdc\xc8+\xf6YY\xfc\xe7\xd3wa\x10E\x99\xb3\x8f\n\xccge\xe9˫\x92\xd8\r\xe2L\x02\xbb\xc64-\xa53\vH\x97E\xfd\xb78\x90\tE\x11m\xd8&\f\xdbH\x8c\xcf\x1c}\x96\xb0i\x0f\x019\x87VY\x1b\xda]\x96J\xaeܒ\x96\xefm\x01\xd0.^\x9eUJ\xf78u\xb5\x10\xe2(\x8a\x1e\xbd{\xb4V\ue5d3}\xf9\xa9\xa2\xa1*x\x06y\xd8e\xa3$\x00n\xe1Ad\xac\x04\xfd\x00\xacB\xbb\x91.T\v4\xb9+gHa\xbak\x11\x8a7\v#{\xdace\x85\xb3>\xb1f`sR\xf5Ȏ\xfft\xf5\xb4Q\x92y'\x7f(\x89\xfa\xdd\x14\xb5e\x96e!\xbfN}\x10\x87\xa4s?JN\x1bO\x7fE\xf3J\xe2\xfd\xb74kȅ6k\xf6\x81\x12\xf4\n\xe8\xb6\x0f\xab\x84\x9d\xae\x92@\"&\xc20\x94\x93\x03/\xd0}@\xe5-\x19\x14ΙP\xbb\x13\x0f*M\xc5<\xed\x95q6\xbf\xd9\x18\xbb|\x84\xa3ߜ\xedj\x89ˍ\x8c\xae\xda\xf7\v\xea\xfc\x13\xa5\xd5x-J\x16GvI\xbf]\x92c\xb6d\x8a\x9c\xe1\xbc-\x90\xea\x05U\xbf\xaf\x1e\xeb-h\t\x16̪\xe4\xd5\xca\xcf\x06\xab\xca\xe8\x1e\xa7+\x94F\xb7$\x8c\xc08=x<ظI6C\xf7\x7f\x8e\x02\xc9\xf3\xa1R&\x92i\x11A\xebV\x19\xeb\x16\x0f{\xae\xfa\xc8\xeabJ\xe4\xe8W\x1c\x19\xdfY\xd0\xccX\xa5Cb\x17\xaa\xec\xc1\xe2:J\x8d\x99\x97\x1b\xb7O\xe4W2\x1d`\fP/[\xed\xe2\xec\xc1\xa5۫\xc2\xff\xcf\xc3\xcc\xc8\xd1\"ؕV\x19\x98h6B[\x12\xad\xce\xccbo\xb3\xd0\xcb]\xe0\xb7KR\xeb)\xcbС,s㑴g\x04E\x9f\xbew֬Q\x85\xe1\xdf)\xa2|\x0e\x8e\x8cr\xbb˒\x0f\x93\f\x93ѽq\xad\xc3\x04\xf4\xc0\\\xb0\xa5\x1fjRH\xcb|n/\x92\xbf7\xa7\xa5\x14rC\x1d\xb1\xf7\xaf\xe6\xe8\xb0`\x06b\x19Ice\xc0\x0e߾eH\xf3!5\xf6e!UM\xd1>\x8f\x86\x1egOwA\xd29\xc5\xd0\x11\x97\xcav\x17z|O?\x18\xb6\x13\xda\xd8\x16\xe1\x05P\x85\x99\xc8z\x1a\x1d\xde\x19\xf1\xa9\xfc\xa4\xf5\xd9\xe1\xe9\x17\u05fa\xb3$\xb9WO>\xc1sIP\x1e\x88\xbf\xe7\a`bǄe 3UKZ,Cu\x81\xdd,\x80\xe8\x98\xe8\x8cI\xa2\xcd\xec4\x96u\x99N\x90\x15I\xa7\x90\xb3+k\xdd&?q\x91\xb6\xb2\xc5\xcec\xab\x9dJ\xa2\x1c+\xfd\xccP\x9fM\xd9\xcd\xe4-\xf9wQ\xd6%\xe3%\xb2eI̹sy\x98!\xed\xd7\xf1\xfa\x89\v\xebOS\xb8M\xd9e\xda4SeU\x80\x85\x90a\x99)iD\x0e\x8d\xfb\xe0\xf9?\x9a\xaf\x1a+\x9c\xed\xb8(j\xbd@G/\xe6\xccҘϫ\xa7\x97\x0f\xe4\xd2\x11Y\x111\x13\x17\xec\x178\xdc\xf3\xf6\xa3\xd2\xcb\\\xe6[\r/\xef\x9aVZ(ʁ\x9d\xf1Nga\x92\xf7\xda\xf7N\xbd\xf0ry\x8c\xb9\xa7\xb3P\t\x937\xf7\xb4)o\xee\xe9\x9b{\xfa\xe6\x9e\x0eʛ{\xfa枾\xb9\xa7\xe3\xe5\xcd=\xed\x947\xf74\xd9~\xa4`\xb8\xa2\x95ۉ\nIX%\xa6o̡=ӗ\xcfR\xf2gA\x96dWo\xc6[\x8e\x9c\x05Zt\x86\xc4t\x8c^\x93n\x8dS2L&w\xa64\xc1\v\x7f\x81\xb36\x01\x81\xb3\xcf\xdal&\x01\xbc\xe0Y\x1b\x8f\xe9p\xed\xfc\x05O\xda\x04Z,?\x84q\xe5ӘJ\xe0aK\xc8\xe5\xa0\xe4\xb1nc^l\x0f\x8f\xd1:\xbfq\xd6\xfdI\xb6\xe6\xf9\"\xf3\xffr~'\"6'\xa7S#P\x85A\xb9\xfacp\xe2,\xdaG\xa9\xed\xfe\x17\x1b]KX\xa7x\xdd5\x03\xddT\xcb~\xca\xeb\x1fG\xb0ϑ\xe4\xd4\xf371\xe7<\xae\xdb:\xc4Խ\xf3\x1e\xbfoZZ(\xbfTޒ\xa5\x9f{ߌ4{\xc6\xc9wn\x8e2\xdbk%Um\xfc\n\x0f\xf6\xf0!sW\x01\x84\x8e\xcc\x12e\xf0\x9e\xedU\x1d9\xe31Cׄ\xcc\xdbx\xbe\xad\xcf\xe0\x00\xcb\x0f\xef\xd7\xfd_\xac\xf2ٷ\x11\xac\x9f\x84ݻ\xfb\x18x\x9e\xa3\xa3\xde9\xe2\x13&\xaf\xbf\x93e(x\x11\x88J3)\n'\x95\x01B߀~\xa9ܒ\xdf\xd9~\xcb\xfc\xc2Sz\x8e\xee\xd2\xcc\xdc&\x97r\xdeK~F>\xee\xb2\xc3R\xb3\xb9\xb7)H\xb3\x94\x8c\xdb\xf1\\\xda\x19\xa8K\xf2lS\xd7\x14\x13rj\xd33i\xd3\xc8\xc3\xe8&\xa5\xd4\xfc\xd9\xe4(45W\xf6u2d\x13\xf3b;ٮ\xb3 \xcf̆M&XZ\xe6kr\xbek'\x8bu\x9eZ\x13Y\xae㹫\xb3 \xc7r[S2V\x93pM\xceSm\xb2O\xe7wF\x9e\x95\x9d\xfa\xf2\xe7`^r\xddb:\xd74)\xc34imc\x1e\xe7\xa4\x1cҥ\x99\xa3IT]\x9a%\xdad\x80Nt\x9c\x94\x1bz\x9a\xf795\x94ٌ\xd0x\xb6\xe7\x14ر<Є\x1c\xcf\t\x90\xdd\xec\xcf\xc5n\xc0\xac4\xcdVX\x9a\xbb9~?Z(\xf3ֹ\xf8-d\xf6\xb9dR\xba\xe74\xa7\x04w_\x06MPZ\x82\x9f8\xe6\x88\xc7Ce瞟\xe1\x88G@nv\xac\xac\v+\xaa\xa2sA\x99\xddñ\xb9\xf2\xe7WE\a\u05f7G\x82\xf6\xe5k#\xf21\x90\xfd\x90\x82\x1b\xf6\x04E\x81\xff\x9eP!s\xd7\x01fj\x05h\xa5\xe2\x1b\x81\xfe\xaa#\x7f\x97\xe0\x95[\x16\xa3S\xfdd\x01K\x844}\x01֤)\x99v\x8f\x9dWO\xdf\xfeR\x83>2\xbas+\xf8AQ1kO{\xfa\xc9l0&\f\xca\xc7k1w)e_\x19\xc5gC\xa3\x02\xd8\a\xe9\f\xf3\x10W\x82\x85Z\xa7\r\xa7\xa6\x94-FO1\x10R5\x10\"\xedS\xbc\xef%\xc7\x1f_#\xb8z\x89\xf0*\xc9\x11y\x8d\x10뵂\xac\xa5a֒䍤㋯\x11l-\t\xb7\x16\xf9\x8c\xe9\xc7\x13_\xebX\xe2+\x84]g\a^\x8bH\x97z\xecpq\xf8\x950\xbe\x99c\x86'>Z\x02\xc8\xe8\xf1\xc2\xf1\x10,\x01\xe2ɱ\xc2\xd9 ,e\x1e\fôg\x1f\x12LNdZ\xb4\x9b\x9e\x9a\x84\x94\xb6\xd1=\x7f\xf8/\xf1\xd0_\xe26x\n\xf6\x89\x87\xfb\x96\x1f\xeaK\xa4\xf3\x99\xe1\xd9d\u05c9\x87\xf7\x16\x05hg\x86h\x93\x10\xa7\x0e\xebM\ai\xd3\vp\xc3Czg\xb8\x13\t\x12\x96Pe\xf9A\xbbgo\xc6(\x9d\x83\x9e\xdd\xd7Z\"γ\x82<\x88\xa3\xfa\xfd\x0fvt\u008d\xa8X\xab\xbbg\x16\xe3\xa8j\xee\x1d\xc9؟\x84\xf4\xbb\xf5(\xb8\x1d\x9f\xa4\xb7\xf1\xd6:L\xf1}\x9d\xd6K\xf5\x17\xab\xbb\x1d;\x03\x15״\x8f\xbf=\xba\xa4 \xb3f\x9fx\xb6oz\x88\x80\xa4~\xf7ܰ\x9d\xd2%\xb7\xec\xb2\xd9\n}\xe7:\xc0\xbf/\u05cc\xfd\xa4\x9a\xf4\x91νb\x11\xa8F\x94UqĈ\x89]v\xc1<Op\xa2\x02\x1b\xf0\xb9U\x85\xc8\"\x1e\xe2\xe8\x95t\xae\xc1Ʌ>ti^\xd6ɂ\x88\xc5(\xd8\\\xf8\v\v\a\x97\n\xefTQ\xa8\xa73\x97.x%\xfe\x8b\xde1I[\x1b\xfbp\xbb\xa1\xeaA\xaa\xe8\r\x94&{\xae\x91\xb1-L+\xf4v\xe0\xe4zt\xa1\x8ed\xaf6\x7fN@\xa4W\x04\x82\x9f\xe1\xd5x\xa6P\x8b\xddn\x1c\x96k\x12,.\x8fL\xf9{\xe2\x85\xceW\x15\xd7\xd1M=\xe6\xe5\xc1\\\xf50\fv|n\x05kҬ\x9d\xbe\x8a\xd0-=\x9a\x87\a\x12h\xb3\xf7X\xf5\xb7щ\xd2\x1dz>\a\xa7\xe9\x83˳G\x96_\x01\xa7i\x97iET\x8c\xfc\x14M\xc7{\xf1\xd5C\xe3/\x91\xffE\x1d\xe0ct\x15\xb1\xffd\xc0\xa0\xc9H\x02]\x80:umz\x9b5\x17\xbf\xce\xfa\x052\xe2\x02*\xfe\xe2\xeb\x05\xe3\xf3-\xc6^i\xf0\xf7\x7f\a\xd8\x13\xb6\r\xa7\xec\xed7\n7\x1bu\xe9g\xb8\x0f&\xc3R\xe1\xe0\xaa\xd6\b\xc8\xd83\v/E-\xab4\x7f\x80\x9f\x95{\t#\x85Z\xfd\x16\xbd\xc7P\xbc3\x17\xb2\x89\xfd\\\x8b)s?\xb6!\xc0\xf6\x90\xc1\xc9u\xf4\x88홷\xed[[$\f\xee\xfe\xfeg7 +JX\x7f\xac]\x86\t\xea]\x03H\xe90P\xd7h\x1b\xd7N{\xf5D\xf7\xb9w\x9f\xab\xe8<\b\x04t\xa8\x81\xd2F\xcf\x1a͡\xf7 D ]\x8a\xb0\x7f\x1bo\xd9\xf1\xa1:L\x9cJ!S\xbb(,n\x8c\xca\x04\xb9]\xb4fNg\t^\xef&\xe2)\xf7yB\x7f\xd6\x06\xbe<I\xd0_\xc3D5\x1b\x19{\x81\xa1G\xc2?\x9f4\x8c\xbe\xbe`\x159{\x83\xeacN\xbe\xf4\x042\xee펰\xf8/L\xf3b\xcb)\xe9f\xe6\x7f|\xee\x8f[\xaa\xd5\xf8#)\xab\xe6ݖ\x8b\x04ʺ\xb7IR\x9e\xe4q\x8f\x98d\xbc\xb2\xb5\xf6.fVk\xba\x96\x19\x81\x80\xbb\xb5\xf8\xbcGy\xdaG\xcefx\xd9>{\xd6.\x87\xce>\xb26¿\xe6Y\x9d\xe8;3.\x90p\x8f\xa0\xad\x10\xfey\xec\x1c\x9d\at\x8d\xf5\xdc\x13DX\xa79\a\xe6\tM\r\xc3\xf5\xd7w1\xd4\xc7\x0f\xf6\xac\xd8g8u\xe4W\xec\x93\xc4A\x9c\xdawwz\arZD\x1d{\x90lr\x88\x87\xa6\x15\x1d\x9d\x1a\xd1\x16}57\xa8>H\xec\xa4\xe7L\x9a*\xee\x98\xd4\x18[\xffY\xec\xdc\nw\x86c\xfa\x97\x93\x1aQ\xc55\xa9\xb4b\nktJ\x9d|4\xa0\x0f\xf4~H\x10\x12oû_\xeam{\x1b9\xfb\xeb\xdf.\xfe/\x00\x00\xff\xff\x80\xea<õr\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xcfo\xeb6\f\xbe\xe7\xaf \xb0û\xcc\xce\xebv\x19r\x1b\xba\x1d\x8am\x0fE\xf3л\"\xd3\tWY\xf2H*]\xf6\xd7\x0f\x92\xec&\xb1\x9d\xb5\x1b0\xdd\"\xf1\xc7Ǐ\xe4\xe7TU\xb52==#\v\x05\xbf\x01\xd3\x13\xfe\xa9\xe8\xd3/\xa9_~\x90\x9a\xc2\xfax\xb7z!\xdfl\xe0>\x8a\x86\xee\t%D\xb6\xf8\x13\xb6\xe4I)\xf8U\x87j\x1a\xa3f\xb3\x020\xde\a5\xe9Z\xd2O\x00\x1b\xbcrp\x0e\xb9ڣ\xaf_\xe2\x0ew\x91\\\x83\x9c\x83\x8f\xa9\x8f\x9f\xeb\xbb\xef\xea\xcf+\x00o:܀ \xa775\x1a\x85\U0004f222R\x1f\xd1!\x87\x9a\xc2Jz\xb4)\xfe\x9eC\xec7p~(\xfeC\xee\x82{\x9bCms\xa8\xa7\x12*\xbf:\x12\xfd\xe5\x96ů4X\xf5.\xb2qˀ\xb2\x81\x1c\x02\xeb\x97s\xd2\nD\xb8\xbc\x90\xdfGgx\xd1y\x05 6\xf4\xb8\x81\xec\xdb\x1b\x8b\xcd\n` $Ǫ\x06.\x8ew%\x9c=`gJ\x12\x80У\xff\xf1\xf1\xe1\xf9\xfb\xed\xd55@\x83b\x99zʹ.T\x06$``@\x01\x1a\xc0X\x8b\"`#3z\x85\x82\x12ȷ\x81\xbb\xdcɷ\xd0\x00f\x17\xa2\x82\x1e\x10\x9e3\xe5Ce\xf5\x9bIϡGV\x1a\xd9\x18\xdc\xceCvq;\xc1\xfa)\x95S\xac\xa0IӅ\x923\r\x94`30\x00\xa1\x05=\x90\x00c\xcf(\xe8u\x8a2\xf3ӂ\xf1\x10v\xbf\xa3\xd5z\xe0AR\xb3\xa2k\xd2P\x1e\x91\x15\x18m\xd8{\xfa\xeb-\xb6$BRRgt\x9c\x93\xf3!\xaf\xc8\xde88\x1a\x17\xf1[0\xbe\x81Μ\x801e\x81\xe8/\xe2e\x13\xa9\xe1\xb7\xc0\x98\xc9\xdc\xc0A\xb5\x97\xcdz\xbd'\x1d\x97ˆ\xae\x8b\x9e\xf4\xb4\xce{B\xbb\xa8\x81e\xdd\xe0\x11\xddZh_\x19\xb6\aR\xb4\x1a\x19צ\xa7*C\xf7y\xc1\xea\xae\xf9\x86\x87u\x94OWX\xf5\x94&K\x94\xc9\xef/\x1e\xf2B\xfcC\a\xd2:\x94\xf9(\xae\xa5\x8a3\xd1\xe9*\xb1\xf3\xf4\xf3\xf6+\x8c\xa9s3\xa6\xecg\xdeώrnA\"\x8c|\x8b\\\x9a\xd8r\xe8rL\xf4M\x1fȗ鲎\xd0O闸\xebHe\x9c\xddԫ\x1a\xee\xb3\xe2\xc0\x0e!\xf6\x8dQljx\xf0po:t\xf7F\xf0\x7fo@bZ\xaaD\xec\xc7Zp)\x96S\xe3\xc2\xda\xc5\xc3(s7\xfa\xb5\xb0\xdd\xdb\x1em\xea`\"1ySK6\xaf\a\xb4\x81\xc1,\xb9\xd4\x1fB\x92=\xfe%\x96AI\n\x9a\x89\xbe\xa4\xfd|\x1fͲ\x9c䗃\x11\x9c^N0=&\x9bi~G-ړuXB\x145\xc1\xf7\xa1\xa4\x83>v\xf3\x9c\x15|\xc1ׅ\xdbG\x0eIY\xb3\xae_\x9f\x1b\xb3\x01\xe5{\xb3'?+wZY\xb1\xca߰K\xa9\xbe\x10\xe8!\x10p\xf4>\xed\xedL!3\x90\xa9\x92\xcflH\xb1[@\xb3\x88\xe7\xc1\xb7!\x7f\xf0MJl\xb4\xec\x13\x0e\xcd\x1e\xf2\x14\\\v\x01o\xf7\xba\x9c\xb9x}\x88\xd0r\xf2\x97\xf4\xbf9'\xb9!\xc6\xc5\xdcUF\xb5\xf8\x902.1\xbe\xbc_\x03\xca\xe8\x9c\xd99܀r\x9c{\x17_\xc3lNө\x19G\xed+u(j\xba\xfe\xbd\x01\x9a9\xa4=y=\xa0\xbf\xb5\r\xf0j\xa6*\x7f\x95\x19v\xa7[\xae\xf7o\xff\x01\xe7+UFw\x03I\xbb+\xa5\x05\xce>D\xcab\xf7\xcaH/\xfe\xf3\x98\x11\xb2\xbd\xb4\x1d5\xe3j5\xc6?\"\xf3\x1anBXl\xf6\xec2\x87o.\xca\x13\rl\xf6c\xc1\x7f\a\x00\x00\xff\xff\xb1J-\xe7\xa6\v\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VA\x93\xdb6\x0f\xbd\xfbW`&\x87\xbdDr\xf2}\x97\x8e/\x9d̦\x87L\x93f'N\xf7N\x8b\x90\x8d\x9a\"U\x10\xd4\xc6\xfd\xf5\x1d\x90\xd2\xdak\xcb\xc9n\xa7\xd5\xc5c\n\x04\x1f\xde\xc3\x03UU\xd5\xc2\xf4t\x8f\x1c)\xf8\x15\x98\x9e\xf0\x9b\xa0\xd7\x7f\xb1\xde\xff\x14k\n\xcb\xe1\xedbOޮ\xe06E\t\xdd\x17\x8c!q\x83\xef\xb1%OB\xc1/:\x14c\x8d\x98\xd5\x02\xc0x\x1f\xc4\xe8rԿ\x00M\xf0\xc2\xc19\xe4j\x8b\xbeާ\rn\x129\x8b\x9c\x93OG\x0fo\xea\xb7\xff\xab\xdf,\x00\xbc\xe9p\x05Cp\xa9\xc3\xe8M\x1fwA\\hJ\xcez@\x87\x1cj\n\x8b\xd8c\xa3Gl9\xa4~\x05\xc7\x17%\xc5x|\x81~\x9f\xb3\xad\xc7l\x1f\xc7l9\xc0Q\x94_\xbf\x13\xf4\x91\xa2\xe4\xc0\xde%6\xee*\xb2\x1c\x13w\x81\xe5\xb7\xe3\xe9\x15\fѕ7\xe4\xb7\xc9\x19\xbe\xb6\x7f\x01\x10\x9b\xd0\xe3\n\xf2\xf6\xde4h\x17\x00#?9]5Q\xf3\xb6dlvؙr\x0e@\xe8ѿ\xbb\xfbp\xff\xff\xf5\x93e\x00\x8b\xb1a\xea%\xb3<_\"P\x04\x03\x13\x12x\xd8!#\xdcg>!J`\x8c#\xe8Ǥ\x00\x13\xfeX?.\xf6\x1czd\xa1\xa9\xf8\xf2\x9c\xf4\xd7\xc9\xea\x19\xae\x1b\x85^\xa2\xc0jca\x04\xd9\xe1T>ڱZ\b-Ȏ\"0\xf6\x8c\x11\xbd\x1c\x85<>\xa1\x05\xe3!l\xfe\xc0FjX#k\x1a\xd5&9\xab\xfd8 \v06a\xeb\xe9\xaf\xc7\xdc\x11$\xe4C\x9d\x11\x1c5?>\xe4\x05\xd9\x1b\a\x83q\t_\x83\xf1\x16:s\x00F=\x05\x92?ɗCb\r\x9f\x02#\x90o\xc3\nv\"}\\-\x97[\x92\xc9WM\xe8\xba\xe4I\x0e\xcbl\x11\xda$\t\x1c\x97\x16\at\xcbH\xdb\xcap\xb3#\xc1F\x12\xe3\xd2\xf4Te\xe8\xbe\xf8\xa0\xb3\xafxtb\xbcy\x82U\x0e\xdaEQ\x98\xfc\xf6\xe4E6\xc2w\x14P\x0f\x94F([K\x15G\xa2uI\xd9\xf9\xf2\xcb\xfa+LGg1\xce\xd9ϼ\x1f7ƣ\x04J\x18\xf9\x16\xb9\x88\xd8r\xe8rN\xf4\xb6\x0f\xe4%\xffi\x1c\xa1?\xa7?\xa6MG\xa2\xba\xff\x990\x8ajU\xc3m\x1e6\xb0AH\xbd5\x82\xb6\x86\x0f\x1enM\x87\xee\xd6D\xfc\xcf\x05P\xa6c\xa5\xc4>O\x82\xd39y\x1e\\X;5\xd88ޮ\xe85\xef\xe4u\x8f\xcd\x13\x03i\x16jitv\x1b\xf8\x8cW3\xf9|>_\xfd$|\xde\xe0P\x86|K\xdb\xf3U\x00cm\xbe\"\x8c\xbb\xbb\xba\xf7;\x84\xcd\xd4}\x9bO\xd2Fm\x03+\xa2\x81,r5\xd59\"I<\x16L\xe8l\xac/R^\xe1<\x97\xc2hUc\xe3.\x81>E\xf2\x18\x98\xef8C\xbeP~L\x90[\x8f\xbbq\xc6zAo\xf3P\xbf@\x13r\x0fG\xb4\xf0@\xb2+\xe6p\xa7\x97\xd4\xf3T\xd0g\x8f\x87\xb9\xe53\xec_w\xa8\x91e\x9c\"Dl\x18EqDtj^uf\r\xf0)\xc5l/3\x9b\x11tD\x90\x9dv\xef\xf1pI4\xfcH\xdc\xf1\xbe\xff1\xe4\x1b\xbd\x17'\xc0\x8c-2z\x99\xb5\xb8~b\xb0G\xc1\xecr\x1b\x9a\xa8\x06o\xb0\x97\xb8\f\x03\xf2@\xf8\xb0|\b\xbc'\xbf\xad\x94\xf0\xaa4B\\\xe6\xef\x86\xe5\xab\xfcs\xa5䯟\xdf\x7f^\xc1;k!\xc8\x0eYUk\x93\x9b\x1a\xed\xe4\xb6{\x9d'\xeekHd\x7f\xbe\xf9'\xbc\x84\xbe8\xe7\x19ܬs\xf7\x1f\xf4\xe6Π\x94\xa2uQ%0\xe8\xdcT\xb1\xbbQ\xcd2\x1f\xe6\x1aq´\t\xc1\xa1\xb9l=\x9d\xbe\xc4h/!Uz\xc2Kl\x06\xf0\xad:\nUu\xa6\xafJ\xb4\x91\xd0Qs\x16=\xf9\xfc\a\x96\xbc\x1b\xc3t<(\aӶ\xa9m\xcaWL\xfe\xa61[\xbc6\x16f\x14\x99/\xbcz<\xe0Y\x03]\x8c\xa4\xf8\U000917b7\x8d\x91\x9bq\xac7\x89\xb5\xfdǜ3\x9f?\xff\xceX\xefw&\xcex\xf3\x19\xa8\xeft\xe7$\x83\xa3\x16\x9bC\xe3\xb0$\x84\xd0\xce\xf4ދ \xeb\x83>us\x8d\xf8n0\xe4\xcc\xc6\xe1̻߽\xb9\xfa\xf6\xaa\xf8\xb3z^,F\xfdƱ+\x10N%\xf7\xd8e\xe3\xca\xdf\x01\x00\x00\xff\xff\xec\xa0\xe0\xa1k\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccXQ\x8f\xdb6\f~ϯ \xba\x87\xbe\xd4N\xbb\xbd\fy\xebn+P\xac-\x0e\x97\xa2\xef\x8c\xc5$\xeaɒ&Q\xb9e\xc3\xfe\xfb@پ8\xb6/\xce\x1d0`\xe7<\x9c%\x92\xfa\xf8\x91\x1f\xed\xa4(\x8a\x05z\xfd\x8dB\xd4ή\x00\xbd\xa6?\x99\xac\xdc\xc5\xf2\xfe\xe7Xj\xb7<\xbc[\xdck\xabVp\x93\"\xbb\xfa\x8e\xa2K\xa1\xa2_i\xab\xadf\xed\xec\xa2&F\x85\x8c\xab\x05\x00Z\xeb\x18e9\xca-@\xe5,\ag\f\x85bG\xb6\xbcO\x1b\xda$m\x14\x85\x1c\xbc;\xfa\xf0\xb6|\xf7c\xf9v\x01`\xb1\xa6\x15l\xb0\xbaO>\x90wQ\xb3\v\x9aby C\xc1\x95\xda-\xa2\xa7J\xa2\xef\x82K~\x05\xa7\x8dƻ=\xb9A\xfdK\x0et\xd7\x05:\xe6-\xa3#\xff>\xb9\xfdIG\xce&ޤ\x80f\nHގ\xda\xee\x92\xc1028.\x00b\xe5<\xad\xe0\v\xd6\x14=V\xa4\x16\x00m\xa6\x19[\x01\xa8T\xe6\x0e\xcdmЖ)\xdc8\x93ꎳ\x02\xbeGgo\x91\xf7+(;v\xcb*P&\xf6\xab\xae)2\xd6>\x03\xe9\b{\xbf\xa3\xf6\x9e\x8fr\xb8B\xa6q0a\xae<a\xfdz\xf4\x9dW\x13\xe5D\x04\xf4\xf6\x9a\x88\x91\x83\xb6\xbb\xc5\xc9\xf8\xf0.\xdf\xc4jOu.\xbe\xdc9O\xf6\xfd\xed\xc7o?\xadϖ\x01|p\x9e\x02\xeb\xae<\xcd\xd5k\xbf\xde*\x80\xa2X\x05\xed%\xdf\x15\xbc\x96\x80\x8d\x15(\xe9;\x8a\xc0{\xea8%\xd5b\x00\xb7\x05\xde\xeb\b\x81|\xa0H\xb6\xe9ĳ\xc0 Fh\xc1m\xbeS\xc5%\xac)H\x18\x88{\x97\x8c\x92v=P`\bT\xb9\x9d\xd5\x7f=Ǝ\xc0.\x1fj\x90\xa9\xed\x91ӕkh\xd1\xc0\x01M\xa27\x80VA\x8dG\b$\xa7@\xb2\xbdx\xd9$\x96\xf0\xd9\x05\x02m\xb7n\x05{f\x1fW\xcb\xe5Ns'\xbb\xca\xd5u\xb2\x9a\x8fˬ \xbdI\xecB\\*:\x90YF\xbd+0T{\xcdTq\n\xb4D\xaf\x8b\f\xddJ±\xac\xd5\x0f\xa1\x15j|}\x86uT\xcb\xe6\x93\xc5r\xa1\x02\xa2\x16\xd0\x11\xb0um\x12=\x11-K\xc2\xce\xddo\xeb\xaf\xd0\x1d\x9d\x8bq\x16\x14Z\xdeO\x8e\xf1T\x02!L\xdb-\x85\xec\a\xdb\xe0\xea\xcc8Y坶\x9co*\xa3\xc9\x0e\xe9\x8fiSk\x96\xba\xff\x91(\xb2Ԫ\x84\x9b<\x8b`C\x90\xbc\xa8A\x95\xf0\xd1\xc2\r\xd6dn0\xd2\x7f^\x00a:\x16B\xecu%\xe8\x8f\xd1ӟDY\xb5\xac\xf56\xba\x11\xf8D\xbd\x86cm\xed\xa9\x92\xf2\t\x83⪷\xba\xcaڀ\xad\v\x80\xa31X\x9e\x85\x9e\x96\xae\\\xcd\xf0[\xb3\v\xb8\xa3O\xae\x8994\x9a\xc46\xf0\xe9\xc0\xc9\x18\x12\x85\xca\xff\x93\x86\xa3\xd8\x00\xbcG\xee\xe9\x97Q\xdb\xc710\x99υ\"ȧF\x91\xb3E[ч\xdcQ\xb6:\xce\xe4\xf4y\xc2ERڻ\ap[&\xdb\x0f\xdab\x1dE\x04\xe9Ր\xec\xb3\xc0\x9e\x0f\xf3\x19\x98\xa7\x02\x8b1h\xab\xa4\r\xdai*\x87t\xd4K]ɪ\x1e\x83\xa3\xc0dS=>\xae\x80{\xe75N\xac\a\x8a\xac\xab\x89\x8dW\xaf\x9e\x97\xaf\x84\xf9\xa8Dh[Ma6\xe3s\xf3\xae϶ɘ6VQ\xb9\xda#덡\xe9#\xe5\x12\x99\xe8\xe6\xd0c3\xeb^\xde_\ay\xd6\xd3\xe3\xdb\xc1L\x06\xdfέ\xfbB\xc9\xeeM\xabK\xc1\x92\xbfT/\xe8\xb4\x11\xc1;Ղh\xfd\xa2\x8c\x81g\xe4 \xaaЁ\x06O\x8c\x026\xb3\x8a-&\xd550\x19\xd6x\xb0=\xe0\xef\xaaq\xc9\xc8i0\xbd.\x0f\xcc\xecБ]\xa5\x10\xc8r\x1bFD\xf2\xf2\x91i0ro\\\xc8\xdb\xdcL\a|\x1a{t\xc0$\x18\xb0\xae\xe9l\xbe<`\x1cE\x84\xe9ɲu\xa1Fn^\x17\v\t4\xb2\xb0\xc9\x18\xdc\x18Z\x01\x87D\xd7\xf7\x88<\xd0b\xc4\xdd\\v\x9f\x1b+\xc9\b;\x17\xc0\x8dK\xfc\x04\xf5\xbc\x1f\xa3\x80\x99r\xcc \xf5{\x8cs8o\xc5f\xaa!\x06ϫK\x10\x9e\x9a\x99_\xe8ab\xf5\x8eP\x8du\\\xc0\x17\xc7\xd3[\x172\fT\x91\xedw\xd1L\xb6wC\xfb.\U000fd392[\x97\xf3\xe4\xdb\xf0\xe0!*\x9d\x17߀\v\x8a\x02)\xd8\x1c\x85-\x1dDM\xa1\xe9\xde1S\x9a\xa9\x1eIg\x84r\xc8x\x0f﹀\xa5NiJ\x14 \x89`on\x0e\x81\x8f\xa1]\x12w7hko\x88\xe9\xf1\x9bڴ\xd9 \x99\x9b\xa1W\a^\x18\x12\xca\xfaО\b\x98U\xfex\xbe\x9a\x02\x7f\x9d\xea\xaf\xd2\xfel\xd7\xcd́\xe7O\x83+\x19x\x03T\xee\xca\xcc\x19\x85\xe0\xe4\v\x052Ԩ\b4\xc3\x16\xb5)_\x9aL\xa0\x98\f_\x95\xcb]6\xed\xaa\xd88v\xba\xb9\xa2˞\x1e\x18\xdd X\xa7\xaa\"R\xf9\xf7\x85\xa9\xab\x80\x0f\xa8\r\xa9\x97\xe6\x9a\x05\xfa\xbc&^\x9f\xb9\xbc\xb8\x83\xf3\xc9\xff\x8f\xfe}⍢\xbf\x89!\xe0q1\xeb4Z\x8c\xf2ۃꁓъ\xbb>ܘ6\x8f_\xe4W\xf0\xf7?\x8b\x7f\a\x00\xa7\r\xa2v\xb4\x13\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=\xcbr\x1b9\x92w~E\x86\xf6\xe0\x99\t\x91\x1e\xcf^vus\xcb\xf6\x8ef\xba\xdb\nK\xed\xb9\xec\x05\xacJ\x92hU\x015\x00J2{c\xff}#\xf1\xa8\xf7\x03E\xd3\x1d\x9e\r\x92\x8e\xe8\x16\vH\xe4\v\x89Df\x02\xb5^\xafW\xac\xe0\x9fQi.\xc5\r\xb0\x82\xe3\x17\x83\x82\xfeқ\xa7\xff\xd0\x1b._?\xbfY=q\x91\xde\xc0m\xa9\x8d\xcc?\xa1\x96\xa5J\xf0\x1d\xee\xb8\xe0\x86K\xb1\xcaѰ\x94\x19v\xb3\x02`BH\xc3\xe8gM\x7f\x02$R\x18%\xb3\f\xd5z\x8fb\xf3Tnq[\xf2,Ee\x81\x87\xa1\x9f\xff\xbcy\xf3\x97͟W\x00\x82\xe5x\x03[\x96<\x95\x85\xde<c\x86Jn\xb8\\\xe9\x02\x13\x02\xb9W\xb2,n\xa0~\xe0\xba\xf8\xe1\x1c\xaa?\xd8\xde\xf6\x87\x8ck\xf3\xf7Ə?rm\xec\x83\"+\x15˪\x91\xeco\x9a\x8b}\x991\x15~]\x01\xe8D\x16x\x03?\xb3\x1cu\xc1\x12LW\x00\x1ek;\xe4\xda#\xfc\xfc\xc6AH\x0e\x98[N\xd0_\xb2@\xf1\xf6\xfe\xee\xf3\xbf?\xb4~\x06HQ'\x8a\x17ħ\x80\x18p\r\f>[\xb2@y.\x8390\x03\n\v\x85\x1a\x85\xd1`\x0e\b\t+L\xa9\x10\xe4\x0e\xfe^nQ\t4\xa8+\xd0\x00IVj\x83\n\xb4a\x06\x81\x19`PH.\fp\x01\x86\xe7\b\x7fx{\x7f\ar\xfb+&F\x03\x13)0\xade\u0099\xc1\x14\x9eeV\xe6\xe8\xfa\xfeqSA-\x94,P\x19\x1e\xf8\xec\xbe\r\xe5i\xfc\xda!\xef\x15q\xc0\xb5\x82\x94\xb4\x06\x1d\x19\x9e\x8b\x98z\xa6\x11=\xe6\xc0uM\xaeգ\x16`\xa0FLx\xe47\xf0\x80\x8a\xc0\x80>\xc82KIٞQ\x11\xc3\x12\xb9\x17\xfc\xb7\n\xb6\x06#\xed\xa0\x193\xe8\x15\xa0\xferaP\t\x96\xc13\xcbJ\xbc\xb6,\xc9\xd9\x11\x14\x12\x8b\xa0\x14\rx\xb6\x89\xde\xc0OR!p\xb1\x937p0\xa6\xd07\xaf_\xef\xb9\t\x93&\x91y^\nn\x8e\xaf\xad\xfe\xf3mi\xa4үS|\xc6\xec\xb5\xe6\xfb5SɁ\x1bLL\xa9\xf05+\xf8ڢ.\x88`\xbd\xc9\xd3\x7f\v\n\xa0_\xb5p5GRFm\x14\x17\xfb\xc6\x03\xab\xf5\x13\x12\xa0\t\xe0\xf4\xcbuu\x84\u058c\xe6bo\xb9\xf3\xe9\xfd\xc3cS\xf7xS\xad\xe8\xeb\xf8^wԵ\b\x88a\\\xecP\xd9~\xb0S2\xb70Q\xa4N\xfb\xe8\x8f$\xe3(\xba\xec\xd7\xe56\xe7\x86\xe4\xfe\xcf\x125)\xb9\xdc\xc0\xad\xb5$\xb0E(\x8b\x944s\x03w\x02nY\x8e\xd9-\xd3\xf8\xcd\x05@\x9c\xd6kbl\x9c\b\x9aF\xb0\xfe\x10\x94\x1bϵƃ`\xcbF\xe4\xe5\f\xc2C\x81Ik\xc2P/\xbe㉝\x16\xb0\x93\xaa\xb6\x17\xce\\\xd5\xd3u|\xca\xd27a\"\xc1\xac\xfbk\a\x89[\xdb\b\xb8HiD\xac\xc4C3\xc9\x01\xb0HI\xb1\x97mN\x84\x8f\xc7\t\xee\f$L\x90$5\x1ax9\xa0\xb0\x1d\xb7\x95\xd5\xe3\x02~Ɨk\xb8\x13\xf7J\xee\x15j\rR\r\x00\xfc\aㆋ\xfd\a\xa9\xee\xb3r\xcf\xc5\xc7\x02\x95兆\xe2@:\xd1\xeb\xe3ؿ\x952C&:O\x13\xcd\x1f\x04+\xf4A\x9aG\x9e\xa3,\xcd\x1cC\x1e\xee:\x1d\x82D\xbc|\xacm-5\xa6Ģ\x17\xc6\rɨ\a\x13\b\x10|\xb6f6\xc0\xb3\xe6\xb6\xd4`J%H\xfd\xe1\x13\xb2\xf4\xf8(\x7f\xd1\biigl\xa2\xd0\xd2z\r[\xdcI\x85\x03p\x15R\x7fj\x8cJ\x91vhk\xeeei6\xf0x@\xd2%Vf\xc6O~\xae\xe1͟!\xe7\xa24\xa3\x9c\xebi9\xfd#-\xcf\xe53\xaa\x19~\xbdc\x86\xfdD\xed:l\xa2\xfe`\x01\x10\xa5[ϲ\xed\x91\x1eN\xa9Ѯ\x01\x91k\xb8\xba\x02\xa9\xe0\xca\xf9\x01W\xd7\xd4\x1bȳ0k.\x1ac\f@|\xe1Y\x16\xc6]F\xb9c\xa0\x93\x9d~\x94\x1f\xb4\x9b\xa9s\x8c\x18\xe9\xd6\xe0\xcb\xcb\x01\xcd\x01\x15\x142\xac\xc0=\x90\x00;\x9e!\xe8\xa36\x98{\xae\x84u/0\xd1ڄ,\xf3 4l\x8f\x01\xe7>\x9d\xa2\xcc2\xb6\xcd\xf0\x06\x8c*q\xd1\xd4\xe9\xf2\xe1\x13jÓ\x19.\\u\xd9\xe0z\r0A\xf9\a\x96\xb6\x1eP\xa8\xa8\xa5%\x9d=!\xb0\xc0\r\xf2\r\xb2\xac\xc1\xc4\x16\a\xe0\xbf\x05\xbc\xa3\x85\x8b\xccYg\xb9\xf44ۅ\x8bc\x96\x92Y\x12\x122)\xf6\xa8\x1co\xc9)\b\x9a\xa3\x90\xf47\x05Z/\x14f\xb4\xf0\xc1\xae\xa4\xb5\xbc\xcfg\x00\x9aţ:\xc0\x856\xc8\xd2\xcd\xd59\x05\x84_\x92\xacL1\xbdu\x9e\xe0\x03\xf9\xb0i\xf0\xdc\xf5\x8c\xa0\xdeOv\xf6nD\xc6\x13\xeb\x80z_sm\xdd\xe4\xb4\a\x18\x1a\xdeı@\xeb+[\x03\xe71\xac݄\xc64\xa7e\xc2H\xb8\xfa\xd3\xd55\xc9s\x00h{\xd4\xf6\x18\x1a\x98\u008a\x03Öo\x00$\xe6\x859\xf6\xa5\xc7\r\xe6\x03\f\x9b4\x13\x91\xa2cJ\xb1c\xe7Y@\xbb\xdan\x9c&\xba\xb1\xee\x1d\xe1\x89\xd0\xecw\x16_w܅\x02\x1c\x80\xc8\xf5\xf7*\xc0\xc5\"Ӵ\x8b1\x8c\v\x12\x15\xed^[\x92\"O\x83u\x1dh\xfa\x12\xcf\xc8a\xe6\xc2\xc1#\x93\xd4\x10\xcc\xf7\u0097\xa5\x9a<\xa6\xba\x95\xc6x\x95\xa4m2\x1b\xf4\x8a\xbec\xa6\x1c\xa4|\x9ac\xc4_\xa9M\xbd\xe1\x82\xc4Fa`\x8b\a\xf6̥\xf2\xa4\xd7~\x00~\xc1\xa44\x83s\x99\x19H\xf9n\x87\n\x85q\x1e\xb3&VN1d|\x0fA\xdfBj3\xe6\x01\xf5\b\xb9\xaf\x1a\x03o\xaa\xb6\xb5p\x8eJkX,\xfa\x83\xe0\x00\xa4H\x10؎\x82\x1b,˜\x0eÁ=#l\x11\x85u\x030\x85\xb2\xb8&װj7\x02\x8c\xe9\xa3H\xa0\xb0[\t\x90\xf5^\xc2\xc2Kd^dH\x01\x11n9\xa4\xd0.+L\f\x98\x98I\xcd\xe9\xf1\xa1\xa2ױ\x81t\xc0\xc9P\x95B;\nɍ\x1bv\x86\xdd\xf7\xe5 3\xacQ\x06\xc5\bC\x82\"\x1c\x80\x02\x95e\xce\x06\xde\x7fa\x89Ɏ \xc588\xb9\x83\xbf\xc9\xed5\xbc\xff\x82\t1\uebcf\x8f\xf7\x90\x97ڐ>\x05\xf7l\xc0S\x8eQ\x910\xfb\xbb\xfb\xdd\t\x06\xbd\xff\xd2\xd8\xf76\x19\xe4uC\x03\x9b\x00E\x11\xc7<'g\x8d\v`4\x97\xf8^\x90\xc3Gn\xe1\x18\r\xb1t4\xc0O7\xea\x90t\x1bP\xf2\x01<\xff'a\xc9Ծ\xccQ\x18\xbd\x1a\x05\xe5\xbf\xf5\xec\x98\"cV\x19#\x8dZ\xfb\x9bsqG\x93\xed\x06\xde̴\x1c\xb7v\xed\x8f_\xe4\x86v\x91\x93\x8c\xf4\xbdjVV?8\xd3^\xc8t5\n\xcb\x7f_\x0e\xa8\xb0%\x89\xbe\xfd\xb4\xae\x8c\x90f\x16X5A\xae\xc3\xf8\xafh\x13\xa1\xb4i\"\xa7Gv\x9b'J$c[\xcc\x1e0\xc3\xc4\xc8e\x1c\xfc\xb1\xd9\x13\xb4\x05\xa1\x03\xe6\x96h>Os\xceLr@\r9\x05A\xbd\xd9AP\xa5\xb0чBz^8.L\x99\x9e\xf0\xd9\x1e\xed\xd6 \x96O3+\xeei\x13\xbb\"\xec\xfd\x17\n\xb7W\x11~\x80\x05\xec\xed\x02h\xafuVl\x9e\xe9Rو\x1aWh\xa7\xff\x1c\xc9\xeeK\xbep\xb3\x97]\x94\xde\xfe\xfcn\x9ee\v\xecB\x8f\xa8\xb7\x13\x88{\xbf,<\x19\xf1N\x87\xbe~vh\x17\x8f\xd2\xd7\xc0\xe0\t\x8f.\xfaN\x1ae\x977\x0f\x12\x14\xdaȽU\xab'<\xae\"\xe0\xd3\x12/\xaa\x80}T\x8f%\xaa\xe2#\xefx\x8cm\xdaa\xea\x13\x1e\x83\x11sܥ\x1f\x88}\x96Ɗլ(2\xdeJ\xef\xcc}\x8d\x8cӥE\x06\xa7\xfe\x06\xb9\x9cHv%\xd6:\x87\xe0\x04\xff\x8a\"̙\xf3\xc1\x0e\xbc\x00#\xa3\a\x00\xda\x19\xa0\x9da!=\xf3\x99e<\xadpu[\xca;q\r?KC\xffy\xff\x85\xeb\x88%\xb7\xfe\x92R\xbe\x93\xa8\x7f\x96\xc6\xf6\xfd\xa6,vD\x9c\xc8`יT\x8b\t\xb7\xeb \xbe4\xf3>ښ\xf9)\a\xb3\xff\xa9\xc4\xc65\xe5a\xa4\n\x9c$e\xf5C\xba\xc1\x82\xe3(\xa4X\x8f\xec\xc9ǿ\x0e\xaf\xd6h\x96ݔ\x11h\xf1\xbf9\xf0\x02\xf8m\x14\x1dz\xf0Ha?\xf7\xc4e\x1f3\xca\xf3\x86\xc0\xbb͙1\x83{\x9e,\x18(G\xb5G(h5\x88\xa7\x7f\x81}>Y\xb7\xe2=\xb4\xf0\xf1\xc6~0b\xda\xff\xae\xa3\xcd\xf3\xba\x12sT\xf3\x91T\xda9\xa8\xb4\x8b\xb6u\x8c\xa2\xb8\xcf\xd2\xd4\x16>\xb0\xec~\xe1z\xb1P^\xady\xdd@\xd2Nnș\x8dx\xff\x0f-\x9av\"\xfc/\x14\x8c+\xbd\x81\xb7\xb6\x90!\x8b\x9b\xdf\xcd\xfe><\xd2\x1c\x8aF\xa1\xe8\xda?K\xfe\xcc2Z\xf0\x8d\x04&\x003\xbb\xfcG\r!w=\xc7\xea\x1a^\x0eR#)K\x1dq\xbfz\xc2\xe3\xd5u\xcb\x02D\xc1\xa7lН\xa0p\xa3H\xfb\x06\xa9\xf23\xa4ȎpeYu\xb5\xe9\xb9RQ#-r\xb7\x16h삦_\xd6OU\xd1\xc7:g\xc5\xdak\xba\x91\xf9\x8c\x85\xaa\x82\x887\xab\x05zW\x05&\x83\xb7R\x81\xf1\xc1\xa3\x19`0\xb7\xf1^41\n\x99.\xc2\xfe^V\xbbn\xc2;Ļ·R\x8cu\\\x87}\xe6d\x9b\x8a\xaf\xab\xaf\xd4\x13\xaaG\xb9YE2\xc8\x06{\x86\xa2-\x1aEj}\bj1\x01\rBa\xc0f\xf5\xf5\x8e\xf5V\xa6\xc7E\xf2\xfdA\xa6\x95\x1bM\x9d\x83\x80#pZ d\x80\x03\xb2\x14լ\x99?mi\x88Ƣ+;\x87\x94unY\x9a\xfa\x94\xe8R\xea#\xacN\x8e\xe6\xb0p\xe2\xfdd\xbb\x04ѐ\x0ey(^B3\xb0j\xad\n\xa9S\x1b\x1e\xbe\xff\xf8\xf0x6\x99\x96j\xa0\x06f\x82\xa4_>\xfd\x18\xe8\xa1\xffm\xa8\x19\xfd\xaccVC#τ}\x9c\xd9)U\xb6\x1a}\x1c'\xfe_\xe5\xf6f\x15ɠ\xbf\xc9\xed`\xe0\xd6F\xb6\xd9p\xb1b\xffCP\xa8\xc6\xc8E\xe0\xb9\x14\xe70,\xbf\xca\xed#\xe6\x05\x05\x11\x16\xc9\xfcou\xbf \xfb-\xb92\xaf}\xbd\xe7ԧ\xd1\xd7\xd6rQg\"\x8ekW̓\xa9͟\x9em\x96v|\x03\xf2\xb5\xa8<r]\x8a'!_\xc4\xda\xfaY:\"fV\xadD\xe7r\x14j\xcag\x00B\xc5\x19.\xe2\xf8r\xa6\x99\xd2Џ\xc9v\x15M\xab\xaf\x14\x18\x01\xbaY-`m\x93\xabU\xa9,-כ\xd5W\xf2H\x8a\xf7T/\x16\x8d\xcdG\u05fe\x8a|k8ȗP\x898Z\xb5S\x7fm\xee\x12\x81\xef\x80\x1b@\x91Ȓ\xeao\xad\xaf\xe1\n\xd7\\\f\x9e6\xdf\x03%\xa8\xed\xef\x1c\x03P\x94\xf9\x14ak\x9bR\xe0brF\xac\xe1\x03\xe3\xd9ײ\xd9\xd7\xe2E\xb39\x14\x19\x06\x8bJ\xc2\xcf\xd9\x17\x9e\x979\xb0\x9c\x98\x06r7\x01\fl\xf5_[.UY\xa2u\x98\x88y\rS;m\x14\\\xd9!\xe544OQ\x85\x92a/+Iɶ\x1d\xe3\xd9H\r\xd4\x02N\xcdMXWq\xbf:q\xeeM\xc7\x05\n\x85\xf1\tm\x85g\xc9g{\xc62q\xb49[\x82V%\xb27\xab\xc5q\xa2K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\x92l\xbe$\x9b/\xc9\xe6K\xb2\xf9\xf7N6\x873\xec#\xabIK>\xf59x\U000bf217\xda{νd\x12ݣҽ\x10$|(\xb1L̤\xa4\xb5H\xf93OK\x96\xd9;H\xe8F'{\x1c\x9cUxmV\x8b\xe3F-\x9c]v<`NY\xe8֕VR \x9d\xea\xb0g\b\xfbM\xc7g\xe2\x18\xd9[F״H\x17UPe\x86\xda\x0f\xe5\xeeũ\x8c\xa9\xbe\x1e\x05]I\xc4%\x06ډ\x88\xcd\xeat\xaf \xe6Z\x88\x11.\x0e\\\x10Q\xdb\xc2\xd6\xca7m\xbc\xe8N\xaa\x03O\x0e\xb5-\xb76\x15R\x89t\u05cd\xb1\xc1\xff\xc9\xf8\xe9\xa4\xe4\xa3gܢ\x94\xda\xf4\x04j\xf36h\xcfr\xd6V=\x1b\xab\fq\xb6R\x87\xb9\xfc\xc0\xffO\xc6r\xd1ռh\xce\xde\xf5\xba\x9eWi}\xa2\xca\xe6\x16l`\xfd\x9a\x96\xf7\x98\xf4\x15\x05\x14\xb3\xac1\xfe\xbf\xb0`\x96k\xfc]\xb7\xe7Y5~R*s\x10\xe9B\x8fj\xf8\x7fA\xa1DWI\x8cWH\\SMd\x10HzM\x17\xbeY_\xb5%\x99\xaf\x9a/\xe7`F\xec.\xb8\x1b\x85\x9fn\xdd\xe1˒҆\x19\xb8\x95\x93g#\xef\xfdX||\x9c=B\U000fe89ca\x16\xaew}\x96\x942D\xc0\xec\x14;,*c\x88U\x85\x85\xe5\v'\x94.D\xc1\x85\x86-\x9a'n\x81!\t\xdf\xc0\xfb\x13Ȍ-U\x88\x82얹\xc82\x85H\x88\xadb\x86\x13K\x14\x16\xb2sIiB\x8b\x991e\t\xab\x93\x8b\x04&K\x12\"\xc1\xf6\v\x17\xc6\xcb\x11\"AN\x14-\f\x96\"D\x82\x8d.Xpg\xde#\xa1.(V\x88\xb4\xba'iX\xdc\xd2\x1e>s\xc1\x82\xa5\x85\t\v\x8a\x12\xa2\xc2|\xcb(j$\u07bf\xafLS|\xf1\xc1,\n\xa18aq\xe1\xc1,\xe4VaBT\xd1\xc1,\xc8ᢄ邃Y\xa0\x91\x05\t\xf1NP\xa4&F6;\xad\xc0\xe0;\x8ag\xd3\x1d\x88Ѩ\xd0\x1d\x886\xb8\xd5vg\x97D\xbf\xbc\xee\xf9\xa8\x97\xbf\xe4P\x1bY\xc5H\xc9\\\x06\x15\x0f\xb7\xb2>\x1ePO\xb9\xf4\xae֤\x8a\xa4\xd57,^\xd53\xdfE)\xae\xec\x91E\xfb\xff\xc0\x12z2\x8d*\xc1-\x94LP\xcfTZGX\xf9\x16+\xfb<\xebf\x03)\xe87\x17\xcc\\\xee\xc8Ν.\x99=c\",\x88Y\xe5[\x8aׂ\xd3\"\xdf\xea\xccHW9\xbf\x87\xe5=\xfe,ɲ\xc5sṒA\x96Ϝ.\x89\x02io\u05c8>c\x12\t\x92\x82\x96\x8d0\xc4\xd4I\x93H\x881\xe7,N\x92pD:q\x84\xff_\x9bX\xfc6)\xc6\xfa\x13g bҎ\v\x13\x90\vR\x91'\x8b-\"=9\"\xb6\xf9De\x14L\xa0u\xf7\xac)\xcbs'/O\xe4\xed\x92=\x8a7\x16\xb3-#}\xb9\xd8\xc1ז\x15\xab3\x8c\x18c\xad\v\x15\xef*\xde+\x8cs\xcf\xe6\x82\xd9\xde\xe8B\xa1\xb8T\xa4Bg\xf6м\x8aљዋvq\xd1..\xda\xc5E\xbb\xb8h\x17\x17\xed\xe2\xa2]\\\xb4\x7f9\x17\xedۖ\xe0E\xa4\xb5\xa7P\x9c\x80\xef\xab0\xfck\xb2\x82\x9b3\xb0N\x0eU`t{\r\xbc\x06-\xfa\xd5Z\xd5\xebO\xb7X\x95\x86ؒ\xb7\xa0\xde\xee▶ǹZȨ\xa9\u05cd\x85A=Q\xcb\xdeYu7ٹ\xf3ڟS_7\xe61\xec\xf0\xe0\\/\x1b\v\xf4/{\xd9ص/\xd5ȑ\x85\xf0\xbcM\xf4b:6dg\xb4U\xb4\x9f6i\x9e\xa2\x04?4;x\xb7\xc8\xeb4\xc1\x8fu\uf23e\xaa\xd8\xf2\\\xf9j\xe1G\xbeW\xec\xeaOW\xdf\x1f\xa7\x17\xf3v\x94\x9b=6\xf5\x00\x87\xb7\xfej\xbb\xafl\x16w\xb5\v\xe9\xbeO\xe5\\\xaa\x8dc\xeaW\xe9V\x04\xbf\xfaV\xa6\xc1\xb0\xefu2\x1b̫\x17\xeaz\x0fn\x8ee\x03]\xe6^\x89ۃ\bv\xa5\xb2\xef\xe2:()d\xa9}\xdc\xe0\xce`\xfeֆ/|*\x94\x02\x19\xb1\x06\xf6\r\x1cd9P\xb1=\xc1\xbb\x99\xfa\xbd\xf1\xaa=7\xb3\xe8\xfd\xcf\xcfo6\xed'F\xfa\x1a>x\xe1\xe6ЃIe\x94(\xec\x11\x7f\xb1o\x16\xe4\x87\tg\xe4\xa0\"Q\xf9\x89\xe0\xd9\u0602\x15z\xb7\xf4\v>Z\xdcY\xb6Y\xaa3\xd3\x01\x8en\xda{\xa8M\x87{\xdd.S\xb5}Q\xd7\x16-Mf\x8fN\xad\xaf\xa8ޛ.\xb7[R\xb3\x17}\xfd\xd0|\xa5^Llj\xa6*\xafŎ3^#4]\x817i\xe3\xc27p-\x1a\xfd\xd8\x1a\xbb\xd9R\xe5\xf3_\x00\xb4\xa4\x9e.\x8a9\xf3\xb5s-\xd6\xc4T\xcc\xf9\n\xb5UL\x05\xe4ٯ\xee9\xffe=\xdf\xf0z\x9e\xc8\vy&\xed\xd0\x02YO\xad\xeb\xe13\xbf\xcb\x1e75\xb3uj\xb3\xbb\xf0i\xfc\x1a\x95X\xc3\xe8-\xa9?\x9b\xe5XK\xef\xe3k\xcd\xe6.\xba\xf9&Wۜ\xff2\x9boy}\xcd̲;\xa9%\x93\x0f\x97T\x89\x91#F\xaf\xff\xbfY-[\r\xb3\xdfK\xffNe\x83T-\xe7r\x00\x81\x96f\x7f\xec4'5\t>ִ\xb3ڃ\v\xd6}]\xee\xac\xe6efx\x91\xd9\n\xb0g\x9e\x0e\xee\xd9\xcd\x01\x8f\xd5{\xf5\x7f\x95\xf6\xb8\xe6\x96\n\xfc\x11>~\xaa\x94y\xd3q\xb9\x99\x86\x17\xcc2`C\xaaأ<a\x82\xf2%\x89\\#-\x19\x14\x05rX\x86+5\xae\x9d\xbe\xdb\x13\xa9C\x19\x18s\xc0\x1c\x12&h\xa1\x18Γ\x8c\x9a\xf2iwҚ\x1c\xaby\xf0\xcf\x12\xd5\x11\xe43\xaaڿ\xa8\xf6\x8a\xc3\x13\xca\xf9\xbd\xba\xcc\xea\x02Tomȝ\xe8\xb9\xd9\xf5\xf4\x84\xb7\xc2\xed\xe1\a\xc1vp\f\xf7\xaf\xb2\xac\x925\xdd\xfaE\xbb\x86\x91\xa6\x83P\x85\xacz\xaf\x96{\xaa]b\x86[u\xd8}\xf6\x8d\xc6\xf2\xad\xc6\xec\"?\xad\x1f'n7N\xdfpL\x80\x8c=\x1c4'ʨmG\x871g\xdcx\xccߋ3k\xc1\xbd=\xf6<\\@F\xec\x06du\xb6\xc3=\v\xb6 K\xef\x1d\x8ddS\xcc!\x9e\x16\x93ε\x15\xf9\x86\x9b\x91o\xb1\x1d9mC2\x03\xb2s8g~K2k\xaf\x16\xc9~\xce\xf1\x8fۚ\xcc\x1d\xa7\x898F3\xe9s\xc5a\xdaX^\xc7\x10]\xe2&F\xf1\xb05/ηU\xf9f\xf7p\x9e\x7f\xbb\xf2\xad\xefۜ]\xbeg4g\xe6\xf1\xb2\xe3-'\a\xef\xa5JQM\xe6:bUsR)[\xea\xf8\xb13f'\xf2\xef\x1dl\x8bY˕\x1d\x18TV\xa7\xde\x13\xf8;\x17>\x8fJg\xb2\x1a\xeb~\x00`\x13V\xb5#2\x1c\xff\xaf\xbd<'\x1a\x9f\xe5\xd2X02\x88)lIu\xf2\x9c\xe9\r\xbcgɡB\xcf6\x84\xc3\xe0\xbeb'U\xce\f\\U)\xaf\xd7\x0e8\xfd}\xb5\x01\xf8 \xab\xa4}M\xee5h\x9e\x17ّ^50\x00\xf3\xaa\t\xe24\x85\x18T\xbe\x82\xd1>\xe5fZ\x84\xf7\xb6\x91\xdd\xcb%\xd6\x01\f7\fR\xc1j\xa9\v\xf43\xaePrO>t\x0f\x1a@r@w\xa3\x90\xbf\n3:\x913z1\xa3W\x9cR\x18N\x05\xad4\xffK\xa1\xd1l\x16\x95$\x04\xfe\xdfˌ'\xc7\x19>\x04\x1dv\x8d;\x8a\xacp\x87\nE\xd2L\xfd\x17\xd4p\xd8Ѵ\x0e\xb5\xa7\xc1\x97e\xecd\x96ɗ\xd52?\x99\x15\xfc\xbf\x94\x8cz\x11\xd1\xdb\xfb;\xdb4̔\xbd\xfd#THUHo\x91\xe4T\x93\xb3Y\x8d\xba6M\x88\x03\x95\x86՟v\xb6V\x1e\v\x17\xabA\x80\xbe\xea\x91,\xed\xfd\x9d\xc3nc'\v\x95/K\xff6!\xae\xd2u\xc1\x949Z\xb1\xea\xeb\n\x87\x11\x98\xd6\x19r~\xc3fu\xc2\xf2\xfa\xc4E\x1a\xc1[K\xa0\xe7+Al\x9a\xb2\x1eGO\xc1c\xfc(\xe3\xec!\xc63\xe2\x11X\xd9\xc7dm9\xb5\x8a,\xca:[\x14O\vV\xe8\x834?\xc9g|7\x18\xcdk\xb1\xe7\xa1\xd3|\xa0\x9c*@\x04\n\x0e\xfa\x8a\xa9\x1eP:\xbc\x01\xb9|\xc6\xf44[<l\x8c\xc2ПeV\xe6\xa8#i\xf1\xad\aH\xa1H\x1b{\xc2\n\xae\x1e\x8eZ\xd1\xf4\xba\xff\xfcJ74#8{~\xf3\xe8\x032U\x968<\xfe\xe1\xfc5bt\x00\x82\xed\xf1G\x99\xd8\x05`\x8e\a\xed\xd6>\xf6a\xe7Pp\xf9B\xcdf\x98\rC[!GG\x17X}Z\xaem\xa7\xb7h\xb1\x1c2(\x13\x93ǘl\x86\x98\xc7G\xfb\xdeXf\xab!6\xefJW\xcb@\xd6N#q3\x10\xe68\xb0\xa5\xff=\f\xac\x17\x00\x99\xf44\xff\xd0\xc5[!\xb1ĕ\xfd-\xc2\xfe\xd9*YP\xb9\xc0\xa29\x15\xfd<ܫ\x11_k\b\x89\x044\xa2\xa1cp\x98\xd62\xe1\xd6Q\xb3\x91g\xaa\xc9\xf6\xc2\xeaS7\xbaa\x9d {ܙ\x1e\xb1`\xda0SvFi\xb1$\xa8\x1a5\x83\x84\x15\xa6TށHJ\xa5(~\xe7@XU\r\x15\xcdC$\x8d\xbb\x05\xdbʝ\xaa\xaan\xf4[c(P0\xeb\xea\xfd0\xd57,,F\x1a\x96\x81(\xf3-\xaa\x11\x93Ru\xb1\x8eޤ\x87\xe7\x1c\x90\t\xc19Vsap\x8f*\x82\xd6[_\xe3}\n\xadU\xdfxZu\x99Й\xa8]\x99eǪ\xbe|\t\xe1\x030\xcf\xc5\n*\xfa?I\xe6\xae\xe3\b\x13\x1cm\xa3v4J̾\xa8\x15E\x1a&oo)\xa0\x7f\xf6\xd4\xc52>x\x11\xf8\xf24mX^\xcc0\xe0\xb6\xdf\x03\x14&R\xa5\x9e|\xaaNc\x15\xe2L\xd7b\xee\xa3\x06\rp֒\x13\x13\x1d4L\x01\x9f\x91^\x87i\x0fvR\x06˂ԛn\x9f\x01\xa8M(\xfeXBYd\x92\xa5a\x81\xf3\xe89\x93\xe4\xb6\xc6\xf6\xaam\xf5JO\xc0\xb4;;\x92\xcd\x00\x13\xfa\x9a鶶7\xe4\x1b\xe1z\x10h\xd4\xd2?hk\x89\xa7>\x1a\x14!/\xdf2hh\xa3s\xd8b:~\xbc\"a\t3\x12\xdc1LmY\x969n\xf9?\xda\xfd\xb5W\xd4T\x8aW\x06\xdc\x01n\x9bc\t#\x0e\xba\xd1V\xa1\xf7\xbf\xf1b\xb3\x94A3\xbb\xbdl/\x157\x87\x91CB-.\xbd\rm\x03\x8fX\xf5\x83iP;GJ g\xb3Zv`iM/\xdb\xea\xd3Gߵe\xcdȣߴ\x19Fcr\x99\x06\xc8\xf0\x19\xb3\b\xb6\xfcH\xed\x86\xd4\xc6\x02\xb8\xb6\xc5\xcb\xf0\x86\x02\x99\xffI^\xd28\xaa.\x0fR7\xff\xcb_l{¿]4n\x01\a\xad\xaa\x840\x023LI{\x04핆\xdfP\x8d\xa4\xa1\xa6l\xe0\xf4Nm\x1c\x87\x89}Y\x98E?\xb2\xe3|\xc9\xefm\xab1q\x9b.M\bG#\xe9I{\x96Q\x1a\xaa\a\x11\xbc\x9b\x1dv\x1b\xbe\xa9\xdf!@\xe6\xdd@\xc7\xeca\xb8Cs~h>Sx\xb7\x1e\x8c\xf9\xb0x\x98#\x9bU\x9cگ\xe1a8\x9c\xbe\x86{TU\x8d\xfdj\x81f'\x9a\xb7\x9d\xdfhO\xee\xf6\xe1n\xac\xe7\xe8\xb2\x1e\x1a\xf4 \x03\xdc>\xdcu\xdc\xf9ޒ\xbeY-Q\xd1>e~\x05:\x81\xb2\xaa\xe7\x18eM\x1f\xad\a\xbcr\x190=?\x99ց\xd13\x14\xd9\x1bF|\xb6&\xf1\x97\xe8SѶ?t\x9a\xa3\xd6l\x8fD\x1a3\xf0B\xbb\xd2=\n\xf2\xf1\x06E\xe5s~\xf5!\xc5֔ظ\xe2\x04\x96\x18*ʱ\x03\x84\x12\xf0F\xabWC3'\x93{\xaaS\xb7M}\xbc\xdcOƅ<\xf9Rp\x15\xb3\xbd\x7f_5$\xdeغ\"\xabo\xf5\xfb\xd41\xe3{N{c\xd2\xc5=M\xd7=\xae\x13\x99Q\xa2\x7f\xf0--\xdf҃!\xa7\x8e\ns>(\x99\xcfP\xf6\xa1Ѵ\x1b\xaf\xab\xa5гu=\xa0\xd0lm\x98ړS\x1aN\x8c\xbd0\x8a\x89\xb3gƭ\xc7\x11\x18\u0600\xceԈ\x0f\xfbX\xb7\xe2\xbaa\x1a]\xe9\x13p\xa3+j+\xe4\xb4}A\x00\xb20\xfe\x00XOi7\xd2\xd2\"\xfa\x95\vem\x96r\xbeT\xf8\t\x99\x9eU\xaa\x0fͶ\xbe|\xc0N\x03\x7fm\xaeKv\x10\x9bP\x18\xae\x02Z=\xa0T b\a^\x86\xa9տϨ\"\xfc\xdc\x0fͶAK\xbcT|\x92\xe9\xd9=\xbc\xf6\xa1\xb9\xfex\xf4\xcdٯtit\xce\x05\xfd\x87\xbc\x16\x9b\xdf\x0f\x9d\x17\xe1O\a\xa9\x1f\x06\x82\x1c=\xe4\xffZ5\xac\x93\xaf\\8\xb4I\xe6lKǀ\x88\xa2*\xe0\xd1\x03\b\xd5ik{\x86^o\x96N\xd6io\xda\u009cXO\ai\xfa\xbae\xb4\x1av\x03\x0f>\x95ɲ\xecx\xdd\x05\xdd(\xfc\xa1!\x1c\xf0\x11xr\xd7|ч\xdfY\xd6wwT\t\xf1\x1aú\xf9\b\xc8p\xd3Dk\xe1\xecs\x7f\xce\xd0WԎ\xc5!\x869<\x1d|\xb0\x00G-\f\xfdk\x05\x15\xc6B\b\xf3\xb8O8\xc3Ł\xe9\x81tL\x8b\x94{j\x03\xbc\x1fܫ\x8c\xfcX\xf8|̻\xfc\x19\xfb\xd1^w\xb1\x03\xa6\xf6h\xc9\xf0\x1a\xb1\x86;q?\x96\x9a]\xc3?\x18\xa7;\xc7>Hu\x9f\x95{.\xea Т\xc6\xf7L\x19N\xba\xec\xf0\x19\xe8\xfb\x81\v\x96\xf1߆lT\xf3\xe1<\xa0\xca\xdd\x1bx\x16\x81\xc6(Xz\xb7M6\xfc\xec\x1dRlD엘ʐ\x0e\x9f\xd3\x13\xdfl\xceLV\xabc\xe5\xd8\xf5\xe0\xd6cn\xa8\xa0\x0fC\xe9#o\xc3$\x8f\x1d\xb5Y\xe3n'\x95q%1\xeb5]L\xe7\xe2\xcd\x03pɪ\xd8\xd2\xed\xb2 ω\xf6L\xa1\xb4\xac\xb1\"\xd9T\x92\xb2\v\xab}\xd1FΎ\x94(\xe3\x82%\t\xa53\xf0\xb56,\xc33\x9bq\x1bا\xb9\x84\xe9/\x03\xa1\xbe\x1e\xc3\xef\x9a\xed\xc3\x04\xad\xed\x8b\x05\xe78g\xb7\x87\xceS\x1e\xdc7п-\xa2\x80\x17ōAѮm\xafB,Z\u008e\x9dd\x82\xc8\xc70,\xbb\x1b\xaf\xb5kQ\xf6X5\x1e3\x9e\x9e8Ib\xd9Z\x96\rB\x05\xa0k\x0f\xec\x95\u07be/\x89290\xb1'\xa5R\xb2\xdc\x1f\x82^\x8e\xec3F\xe0\xa6%!\x05\x85\xb5\x1e~\xc9RhJ%\x1aeq\xbe\xd28m\xa0˒\xa7QL}\xed\xa4\xd5\xdd\r\x97\xaf\xfd\x9b~\xd6\x14\x9bY{Y\xd8*\xeek_\x0f\xa48\x9d\x1f\xb6%\x05#@\xebWjX5(\n:\x7f\xab=>\x117\xa1\x9d\xbc\xb2\xb8\f\xdd/T\xd5r\xb3\x9a\x14\xf6\xa7\xbae%m\n7\xbb\x8a\x98\xf0z\x8f \x8eW\x9a\x82\xb6C\xc9\xcf\xe9\xe8\n\x81\xce$)\n\xd5B\xf9]\x1f\xfd@\xea\x11z\rA\xd5\x14_I\xc9l\xd6\x01kn~\xdf\r\x99\xbd\x01\xc2zV3\xbc|\xa8\x1a\xfa \xbb\x9eR\xed\xc1\xcdq\xa10\xe8\x1a)\x19\xdd\xf7\x1c\xfe\x1e\xf1]G\x13\x92èygz\x14?\xe6\xc8]-\xb9\x81jڰF\xe6R\x06\x90\xbe\xed\xf7k1\x96\xe4\\\xdd\xcb4\x02\x10\xe63-q\xfa\x13\xa5E\xb3\xba\xe4]\xb3\xa9+\xcaZ,\xb01\x9d01\xc3\xfe\xd2_D\xe5}\xf4\xf1\xb0z\x04&Sw\x9bϖ\x04\x05LN\x1e}\xc4\x01\x9er\x83\xab\xe8\x03\rle\x1bv\x19׀|\xc2\x16\x03\\\x15\n\xaf\xa8.\xff\x8a\xa6\xd5\xd5\xc9X\xbbCPQh\x7f\xb2M\x03\xdf\xea\xd3S~ʉ\xfd,\x0f\x87\xbd\xf8\xe0P>\xd0\xc6\n\x87ϺM\xfa\xa9\x15\x00\x17\xbc9\x95\x15\x9a\xba/\x9b\xd4\x0f\xad.\xe3\xf3\x99d;\x02Ϗ\xfb}\xcc\xe6\xf1\x14\xc9\xc4ebk\xa7\xfa\x83O\x9c\x96\f<\x9aX\xefgI\x19+@\x99\x17\xe1\"\xe1\xb5\xd2\xdb\x13Bz\xf0\xa5\xd1.Ov\xab\xb0\xba\xd5\xc5\x02\xa62f\x91\xf8\x8d\x87=\xa1\xe3\xbdF\xba\xb9\x8a\xaa\x9d)\x928\xb0\x16B?_\xdd\xcaN\xb7\xd1\u05eb\xe5z\x13\xc5\xe6A]y\xae\xb6\xd6\xefc\x82\xf9\xf5N\xbc\x19֯.\b\xa2\xb0~\r\xd1\a\xe0{\x10\x01\xfe\xc0w\xee\x9cZBX\xffq\x81\xfb0\xa9\xf6'k\x9b\x0f\x16\xce\x10\xffj2Zi\x03\x91U\xd8\x11\xdeQ\x90\x8b*\xca\a\xa7\xe0}\x86\x14@ш\xed@\xe8\xab\xd5\x12g\xbb]\xb8VG\xd8f\xe8\xf8<\xd2ml_5\x15\xf3s(\x80>OB\xa9CP\x15\vYFP\xd5\xed\xab3f\xe7\xa5\xee\x85)*\x06\x9c\x9bc\xff\xf0\xcd\x06Rf\x1e\xc2@Ҭ\a\x12\xea4Z\x88f\x8cx\xfc\x9bf\xce,\xe08\x92\xb4\xee\xe4\xd1Δ5\x1b\\Bz?Z\x03\x9a6\xe6\xb6\x1f\xe9\x06\x8c*q\xf5\x7f\x03\x00\xb3\xf6\x15\xc7\xc9\xc9\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecX\xc1\x92\xdb6\x0f\xbe\xfb)0\xf3\x1fr\x89\xb4\xc9\xdfKG\xb7d\x9b\xce\xec4\x9b\xee\xacwr\xa7%\xd8b\x96\"U\x10\xf2\xd6\xed\xf4\xdd;\xa0$[\xb4d\xd9δ\xb94+\x1fV$\b\x02\x1f\x88\x0f\xa0\x92$Y\xa8Z\x7fF\xf2\xda\xd9\fT\xad\xf1wF+o>}\xfeѧ\xda\xddl\xdf.\x9e\xb5-2\xb8m<\xbb\xea\x11\xbdk(ǟp\xad\xadf\xed\xec\xa2BV\x85b\x95-\x00\x94\xb5\x8e\x95\f{y\x05ȝer\xc6 %\x1b\xb4\xe9s\xb3\xc2U\xa3M\x81\x14\x94\xf7[oߤo\xff\x9f\xbeY\x00XUa\x06+\x95?7\xb5gGj\x83\xc6\xe5Ae\xa57\x14\xfe\xf1\xe9\x16\r\x92K\xb5[\xf8\x1as\xd9jC\xae\xa938L\xb4\xaa:3Z\x17\xde\a\xad\xcbV\xeb\xc7N\xeb}\xaf5\b\x1a\xed\xf9\x97\v\x84?j\xcfaAm\x1aR\xe6\xac\xc5A֗\x8e\xf8\xd3\xc1\xaa\x04V\xdeT픶\x9b\xc6(:\xa7h\x01\xe0sWc\x06AO\xadr,\x16\x00\x1d\x90\xc1\xdb\x04TQ\x84\xd0(\xf3@\xda2ҭ3MՇ$\x81\x02}N\xba\x16\x91\f\x9eJ\xec\xf6\x84nS\xe8w\x85\x16q,`M\xae\xb5\x13\xe0\x8bw\xf6Aq\x99A*ا\xedy\xe8\x01\xea\x84\x04\xfa\f\x96a\xaa\x1b\xe2\x9dX홴\xdd|\xb5\x1d\xecNX\xc1\x8a6ȓV<\x85\xa9+\xac\xf0\xac\xb8\xf1\xe0\xd6\xc0%v{\x1f\x94\x0ew\x0e\x82i]*\x8f\xb1\xe3a\xe2\x8a-mS\xad\x90\xe2-\xb1\xe8\xf0\xf0'w\xee\x05\xdfGr-\xf8\xf1X\x8b\xbe\x1c\x86\rRg\xc5@_\x9f\xc3iN\x18 \x7f\xd2\x15zVU\x1d\xe9|\xb7\xe9\xfdl\xf5\x15\x8aہv\xcb\xed\xdb\xf0\xe2\xf3\x12\xab@\a\xf2\xe6j\xb4\xef\x1e\xee>\xff\xb0\x8c\x86!\x06a>\xdb@{P@\xf8[\x83\x9e\x81\x1d\xe4\xaeޅ\xe8\xc4\bɣl1\x98\x01\xc2\xday͎4\x86\x90\xaa\x93\x87\x8c\x1d(\xeb\xb8Dz\r\xca\x16\x03\x95\xec\xa0r[\x14\xb5\x9a\xe0\xdd\xc3\x1d\xb8\xd5\x17\xccً)\x9aӽhM\xaeFb\xdd'xgсc\a\xa3G\x00\xbc\x12\x8c\xda,\x86B\xc8\x15\xbd\xec\xd7g6\x16\x1d\xac\xe2\x03\x97ڋc\x84\x1e-\x0f\x0fg\xff\x88\xa3\xb6\xb32\x85%\x92\xa8\x01_\xba\xc6\x14\xc2\xc9[$\x06\xc2\xdcm\xac\xfec\xaf;\xf8#\x9b\x1a\xc5\xd8\xf1\xdb\xe1\x91\xc3CV\x19\xd8*\xd3`\xc0\b*\xb5\x03B\xc1\x02\x1a;\xd0\x17D|\n\xf7\x8e\x10\xb4]\xbb\fJ\xe6\xdag77\x1b\xcd}m\xc9]U5V\xf3\xee&\x94\t\xbdjؑ\xbf)p\x8b\xe6\xc6\xebM\xa2(/5c\xce\r፪u\x12L\xb7\xe2\xb0O\xab\xe2\x7f\xd4U#\xff*\xb2u\x94q\xed/\x14\x81\x99\b\b\xef\xb7'\xad]\xda:z\x00Z\xdbM\b\xc9\xe3\x87\xe5\x13\xf4[\x87`DJ\xa1\xc3\xfd\xb0\xd0\x1fB \x80i\xbbF\n\xeb\x02\xaf\x06\x9dh\x8b\xdai\xcb\xe1%7\x1a\xed1\xfc\xbeYU\x9a}\x9f\x05\x12\xab\x14nC\xc1\x85\x15BSK2\x16)\xdcY\xb8U\x15\x9a[\xe5\xf1_\x0f\x80 \xed\x13\x01\xf6\xb2\x10\f{\x85ßh\xc9:\xd4\x06\x13}i?\x11\xafy\xcaX֘K0\x05OQ\xa4\u05fa\xcbs\xb7\x8et\x02\xa8\x03ǃ[\x0fie\x96LB\xecz:9R9C.\a\xb68\xcd\x18\xf2T\x8a\x9e\xdb\x02\xfa\x88\xaa\xf8՚ݱ\xc4\x11\x1e\xf7\xa3\x05\xe0Q8\xaaDPy\x8e\xdeC\xe5\n\xec]\xf4\xc3\xe2<|\x866\xef59\x9b#(c.EgB\xaf\"\x14\xde\xd6X\x84\x85[$\xbd\xd6X\xc4x\x1c\x8e\xc3\xca9\x83\xea\x98\xd8\xe2n\xe3\f\"\xcbH\xb8?\rR\xab\xe28\x8fj\xc1H-\\\xea\xf7\xd0K9 \xa7\xbc\x1be\x86\xfc\xe2&\xe6\x8csO\x91\xf07w\x8e\xdd\x15\xae\tgi\xc2#\xf6M\x8e\xa2y49\xd9\xd2\xcd\x13Fh\xbb\xb2\xc5I\xcc\xcePFX\xde#\x997Dh\xb9S\x1a\xe9\x04AX\x9d\xe9Y.\xcd\xf3\x0e\xf73\xd1\ueeb9p\xbc\x86\xd1\xeaB\xdd\x02\xb9\x0f1\xd4\xe4$\xe1q\xd8\xc5\xf4\x8fw\xb0V\xf4\x1a^J\x9d\x97A\xa1\x7f\xd6u\x8d\x05\xbc\x94h\xe3\x9eW\xc0 \xf4M5\x95\xa7\x9a\xb1\x1a\xb9s%\xe6w\x8cU\x8f\xb8ldx\xcc\xcf]\xcc#\xbb\x86\x9d\x9c#P\xa3\xe3\xba\x1b\xdb;\x17\x85\xd0 \x00\x129\x9a\x9e:\xf2\xea\x83H\xf6\x86\x87ec\x03e@0\x82\xb5\xd2f2\x14\xed\xefEs\xf9\x1a4\xbf\xf2\x80U\xcd;Ѓ\xb5/\xcawZ\xa7Bp&\xef\xfaGX\xe1\"\xb7\xe4R9C%n\x7fE\x99\xacz\x87g>\f\x17\xd8<\xcd\x18=5\x88i\x13\x13'h\xa1à1F\xad\ff\xc0ԌW\xb7k\x15\x91\xda\x1d\xcd宪\rF\x97\xa2l1\x8b\xe2\xedxEh\xb6\xa9h\x91e]\xe1\xd1qyQS%\xb3\xdbz*\xf2kG\x95\xe2\xf6\n\x96\x88\u0091\xc4E\x0eO\x06 \x9cg\x7f\xc6ɐ\x01^.\x13\xac\xb4\x95\xba\xb1k\xf3G|T\f/H\bhs\xd7Ƚ\x01\v(\x9a\x13\xb1\x8e\x90\xb8\x82ff\x8f\xd0W\x87\xfb\xe8N}\x06\x85\xfbXz\x9f;\xd1}\xbe'\xeb}\xe9\x1c\xe9l魭x\a\x16o\x99z\x8c\xc8\xf82?6\xff\xb1\xcf@\x8d\x97\xfa0\\2\xe7ȹFo\xef\xe6?\xe6T\xf8\xc2rƋ\a\x91\x99*\xde{\x12\x9b9ch\x9bj\xac?\x81O\xf821zg\x1f\xc8m\b\xfd\xd8\xfb\xa4O\xfe\t\xbeO\xe0A\x11ke\xcc\xee\xe7銐\xc0\x89\x89\x99\xa3>\f\xc6\x19\x88\xa2\x00ǝD\x14\xd3˯\b\xfb>㪮bB\xe3\xf7>\xe3{\x9f\xf1\x1f\xef3<+\xe2K[\x8ce$|Aw!\xf7\x18\xfa\xe6\x9d\x04;V\xe6\xb2J\xfa4\x10\x9d\xab>>\\h\xb1\x00\x1dXe\xa4\xb4\xffF\xb0'\xa9\xebJM\xb0xH\x94\x97\x98\x1d\x11\xeb\x8c\xed\xe7*\xe7\xf4m\xee\x1a\a&\x8f\xe6h\xd0\xcb\a\xe1b\x10\xce\xee\x1b\xc1p\xa4Y\xed\xbf\xaef\xf0\xe7_\x8b\xbf\a\x00\x8f\xd9\xcc\xc5.\x1c\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z͏\xdb8\xb2\xbf\xfb\xaf(\xcc\x1c\xfaҖ\x93\xbc\xc1Ã/\x0f\x9dΛ\x87`;\x93Fw\x92\xb9\xecah\xa9ls\x9a\"\xb5\xfcpǳ\xd8\xff}Q\xfc\x90d\x8b\x92\xd5Af\xa7m \xb1D\x16\xab~\xf5\xc9\"\x97\xcb\xe5\x825\xfc\vjÕ\\\x03k8~\xb5(\xe9\x97)\x9e\xfe\xc7\x14\\\xad\x0e\xaf\x17O\\Vk\xb8uƪ\xfa\x01\x8dr\xba\xc4w\xb8\xe5\x92[\xae\xe4\xa2F\xcb*f\xd9z\x01\xc0\xa4T\x96\xd1cC?\x01J%\xadVB\xa0^\xeeP\x16On\x83\x1b\xc7E\x85\xda\x13OK\x1f^\x15\xaf\xdf\x14\xaf\x16\x00\x92ո\x86\r+\x9f\\c\xac\xd2l\x87B\x95\x81dq@\x81Z\x15\\-L\x83%\xad\xb0\xd3\xca5k\xe8^\x04\nq\xf5\xc0\xf9[O\xec1\x10\xbb\x8b\xc4\xfc{\xc1\x8d\xfd\xdb\xf8\x98;n\xac\x1f\xd7\b\xa7\x99\x18c\xcb\x0f1{\xa5\xed/\xdd\xd2K\xd8\x18\x11\xdep\xb9s\x82\xe9\x91\xe9\v\x00S\xaa\x06\xd7\xe0g7\xac\xc4j\x01\x10\xa1\xf1\x82,\x81U\x95\a\x9b\x89{ͥE}\xab\x84\xab\x13\xc8K\xa8Д\x9a74$\xc9\x02Q\x18HҀ\xb1\xcc:\x03ƕ{`\x06n\x0e\x8c\v\xb6\x11\xb8\xfa,Y\xfa\xbf\xe7\x18\xe0w\xa3\xe4=\xb3\xfb5\x14aV\xd1\xec\x99Io\t\xe15\xdc\xf7\x9e\xd8#\t`\xac\xe6r\x97c\xe9\x8e\x19\xfb\x85\t^y\x91?\xf1\x1a\x81\x1b\xb0{\x04\xc1\x8c\x05K\x0f\xe8W@\b\b\"\x84\x84\x10<3\x13\xd7\x018\x04*X\x8dr*\x06kš\x81mb\x05\xbe\x9cQ\t\xfcӓ\xc8}\x8fl\xb2\xef\xa2\xd4ؒ4\x96\xd5\xcd\tݛ\x1d\x8e\x11;\x81\xe2\x1dn\x99\x13\xb6/*\xdbu\xc2f\xc4j\xb0,\xaa0+\xbe\r\x92\xbc;y\x16V\xdd(%\x90\xc9E7\xea\xf0\xda\xff0\xe5\x1ek\xef\xa3\xf4K5(o\xee\xdf\x7f\xf9\xafǓǐ3\xa43\xa7 ű\x9en\xf6\xa8\x11\xbex\xff\vz3Q\xb4\x96&\x80\xda\xfc\x8e\xa5\xed\x94\xd8hՠ\xb6<9K\xf8\xf4bQ\xef\xe9\x19OW\xc4v\x18\x05\x15\x05!\fv\x14\xfd\x05\xab()\xa8-\xd8=7\xa0\xb1\xd1hP\xda>\xbc飶\xc0dd\xaf\x80G\xd4D\x06\xcc^9QQ\xec:\xa0\xb6\xa0\xb1T;\xc9\xffhi\x1b\xb0*\x1a\xaf\xc5\x18\"\xba\x8f\xf7O\xc9\x04\x99\xaa\xc3k`\xb2\x82\x9a\x1dA#\x81\x00N\xf6\xe8\xf9!\xa6\x80\x0fd\xef\\n\xd5\x1a\xf6\xd66f\xbdZ\xed\xb8M1\xb8Tu\xed$\xb7Ǖ\x0f\xa7|\xe3\xac\xd2fU\xe1\x01\xc5\xca\xf0ݒ\xe9r\xcf-\x96\xd6i\\\xb1\x86/=\xeb\x92\x046E]\xfd\xa8c\xd46W'\xbc\x0e\xbc6|}Ԝ\xd0\x00E\xcc`\x05aj\x10\xb4\x03\x9a˝G\xe7\xe1\xff\x1e?AZ\xda+\xe3\x84h2\x8bn\xa2\xe9T@\x80q\xb9E\xed\xe7\xc1V\xab\xda\xd3DY5\x8aK\xeb\x7f\x94\x82\xa3<\x87߸M\xcd-\xe9\xfd\x1f\x0e\x8d%]\x15p\xeb\x13\x13l\x10\\C\x8eY\x15\xf0^\xc2-\xabQ\xdc2\x83\x7f\xba\x02\bi\xb3$`穠\x9fS\xbb?\xa2\xb2\x8e\xa8\xf5^\xa4\\8\xa2\xaf\xac\x17?6X\x9e\xf8O\x85\x86k\xb2p\xcb,\x92\xf3\xb0\x13\x8a\x90\\<K\xeddh\u07b9\xe9\xc3\xca\x12\x8d\xf9\xa0*<\x7fs\xc6\xf2M;\xf0\x84\xc7\x06u\xcd\r\xb9\xbe\x81\xad\xd2\xe7\x19\x83\xb5\x11\xb8\xffI\x91\xaa\x18\xbcC\xe9\xea!#Kx@V}\x94\xe28\xf2\xeaW\xcdcd\x9f\xa1H\xfa\x06\x16\x1f\x8f\xb2\xbcG\xcdUuA\xf8\xb7g\xc3[\b\xf6\xea\x19\xb6ެ\xa5\x15G\x8aA\xe6(\xcbH~@\x13\xe0\xe6\xfe}4\x96\xe8@\xd1\xdf\"V\x05\xdcD\xcfU[x\x05\x157T\x00\x18Ot\b\x96t\xc2\x17\vk\xb0ڽH\xfcR\xc9-\xdf\r\x85\xee\xd74c\x16s\x81\xf4\x19r\xb7~%\nMd\x1d\x8dV\a^\xa1^\x92\x7f\xf0-/)\xa0o\xf9\xceio\xb3\xb0\xe5(*3\x94t\xc4\xcb\xe8[j\xacPZ\xce\xc4\xfa\x02'\xed@Z\xd42.C\x96\xea\b\xf8`\xa3\xeb\x98R\xa5EY\xb5\xd5H\xffc\x95\x8fZ\x06+x\xe6v\x1f\xc2a\xb2\xe9\xc1\xf8qߣ\xcf\x13\x1es\x8f\xcfx\xff\xb4Gx\xc2#\xc5\x00b\xd9`\xa9\xd1zkCA\t\x8cL\xa9\x00\xf8\xe0\x8c%\xd6\xce\xe3D\xfa\xf3\x85Z\x9a\xfd\x84\xc7!\xd0\x17\x95\x1bK\x98\xcb,_Q\xe9\x9c\x18ָE\x8d\xd2f\x83:m@\xb4D\x8b~sS\xa9\xd2PN-\xb1\xb1f\xa5\x0e\xa8\x0f\x1c\x9fW\xcfJ?q\xb9[\x12\xe0\xcb\xe8A+bŬ~\xf4\xffd9\x02\xf8\xf4\xf1\xdd\xc75\xdcT\x15(\xbbG\r\xce\xe0։dh\xbd\xfa\xe6\x1a(\x15\\\x83\xe3\xd5\xff^-2\x94.ᢼ\xae\x98\x98\x81\rEz\xbe=\xc2\xf3\x1e=S\x04\xd1cЊ\xd2@\x99\x92\x94]Gm\x86XSM\xe8\xaa_a\xf6\xff(0Q\x06\x19\xb2\xb4$sz\x89\x9b\x01|]v\x8aZ֬Y\x86\xb5\x99U5/\xcfF\xc7\xd2x\xbd\x98\x84!\x95\xdd\\V\xbcd\x16ͩ'\xa5\xedH$6\x1eTc\xf0l'\x16\x8b\x97\xc0\xb4e\\\x90\x99\xa5\xc4i.p\xfd\xf3\xf9x`:씼\x1d&\x93\x9f\x9d\x04\xcd5p\t\x8d\xe6Js{\x04\xa5+\xd4\xd7=\x12\x06,\xd3;\x8c%\xdcT\xa4\x01\xcf\t\xa1\x81\x15\xd1|ޣ\x04n\xaf\f\xb8n7\t\xac\xdd\xe7\xd0\x16\xaf\xf0\xb1e˵\xb1p\xbe\xe3\xec\xff)\x99\x9c\xb9&\xbd8\x83\xd5\x10fn\xb1\xceF\xb9Iי\x95Ƙ\xd6\xec\xdc`C\x18\xb8S\xe5\xd3\x05\x95}l\aB͞\xd0\xf4\x15D%\x1d<kn-\xcav\x0f\x11\x11\xbe\x1e\x90\xa5\xadD)\\\x95\xea\xe9HDc\xa3\f\xb7Js$uֵ\xb3$\x91\xaf\x88\x18h\xb4\x94`\x94\x84\xc6\x17\x1b\x19\xaa\x9b\xa3\xe7)V\x03\x828\x8dv\x14\r\xa8x)l\xd3i\xa7\xce\x16|\x03\xe0\xa8.Ln\xd8獦'\x06{8\x0e\x99\x1c\xaf\xe9賄\xff'ϓL\x96C\x01賄[U7\x82\x8f\x0e\xb8\x10\x93[\xe4Ǫ\xbc\x81\xc4\x0f\xa73Hx\xaa\xf1\x84:U\xb8\xb7\x9a\xe0\x8bO#\xa1\x19\x80m-R8'\"\xd1\xc2\nxo\xdb\xc0\xce,\b\xa4\xd6Ǜ\x9f`\xaf\x9c6\xc5\xcbe\x9c\n\xf1\xa4\xa5\xcc\xe33P^\x92\x05\x82\rĝ\xc6z1\x89\xe4\xc7\xfe\xd8\x14,!\x16~\xd1\a\rZ\x8ak\x06$\xd2\xee\x82\xe9aN\xf2\xe5V\xa9\xa4\xa4:\xc7*`m\x11ye\"?)\xc8\x16/t\x82\x8d+\x9f\xd0\xce0\x8a\xb7~`r\x840\x8d\xd8r&\xb8\xf8%6.j\x11\xa0d\xb7\xa8\xe7\xf0r{C\x03\xdb\r\b\x83\xdb\x1b\xd88Y\tL\x1c\xf9\xb0\x7f@ͷ\xc7\xfcZ\xf4\xf9t\xf7\x98P\xa5\x1c\x94\"_\xc26/C\xa8\x8eװ9Z\xfc\x16!\x1b\x8d[\xfeu\x86\x90\xf7~`\x02\xbcav\x0f\\\x1a^!\xb0\f\xfca\x1b\x9c\xa5\xda\x16\a\x05|\x8c\xf5\xd9wv\xb2\xc0\xceK\x9c(a\xbc^\\\xc0 \fkQ\x88\xd3\xce\xe2\xee\xa8\xd1MH\xe4\f\xdb\xe1\x036J۟)\x80\xa0,\x8f\x17\xb8\xf9\x9c\x992\xb1\v.\x99(\x9dH\xed\xd6\xd3?b\xde\xf0?\xda\x04\xe2ciW\xb5\xf4rM\x94\xed\x1a\x9e\xf7\xbc\xdc'5\x98\xc5\x19A\xaf!\xd6\xe6dfC;\b\r\xad\xc0\x84\xe8\x914i\xd1TD\xb5;\xee\fQ\xbf\aW\x1a\xa4\x8a\x9b\xf2v?N\x04=\x88\xd4(S\x9abX(\xa4\xc2Cn@Iq\x8c/\xb3\xf9!\xf5Jz\x92\"4\xc2\xedh[\xca\xebF`\xddk\xdb\x1dޜ\x0e\xbc\xb9\x7f\x9f\xabM\xb0\xd8\x151Dqa\x97\\\u0096\v4Gc\xf1\xa4Ӏס,\x94x@\xddr\t[\xa53D\x89\xde\xe1u\xe2-\xe9\"\x94A\xa1\xeeMl\xf7\xd06\xae\x89DK\xa1\\\x0e\x80\xe4\x05\xe6ŕ̈́aǓ\b\xae\xe4\\\xbb\xfe2\x9c1a\xd6\xe9\xa4c@3Xu\xa9\xb4F\xd3(\xe9mq^k\xa7c\xf9{\x02\xf1\x8c\x9b\xf7\xbe\xdba/\x01\xf0k7\xb2W\x1c'\xf7\xf01֟\xa3-\x05?`\xd5떘\x9c\x05\xaa\r5V\xb0\x82\xcd\x11\xf0k\xb9grGP\xf8\xa4MhP7\x93v\xf4%\x02+K\xe5\xa8k\xac\x9eP\xa6\xdea\x86doEr^\x06Z\t2`i,\xb2*<\xa2c&^\xf6\x86\xfa:\xabd\xf2\x8a\n\xad\fQڿ\x80U\xbb\xb0\xff\xa6\xa6B\xaf?\xf4\x9dkm\xe2\xf7\xe6\xe1\x97ܫ3]<\x84\x91)\xe2s\xcfϖw1\x9fH\x013\xc6ձ\xf7\x94\xa5\x19\x8c\xf1\x197\x91\x82%\xdb}\u008c\x81]\xb0\"\xfa\x1a\xf4\xdd\xddw\xb1E7C\x8a\xc7\xd3\x19\x83\x12zĜ\xb2\x84Ö\xd6\xfbH\b\xb0\xbd\t\xfe\x95ƭF\xb3'\x8b\xc3-\x85F\xbbG2\xbd\x86k,\xd2q\\.c\xc4\xca\U000b5bfc\xf3\xc8\\\xd0\xfa\f\xf0<\xec?s1g\xaf\xf5)\x8dm\x13>\x95=j;\xa2L\xe0rB\xf9\xf1d\x80ι\xa4\xaap\xc9v(-4\xaa2\xd7\xe0\x8ccB\x1c\xa7\x9cr\x8c2\xb1ءJE\xe3\xea\xc0\xf4J;\xb9\n]E\xb3\n\xc7\xfe\xabH0\xd2[}\xab\xfdMU^ѯ\xe6\x97^ybKP\xfd\x9d\xcaٻ\x94\xa6\x163V\xa0(\xe4\xceB@\xee\x18\xe1tK\xf4\xe8g\xb5I\x87\xb4\xa76\x04_\xef\xe4\xe7\x84$\xe4\xe9,\xe6E\xa4\xd9\a>?\xf4N|\xe8dQ\x82\x93>l\xfa\xdei\x01\x7f\x97\xf0\x8eN\t\xa9sW\xad\xc9Ju\xceG\xb8\x01\xa9\x9eiz\x8f\x9e'\x01*\x14{\xd4\r\xf5'\xb2\xbe\x8f\x1e^=s!\xa8\x93\xad\xb1V\x87l\x01EA@#\x99\xb1/<\x0eo\x8aW\xc5\x0f\x8by\xbd\x87?\xeb<\xe9\x96|\xe7\x02\xaco\xbb\x91\xc9ѥ\xab7\xe7u\xbd9\xad\x88\a4Ӓ\xa9\xbc\xe8zV\x11\x90\xf6\"\x87q\x1ew\xeazO\xd5\x1a!\x92\xd1A\xf9\xee\xccځ6\x8a\x06Kg\xf9\x01\xa9\xfb\xe94\x9a\vR\xde\x0eg\xe4\xa5\xedX2C;O\xd1lD\xd4X\x8aR\x03\x97|\x85\xcb\x12sbg\x88*\x89/C\x80\x90$A\xb0\xea\nF\xba\xc6r\x01\x86\xbb\x91i\xf9\x9b6\x1d\x16\x03\xaa\x90\xf0\x1a\x83\"\x80P,ƶ\xecT\xb5.mw\xf1fv\x96\x9b0z\xe2\xfe\xb1\x85\xf9\xc5\xc0\x8cM̓3\xbb\xfbOW\x93\x12\x94d\x16\xed*\xe2\xf8\x17\xc0s\x94%V\x0fx\xe0\xc3;4\x03P~\xb8\x1b\xccHX\xb4;\a\xfa\xf1[\xba\x8a\xb0\xd2q\xd8o\x03\xc2\xe0\xb7~i?=f5C\x98\xdf>\xde]\x19rx\xea\x11\xe6\xea\xa6g\xba[D\xe7\xd1~\xb7\x1e\xdbF\xa5pƢ\xce$\x856\xa2\xfb<\xe0\x8b\xc0\x81s\xd17\xde\x01\x01\xe5\x8f\xe8*R\fTH\xd77h\xff\xe07\x12\xd8\xdd\xf1\x89\xfcOs\xca\xe4 \x8ftY\x83˱\x941K\xa3sM\xbc\x1d\x9c7\xea\xc4}\xd2lR\xccKq\xff\x8f\xdb\xf5\x8b\x9d}\xbe\x8b{+\x9d:0;s\xf0\xbf\x0e\an\xec\x1d\xb33\x9a\fw\xdd\xc8$9\xf1\x02\x96Q!o\x95'\x95\xe9R\xcd\xcf\xfaP9\x9d\x9aE\x1eԩT\xff\xed\"\xd7h\xcc\xe5\xce\xff\x870\x8aDei\n\xb0\x8drv*\x18]\xe5|8\xde\x1f}\t\x8f\xfeV\xec\x05\x0e\xfd=٤\x8a\xd2i\xba\x9b\xd0]\xb3\xa2\x87\xd9\x12\xbb\x98]_\xb6\x17y3\xef\x86W{\xe7\xc9\xe5fZ۽\x9b66:\x8a\xc2\xee\x8ee\f\xaa\xdf\xc7\xd6\xe0\xbd\xef+*\v52\xe3\xf4d\xef3\x15\xe1-\xe5\xef\xda\vt3,\xf5s\xb2\xd3\x19=\xe9S4&\xe2S\x04\xe1\xa4\x03LW=B\xba\xcd6\xd2G\xdd=\xd1$[5h\xaf\xe3>)0\x1b: ~\x1d\x83\xb6\x855\xd7Rΐ\x9e\xd3d\xfeΝ\xb0\x00\xe1ۣͿ>S\xce\xdbnt_E\xd4\xfa\xf3'P\xe6\xfa|\xcb\xc4d\xce\xdc\xe8\xa31ރ\x1e?\xa6N\xa9\x82K\xfb\xdf?eGLm\x10Rb\xfc\xec\xeb\x98|R\x1cHxw2!\x9f\x14\xbd\x19\xfb\x94מ\xa9T\xd3\x02\x8c\xe7\xba\x19\n\xbc\xe0T\x93I`*\x11\x90(\x1a\x99\x89Eg{Nһ\x99r\xed\xcf/\xb2Ta̰\x93\x05t~\x92\xac:\xd6ͯ\xe3\xac\x11\xb2\xddqJ\xdcL\x86\x9eq\x9b\x8f\xc9\xe0\xda\xea,&\xe7Ј\xf4oF\xa82\x8dD\x85\x9c\x92o\xc3Q\x8bA[|\v\xd8\xed͒\xe3\\\xb7y8\x9d1\xcbu\xb2T{\x8bs4\xd36\xf7\xadN3ګ˾\x18<\fm\xb2\x9e)\xc7\xe8\xdc\x7f\xe26\xedU\xfb5\xfc\xf3_\x8b\x7f\x0f\x00ݎ\xba\xefc5\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcX_o\xdb8\x12\x7f\xf7\xa7\x18\xe0\x0eh|\x8d\x94\xe4\xee\xe5N/E/m\x17E\x9b6\x88\x83\xbe\xa4]\x80\x92\xc6\x12k\x8a\xd4\xf2\x8fSw\xb3\xdf}1\x14%K\xb6\xe48\x01v#?\x84\xe4p\xe6\xc7\xdfp\xfeHQ\x14\xcdXͿ\xa06\\\xc9\x04X\xcd\xf1\x87EI#\x13\xaf\xfekb\xae\xce\xd6\x17\xb3\x15\x97y\x02\x97\xceXUݠQNg\xf8\x06\x97\\r˕\x9cUhY\xce,Kf\x00LJe\x19M\x1b\x1a\x02dJZ\xad\x84@\x1d\x15(\xe3\x95K1u\\䨽\xf2\xd6\xf4\xfa<\xbe\xf8w|>\x03\x90\xac\xc2\x04R\x96\xad\\m\xacҬ@\xa12\xafҢ\xb1&^\xa3@\xadb\xaef\xa6ƌ\xac\x14Z\xb9:\x81\xedB\xa3% h\xd0\xff\xdf+\\4\n?\x06\x85\xb7h\xac\x97\x11\xdc\xd8\x0f\x87\xe5>\xf2 [\v\xa7\x998\x04ы\x99Ri\xfbi\v#\x82ԈF\x83\xe1\xb2p\x82\xe9\x03:f\x00&S5&\xe0U\xd4,\xc3|\x06\x10\xf8\xf2'\x8b\x80\xe5\xb9\xf7\x00\x13ךK\x8b\xfaR\tW\xb5\xccG\x90\xa3\xc94\xafI$\x81\xdb\x12\x839\b\xf6\xa05\bD\xac\xd7O\xfb\xbe\x1b%\xaf\x99-\x13\x88\x89\xe08\x1dc$\xc8\x12\xcd\t\xecL\xda\r\xe16VsYL!1\x96Yg@-\xc1\x96\b\xe1ĻֽL\\\x97\xcc`Xm\xec-\xfc\xc2\x13\xacIW\xa5\xa8[kY\x89\xd9\xca\xc0}ɳ\x12jf\f\xe6\xd3\xc6\xfd\xf2\xa5\xdf\x11\x84\x1a\f\xd7\xfd}͉\xc9\x05\x05\xeag\x80X2.\x0e\x80h\x96G@\xbc\xeb\xef\x1b\x03\xd1\xd3\xd5Fi\x9ci\xf4.\xbc\xe5\x15\x1a˪z\xa0\xf2u\xd1r\xdd\xe8˙m&\x9ac\xaf/\xfc\xc0d%V>\xe0i\xa4j\x94\xaf\xaf\xdf\x7f\xf9\xcfb0\rC\x0e&#\v\xb8\x01\x06\x1a\x7fs4\xb0\n\xb4\x93\xc0\xc08n\x91\xe8\xca\xfa\a\xa7\x1f+\x18\x97\xc6\x02\x9b\xbaЧ`K\xad\\Q\x02\xb7\x06T\xfa\x1d3\xebo=B-\\\xc1%0\x99\xd3\xcd\xeb)mu\x90J\x94y\xeb\xa7`Ac\xad\f\xb7Js4\xa7`\x15E\"_nHGg=Œ\xadq\x00\xd4\xc0\x17\x9f\x94\x00\x7fԘY\x13w\x8b\xb5V5j\xcb\xdb\xf4\x106l\xb3qov\x87\xc8\x17\xc4u\x93\b \xa74\x8c\xc6\xe3\b\xc9\x01\xf3\xe0\x9e\xe6\b܀\xc6Z\xa3Ai\xfbQ\xda>j\tL\x06\x8ebX\xa0&5`J\xe5DN\xd9{\x8dڂ\xc6L\x15\x92\xff\xect\x1b\u200c\n\xd6\v\xde\xf6\xa1K\xa8%\x13\xb0f\xc2\xe1\xa9g\xbbb\x1b\xd0\xe8=\xe1dO\x9f\x1711\\)\x8d\xc0\xe5R%PZ[\x9b\xe4\xec\xacතB\x99\xaa*'\xb9ݜ\xf9\x82\xc2Sg\x956g9\xaeQ\x9c\x19^DLg%\xb7\x98Y\xa7\xf1\x8c\xd5<\xf2\xd0%\x1d\xd8\xc4U\xfe\x0f\x1d\xea\x96y1\xc0\xba\x97=\x9a\x9f\xaf\x19\a<@\xb5\xa2\xb9\xb6\xcd\xd6\xe6\xa0[\xa2\xb9,\xbcKn\xde.n\xa15\xed\x9d1P\n\xed\xdd\xec6\x9a\xad\v\x880.\x97\xa8\xfd>XjUy\x9d(\xf3Zqi\xfd \x13\x1c\xe5.\xfdƥ\x15]\xfc\x10R\xe4\xab\x18.}i\x86\x14\xc1\xd5\x14\xd4y\f\xef%\\\xb2\n\xc5%3\xf8\x97;\x80\x986\x11\x11{\x9c\v\xfa]\xc5\xf6\x8f\xb4$\x81\xb5\xdeB\xdb\tL\xf8k2\xf5,j\xccȏD%\xe9\xe0K\x1eJ\"\x85\x05d\xaa\xaa\x99\xe5)\x17\xdcn\x06\xea\xc1\xd7,\x8a\xb0\xc9$\xb4\x8d\xf5\xe9x\xa7'\x1d\x03\xb7+t́ڃP\xa6\xdeI_\x01۞R\xe8\xd0\xfaxFc\x87\xa8\x0f\xf8\x87~\x82\xe9\x02?{g,\xf8O\xdc\a\xcd\xe4\xe6\xf3r\x7f:\x1a)Vc\xeb\xa3Fw\xa8\xf88\xc4\xd0y\x93\xff\xecH\bA\xe6j\xa1X\x8e9X\xb5\xa72\xf8\x93vVNX^3\xddn01\xbc\xc1%s\xc2\a\x12\\\x9c\x9f_\xf1}\x96\xa4\x13\x82\xa5\x02\x13\xb0\xda\xf5\xebJp?\xb3\x94\x12\x13\xf8\xf5\xe4\xebˇh\xfe\xea\xe4\xe4\xee<\xfa߷\x97'_c\xffϿ\xe6\xaf\xe6\x0f\xed\xe0\xe5|~rr\xf7\xe1\xea\x97\xdb\xeb\xb7\xdf\xf8\xfc\xe1N\xbajՌ\x1eN\xee\xf0\xed\xb7#\x95\xcc\xe7\xaf\xfe\xb9\a\xe5GDM\xb8\x96h\xd1D\\\xdaH\xe9\xa8!z\x14\xbbY\xf1\xfa\xa6\xad~\x9b\xe4\xb03\x16\x03a\xbf\xd7\xf4\u06dd\xe0\x906T($\xfc\xed\xdbM\x8b\xf4LT_\xd0(6\xa0\xe4\xd4EM\x95\x12ȆU\x8e\x12!\u05f8\x93\xd2#H\xc7\xc2\xe8\xa8|\xe3;\xd0d6I\xc4t\xc6\xf1;\xdb[\x9a9\xadQ\xdam;<\xd0\b\xc0\xa6\xbb\xa6c\xd3K\xc3\xfc#~kzK`\x1a\xfbޢ>,\xddt\x1d\xfa)p\xe9\aJ\xe7#A\xeb=\xb6\x81{\xd4H\x1dܾ\x7f\xb8\xc5j\x04ȱ\xccy\x8cD\x1ck\xf0=\x9e\xa2\x03\xaaѬv\x88\xb2@\x9c\xaaj%Q\xda\xf1\xe5]\x06[\xe9ε݄Z\xb6]`\xc7\xed\x84F\xa0\xcd\xc4\xfaR\xe91\xc8\xf4\xa0t\xd5\x14\xa2\bB\"\xa4VwRf\x1b\xa0\x13\"\a\x93/\xfdr\xa7'\xaa\xd4\b3o\x820\x9d\xadT\xf7 Th\x8e<\x13`\x95Z\xc5\xcf\x05R\xa11\xac\xc0\xa3p\\5\xb2a2Ez\x03\xdc\xf4\x804oYφBU\xf7(\x1c\xf4B?V\xaa=\x8ag\x9b\xd7h\x9c8\xee\xaa\xdex\xd1\x16B\xb3\xf1(\x10\x87\xaf\xde\xe0\x8dx\xf7\x89\x86\xef\xaaO<\xdex\xfen5w\x916\xbaJ\x14\x8f.4\a\x1fY\x9a\xc8\xf9G\xd5\xf9f/Ӛ\xed\x06\x17\xa1\x148x\xf1Nf\a\xfdt\xb9\xbfÿ\x88\xe9\xbc\xf1\x9c\xe5\x15v\xc9\x19\xee\x99im\x8c\xdd\xe2\xa5\xd2\x15\xb3\xcd\xfb|D;\x9fw\xb2Q\x17\xf5\xbfO<r\xa6w=\xd1.\b\x1e\xfb0\xb2\x7f\x9aC\xed\xe3dN82\x1bx6\x1b\xc3\xd4\xef\x19˴\x8d\x9fBG\xff\x9b\xd1#(\xae{\xa2G\xd0\xd1h~\x1a\x1d\xfe\xf3\xd9c0Hf\xac'\xe9\x92\xd3x\x11\x1dO\b\x11|\xc2\xfb\x91\xd9\xf7\xf2Z\xabB\xa3\xd9\xef\xf6\xa2\xf6\xb2\x8fd\x88\xc9\xd4q\xc0\x05\xdei\xc7\xc6\xd9b \xfcH\x88y\xcd\x7fo\x80\x8d棽IC_n\xf2\x9e\xee\xd0d\xf7g\\\xda}\x06I\xe0\xf7?f\x7f\x0e\x00\xac#uQ\x01\x18\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4U=s\xdc6\x13\xee\xf9+v\xe6-ܼ\xe4YI\x93a\x17\xcb.4I<7\x92\xc7=\x0e\xd8#a\x81\x00\xb2\v\x9c\xa2d\xf2\xdf3\v\x92\"\xefKV\x8a\x90l\b\xecǃ\xe7\xd9]\xd4u]\xa9h\xbf\"\xb1\r\xbe\x05\x15-\xfe\x91\xd0\xcb\x1f7\x8f?qc\xc3\xe6pS=ZoZ\xb8͜\xc2p\x8f\x1c2i\xfc\x88{\xebm\xb2\xc1W\x03&eTRm\x05\xa0\xbc\x0fI\xc92\xcb/\x80\x0e>Qp\x0e\xa9\xee\xd07\x8fy\x87\xbbl\x9dA*\xc1\xe7ԇ\xf7\xcd\xcd\x0f\xcd\xfb\n\xc0\xab\x01[0\xe80\xe1N\xe9\xc7\x1c\t\x7f\xcfȉ\x9b\x03:\xa4\xd0\xd8PqD-\xf1;\n9\xb6\xb0l\x8c\xfeS\xee\x11\xf7\xc7\x12\xeaC\tu?\x86*\xbb\xcer\xfa\xe5\x9aův\xb2\x8a.\x93r\x97\x01\x15\x03\xb6\xbe\xcbN\xd1E\x93\n\x80u\x88\xd8\xc2g5 G\xa5\xd1T\x00ӱ\v\xcc\x1a\x941\x85H\xe5\xb6d}B\xba\r.\x0f3\x815\x18dM6\x8aI\v_z,G\x84\xb0\x87\xd4#\x8c\xe9 \x05\xd8\xe1\x84@2\xc8\xfb\x8d\x83ߪԷ\xd0\b_\xcdh*@&\x03\x89\xd3\u0087\xd3\xe5\xf4,\x809\x91\xf5\xdd5\b\x9cT\xca<\x83(ym\xf0\xb0\x1c\xfb\x14@\xb1ob\xaf\xf88\xfbCٸ\x96y\xb49ܔ}\xd6=\x0e\xa5\xca\xe4/D\xf4?o\xef\xbe\xfe\xf8p\xb4\f\xc7X/H\v\x96A\xcdH\x85\xb8\x82\x1e!x\x84@0\x04\x9aY\xe5\xe6%h\xa4\x10\x91\x92\x9dKk|WͳZ=\x81\xf0NP\x8eV`\xa4k\x90\x8brS\x11\xa0\x99\x0e6\x92i\x19\b#!\xa3\x1f\xfb\xe8(0\x88\x91\xf2\x10v\xdfP\xa7\x06\x1e\x90$\fp\x1f\xb23\xd2l\a\xa4\x04\x84:t\xde\xfe\xf9\x12\x9b園ԩ\xb4\xe83?\xa5\xe8\xbcrpP.\xe3\xffAy\x03\x83z\x06B\xc9\x02ٯ\xe2\x15\x13n\xe07\xa1\xc9\xfa}h\xa1O)r\xbb\xd9t6\xcdCC\x87a\xc8ަ\xe7M\xe9\x7f\xbb\xcb)\x10o\f\x1e\xd0m\xd8v\xb5\"\xddۄ:e\u008d\x8a\xb6.н\x1c\x98\x9b\xc1\xfc\x8f\xa61\xc3\uf3b0\x9e\x15\xc8\xf8\x95F\x7fE\x01i\xf3Q\xf6\xd1u<\xe8B\xb4\xf5]\x91\xe4\xfe\xd3\xc3\x17\x98S\x171\x8e\x82\xc2\xc4\xfb\xe2ȋ\x04B\x98\xf5{\xa4\xe2\a{\nC\x89\x89\xde\xc4`}*?\xdaY\xf4\xa7\xf4s\xde\r6\xf1\\\x92\xa2U\x03\xb7e\x92JS\xe7hTB\xd3\xc0\x9d\x87[5\xa0\xbbU\x8c\xff\xb9\x00\xc24\xd7B\xec\xdb$X_\x02\xcb#Qډ\xb5\xd5\xc6<\xbe\xaf\xe8u\xa1i\x1f\"jQPH\x14o\xbb\xb7\xba\xb4\a\xec\x03\xc1Sou?7\xedQ\\X\x1a|i\xe6\xeb\r-\xef2&Ow\xae\x1e\x1e\x8av\x96\xf0\xa4\n\xebU\xb07\xf1R\x86\xe1\xbfd\xa6\xf8\xcc\xdc\xe8L\x84>\xad泺\xe4\xf4V.\x90(\xd0\xd9\xea\t\xa8O\xc5H\x86OR\xd63(\xff<9B\xeaU\x82'$\x04\xf4:d\x993h\xc0\xe43\xfe&Z\xd6wI\xa4\xa0\x91W3x~m\xc2\xe1\x02\xa6Wԑ\xcfg\xe7\xd4\xcea\v\x892VG{/\x8a(\"\xf5|\xb2W\xee\xac\xefP\xb0\x15\x9bK\x1a\xe0|E~W\x04\xf9\xd0\xe7\xe1<S\r\x9f\xf1\xe9\xc2\xea\x9d\xdfR\xe8\b\xf9\xb4\xe4\xc5e;\xb2\x87\xa6:\xdax\x8d\xa5\x8bEy\xb6\xc8r\xe5\x98\x15\x8b\x9c\x02\xa9n\xcd+\xe7\xdd\xcb\xfcn᯿\xab\x7f\x06\x00\x045\f\xc6i\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x13\xbe\xebW\f\xf0\x1e\xf2\x16\x88䤽\x14\xba\xa5\x9b\x00]t\x9b\x06v\x92;-\x8d%v)\x92\xe5\f\xedl\x7f}1\x94\xe4O\xd9V\n\xd4\xdaÊ\x1c>3\xf3\xcc\x17\x95\xe7y\xa6\xbc\xfe\x8a\x81\xb4\xb3%(\xaf\xf1\x1b\xa3\x957*\x9e\x7f\xa6B\xbb\xc5\xf6m\xf6\xacm]\xc2C$v\xdd\x12\xc9\xc5P\xe1{\xdch\xabY;\x9buȪV\xac\xca\f@Y\xebX\xc92\xc9+@\xe5,\ag\f\x86\xbcA[<\xc75\xae\xa365\x86\x04>\xaa\u07be)\xde\xfeX\xbc\xc9\x00\xac간\xda\xed\xacq\xaa\x0e\xf8WDb*\xb6h0\xb8B\xbb\x8c<V\x82\xdd\x04\x17}\t\x87\x8d\xfe젷\xb7\xf9\xfd\x00\xb3\xecaҎ\xd1ĿM\xed>\xe9A\u009b\x18\x94\xb94\"m\x92\xb6M4*\\lg\x00T9\x8f%|T\x1d\x92W\x15\xd6\x19\xc0\xe0b2+\x1f\xbc۾\xed\xa1\xaa\x16\xbbD\x9b\xbc9\x8f\xf6ݧǯ?\xadN\x96\x01j\xa4*h/\xa4^\xd8\f\x9a@\xc1`\x01\xb0\xdb\x1b\x05ʂ\n\xac7\xaab\xd8\x04\xd7\xc1ZU\xcf\xd1\xefQ\x01\xdc\xfaO\xac\x18\x88]P\r\xbe\x06\x8aU\vJ\xf0zQ0\xae\x81\x8d6X\xec\x0f\xf9\xe0<\x06\xd6#\xcb\xfds\x94CG\xabg\x86\xbf\x12\xdfz)\xa8%y\x90\x80[\x1c\xf9\xc1z\xa0\x03\xdc\x06\xb8\xd5\x04\x01}@Bۧ\xd3\t0\x88\x90\xb2\x83\a\x05\xac0\b\fP뢩%\xe7\xb6\x18\x18\x02V\xae\xb1\xfa\xef=6\tC\xa2\xd4(\x1e\xd3\xe1\xf0Ӗ1Xe`\xabL\xc4נl\r\x9dz\x81\x80\x89\xa7h\x8f\xf0\x92\b\x15\xf0\xbb\v\b\xdan\\\t-\xb3\xa7r\xb1h4\x8f\xb5S\xb9\xae\x8bV\xf3\xcb\"\x95\x81^Gv\x81\x165n\xd1,H7\xb9\nU\xab\x19+\x8e\x01\x17\xca\xeb<\x99n\xc5a*\xba\xfa\x7fa\xa86zub+\xbfH\x9a\x11\am\x9b\xa3\x8d\x94\xf37\" Y\xdf'L\x7f\xb4w\xf4@\xb4\xb6M\n\xc9\xf2\xc3\xea3\x8c\xaaS0N@\xf7\x99\xb3?H\x87\x10\ba\xdan0\xa4s}\xe6\t&\xda\xda;m9)\xa8\x8cF{N?\xc5u\xa7\x99\xc6d\x96X\x15\xf0\x90\x1a\n\xac\x11\xa2\xaf\x15c]\xc0\xa3\x85\aաyP\x84\xffy\x00\x84iʅ\xd8y!8\ue147\x9f\xa0\x94\x03kG\x1bc'\xbb\x12\xaf\xb3R_y\xac$zB\xa0\x9c\xd4\x1b]\xa5Ҁ\x8d\v\xa0\x0e\x95?\x10x\xa8\xda\xeb\x95+\x0f\xab\xd0 \x9f\xaf\x9e\xd9\xf29\t\x89\xfa]\xabN\x1b\xcd\xff\xb1h\n\xe9\x154\x18\xd2w\x8f\x1fN\xf5߶A\x9eT\v[\x9c\xda:3\xe6]/9\x921\x1cL=al]\xbb\xd6\x11J#`I\xcfIH\xd1(L\x1a\xcd\xe01\xa4֜\xba\xf6kص\xbaj\x05~t\x12kX\xbf\xa4\xd4\xfd%\xe1?\f\xc0\x83%W\xf0%m\n\xf8|0\x91Nl\x14\xf52\x8czpI\xfdS\xf0G[\xe3\xb7K\x16od\xdf\xf5F0\xc9\xe3\xd8\x0f\xc4/A\x14\xeb\xa4\xdf\x1f\x87wZ?\xda\xd8M+\xc8\a'\x9e\\ss\x7ftr\x96Pbb\x96䭀\x8c\xb2_\x9d\x89\x1d\xae\xac\xf2Ժ;\x16<2v\x7fx\f\xa9\xd0n\x8b\x8e7\xa3\xfd5\xe2\x86`4w\xf4\xfe\xea\xdc\xf3m\xb9%\xca\xe0\xc6\xeb<\x0f\x02\xb3Pf\xd8>H\xce\"\xe4HvŊ\xe3\x1d\xb9\xfb\xce>\xac\x1e\xbf'vW\xc4\xc7$\xf97%%\xddaFI\xc9\xd5o,)9\"%%\xff˽7Xd\xa4\xc3`\xddin'\x11ah@r0գ\xccl\"W\xe94\x01\xbf\xdf|\x99\b:\xe0DO\xc8S\xaf\x98X\x16\xe3/\x96\xaf̱k\n\xf2a\xb6d30(\xe5I\x99]e\xf6|\x1a&\xf9\x91\xea*\x86\x80\x96\a\x14!]\x9dߔ\x8bl\xde(\x1a\x1bߗ\xe5S\x99\u074c\xf5\xa8\xe0\xcb\xf2)M\x1a\xa5mo\x8d\x0f\x98\x93n,\xd6 {2\x15ey\x82\x8c\xfe\xef\xf4\x8e=#\xa2\xf8\xcd\xeb\xbe%\xdd1\xf1\xc3^P\x98ڵh\xfbk\xd9\x197= R\xba\xf2V\xea\xfc\xb2-\xcf\x1a\xa1F\x83ð\x12w\xe8\x85\x18\xbbK\xbb7.t\x8aK\x90\xebZ\xcez\"\x8dl4F\xad\r\x96\xc0!\xe2\xf78\xee[Ex\xc7\xe7O\"3\x95\x18\xfbb<\xf3\xbe\xc8捷\x1c>\xe2nb\xf5Sp\x15\x12a=ߓ\xc9\"\xb8X$\xf9\xac\xa9\x8fX\x1a>\xd5J\xe0\x101\xfbg\x00ã}U\xbf\x0f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y\xdfo#\xb7\xf1\x7f\xd7_1p\x1e\xfc\rp\xbb\xcaݷ(\n\xbd\xdd\xd9M\xe16\xb93\xceν\x04y\x18-G\x12\xe3]\x92%\xb9\xb2\xd5 \xff{1\xfc!\xed/I\xb6\xdbKO\x02\xceZ\x0e\x87\x9f\x19\xce\xef-\x8ab\x86F~!\xeb\xa4V\v@#\xe9ɓ\xe2_\xae|\xf8\x8b+\xa5\x9eo\xdf\xce\x1e\xa4\x12\v\xb8j\x9d\xd7\xcdgr\xba\xb5\x15]\xd3J*\xe9\xa5V\xb3\x86<\n\xf4\xb8\x98\x01\xa0R\xda#?v\xfc\x13\xa0\xd2\xca[]\xd7d\x8b5\xa9\xf2\xa1]Ҳ\x95\xb5 \x1b\x98磷ߕoߕ\xdf\xcd\x00\x146\xb4\x00\xa3\xc5V\xd7mCK\xac\x1eZ\xe3\xca-\xd5du)\xf5\xcc\x19\xaa\x98\xf7\xda\xea\xd6,\xe0\xb0\x10\xf7\xa6s#\xe6[-\xbe\x046\x1f\x02\x9b\xb0RK\xe7\xff1\xb5\xfa\x83t>P\x98\xba\xb5X\x8fA\x84E'պ\xadю\x96g\x00\xae҆\x16\xf0\x11\x1br\x06+\x123\x80$b\x80U\x00\n\x11\x94\x86\xf5\xad\x95ʓ\xbdb\x0eYY\x05\br\x95\x95\x86I\x02z\x88\x00!\"\x04\xe7ѷ\x0e\\[m\x00\x1d|\xa4\xc7\xf9\x8d\xba\xb5zm\xc9Ex\x00\xbf:\xadn\xd1o\x16PF\xf2\xd2l\xd0QZe\x15-\xe0.,\xa4G~Ǡ\x9d\xb7R\xad\xa7`\xdcˆ\xe0qC\n\xfcF:\x887\x02\x8f\xe8\x18\x8e\xf5$\x8e\x1e\x1c\xd6y\xbb\xf3ؘD\x16\x11\\Y\xc2\xc3\xd6\bA\xa0\xa7)\x00{}\x82^\x81\xdf\x10k>\x18\x16J%\xd5:<\x8a\xd6\x02^Ò\x02D\x12К\td\x86\xaa\xd2hQ\xaa\xcc4\xd1\xf0\xef\xceQ\xcf\xd4\r\xd3\xff\xb7Q\xa5e\xfe3\xd8\xc0+\xa0\xbc\xe8\xdcH\x9c\x16\xe3\xa9_\xba\x8f\xce\x1d\x9clӒ\xd1Nzmw \x05)/W\x92,\xac\xb4\xed\x9a\xcd\x11\b\xbc\xf7f\xbf)\x11E(\x9f\x0flo\xae\x9f\x89\xe8~C\x81&\xab\xa35\xb5FA\x96\x15\xb2A%j\x02\x0eX\xe0-*\xb7\"{\x04U\xdev\xbf3}\xf5\xfc\x94\xf9uV^r=Icw^[\\\x13\xfc\xa0\xab\x102\xd9\xc9,\xf5\xbc\xccmt[\vX\xe6S\x00\x9c\xd7v\xd2\xe5\u0604\xe2\xae\xc47\xb3\x1dx~\xff\xcc\xe3\xe8;\xbcs\x84/+\xf6Z\xa9մO\xbf_Ӵ?\xc7\xe5\xed\xdb\xf0\xc3U\x1bjB\xb2\xe0_ڐz\x7f{\xf3\xe5\xff\xefz\x8f\x01\x8cՆ\xac\x979\xa0\xc7O']u\x9eB_\u0557\xcc0R\x81\xe0<E.zE|F\"a\x88\xd7!\x1dX2\x96\x1c)\xdfUI\xfe\xe8\x15\xa0\x02\xbd\xfc\x95*_\xc2\x1dY\x8e\xe8\xf9b*\xad\xb6d=X\xaa\xf4Z\xc9\x7f\xedy;\xb65>\xb4FO)\xaf\x1c>!\xf4+\xaca\x8buKo\x00\x95\x80\x06w`\x89O\x81Vu\xf8\x05\x12W\u008f\xda\x12H\xb5\xd2\v\xd8xo\xdcb>_K\x9f\xd3t\xa5\x9b\xa6U\xd2\xef\xe6\x1c\x82\xac\\\xb6^[7\x17\xb4\xa5z\xee\xe4\xba@[m\xa4\xa7ʷ\x96\xe6hd\x11\xa0+\x16ؕ\x8d\xf8Ʀ\xc4\xee.{XG\x86\x11\xbf!\xbd\x9e\xb8\x01N\xb0 \x1d`\xda\x1a\x05=(:\a\xc8\xcf\x7f\xbd\xbb\x87|t\xb0\xfc\x1eSHz?lt\x87+`\x85I\xb5\xa2\x14`VV7\xe1\x9aI\t\xa3\xa5\xf2\xe1GUKRC\xf5\xbbv\xd9H\xcf\xf7\xfeϖ\x9c\xe7\xbb*\xe1*\xd4.\x1c\xa8[Ö+J\xb8Qp\x85\r\xd5W\xe8\xe8\xab_\x00k\xda\x15\xac\xd8\xe7]A\xb7\xec:\xfcc.\x8b\xa4\xb5\xceB.\x9a\x8e\xdcנ\x12\xba3T\xf1\xed\xb1\x02y\xa7\\\xc9\x14\xa18\x9c\xe3\xb0p*{\x8c\xa7\x1d\x97?\x93\xd1iH4@\xf6ajOƦ:15\a\xcc\x18\xfbFL\x01\xea\xbc9G\xd9\xfd\x9en\xe6r)\xc0\xf6e:q\r\xfc\xadPUT\x9f\x91\xe4*\x10\x81T\x82\x95I{\xeb\xe3@\x11\x19\x04\x83\xd5j\xad\xc7'\xf0g\xa8u\xb8\xf1P\xa1b\x8bu\xe4s\x85FC:\x96I*\xae\x15'xj\v\x87\x02\x12B\xa1xL\xf2\xa5\xd65\xe10:*-\xe8\x8c\xe0\x1f\xb5\xa0\xa9\x1b\xe3\xad\xe07\xe83j&\xb2\xadR\xd3\xe2k\xf5\xa2;1Z\x9c\xc1\x95ND\xb0\xb4\"K\x8a\x03\x90>[ɍxB\xaf\xc6\x1ac<\xee\x0f\xa7\x12\xda$\xe2\xf7\xb779\x89e%&\xec~|\xee\x19\xfd\xf0w%\xa9\x16!ǟ?\xfb\xf2f\x15\x15żXQ\bFRE\xbd\xfc\bR9O(@\xaf&9r\x83\b\x1c\xf3,\xa5\x1dob\xf0NY\xe2\x90U=J\x05\xc8iC\n\xf8\xfbݧ\x8f\xf3\xbfM\xa9~/\x05`U\x91cF\xe8\xa9!\xe5\xdf\xec\xbb$ANZ\x12\xdc\xf3P٠\x92+r\xbeLg\x90u?\xbf\xfbeZ{\x00\xdfk\v\U00104369\xe9\rȨ\xf1}F\xcaFæ\xcd\xea\xd8s\x84G\xe97R\xcd&Y\x02r\xfb\x92\xc4~\f\xe2z| \xd0Iܖ\xa0\x96\x0f\xb4\x80\v\x8e\xbc\x1d\x98\xbf\xb1\xef\xfc~q\x84\xeb\xffŨv\xc1D\x17\x11ܾ\x04\xe9:\xdd\x01d\xf4<+\xd7k:\x14\x94\xc3\x7f\xbc\x85\xb6\xa4\xfc\xb7\xa0-k@\xe9\x0e\x8b\xc0X\xba\x9c#H\x8c@\xff\xfc\ue5e3\x88\x0f|X_\x1c\x18\xe9\tށL}\xa6\xd1\xe2\xdb\x12\xee\x83u\xec\x94\xc7'\x0e\x0f\xd5F;:\xa6Y\xad\xea\x1d˼\xc1-\x81\xd3ܵR]\x17\xb1\x04\x14\xf0\x88;\xd6B\xbe86c\x04\x83֟\xb4\xd6\\\xf8\xdd\x7f\xba\xfe\xb4\x88\xc8ؠ֊\xe1p\xc1\xb0\x92\\\xc8q\x05\x17\x16\xa35Jw\x84\xa3k\x03?\x86YmP\xad\xb9\xa4\v\x97\xb4j\xb92+/g\x13\x9b\xce\xf9\xf1\xb8\x1a\x9bv\xe1P\x95\r\x03\xc7\xff\xac\xaey\xa6pld\xcf\x11\xae\xdb`\x9d\x14\x8egPV\x91\xa7 \x9fЕc\xd1*2\xde\xcd\xf5\x96\xecV\xd2\xe3\xfcQ\xdb\a\xa9\xd6\x05\x9bf\x11m\xc0\xcd\x19\x8a\x9b\x7f\x13\xfe{\xb5,a\xbc\xf0\\\x81zc\x8f\xaf)\x15\x9f\xe3\xe6\xaf\x12*\x97\xef\xcf\xcfc\x97w\xa9\xa8\x1c\xeee\xb7x\xdc\xc8j\x93\xfb\xb2\x14c'Y\x02{`\x83\"\x86fT\xbb\xafnʬ\xd0\xd62\xa2]\x91\x06\x9b\x05*\xc1\x7f;\xe9<?\x7f\x95\x06[\xf9,\xf7\xfd\xe9\xe6\xfa\x8f1\xf0V\xbe\xcaW\x8f\xf4\x1e\xf1\xfbT\x1c`\x15\r\x9a\"R\xa3\u05cd\xac\x06\xd4\xfdq\xd0bvR-\x9f{ĹМ(\xed\xf74\xe5\xec\x05by\\O\x14n\xdd9\xee\xa9\xf2\ue93ezb\xdc\xe3\xda\x01Z\x02\x84\x06\r\xdf\xf3\x03\xed\x8aX\x10\x18\x94\x96\xc5B\x9f\xe7\x0eK\x024\xa6\x96\x93\x89\xdb\xebnɚ4\x81.\x88R\xbe\xe4ֺ\x03\xb0\xc5i\xf8y$Ƥ\xf9\x0eΌ\xe0\xfcf\xaaM\xeb\r\xe6\xc6hI\xb5\xcd\x18J\x01\x0f\xdaH\x9cxn\xc9\xf9\x91}\U000462cb\xd9\v.+\xceH\xcf\xe8 \xcd\xea\xa5\x1bU]\xe9*\xd8\xd7R\xba\xe7\xe6#\x8c\x85G,\xe1T3q\x14\"7\x93\\\xe5\xf6!\x16\xb0\x9c\xea\x9f\a4܈\r\x1e\x19-\x06O\xfa>9X썐O\x9a\x15\xd7\xe7\xed\xc0UzJ\x1ct\xaf\\\xb5\xb7.[T\x8c\xbe>\xbf\a\xe1\xd6c\xd4\x16Ϟ\xd7|U\x9a\xab\xfa\xde0\xf3\xcc\xf5^\x8dw\x84\xb9\x9f\x15\xc9\xdc\xf9=\tf\x7f\xe3\xf7#錩i\x02t\xd8ŝ\xdc\xfc\x06n$B\xc9\xcd\x1d\xc1\neM\"\xb1t\xe5p\xcf\x04\xd7.\x97%\xad\xb8\xb4\x8b\xae\x97\x1b\xd9\x04o_\xd6\xf2\x88'\f\xd4.\xdd\t\x9e\xad#\x11f\xf9\x13J\x18\x97\xba+m\x1b\xf4q\x00\\L2Um]㲦\x05x\xdb\xd2\xf3͜\xc7^\xce\xe1\xfa\x9c+\xfe\x18\xa9\xd8n0o\x01\\\xea\xd6\xef\x1b\xfc^x\xbctɦ^\xe0r\x00f\xb2u\xee\x01\xe1\xee:[節\xeb\xb0'5\x88\xfb\x86,\xbe \xe5\xbe\x10\x964>\xe6\xb51\x01\xe2@\xe7\x1cB\xa6\x99r\xb0}\xf4:\xe9a\xa7\x82\xf2\xd4̩\xe8\f\x9c&\x16\x93}M\xe4\xb5\x02\xbe\x0f\xde\xf0\"\xf9\xd3A\xe7T\x90\xc8`\xa3\xeb\xec\xcc\xdac\r\xaam\x96dY\x0f˝'\xd7\x0f\xe7#\x9e\x90\xba\xc0\x83\x1a;\xfb\xf3\xfdEN\xa9\xb1M\xe3\xbb\xe0]^\x83\x90\xceԸ\x9b`l2B\xee\xd3ع8\x04\x1c\xec9;\xb5!\x1b\x96^:\x85\n\x98\xae\xb5\x9a\xb0\x95\xae?K\xe5\xff\xfc\xa7I\x8a\xe8$\xfcZc=H\x0ei\x9d\xd5\xf9a秏\xff\xcfO8Q\xc48\x85\xc6m\xb4\xbf\xb9>c\x05w{\xc2\xec\r\xa3\xf7\x98\xb4\xe7\x96La\xc4\x11:\xb1\xa5|\x89\xa9\xf6ߕ\x9f\x83\xda#>\x93\x85\xd2[\xfa1\x1a\x80;2h\xd9\xd3\xc3˓\xab\xe1۽7\xe0$O\xb8B\xe5\x19K\xd18\xb4p\x9c\x9c\xb8\xb4Җ&B&\x8c\xd3J/\x89\xf4\xe1\xff\x91\xf9c\xd2NF\x0f\x03r\xd1\xe1\x9d\xde*t\x9f\xb4\xcbܻ\xba\x05\xfc\xf6\xfb\xec\xdf\x03\x00\xb1\x1d\xa8\xffM#\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s\xe3\xb6\x11\x7fק\xd8q\x1e\xdc\xcc\x1c\xa9\xe4\xda\xe9t\xf4v\xe7k:n\x93;\xcfɹ\x97L\x1e bE\"&\x01\x16\vJV3\xf9\xee\x9d\xc5\x1f\x89\x14)\xc9v\xebDԌM`\xf1\xc3\x0f\x8b\xdd\xc5b\x95e\xd9L\xb4\xea\vZRF/@\xb4\n\x1f\x1dj~\xa3\xfc\xe1o\x94+3\xdf|;{PZ.\xe0\xa6#g\x9a\xcfH\xa6\xb3\x05~\xc0\xb5\xd2\xca)\xa3g\r:!\x85\x13\x8b\x19\x80\xd0\xda8\xc1\xcdį\x00\x85\xd1Κ\xbaF\x9b\x95\xa8\xf3\x87n\x85\xabN\xd5\x12\xad\aOSo\xbeɿ}\x9b\x7f3\x03Т\xc1\x05\xb4FnL\xdd5h\x91\x9c\xb1H\xf9\x06k\xb4&WfF-\x16\f^Zӵ\v8t\x84\xc1q\xe2@\xfa\xce\xc8/\x1e\xe7s\xc0\xf1]\xb5\"\xf7\xaf\xc9\xee\xef\x159/\xd2֝\x15\xf5\x04\x0f\xdfKJ\x97]-\xec\xb8\x7f\x06@\x85iq\x01\x1fE\x83Ԋ\x02\xe5\f \xae\xd3S\xcb@H\xe95'\xea;\xab\xb4C{\xc3\x10Ic\x19H\xa4ª\x96Ez8`\xd6\xe0*\xe4)\xbdV\x85\xd2J\x97\xbe)\xa8\n\x9c\x81\x15Bd\xc2\xd3\xf2\xf3\v\x19}'\\\xb5\x80\x9c\x15\x97\xb7F\xe6:aF\x19~\xef\xcd\x14[ݎ\xd7A\xce*]\x9eb\xf6\x7f&\x15\xbb\x03\x9f;#\x9f\xc8\xe4\xbeB/\x93\xd8tmm\x84D\xcb\x1a\xa9\x84\x965\x02\x1b(8+4\xadў`\x91\x86\xdd\xefZ\x8c\"\x81ɏ\t\xaf\xd7\xf3\x1c\xed<G\x15A6v\x86\xe9\xbf\xf4\x9b.\xcd{gd\x1c\x00Ѩ\x81\x9cp\x1d\x01uE\x05\x82\xe0#n\xe7\xb7\xfaΚ\xd2\"\xd1\x04\r/\x9e\xb7\x95\xa0!\x8f\xa5\xefx]\x1ekc\x1b\xe1\x16\xa0\xb4\xfb\xeb_Ns\x8b\x83rg\x9c\xa8\xdf\xef\x1cҀ\xe9\xfdqs\xd0\x1a;[\x89\xf6\x8f\xa3\xbbb\xa6\x1f\x8c\x1e\xea\xf5\xfdQ\xeb\x14\xd9\x1eh\x8a\xb7yaч\xda{\xd5 9Ѵ\x03\xd4w\xe5\x10O\n\x17\x1a¤\x9bo\xfd\v\x15\x156>t\xf3\x9biQ\xbf\xbb\xbb\xfd\xf2\xe7\xe5\xa0\x19\xa0\xb5\xa6E\xebT\x8a\xae\xe1\xe9\x1d\x1e\xbdV\x18j\xf6\x9a\x01\x83\x14H>5\x90B|\bm(#\x87\xe0,\x8a\xc0bk\x91P\x87sd\x00\f,$4\x98\xd5/X\xb8\x1c\x96h9\xb4\x02U\xa6\xab}\x04ڠu`\xb10\xa5V\xff\xd9c\x13\xfb\x1eOZ\v\x871\xc4\x1f\x1eִբ\x86\x8d\xa8;|\x03BKh\xc4\x0e,\xf2,\xd0\xe9\x1e\x9e\x17\xa1\x1c~`\x83Vzm\x16P9\xd7\xd2b>/\x95K\x87fa\x9a\xa6\xd3\xca\xed\xe6\x1c\x14\xadZu\xceX\x9aK\xdc`='Uf\xc2\x16\x95rX\xb8\xce\xe2\\\xb4*\xf3\xd45/\x98\xf2F~e\xe31K\xd7\x03\xae#\xa7\v_\x7f֝\xd9\x01>\xec@\x11\x8884,\xf4\xa0\xe8\x14\xb2?\xff}y\x0fij\xbf\x19\x03P\x88z?\f\xa4\xc3\x16\xb0\u0094^sЭ\x14\xc1ښ\xc6o3j\xd9\x1a\xa5\x9d\x7f)j\x85\xfaX\xfdԭ\x1a\xe5x\xdf\xff\xdd!9ޫ\x1cn|&\xc1GGײ\xe5\xca\x1cn5܈\x06\xeb\x1bA\xf8\xea\x1b\xc0\x9a\xa6\x8c\x15\xfb\xb4-\xe8'A\x87\x0f\xa3,\xa2\xd6z\x1d)\x839\xb1_\xc7Yɲł\xb7\x8f5\xc8C\xd5Z\x15\xde78\xfc\x80\x18e1\xf9\x00z\xdau\xf9Y\x89\xe2\xa1k\x97\xceXQ\xe2\xf7&`\x1e\v\x1dq{?5&\x91ӽ3/\x80\x03\x13\x12\xfbH\xd4\x7f\xea4x[\xa1\xc5\xfe\x18\x8b\xad!\xe5\x8c\xdd10#\xa0\x1c\xae\xe9\xccF\xf0\xb75\xf2\xc228\xdc{\x87\xb0\xb8F\x8b\xba\xc0\x14!\xcee2#L\xe8\x1f\xe8c\x8a\xa7U\x7f.zN\x12~ww\x9b\"f\xd2p\xa4\xee\xc6\xf3^P\x0f\x7f\xd7\nk\xe9\x0f\x94\xcbs_߮\xc3d\x8c\xc5z\x12\xd0*,p\x10\x8cAir($\x98\xf5$\"\xdf\r\x80\x1d\xccb\x1c\xf1&D\x8a\x18\x92\x0e!\xdc\t\xa5Ap\x8cR\x12\xfe\xb9\xfc\xf4q\xfe\x8f)\xcd\xefW\x01\xa2(\x90\x18H8lP\xbb7\xfb3[\")\x8b\x92\x13\x17\xcc\x1b\xa1\xd5\x1a\xc9\xe5q\x0e\xb4\xf4\xd3۟\xa7\xb5\a\U0001dc40\x8f\xa2ik|\x03*h|\x1f\xfe\x92Ͱݳ:\xf6\x88\xb0U\xaeRz6\t\t\x82\x93\xf7\xb8\xec\xad_\xae\x13\x0f\b&.\xb7C\xa8\xd5\x03.\xe0\x8a\xbd\xbcG\xf3Wv\xac߮N\xa0\xfe)8\xd0\x15\v]\x05r\xfb\xf3\xae\xef\x91\a\x92\xae\x12\x0e\x9cUe\x89\x87D\xf4\xf8\xc3Cp\x83\xda}\rƲ\x06\xb4\xe9Ax`\xf6\xce\x10\x8fP\x8eH\xff\xf4\xf6瓌\x0f8\xac/PZ\xe2#\xbc\x05\xa5\x83nZ#\xbf\xce\xe1\x9e\xff\xa5\x9dv\xe2\x91\xe3@Q\x19\xc2S\x9a5\xba\xde\xf1\x9a+\xb1A \xd3 l\xb1\xae\xb3\x90oH؊\x1dk!m\x1c\x9b\xb1\x80VXw\xd6ZS\x96q\xff\xe9çE`\xc6\x06Uj\xa6ç\xd3Zq\xd6\xc0\xe9\x82\xef\f֨\xe8\x04\"u\x1e\x8fi\x16\x95\xd0%\xe7\x0f~\x93\xd6\x1d\xa7\x01\xf9\xf5lb\xd0%?\x1e\x1f\xfd\xd3.\xecS\x80\xe3\xc0\xf1\x87\x1d\xa2O\\\x1c\x1b\xd9S\x16\u05ffk\x9d]\x1c\x97\x1f\xacF\x87~}\xd2\x14\xc4K+\xb0u47\x1b\xb4\x1b\x85\xdb\xf9\xd6\xd8\a\xa5ˌM3\v6@s\xa6B\xf3\xaf\xfc\x9f\x17\xaf\xc5߮\x9f\xba\xa0\xc1\xa5\xff5W\xc5\xf3\xd0\xfcE\x8bJ\xb9\xe2\xd3ϱ\xebeL`\x8eǲ[l+UT\xe9\x12\x10c\xec$$\xb0\a6B\x86\xd0,\xf4\xee\xd5M\x99\x15\xdaYf\xb4\xcbbM+\x13Z\xf2\xff\xa4\xc8q\xfb\x8b4ة'\xb9\uf3f7\x1f~\x1f\x03\xefԋ|\xf5D\xa2\x1b\xbe\x8fفVֈ6\v\xd2\u0099F\x15GҜ\xfb\xddJV\xfcZ\xa1]\xccΪ\xe5\xf3@8e\xa1\x13Y\xe4^&\x9f=cY\xa4EK\x95q\xb7\x1f.\xf0X\xee\x05\x13\x87\xc3v\xc5\xe41a\x1d\x15\x81\x9e\xc7\xc7\xfb\xcb>6\\\"5\x94ŇU\xa5?\xb6\xf6\xbe\xefo\x11Z4\xa2_\xfc\xeb\x7f\x1aѶJ\x97\xcf\xe2گ\xa5] \x9a\xaak,\x9aX^\xa8\xe6\xb9j\x8a\xe7\xa0\xc67f\x8b\xbak\xc6T2x0\xad\x12\x13\xed\x9c\u05cf\xec\x93\a\\]=G\x13\xc1\x00.\xe8 \x96\x9e\x14\x8d\xb2\xb6h?\xec\xab1]໋\xb7\xa2\x11$\xbcĮ\xf8\xda\xcdI\xf2\x90a\x06\xab\xa9\x9bޑLk\xe4Q\xcb\xd0\x7f\x8f:\x0f\x0eu\xdc1\xb4գ\xdeAI\xf4l\xbc\xe1+@wt\xd9:\x7f\xb5\xf6\x03\x92Յ\b\xefR\xe5Ϭ\xff\x87\xcbua\xf8\xea0(\xcf]\xb0\x81\x9b\xf1\b_ɲ2\xfa\x84j\xd0\xdfX=\x0f\xd8\nJ\x93L\xed7\xf4\xf0\xc2P_Z+\x8c\x95(}b\xcf\xf7\x8e\xb5P5ʄI\x9ct#\x90/\xe9\\O\xe5\xb1\t\xa8#\x94>nL\x90\x1e\x8fKUR.\xe4d\f1\x92\xd0]]\x8bU\x8d\vp\xb6ç\x1b/\x17^\x88Dyɿ~\bRL]\xa4! V\xa6s\xfbK\x7ft\xb4\xa8\x8ak\x8aV\x90?\x87\x8c\xaf\x99_\xa0r\xc72S\x16\xb7w\xf9\xf3&w.\x94}\xc4\xedD\xeb\xa8j}x\xb2d%\x13\xd7\xc0\f\xbe\xf3\xd6\xf1,\x05ĉ.\xe9 \x8aAe\xead\xdd\\\xb2\a\xdd5+\xb4\xac\b_*O\x1aI\x81c\x84\n\xf1\xf6u\xd0\xe4\x01!\xee\xa4\fP\xf1>Y\b\xcd5\x1bo\xbf\u0380T\xd4\xd6b7\x81\x9bj\xf6>\xc1b\xf3\xe5R\xd5\xc1b\"8p\x81\xc7\xf7=\xb7\xfa\xb3\xff)`\xaas\xfa\x87\x85\xe1g\xfc+\xc1\xf0s\xf8i\xe4uf8\x93\xf2\x91\x13\xd6\xed\xe3\xc1\x05[X\x0e\x84/E<\x0f=\x1d\xef\xfa\xa1k\x1c\xa8\x86\xd3\xfc\x9e1jRQ\xa3F\xcf\\\xf6\xb0c\xe5\xb4\xdfҭҥ\x89\x16\xf0\xebo\xb3\xff\x0e\x00g\b\x17r\xc1\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=ے\x1b\xb7r\xef\xf3\x15]ʃ\x92\xaa%u\x94\xbc\xa4\xf8&\xcbry}liK\xab\xc8\xcf\xe0L\x93\x84w\x06\x18\x03\x98]1\xa9\xfc{\xaaq\x99\v\xe7\x86\xe1r}tR\xe4\xa8\xca^\x12h\xa0/h\xf4\r\x98\xd5j\x95\xb0\x92\x7fE\xa5\xb9\x14\x1b`%\xc7o\x06\x05\xfd\xa5\xd7\x0f\xff\xa9\xd7\\\xbey|\x9b<p\x91m\xe0}\xa5\x8d,>\xa3\x96\x95J\xf1G\xdcq\xc1\r\x97\")а\x8c\x19\xb6I\x00\x98\x10\xd20\xfaZӟ\x00\xa9\x14F\xc9<G\xb5ڣX?T[\xdcV<\xcfPY\xe0a\xe8ǿ\xad\xdf\xfe\xfb\xfao\t\x80`\x05n@\xa16R\xa1^?b\x8eJ\xae\xb9Lt\x89)\xc1\xdc+Y\x95\x1bh~p}\xfcxn\xae\x9f]w\xfbMε\xf9{\xfb\xdb_\xb96\xf6\x972\xaf\x14˛\xc1엚\x8b}\x953U\x7f\x9d\x00\xe8T\x96\xb8\x81\x8f\xac@]\xb2\x14\xb3\x04\xc0O\xdd\x0e\xbb\xf2\xb3~|\xeb@\xa4\a,,9\xe8/Y\xa2xww\xfb\xf5?\xee;_\x03d\xa8S\xc5K\"V=7\xe0\x1a\x18|\xb5\xb8\xd1\x04,\xad\xc1\x1c\x98\x01\x85\xa5B\x8d\xc2h0\a\x04V\x969O-\xa9k\x88\x00rW\xf7ҰS\xb2h\xa0mY\xfaP\x95`$00L\xed\xd1\xc0߫-*\x81\x065\xa4y\xa5\r\xaau\r\xabT\xb2Dex \xac{Z\xe2\xd2\xfa\xf6\x04\x97ׄ\xaek\x05\x19\xc9\t\xba){\x92a\xe6)D\xb35\a\xae\x1b\xd4N\xd1\xf1(1\x01r\xfb\a\xa6f\r\xf7\xa8\b\f胬\xf2\x8c\xc4\xeb\x11\x15\x11'\x95{\xc1\xff\xbb\x86\xad\tQ\x1a4g\x06=\xbf\x9b\x87\v\x83J\xb0\x1c\x1eY^\xe1\r0\x91A\xc1\x8e\xa0\x90F\x81J\xb4\xe0\xd9&z\r\xbfY\xf6\x88\x9d\xdc\xc0\xc1\x98Ro\u07bc\xd9s\x13\x96I*\x8b\xa2\x12\xdc\x1c\xdfX\x89\xe7\xdb\xcaH\xa5\xdfd\xf8\x88\xf9\x1b\xcd\xf7+\xa6\xd2\x037\x98\x9aJ\xe1\x1bV\U0009577a \x84\xf5\xba\xc8\xfe\xa5f\xdb\xeb\xce\\͑$O\x1b\xc5ž\xf5\x83\x15\xf3\t\x0e\x90\xc0;Yr]\x1d\xa2\r\xa1\xb9\xd8[\x96|\xfep\xff\xa5-g\\w\x80\x82\xa7{\xd3Q7, \x82q\xb1Ce\xfb9i#\x98(\xb2Rra\xec\x00i\xceQ\x9c\x92_Wۂ\x1b\xe2\xfb\x9f\x15j\x12h\xb9\x86\xf7Vw\xc0\x16\xa1*3f0[í\x80\xf7\xac\xc0\xfc=\xd3\xf8\xe2\f J\xeb\x15\x116\x8e\x05m\xb5\xd7|\\cG\xb5\xd6\x0fAy\x8d\xf0˯\xfe\xfb\x12\xd3Ί\xa1n|\xe7\x979\xec\xa4\xea(\aRf͂\x1d_\xb4\xf4\xb8\xd5O\x1a\xec\xf4\x97\x93\xa9\xfcP7$\xf9!\x16V\x82\xffY\xa1Uqn\xc5bO\xa5\xf4@B\x98\x9f\x15\x8b\xee$'hJ\xffR&R\xccgf\xf9\xde6\x02.2\"\x0e֢D\xab\xde\x01\xb0\xf4\x93b/\xfb#\xb4\xa6\xb7\x86[\x03)\x13$v\x1a\r<\x1dP؞a\xfa\\\x03\x17\xf0\x11\x9fn\xe0V\xdc)\xb9W\xa85H5\x00\xf2w\xc6\r\x17\xfb\x9f\xa4\xba˫=\x17\x9fJT\x96q\x1a\xca\x03Ip\xaf\x8f\xa3\xc2V\xca\x1c٩\xda\xc3oi^e\x98՛\x8e\x9e!ɇ^\aҎ\x86qAj\x80vA\xe2\x9eh~\xa5]\xa5\a\x12\x80)\x04Z\x88\\8x\xc0;\x14\xe9c\xc1\r\x16\x03\x93\x9bd2\x80\xa8\xf2\x9cms܀Q\x15\xf6~v}\x99R\xec8B\x98`\x89\xc4ҥn\xef\xf5b\xceSl\xef\x97V\xc0I\xe2\x99!\x1a\xf4\x80\xc2wN\x15\xaeI\xfa\x02\x96w2\xe7\xe9q\x964C\x9d\x82\xd6A\xdd\xc6\x10\xb6x`\x8f|P\xf2I1\x91\x88<4\xf6D\xb3\xa7H\xd8\xd6@\xb2\xf3\x10\x1e$\xd6Aʇ9\xde\xffLm\x9a\xcd\vRk\xc3֨xn{[b\x8b\x80\xdf0\xad\xcc\xc04\x01\xb2\x8a\xe6\x00RA)\xb5\x19\xe7\xfb\xb8\n\xa6\x87\xfaze?\xf4\xf3\xc9\xf4\xef\x9a\xd6\xc0۫\xf8\x9e\fR\x87\x9c\x91aփ\xf0\x00\xa4H\x11\xd8Π\x02\x96\xe7N.\xe1\xc0\x1e\x11\xb6\x88\xa2挵\x81\xa8\x01\xd3G\x91\x8e\xc0*\xadb\x03\xd9h6\v(\x95E\x99#mك\xfdF\x97B\x0f\xe1\x1a/\x87.\xb1رHUB;L\xbc\xac\x8d@\x03x:\xc8\x1c\x9b\x19\x82b\xe6`M\x142\"\t@\x89\xca\x12a\r\x1f\xbe\xb1\xd4\xe4G\x90b\x8cv@\xc4\xfeEno\xe0\xc37L\x89\xf9?\x7f\xf9r\aE\xa5\rl\xeb\xedy\f\xef9a\b+\xf7\xd44\x98 Їo-\v\xa1M /\x03\x1a\xd8\x04(rǊ\x82\x18\xcd\x050\x925\xbe\x17djA)Gq\x88ţ\x05~\xba\xd1\tJ\xefÔh5b=C\x9a%S\xfb\xaa \x8fg\x06\x1e\xb4V\xc1\x14\x1a\xb3\xc2\x18\xa5xN\x9f\x82\x8b[+\xe1\xf0v\xa6\xe5\xb8\xfa\xee~\xfc\xbe\x8dj!!}\xaf\x86\x94\xf5\x17n\v/e\x96\x8c\xc2\xf2\xcf\xd3\x01\x15v8\xd1W\x8fk\xb8\xdd\xd1n8\v\xac^ 7a\xfc\xd7\x1av\\iӞ\x9c\x86J\x8f\xaf\xa1\xc5\x1c\xc9\xd9\x16\xf3{\xcc15r\x19\x05\x7fm\xf7\x04mA\xe80s\x8b4\x9fǹ`&=\xa0\x86\x82\xccG\xafv\x10T%\x04\xed\x1f\xa5\xf4\xb4pT\x98R=\xe1\xb3=Z\xd3$\x96N3;\xeay\v\xbbF\xec\xc37\x8aBԑ\x0f\x80\x05\xe4=\x05\xd0\xdd\xd3,\xdb<ѥ\xb2\x06=Wh\x97\xff\x1c\xca\xee\xf9r\xc0N/\xb2\xe2\xe0\xdd\xc7\x1f\xe7I\xb6@/\xf4\x90z71qoi\x86_F\f\xee\xa1ǯ\x0e\xed\xfcv}\x03\f\x1e\xf0\xe8\x02\x15$Qv{\xf3 A\xa1\rrX\xb1z\xc0c\x12\x01\x1f(TW\xc76\xa2z,\x11\x15\x1f\xa4\xc0\x01\x034\x8a\xa8\x0fx\fJ\xccQ\x97\xbe \xf2Y\x1ckR\xdb\x18\x98\x8f\xdb\xc5=F\xc6\xc9\xd2\"\x85\xd3<\x81/g\xa2]\xb3\xb5\t\xb78ƿ&\a7w&ׁ\x8fx\xdcc\x0fI\xa0]a!\x92\xf5\x95\xe5<\xab\xe7\xea\xd6ɭ\xb8\x81\x8f\xd2\xd0\x7f>|\xe3:b\xcbm\x1e\x92\xa4\x1f%\xea\x8f\xd2ؾ/Jb\x87ę\x04v\x9dI\xb4\x98pn\x14ѥ\x1d\"\xd3V\xcdO\x19\x98\xfdO\xcd6\xae)d%U\xa0$\t\xab\x1f\xd2\r\x16\fG!\xc5\n\x8b\xd2\x1c\xe3I\x05~^\x9d\xd1,\xb9)\x1cѡ\x7f{\xe0\x05\xf0\xbbStӃ/\x14\xdas\xbf\xb8@mN\xf1\xef\xe0\r\xd9\xf0\"3\xb8\xe7i\x121\x82\x7f\nT{\x84\x92v\x83x\xfc\x17\xe8\xe7\xb3e+\xdeB\v\x1f\xaf\xecOb\xb1c\xcf*Z=\xafj6G5\x1f\x89:^\x02K\xbbi[\xc3(\x8a\xfa,\xcblV\x88\xe5w\v\xf7\x8b\x85\xfc\xea\xac\xeb\xd6$\xedↂ\x95\xb4\xb2\xff\x876M\xbb\x10\xfe\x17Jƕ^\xc3;\x9b\xe1\xc9\xe3\xd6w\xbb\xbf\x0f\xf8\xb4\x87\xa2Q\xb8\x06\x92\x81G\x96ӆO\xd9\x15\x01\x98\xdb\xed?j\b\xb9\xeb\x19V7\xe4\xc4j$a\x81\x1d\xc7<#\x9c^=\xe0\xf1\xd5MG\x03D\xc1\xa7\xae\xb7\xe2\x953\x1dz\n\xa9\xb63\xa4ȏ\xf0\xca\xfe\xf6j\xdd3\xa5\xa2FZdn-\x90\xd8\x05M\xbf\xad\x9a\x10Ԫ`\xe5\xcaK\xba\x91Ō\x86\xaa㢛d\x81\xdcձ\xd6`\xad\xd4`|\xa4|\x06\x18\xcc9ދ\x16F)\xb3E\xb3\xbf\x93\xb5\xd7\xdd\x0e\xee_nJ1\xdaq\x15\xfc\xcc\xc965]\x93g\xca\t\xa5\xee6I$\x81l\xb0g(ڢQdֆ\xa0\x16\x13\xd0 \xe4%\xd6\xc9\xf3\r\xeb\xad̎\x8b\xf8\xfb\x83\xccj3\x9a:\a\x06G\xcci\x01\x93\x01\x0e\xc82T\xb3j\xfe\xbc\xad!z\x16\xa7\xbcs\x93\xb2\xc6-\xcb(bo\xe4r\xec#\xb4N\x81\xe6\xb0p\xe1\xfdf\xbb\x04\u0590\fy(\x9eC3\xb0\x1a\xa9\x82\x1fqǪ\xdcfP\xe1\xee\xd3\xfd\x97\x8b\xf1\xb4R\x03)\xb8\t\x94\xfe\xeb\xf3\xaf\x01\x1f\xfa\xdf\x16\xa1\xe9k\x1d\xb3\x1b\x1ay\xa1\xd9ǩ\x9dJ\xe5\xc93\xd9\xff\x87\xdcn\x92H\x02\xfd\"\xb7\x83\x81[\x1b\xd9f\xadR\x8c\t\x80`\xa1P\x8a\xd3\x05ܹ\x14\x97P,\x7f\xc8\xed\x17,J\n\",\xe2\xf9/M\xbf\xc0\xfb-\x992o|\x1d\xccԧ\xd5\xd7f\xbd\xa93!\xc75\xa4\nmLz8s|\x06\x9b\x06l\x03\xb2\xb5\xa8\x92dU\x89\a!\x9f\xc4\xca\xdaY:\"fV\xefD\x972\x14\x1a\xccg\x00BM\x19.\xe2\xe8r\xa1\x95Ғ\x8f\xc9v5N\xc93\x19&\x06\xcb\x14&Hۦj]UD\xdb\xf5:y&\x8d\xa4\xf8\xa0\x94Tѳ\xf9\xe4ڷr\xa8\a\xf9\x14\xea%\\$}\x02\x14\xb8\xd4$\x02\xdf\x017\x80\"\x95\x15\x95*Y[\x03-`\x17\x83'\xe7{\xa0Z\xa7\xfb\xcc\x11\x00EUL!\xb6\xb2)\x05.&W\xc4\n~b<\x7f.\x99\r/PV&\x9a\xcc_\\\xfbZ\xa3\x12\xf3\v\xf6\x8d\x17U\x01\xac \xa2\x81\xdcM\x00s#v\xf9\x02O\x8c\x9b\x90_\xf4\x1a\xbaV\xb5\xd3Ja\x8b;\x8a\xf6\xa7Rh\x9e\xa1\n\xd5U\x9eW\x92\x92m;\xc6\xf3j(a\xbc\x88Rs\v\xd6U\"&g\xae\xbd\xe9\xb8@\xa9pA\xeaZ\xe1e2מ\xb4L\x1cm֖VzHY\xaf\x93Ł\xa2k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\x9am\xbef\x9b\xaf\xd9\xe6k\xb6\xf9\xaf\xce6\x87\x03\xe4#\xbb\xc9d\x80\xa6\xc3<\x9f\x86\x0eg\xdc)\xdf۹gC\n\xa4\x03\x14\xf6\xb8^\xd3V\xc9ʵ\x1d\x17\xfa\x91\xb3\xe3\xb0e\x1a3\x90\xfe~\x80*G\xed\xc7\xcalqA\xad\xb7\xf4\xcd(\xe8\x1ay\x17\x83\xef\xc6\xfc\xd7\xc9\xf9\x1bp̭\x12#t\x1c\xb8_\xa2Q;\x9dMfZO\x18\tO\a\x9e\x1e\x1a\xb5i\xd5\x17d\x125\xa5\xf9\xec\xcdF\x93\xa1\xcaI\xdeG\v\xf7\xa2\xecմ\xacvi\x1b$m9i\xeb\x9e\xfd+\x18\xfc\xf73\xa1\xf8\xff\x9f\x84\xe5\xe2T\xf2\xa2){\xdb\xebzY\xa1\xf59!\x1bƷ1\xec\x1b\xdaIc2E\x14\xbb\xcb\xf3\xd6\xf8\xffČY.\xf1\xb7\xa7=/*\xf1\x93\\\x99\x83Hw`\xd4\xc3\xff\x132%\xba a\xbc\x18\xe1\x86\xea\x0f\x03C\xb2\x1b\xd8\xf1ܚ\x85\x1d\xce<k\xbd\\\x82\x18\xb1\x0e\xe7i\xc0{\xba\xf5\t]\x96T\x11\xcc\xc0\xad\xed)\x1b\xe4\ue1fd\xe3C\xda\x11\x92\xf7\x8cʁY\xb8\xde\xf4YR5\x10\x01\xf3\xa4\xae`Q\xc5@\xac(,\xac\x148\xa3J \n.\xb4t\xd1<r\v\x14Ix\x02\xed\xcf@3\xb6* \n\xb2\xdb\xe6\"+\x02\"!v\xea\x06ά\x06XH\xce%U\x00\x1db\xc6T\x00$g\xe7\xe3'\xb3\xff\x91`\xfb5\x02\xe3\x99\xffH\x90\x13\xf5\x01\x83Y\xffH\xb0ѵ\x01\xee|y$\xd4\x05u\x01\x91Z\xf7,\t\x8b\xdb\xda\xc3g\xce/_Z\x03\xb0 \xff\x1f\x15Q[\x86Q+\xc7\xfd}%u\xe2\xf3\xfc\xb3S\bu\x00\x8bs\xfc\xb3\x90;5\x00Q\xf9\xfdY\x90\xc3\xf9\xff\xe9\xdc\xfe,\xd0\xc8\xdc\x7f\xbc\x11\x14)\x89\x91\xcd\xce\xcb\xe5\x7fG\xa1c\xbaY\xf0\xe7\xe1+\x11G\xe6s\x17ztmځx٬'\xebc_\xb52\xa6\xbb\xdb썃\uea06\xfd\xae\xf6\x1c\xd6ɳtl\a\x87\x81\xc9ց=\x16\x0e\x8aX\xffc\x12&\xf8\xbbuc\xa6\xb8\xc4ڜ;m1{\xe6BX\x10\x1dD.m\r\xfb\x82\x88\x98\xa6/q\x96\xa2'C\x94\x88\x83'n\x0e\xf6ԈW\x1bty\xa5\x15(\x16u\xac\xc0g$\x98\xee\xdeq\xf9=\xec\xf3\xf1\xe77\x96\xed\xa2\v\xcfr\f\xb23\x90\xbafh\xfd\x85\x88*\x14\xf0\xe2'\xb3\xe8s\x1d\x91 )zيGL\x9d\ue204\x18s\xb6\xe1,\x0e\xd3z\xfd2\x9f_\x1a\xe1\xc1\x87\xa6\xf7D\xb6)\n.\x902\xbfh\xde\xe9\xd2\x19\xa8\xb3i\x1c\x91&\x1d\xa1\xefs\x13\xa6/\x93:m>q\n>&\x9d\xba0\xb1\xba \xc5z6\xdb\xe8|\xad\x17\xee3X\xf7{\xd3\xfb/Y\x1a\xb5R\x89\x04\xe9.\xba\xfe\x8c,;\x86\xf5\xc1\x8c!\x9f\x9a\xcc\x1f#\xe9PV[#\xbe\xc0\xcaX\xe2\x17\xfaY̶\x8c\xb4\x9f\xe9\x1f\xbd\x10f\x93,b\xea\xad\xe0MZ\x9d\t\v\xe2E\xad\x1d\x1a\xa0\xde\xe8\xf4\x19bx\xdb\x01@\xb6O0\x9c\tt\xb3\x15Ůx'6u͠\xb5o\x82\x1d\xedޑ1r\xd1\xf8\x85L\x97(\xce\x0ezI\xe7W5\x9dg\xdb\\x\xf8\x88\"\x90\x11\x11\xf8+\xb5PW^#\xe1\xb66\xf4\x17\xd02\xd1r\x13\xd9p^\n\xfequ(\x13\x9d}\xf2\xf3\xbd{qR\xf0@\aV߉\xfa\x18\xec\xd52H\x9e\x0eh\xef\x10\xf0odZٗO\rY%\xc1Y\xad_\x86\xb4\xc5:#kw\xb1`\x9e\xb9\xab\t|\xb4)\xe8\x93a\xe3\x9b2\x917\x90\xb5\xea|i5\xad\x93\x85I\xba\xa9\x17\x9b\xf0^J~\x93,\xcd\xe1w\xdf\xe0Q\xe7\xd0\xc3+<d\x18\xa4\a8\xbc\xd0Ƚ\x1c\xab\x9d \xee&\xe3m\x18*\xcct\x9dD\xeb\xd9Ʌ\x14E\xb4!9\f\x13Y(dѯ<\x99\xa2W_l\xda\x14kdз\xf3\xaf\x04\xfa\xbe\xc8g\xb0\xa8_\xc6\xe3\x95\xf7\x1c\x05\a\xba\xb4\xd6(if\xab\xb9ɍ$y#˱\a\xd1E\x95|\x88\xea\xd6`\xf1.%p>\xa2J\xb1Y\x1b\xfe\xf4\xabͿ\xa2\x8bkx\v\aY\r\x94yMPg&\xe9?\x9e\xeaw\x92A\xef\xb2z|\xbb\xee\xfeb\xa4O\xfc\xdbhL\x0f&\xd5^Ա\x15k\xad\x88\x8c?\xf2\xacbyg\x91\xb5Ģ\x91\x1eJ\\\t\x9e\x0fU\xc0\xb1\xbc\xe9\xdf\x11#\xf8d\x11`\xf9z\xa9hL\x9b\x88\xa7\x01\xf3\xa16'$\\R\x15\xd0\to\xaf\x93\xb1\xe4ֲ0\xf8\xe8\nzF\xde\x7f:Q\xbf$\xdb\x1f}G\xc0|\x8e?ƺ\x9f\xc9\xe7w\xc8q\xc1\xb3\xfeӹ\xfbIU\x16\x9e@\xb5\xe8\xe9\xc7f\xe7g\x8b\x9c.\x7fJ\x7fI&>\x8a8\xf3Y\xf7\x0eibr\xed>\xb7\x9d\xc4\xd4N\\\xfc|\xfd\xe5OԿ\xe0\x19\xfa\xc8S\xf3\x93zh\x01\xaf\xa7\xb6\xef\xf0\x99\xf7\x02\xc6U\xcdl\x86\xfbY^BD\x0e{I\xe6z\x96b\x1d\xb9\x8f\xcfRϝF\x7f\x91\xf3\xe7\x97?q\xfe\x92g\xccg\xb6\xddI)\x99\xfc\xb1\x13\xba\x989+^\xbb!\xbf\xb1\xb2\xe4b\xbfIΕ\xa6II\xeaH\xd1Ǔ1;\xa2\xd4\xf6\x16:~\xd6А\xee\xd5\xc2\xfd\xb6\xc1\x85\x00.\x8c\\\xc3;q\xec\xc1\xd5#\xd7|\x05\x13\xb0\x91\xca\x12\x9ex\x9e\xb7\xdf,h\xc1\xb6A\xf9\xe3\xc7z82@\r\xd7KX(U\xc7:֛iz~:i\xde\x0e\x14N[\xdb=\xb8`\xed\xef3\xad\xed\xa2\xca\r/\a\x97|\xa9\xe4#\xb7a\xc7\x03\x1ekz\xfe!\xedI\x95-\xd56\"|\xfa\\\xaf\xc6\xf5\x89\xe3\xc0\x86\xd6\xd0\x13\xda7\b\xf6\xd1O\xdd\xdb}S\xb9B\xda\xf3\x88\x93A\x1e\xfc\xc9\xdd\x1b\xbbb\a`\xda\x03:\x96\x99ExU+\xb9]I\xf4^4m\x0f[Aw&\xfb\x9f\x15\xaa#\xc8GT\x8d\x81T{\xb8\xc3\x1a\xc1\x19\xee\xbaʛ\xda\x1b\xaf.\xc9\x1e\xea\xf9\t\x8d~\x81w¹B\x83`O\xe6\x18nyk\xf9Ft\xb7\b\xb9=#M\a\xa1\nY\xf7N\x96\x9bڧ\xc8\f\xb7:!\xf7\xc5=\xa5\xe5\xbe҄d\xc4\xc8Ǚ\xfe\xd2\xf9\x1e\xd3\x04\xc8غ\xe8\x18\xaf)\xa2\x0e\xbaC\x98\vzN\xf3\xa7\xefgM \xaf\x1a<\r\x17\xa0\x11\xebA%\x17\xabk^\xe0C-\xbd\xdd,\x92L1\xf5\xcb\x1d\"]ʗzAo\xea%\xfc\xa9\xf3<\xaa\x19\x90'u\xc9\xf3>լ\xbeZ\xc4\xfb9\xcf%η\x9a\xab$\x8e\xa8 \x9e4\x8f\xe3f\xda\xda^\xc7&\xba\xc4ϊ\xa2ag]\\\xce\xd7z\xb1۾.\xefo\xbd\xf4\xad^\xb3\xdb\xf7\x8c\xe4\xcc\xfc\xbc\xc4\xf3zF\x92\xa1dd\x94n\x92I\xf9\xb9\xb3\x8d\xac\xed\x9e\xda\xed\xde۾\xc4[]\xe9\x12=}K%\xf7d1\xf5\xa0\x01\xa4\aL\x1f\xc8\x01\xf0\xfe\x8d}]\xf7AI!+=\x9dk\x18\xbd\xed'\x18\xe2\x950\x9c^\x10N\xfc\xae\x84F\xb3^\x94\xd8\v\t\xf9\x8f2\xc3;\xa9\x8c\x9e#\xc6i\xfb\x81$h\xcbm\x94y\x06\"4\xedA\x06\xe7\xfdx\xcf\xe7<\xb6\x0e\xa3\xe5ǿ\xfb:\x87\x8f'\xfe\xdd\xd7\x19DȈ\x0f\x1em\x0f\"\x00\xf5\xb7\xb8h\xc1J}\x90\xe6\x05\x90\xb97\xccT\x91\xf8\xb8\xb6\x1d\x94xzhe\xfe\x9e0$\xa0=\xf4\x1eX:0\x88\xa0\x1d [\xa6a}SJ\x00\x81\x90\x7fm\xb6'\xf2\x94\xfe\xd9\xe7\xf3\x1dy\x06a\x92#OYfٔ85tY'\x8b-\x81\xd9\xddk\x86P\xd3\n-2\xf1\x1c\x91|~\x0e\xb1\x06\b5v\xaa;\xe6\xe4\xf6?\x94\x9e\x13\x1b\x95N\x0f\x98U9R\x18n\x93L\xd2\xf7\xbe\xd548d\x95\xe0\x7fV\xedS%MM\x91o݃\tm\x95T\x17C\x04Ve\xceM\xfd\xc1\xaa\xd30\x92'\xba\x87L\xb2<\x00\xb5\r\xd2.\x8eBj\x92\xf7\x94\xfcg]\xa5)j\xbd\xabr\xaf\xa9;\xd7o\x8d\x15\xca\x06\x1c\xd6I4ǆ\xedٕ\x1f\xf5\xe3iLp\x843z@MN\xa8Ȕ\x95\xa6R^\xcc\xd3J)\x8b\xb2\x85A\\a\x81'\x9eDI\x9c\xd2\xf2\x95\\\xbe\x0eA\x1bV\x943\x12\xf2\xbe߃\x18 U֪\\\xf0K\x91\xa6\xdf\x18\t=\xb8\x00OL\xd7\xc5dٺ\x05\xdb\x15\xd0Z\x87(\x95\x8a\xe2\x88\xf8\x88\xf4\xd6\x13[\xfa\x8d\xf5n0\xb4\x10)\x84c\xf7~\xf5Z\xd7p\xaciD\x15\x13\xf7\x86)SO\xbd/\x11;\xa9\nf6\x901\x83+\xea\x9d,\\\xa8\x13\v\xdd^{\xa5g\blkȽ\a\x90\xfa러\xf6\xb1\xbd\xa1@\xad\xd9\xde\xca\x013\xf0\x84\na\x8f\x82ܣ\xc1\r\xdf\xfb\x91M\xf1\xbcܵ\xb9\xe3.\n`\xa9\xa1\xc2\n;\x00\x19\xde\bu\xd8{\x00\xa4\x93dۄ\xedG\xd7\r\x17\x06\xf7\xbd\x80\xb3/\xdc\xff\x8cLK1C\x88\x9f\xdam}\xb8\xc0N\xd1\xdf\x10\xe0\xcc]\x125\x14\x86\xab\x1a\xa7\x1eT\xab\x8dh\xe4\xf5\x12fQ\xb5|\x94)\xf3sݰ\xf1V\xb8prD\x14g[\xaa\xefi\xf6\x98\xe1\xeb\xbc\xc2\x11\a[\x98\xac\xd7K\xa5n\xda.\xb10߹\x82\xf1!?b\x10\xa7\xa6C\xd8\t\x8c4,\aQ\x15[TV\xe9\x84\x06\x83\x00\xfd\xb0k\xa0\x1b\xb1\xf8\x8e\xa7,Ϗ7\xa7\xa0[\x912\x1a\xc2\x01\x1f\x81'w\x0e\xa4\x13\x00\xaf\tZ\xa7\xbb\x82\a\xd9̰i>\x022\x9c\x17j\xed\x1ec\xd7\x18M\xc9u\x8d-\tm<\x85]\xeb1\xf2Z\x80\x93V\f\nR\x8d6\x83\x13\x16\xc79s\x9f\xb0\x1d\xca\x03\xd3sF\xc3\x1d\xb5\x01\xdeߚj{\xc1oeI\xdc9\x93\x15|ħ\x81o\x1d\xb5l1\xc9\xf0\x86\xb2\x82[q7\xe6ݮ\xec!\x0f.\xf6?Iu\x97W{.\xea\x1a\xbce\x8d\xef\x982\x9cd\xd9\xcdg\xa0\xaf\xdf\xc7\x06\x7f\x9b\xef=\x0e\x96\x89\x14\xf3\xa1\xdf&\xd4X\xf0\xf6\xe7x\xe8\x9bͩ0\xafc_k\xbf\xe8\x86\xf7\xf50\xe8\x9a\xc2\xd3\x18\x02\xf9\xbc\v\x94\xd3\x01JmV\xb8\xdbIE\xef\x0fʏ\xb0Zѡ'g\xca\f\xc0\xa5%o\xad\xf1\xaa\xa4\xfd\x99L\xf4\x10(\r3\xb3e\x91\xf4B7e\xf7\r{eV\xc1\xe8\xd4\fp\xc1Ҵ\xa2\x9d\xf2\x8d6,\xc7\v+Yk\xfe{I\x8f\xd1\x00\xb7\xed\xf6a\xf94\xab߂s\xa4\xb3\x87\xc1\xdc&=\x98Ĥ\x7f\x9d\xb3\xa8\xa0%\xecؐ\x92\x9aS\x05\xb4W\x1a\x96ߎ\xbb2\x1d\x1c\xbeԍǔ\x98GC\xb6\xd3\xe9\xebd\xe2\x16\x0eߕx\x96\x1e\x98ؓ\xf8(Y\xed\x0fA\x04\xc7l\x99\x11\xa0YE\x93\x82\xd2.y\xbfu(4\x95\x12\xadx\xaeO\x91e\xcdt\xa7\x80\x9e\xadM=\xd0N\x01p\xb3\x17n\x92IZ\x7f\x9e\xec<B\xff\x1eHhvm\x17ޛ\x8e\xeb\xd1j\"\xcf)\xd0c\x9d,!\xc6 \xbe\xb5v<\aߺs<\xbe흽\xf16\x96 ?\x00\xf4r\xe4\x18\xb3\x18\xe6i1m=X\xfczP!\x0e\xe30U\x1f\x8f\xeb\xd8\x19\x030G,\x8f)Z\xe8\xfa]\x9d\x9b$\xeeE\x9a\xc1\x19ԓZ\xe0\xf5\x10\xb7J\x85\xab\x80\x11\x19\x89t\x9fE\xfdň\xc1=\x1a\xcd\x19\x9e\x9d\xf7\x00F\xa7\xc8\x1c\xc6ɒ\x83\xb9\xd3\xfbM\xa4\xf7>0\xe9\xf7\xfd~\xc3>\xfc\xe4\xdd\x16]\xe7}\xa4ټ;\x1d\xb5\xf3Κ9-9\x8c\"\x81\xf5\xb2\xc3\xd2\t^\xa5?\xb2\xee\x1d\v\x12\xf7\xf5\xb93\x99\xba\xbc\xa53\x91\xe1\x8b[\xa6ϧΎ>b\xb5O\xd9\xee\xb6\x02\xac&\x01\xf16\xb8F7\x80\x9c\xf2%#\xe0\x00^\x95\n_Q\xf6\xfd\x15\xad\xabWg\xcfڕ:EM\xfb\xb3m\x1a\xe8\xd6\xd4H\xf9%'\xf6\xb34\x9c:⾂{\xda3p8\x872i\xa5\xd7\x00(̄ٹ\xa4Н(\xd5&\x99$F7\xa45\xbc\x92\x83\xb2\xabu\xfd\xc0\xb0\xc4t;0\x9d\x87\xf9~\xa3h\x8f\xb5\x03\xf8!&\x9e\xd6\xf8\x8b\xed\xc8Z}\\\x8d\"k\rD\x1f\x03\xebA\x04\xf8W\xbes\xe5G)\xcd\xfa\xdfⷋI^GQa(\xe0\xff\xc4\x14\xbd\x84u\x0e\xf9\xdf}\xb3\x81p\xa2\x870\x10P쁄&\xc4\x18\xfc\xad\xce\xde6\x16P\f\x93\x046\b4x>\xe2\x19!\xc5Ac\xbb\xf7\xa5\x15\xe4\xacEd?\xd2\x06\x8c\xaa0\xf9\xbf\x01\x00\x85\x96wD\xac\xa6\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec]_\x93\xdb6\x92\x7fק\xe8\x9a{\xc8\xdd\xd6H^߽\\͛c;\xb7\xb3q\xec)\xcf\xc4\xf7r/\x10ْ\x90!\x01\x06\x00g\xac\xdb\xda\xef~\xd5\xf8C\x91\x14\xff\x00\x1a9\x9b\xecItU2\x12\xd0ht7\x1a\r\xf4\x0f\xe0r\xb9\\\xb0\x8a\x7fA\xa5\xb9\x147\xc0*\x8e_\r\n\xfaK\xaf\x1e\xffS\xaf\xb8|\xf5\xf4z\xf1\xc8E~\x03okmd\xf9\x19\xb5\xacU\x86\xefp\xc3\x057\\\x8aE\x89\x86\xe5̰\x9b\x05\x00\x13B\x1aF_k\xfa\x13 \x93\xc2(Y\x14\xa8\x96[\x14\xab\xc7z\x8d\xeb\x9a\x179*K<4\xfd\xf4\xe7\xd5\xeb\x7f_\xfdy\x01 X\x897\xa0\xb3\x1d\xe6u\x81z\xf5\x84\x05*\xb9\xe2r\xa1+̈\xe8Vɺ\xba\x81\xc3\x0f\xae\x92o\xd01{\xef\xebۯ\n\xae͏\x9d\xaf?pm\xecOUQ+V\xb4ڳ\xdfj.\xb6u\xc1\xd4\xe1\xfb\x05\x80\xced\x857\U00011568+\x96a\xbe\x00\xf0\xfcۦ\x97\xc0\xf2\xdcJ\x84\x15w\x8a\v\x83\xea\xad,\xea2Hb\t9\xeaL\xf1\x8a\x8a\xdc\xc0\xbda\xa6\xd6 7`v\xd8n\x87\x9e_\xb4\x14w\xcc\xecn`\xa5m\xb9U\xb5c:\xfcJ\xbd\r\x04\xfcWfO\xbci\xa3\xb8\xd8\x0e\xb5\xf6\x06\xde*)\x00\xbfV\n5\xb1\f\xb9U\xa0\xd8\xc2\xf3\x0e\x05\x18\t\xaa\x16\x96\x95\xefY\xf6XW\x03\x8cT\x98\xadz|zN\xba_\xce\xf1\xf2\xb0C(\x986`x\x89\xc0|\x83\xf0̴\xe5a#\x15\x98\x1d\xd7\xf32!\"\x1dn\x1d;\x1f\xfa_;\x86rfг\xd3\"\x15\x8cw\x95)\xb4v\xfb\xc0KԆ\x95]\x9ao\xb6\x18A\x8c,tU\xb1Zcީ}\xd7\xfe\xca\x11XKY \x13\x8bC\xa1\xa7\xd7\xf6\x0f\xeaui\xc7\x12\xfd%+\x14o\xeen\xbf\xfc\xc7}\xe7k\xe8J4\x985p\r\f\xbe\u0601\x01ʏT0;f@!i\x1e\x85\xa1\x12\x95\xc2e\x90n`\x8b\x1e\xa9\xa0B\xc5eγ\xa0\x15[Y\xefd]\xe4\xb0FRЪ\xa9P)Y\xa12<\f=\xf7\xb4<J\xeb\xdb\x1e\xc7\xdfQ\xa7\\)g\x89\xa8\xad\xf1\xf9\x01\x85\xb9\xd5~\xc9\xdc\xf8\xe0\xfa\xc0\xbfUR\x870P!&@\xae\x7f\xc1̬\xe0\x1e\x15\x91\t\\gR<\xa1\"\tdr+\xf8\xff6\xb45Y=5Z0\x83\xde\x1f\x1c\x1e;\x80\x05+\xe0\x89\x155^\x03\x139\x94l\x0f\n\xa9\x15\xa8E\x8b\x9e-\xa2W\xf0\x93T\b\\l\xe4\r쌩\xf4ͫW[n\x82'\xcddYւ\x9b\xfd+\xeb\x14\xf9\xba6R\xe9W9>a\xf1J\xf3풩l\xc7\rf\xa6V\xf8\x8aU|iY\x17\xd4a\xbd*\xf3\x7f\t\x1a\xd5\xdfux=\x1ao\xee\x9fu\x84\x13\x1a \x8f\xe8\f\xc6Uu\x1d=\b\x9a\x8b\xadU\xc9\xe7\xf7\xf7\x0fmc\xe2\xc1焏\x93\xfb\xa1\xa2>\xa8\x80\x04\xc6\xc5\x06\xfd\x88\xde(YZ\x9a(\xf2Jra\xec\x1fY\xc1Q\xf4ů\xebu\xc9\r\xe9\xfd\xd7\x1a\xb5!]\xadଢ଼^\xc8\x0e\xeb\x8aF`\xbe\x82[\x01oY\x89\xc5[\xa6\xf1\x9b+\x80$\xad\x97$\xd88\x15\xb4g\xc6Ç\xa8\xdcx\xa9\xb5~\b\xd3ۈ\xbe\xc2\x18\xbf\xaf0\xeb\f\x19\xaa\xc77<\xb3\x03\xc3z\xcf\xc6\x05\xf4<\xe8Ԩ\xa5\xc7y\xae\xfe\xb7=>\x9c/\v\xad\xa2\xa6\xf9\xc3\xecPu\xa61\xb2+G\r\xa4\x02!\xfb\xda\x1d\xf2\x82\x87O\xa02\xc3I\xd7\xeb\xc5\xceoG4\xc1\xbb\xbaբ\xf7\xf5\x98V\xe91XV\xe46fX|\xf0ňE2\xf5\xbc\x89\x9a\xc2\xc4\x1fܬ\xf4\xde\x15\x8e\x9c\x1b\xfd\xa3\x92\x95\x92O<\xc7|X\xabӚ\xa5'c\"\xc3b\xe8\x97\x1e\xd3omA\xe0\"'\xa3\xc2f\f\x12\x93\x8e\b\xc9\x11\xa4\xd8\xcac\xc1\x84\xcf\xda\xf6k\x05\xb7\x062&\xa8s\x1a\x8d\xd7\xc5\x0e\xfd\xcf$\x16.\xe0#>_í\xb8SrK\xda\x03\xa9F\x88\xfe7ㆋ\xed\x0fR\xdd\x15\xf5\x96\x8bO\x15*k\xf6\x1al|t\xac\xc29S\xa3'\xd3\xfc^\xb0J嵐\xc9_\xd6&FH\xf7\xb7\xbdJ\xad!A\xe2\xb1\xc1\x8d\x1d\x01F\xc23\xe3\xc7C\xc0=4`\xdf\xde\xdf\xc2\x17\x8a\x151\xd0\x04\x17\xf6\x81\xa9\x95 \xdf\a\x9f\x91\xe5\xfb\a\xf9\xb3F\xc8k\xeb\xaeC\xc0r=Bx\x8d\x1b\x9a\x8e\x14\x12\r\xaa\x80J\x91s\xd06\ue4b5Y\xd9H,\xc7\r\xab\v\xe3\xbd?\xd7\xf0\xfa\xcfPrQ\x9bIi\x0e\x0e\n\xfaG\ueb94O\xa8\"d\xf8\x8e\x19\xf6\x13\x95퉎h\x80%\xe2ǅ\x15\xe3z?H\x11Z\xf6\xb4\x82\xdbM\x8b*\xd7puE\x0e\xe8ʭ\x15\xae\xae]ٚ\x17fɅmg\x84\xa6k\xfd\x99\x17Eh\xff4i8\xe1:\xdd\xea\a\xf9\x83v\xe3=F8#U\a<o%sx\xb2M\f\x92\x05\xd8\xf0\x02A\xef\xb5\xc12\x8c\xbcCHG\x9ds\xd3FQx2\x1a\xd6\xfb\xc0\xfbp\xbfE]\x14l]\xe0\r\x18U\xe3\x84hƇ]_6\x9fQ\x1bޛ\x01\a%s\xd5\x17\x8d\xab9 \x18e\x7f\x18\xa4\b}\tP,\xc8\x1ei=\xe2%DAeQ\xb4\x84;/\x15\x80\xff\x11\xf0\x8e\xe2 r\x9c\xf9\x8d\x8fz8\x169\xb9:!\xa1\x90b\x8bʵH\x11e\xb00\x85dq\xf9∠\xfdG!\x88\u0082b)\xd8\xd4\x14\x1e\xae\x80<\xc1\xa8\x8dp\xa1\r\xb2|u\xf5\xad\x94\x87_\xb3\xa2\xce1\x7f[\xd4ڠ\xba\xa7\xb5q\x1e\xf6\x06t\x84\x12\xdfO\x12\xf0qi\xc13\xa4\x892s\x85\x96v\t>&\xa4C\x88\xba\xafЮ\xa9\xac\xe3\xf4\x9c\x1ebϖ\xab\xa0)\xc9H\xb8\xfa\xd3\u0558\x13eE\xd1k\xbdێ\x06\xa6\xb0\x91Fǣ\x8ePl\xfc,\x96\x95\xd9\x0f\xdb\x117X\x8e\bq\xd6\xe5$\xa8\x97)ņ\x9cj\xe8N\xb3\xd5q\xbaz\xc7H\xf4\x14,B\xb1\x7f\x90\x8a\xfb\xed\xff\x7fT\xf2Ij\xd5v\x83\x8fqA\xea\xa4}\xb6\x8e6\xfb+\xc5\xf0\xb1\x9b\n$SZ\xcdq\xe1h\x92sk)\xef\xf7,\xb3SF\u0098\xe97\x96\xe6\xcdy\xc7ƌ\xea\x0f(\xb0\x9d\x94\x8f1B\xfa\v\x95;\xec @f\xf7\x9aa\x8d;\xf6ĥ\xd2\xfdm(\xfc\x8aYmF\xfd\x043\x90\xf3\xcd\x06\x15\n\xe3V\x06\xcdF따\xa6\xd7O\xf4T2l-\x8e\x95\xe8u쮩\x00\xbc=D\xacGu\xbd6r\x94\x14Y\x9c\xed(H\x91!\xb0\x8dAe\xfd\x95U+\xec\xd8\x13\xc2\x1aQؠ\x0es\xa8\xab\xeb\xf1\xc5\x13=\a\nL\xefE\x06\x95]F\x81<\xac\xa3,\xcdL\x96U\x81\x86Ll3A\x8db,\xb4\x13 \x13#Nn\xd6\x06\x8f$\xd6H\xc6\t\x8c,\xc8i_\xd5B;9l&\xfbH\xee\x05\xe1y'\v<t\f\x14\xf3\x9b\x14\xb4QHD*T֝\x8c\xf3M\xcf\xfb\xaf,3\xc5\x1e\xa4\xb0\xf3\xd4_\xe5\xfa\x1a\xde\x7fŌV\x15\x7fyx\xb8\x83\xb2ֆ\x02\xb8\x10|\x8e\xac\x13b\r\xec\xa0\xf5\xe9\x12=\xa1\xbd\xff\xda\xda\x14j\v\xcdۏ\x06\xb6\x98\xa4F\xcb`Y\x96\x14\x92r\x01\x8c\xa8\xf3\xad\xa0 \x96\x02\xe0i\x19\xc5\xf7\xab\xd5\xcc|\xc1^\x17\xdf\x06\xf6\xc8\x19`\xc3-\x13\xf9b\x92\x88\x7f\x98\xda֥\xdd\xfe\xb6\x01\x83\x95\xca\\\xb7\xa2\x8c7\xc1\x9dv\x9f\x92\x8b[\x1a\xc67\xf0:\xa2\xf4\xb4\x9f\xed~\xfc\x94<\xb6\x06\x9f\x14\xb2\xafy\x10s\xf3\xc5\xd8\xee\xd9\xf1\x87\x96L\xcf\xd67\xb45u\xec\xbd)@\x8b\"H\xb3^3\xc0\xdc\"\xbe\x92\xf9w\xb4\xb4Rڴ\x99ԋ\br\x13\xeb\xf9\x17h\xb4`k,\xee\xb1\xc0\xcc\xc8t\xc9\x7fh\xd7\x06m\xc9\xe8\xd0\xd3hAq\x03%3\xd9\x0e5\x94\xb4\xf9\xe3ݝ\xddY\xb4\x9b\xa1\x95̯\xa3\xf5\xe8\xa4K\xaeo\xbd\xb7\v*\xb2\x8aX\xd9E\xc4\x0e\xa7;\x11zlG\xdf7\x1b\xbe\x91\xb5zb\xef\x13\xe9\xce\xd2V\xa5^\x19R\xd9}P\xaeк\x91\x18\x11\xb8\x87V\v\xed\x9av\xc2|\xf3\xf1]\x9c\x18\x13}\xd0Q\a\xdfLt\xc2G\xa2ᗉ\xd0s\xe8\xf1\xa3N\xbb\x9dC}\r\f\x1eq\xef\x12eduv\xea\xf5dA!\xed\x96;\x83~\xc4\xfd\"\xb2\rrܢɯE\xd7J5%\x9f0\xc3}J\xf1\x9e\xa0\x1fq\x1f\x9c\xa6\x938}A\"\xb5}n\xc4Ϫ\xaa\xe0\x1ef\x10\xff\x18\x19ooɎ\xeb\xf0\x04\x9d\xbd@\f\x8d\xda\x0f\xe9@g\x18\xdfQ\x1e\xa1pQ\xe6\x8eW\xd3a\xefЇ,Վʐm\xfd\xc2\n\x9e7<\xbbE\xfa\xad\xb8\x86\x8f\xd2\xd0\x7f\xde\x7f\xe5ڤ\n\x9a\x8c\xf7\x9dD\xfdQ\x1a[\xff7\x11\xbb\xeb\xd0\v\x84\xee\b\x90\xf91\xe1\xd6b$\xa7vZW\xd3\x14\x12\xed\xf8\x0fO\xa3N\xae)\xd5*U\x90.\x19\xb5o\xd65\x18\x02a!\xc5rb\xd7c\xfcq\xfcuZ\xb4*\xd0\x14k\xb7u\xd2n<\xb1\x8d.\xab\x8eMx\xa0MZ\xf7\x8b\x03\x1a\x14\x84\xee\ti\x16\x9b\x1eg\x06\xb7\xa3\x1b\xc9cO\x89j\x8bP\xd1\f\x93&\x8bD\x7f\xff\"\xdbK\x8b,\xc3\xc7O\"\x03\xb9\xe2\xb1g\x99\xe4\xf6\x97\x8d\x19DW\x19ɪ\x9f\xb3\xe76X\xb0\x81Z\xb4vڐ\xb0\xf49\xe9\x04\x9dv\xfcB\x8ba\xeb\x1c\xa0d\x15y\x86\xbf\xd1Dm\a\xd0ߡb\\\xe9\x15\xbc\xb1\xa0\xb7\x81\xa4\xf2\xd8Ӧ\xe17\xa2\xda\xcdQK\xb4\xff\xf9k͟XA\xc1\x86\x91\xc0\x04`aC\x8f\xe8f\xe4\xe6(л\xa6Ž\xb6q\xc4!\xb7r\xf5\x88\xfb\xab\xeb\x8e\a\x89n\x83\xf2\x84\xb7\xe2ʅ.G\x8e\xad\x89s\xa4(\xf6pe\x7f\xbbZ\x1d\x85uѭ%\x87\x7f\x89\x96\x9dX\xfc\xeb\x920\x99J\xa0A\xbd,Y\xb5\xf4\xa3\xc2\xc82\xc2\xe35[\xbd7\x8bD\xdbl\xb6\x90C\xd4Ԑ\xf2[u\x11\x04!fs\"y U2O\xee͝lv&\xa8\x1fa\xb7\xf1\xfc\xec\xc5z\xdeeXwϖk\xe4\xbe8\x93]\x11\xf4\xedf\x91 <\xbb\x916\xb4{\xa5Q\xe4\x14\xd3\xcc\x10\x03G\xc1\xc3TV\x8b\xf3-\x0e\xd62\xdf'\xdb\xc2\xf72o\x96\x02D \x18C$\x7f\x89\x06\x01\xb0C\x96\xa3\x8a\x9aVN\x9f\x8e\x928\xea\xeb\xd71h\x03t\x96\xe7>\xd9~\x8aD\"\xbdZ\x89fw\xc2 \xfe\xc9V\v\xaa\xb36\xe5(y\rFЃ\xa6O!9o7\x1c\xef>\xdd?\x9c]\xef\xb5\x1aAsMt\xf1\xe7\xcf\x1fB\xff\xe8\x7f[J\x88\xdd;\xb3\xf9\x19#\xcfܛx\xb7V\xabbq&S\xf9E\xaeo\x16\t\xc2\xfb\xab\\\x0fn\xb2\xdb\xcc\xc4\xfc\x06\xfb\x8f\xcd<k)\x11\x9a\xce\xe5Y\xb8l\x81\xaa_\xee\xb4~\x91\xeb\x80;L\xb6\x8f\xbf\x1e\xea\x06;YS\xf8\xf5ʣ\xd3\xe7>\xad\xfa\x16\xa8J\x04\xa8\xb3\\;\xbc\x1aA\x8d\x94,\xcf>\xe2{q\fŊ\x84\x02_\xd6\xe2Q\xc8g\xb1\xb4q\xa2\x8e܋lf\xc4s\x065\xb1\xfe\xa3'-.\xe2eu\xe6\x91ֲ\xa3ٲM?\x17gR*\x11\xbcY$\x8a\xbe-\xf5\xe6\xe4\x00\x85\x13\xabř\xe4'\xc5{BQ&q\xf6\xc9\xd5i2\x18\x1av\xf2\xb9\x81h۬\xc8\f9p\x99p\x04\xbe\x01n\x00E&k:\x9e@\xb1\x91\x87u\xba\x9c\nmX\f \xf4\x8f\x9f\x18\xa1\xa0\xa8˹\x8e.mʈ\x8b\xd9Q\xb5\x84\x1f\x18/Υ\x06\x8f`MRC\x80\xe9\x06\x0fN\x86R\xb2\xaf\xbc\xacK`%\t\x14\xe4|\x96\x85Z\xee\xea\u0382{-\x80\x92(\x92`[\xae}~\xcc{\x98n&\x85\xe69\xaap\xea\xc2\xebSR2v\xc3xQ\xab\x11<n\xb2\xf4b\x1c\xc0Ҧ{\x16/\x1c\xc7\xf3\xfb+\x95\xc248\x85³\xa1)\xbc\xe0\x99\xd8[\x1c\x00Ql`\x14\xab\xc5\xc9{s\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\\\xc0\f\x170\xc3\x05\xccp\x013\xfc\x91\xc1\f\xe1&\x8f\x89Y\xad\xa3\xc3Í \x14K\x92\xac\xb5_)\x1c%\x17\xe9F\xab\xa9\x85\x03\x01\x17H\xd8\x04\x8c\x109\x7f\xe2y\xcd\n{\v\x14\xdd\xd7g/\xbf`\r\x7f\xab\xc5\xc9{u\x1d\xfe\x1d\x12#\xf4\x82P\x0e\x9d\xbb))\xd5&\x95M\xddMP\x84\x012\xe3bX3\xba8K\xcego\x15\xdd\x1d\xedY\xc9-8\xa7q\xea\xfa\xfa\xa0)\x97\xe0\xe9&\x95V\x8b\x97G.\xb1\x97\xeb\x8cHv\xe0\x9a\x9d\x83\xbf\xed\xcc\xc4\xf3Αn\x1f\xdc\xf1lw\x98?\xac\xef\x86\\\"\xddHfl\x12gv\x7f{\xd62\x92FmrJu~\xf0u\xe5\x1e\xac\xe94\xb17\xb5[\xb3\x1cI\xbd1\x9b\x8b\xd0\xdbB\xe7\xa2o\xadIR\xbf=\xaa~~c\xf7\x89J\x9b7\xb2\x89\x92k\n=bӗ\xb4\xd9۾$L\xff\x93)\xee\xb4\xd1rۯ}\xf6\xd1r\x16\xad5l\xfc\x93(-\t\x993\x8eʹ&,qPX~MW\x84\xda\x18<fe\u05c8tVs\xe7\x14PʮA?\xcb2_\xa3'\xab\b(M\x04I8@%|\xa0j\xb3+\xc7\xf9\x96\xb4<J\xa4\xa5\xa6\xc3g\xa2H\xb6:eôi\xe8L$ɐ\xe6\x1a\x04\xd8$\xc3fRL\xe5\x04\xb8L<T&\x9adK\xa8~\xec$\xc1d\x12\x9cR_\xe2'v;\x16\x1a\x13M\xdd9\xec\bXL\x02\xc5#\x00\xcd\v 1'\x888\x15\n\xd3\x11\xf0\x14\f&\x19\x8e\x13\x003\xa3\x10\x98\x04\x8a\x11`\x19\xdfZ\x02\xd1H\xa0L\x02\xc5A\x16\x87@2\t4'\xe04\xa7\x01d\x12<\xf9\xc9V\x18\x1fZ\x84O̦\xca)`\x98D L\xf4\xf6jz/[\xe0\x8e\xdfo\xa61\x02\xf0\x12\xc5C\x0f\x143\x03v\x89\"9\x03\x88\x19\x04\xbaD\x11\x8e\x03\xc34 \x97(\x9a\x89@\x98\x94!rB\xf0\x96`\xd5\tEO\a\xbe\xfcNs\x13t\xd3n\x12[tӮ\xdb<\xec\x84\xea\x03\xbb\x8b3T\xa1u\xa8\xc6_\x98\xab\x8dl\xf6\xb2\xc9e\xf7n^\xa6p\xbey9\xdb\xf8\xc3Tk'\xf3p\x13\xef\xd5\xc1\xbb\xb8\x8d\x83+{D\xda\xfe\xff<͌j:\x13\xac\x94\xccPG\x9ch\x88\x9cu:\xe2=\x96c?{<w\x90\xcd3ܬ\x1eW\x8b\xf3\x86\xf11\xe7\xccfO\x9b\xd1+\xf20\x8b2\xe5SxL<3\x96tr\xecp*,\x9ar\xdb\xd4\x7foAKډ\xb2\xf4\x10\xe0\x84\xd3e\x83\xea8\x9c\xd6\xf2\ni\xbe\x10\x89Au\xf4I\xb3\x04\xba\xb7\x9b\xe8\xf3f\tT\x13NN\x9dl\x01\x91\xc9\xe9\x11\xbdL\xa5\xa9\xa3)\xb6\xde\x113\x9d\xb0N\xa0\xd8Mm'8\x9a\xd8$\xf6\t\xe9\xec\xc4\xc4\xf6\x8b\xd4\x1a\x99\xec\x1eQ\xeb|\xda;\x9a.P\x90\x1a\x93\x00O\xa0\xd8J\x95Ϧ\xc2\x13\xc8F'\xcd_\xa0\x99\xd45\x9fwOQ\xa5\x13\xe2\xd8\x14F\x96v\xeaZ\x9c\xb1\xf5\xd8\xf9\xa3Ri!\xf3\x9d\xc2\U000c7995\xe2R\xd1\x04>\x13\x9d\xceҴ\xd1k7:\xf5\xc6Kw#\x8c\x84\xa7\xb3T\xa9\xec%<\xbd\x84\xa7\x97\xf0\xf4\x12\x9e^\xc2\xd3Kxz\tO/\xe1\xe9%<\xfd\r\xc2\xd3\xdf\x0e\x12\x1b\tߘc{\xa6-\x8fR\xf2o\x1a\r!\xde\xc8\f?\x84P\xea\xd7\x1cxQl\xd2\vF\xf5\xc1\xef6\x10*\v1\r\x83\xc9]\xd2\x15\x11\x85\x9f\xe1E\xac\x81\x01\xdf\xc9\xf47u\xdeN\x12转\xf0%/b\xf5\x9c\xf6\xe4r\xceװ\x06Y\xa4\xbf\xa1\xf3\xdaØJd!%dA\f\x98\x8f5;\x16\xc5v\xf8X$ǧ\xb3\x8e1\xdad\xc6\xc6\x1b\xef\xc3-O7\x991\x12=\xa3ip\x93^\x86g1\x9b\x96\x86\x1d\xc6a\x84*e\n\xfft\xf5\xc7\xd0\xc4I\xb2\x1f\x95\xb6\x13\xe1 Eh\v\xd69^mW\xf5m\xa8e\x17\xf2\xfa\xc71\xecS,y\xcct\x1b\x9b\f\xe68H\x12ƌ\xb4+\xcc@\xec\x8f K\x83\xe5\xa7pݤ\x8fjc\xc49P\xad5ے۷!mx\xe1:ݜ:H\x15\xec|j\xdfQ\xbaSR\xc8Z\xfb\x1d\x9e[\x83\xe5\x1b\xbb\xa9\xe4\xc1\x00v{)\xc1\x19\xbc\x86\x9d\xacG\xcex\xcc\xc85\x02y;\x8e\xb7u\xa3\xb4DÞ^\xaf\xba\xbf\x18\xe9ѷ\x83$\x01\x9e\xb9\xd9Q\xa4\"\xec\x05,b\xdb>\xe2\x13\x06\xaf\x91\x83\x867B\x91\x8e\xc3\xf0\xc2Ye\xa0бI\xf8d\xfb\xc0\x8aթ\xf65\xbf\xf1\xd4\a\x88\x8c\x95\xebI\xb5_\xad\xbb\xa7\xda\x05\xb8\xceG\xc9/\xc0\xe3N\x0e\xd1t\xecm\f\xd3\xfe\xcd\xd9ӈ\xdba,\xed\f\xd5\x14\x9cm\xec\x9eb\x04\xa6\xb6#\xa2\xb8K\xe7f(B\x02~v֏\x86'H4\xa9;gC\xc8\x1a\x99z]\xdc,\xc9\x13Ѱ\xd1\x02\x8bC\xbev\xc45\x85wm\xba\x1dqs\xe8\x14\xca\xf5\x18\x06F\xb7\xa7͒\x1c¶\xc6 V\xa3x\x8dƩ6\x97\xb9͒}\x19:u֯%\xda\xc2\\\xac\x11>q\xfb\x16\xd3X\xd3(\x84i\xd4\xde\xc6<\xcf-\xcc\xe48˩\xc8\xd1(\xa9v\xc6M\x8b\x8d1\x94hs-\xdaD\xc3Q\xd8\xd0\xe3\x8b\xd0&(\xce#Bǯ>[ď\xef\xd8\xcb\xce&H\x8e^\x83\x16\x13\x06\xccZ\xd3l\x81T\xec&\x05\x9193\xecfq\xda\xec\\\xfc#l\xf6\xa5b\x92\xaa\x134\x8f0\xd4\x19\x19\x9fzUȼB\x9c8\x14\x88\x0fR\x84Cx~B >B\xf2v\x03e]\x18^\x15\x16\xb5\xf9\xc4i\a\xd1\xecp\x0fϼ(\b\xeb\xf5\x8b\xb4\a\xd7\xd7t\x94\b\xe1\xd3\xe7\xc6\xe4\xc7\f\xb1\xd3\x13`\x1a\x9e\xb1(\xe8\xbfGRȘ\xa0\x9c[&\x97H\xd3\xd6x\"\xd0\xf5!\\\xa9t\xed\xb6\xc5\xfc\xbb\x03\xcc\x0eKȘ ^\xc7sm\x93S\xc9txl]\x99\xb5T\xf8\xb5F\xb5\a\xf9\x84\xaa\x89\x83FH\x1e6\x91\x9a\x98^\xd7\xc5\xc1\xf9x/F\u03a2\xef\x8cF)\x1e\\\x00\xbc\x11nb\xee\xf3\x1an)o-\xa7\xa6\x9c-\xad\x9e\xc6H\b\xd9PX\x9c\x1e}\xf7;7^\xb2\xa7\x863-\xaeα\xbc\x8a\nD\xa6m\xe8\xb4%ַZd\xa5.\xb3\xe2T\x9dp|\xb1#\xac3-\xb6R\x96[\x913Eڒ\xab\u05ed\xb3-\xba\xbeɲ\xeb\xe4\x85W\x92\xe8b\x8f\x1dv\x04\x17\xb3\xfcZ\x9ct\xef\xf5\xe4\x02,\x82\xe4\xe8\xf1\xc2\xe1%X\x04\xc5\xce\"-j\x11\x16A4\xe6\xce\xed\xb4C\x82\x11\xfe/\xd96b\x166\xf1˱\x98\xc3\x7f\x91\x87\xfef\xe3\xc3x\xee[S\xfd\x14\xf3\xa9an\xb4\x9c;\xe3*~y6\xd9\xf4\x9bo\xb0@;q\x896Iq\xea\xb0\xde\xf4\"m\x92\xec\xf4m\xd5q\xe1D\x84\x85E\x14I?h\xf7\xe2d\x8cT9\xaaټV\x8a9\xcf\x1arǄ?\xf5\xda\xefet\xfc2\xc1r\xd9Ι\x8diT6\xf7\x8ed\xf0#\x17>[O\x86ۊI\x02\x11\x9b\xc4<\x04L#$;Q\xaaS\x9fO k\xac\x18\x9d\xd0\xceaM\xa6V\x96L\xaf\xe0=\xcbv\r\x9b#$\xa9:옦DT\xc9\f\\5\xa9\xd0W\xae\x01\xfa\xfbj\x05\xf0\x83l\xe0#\x87\xae\x8f\x85\x02\x9a\x97U\xb1\xa7\xc33p\xd5&\xf32\xc3\x195؊\xd1\xda\xecf^\xc5w\xb6\xa0M,e\x94-h\xee\xd1%xy\xad+\xf4#\xb7RrKk\x83A\x8a\x00\xd9\x0e\xdd=t\xfe\x92\xe8\xe8\x04\xde芮e`\xb50\x9c \xe8\x14S\xd4B\xa3Y\x9d\x04\x98\t:\xba\x93\x05\xcf\xf6\x11\xb2\tv\xef*\xf4\x8c_\xe1\x06\x15\x8a\xac\x85\f\x19\xa4H\xef\x11+xf\x03e\n\xb2}\x9f<\x90h#\x8bB>/N[\x03\xb0\x8a\xff\x97\x92ѯP|swk\x8b\x87\x91\xb6\xb5\x7f\x04Da\xe8\x04\xacqz\x92;t\xdc\ue237\xa9\x0e z\x9b?'(\x92/hb/?\xb5e\x84Q|sw\xeb\xb8\\\xd9\xc1F\x87\x12\xa4\x7f\xff!W\xf9\xb2bj4\xd1\x19\fB_w8\f\xb1\xcdj1Uif\xaa\x7f\xe4\"\x8f\x94\xb9횗7Q\xee@\v\xac\xa4[\xf2|\tOӇ\xb9g\x8fq\x7f\x03\x9e\x82\xa8\x87\xb9ZZ).\x12!\x8a\xb3\xd3t\xea$\xad\x05\xab\xf4N\x9a\x9f\xe4\x13\xbe\x1b\xddY\xed\x88\xef\xbeWe\x00T\x18\xa8\x02m\xd6.f\x10\xdcP\xca'\xcc_6\x0f\x8c;\xbd\xc0\xca\x17Y\xd4%\xea\x84\xfe\xf9\x1a\x03\xdd#\xc8\x01{Ć\xf6\xc4|OC\xf6\xee\xcbw\xbaeQ!x\xf5\vl\xbf\xe9\xd5 \x10\xfc\xcf#$\xbf\xff\xb6\x98J:PŶ\xf8Afvz\x8a\x91V\xb7\x86\xdfm\xb2#5\x04\xb8\x01a\xed\xc7\xda M\xc2R\xbb\xbe\xf5\t\x1e\xce\x05wg\x8e5Zn\xc7\\\xd9\xcc\xf04\xa6\x88\xe8\xdc\xc3\xc3\a\xd7!\xc3K\\\xbd\xab\x1d\xea\x86\xfc\xaeF\x92t訓\xc8z\xb8)z\xe8\bn!\xbd\x1c\xbe\xef\xf7C!\x89\x89^\x10/\xd5I\xbdy\xb2\xa6\x1a\f7\x88.\xc6ؿ\f\xd7l\xed~\xb6\x948\x05\xab\x93\x9bQZLk\x99q\x1b\x8a\xda<\x82=_1\x95&\x98\\\xfeψbzI1\xe1?k\x8d\x9f\x9e\x05\xaa\xcfa\xa0\xea[1\xf6\x86\xe6\x8e\b\x7f>\xaa\x18\x14<\xe48(\x00\xee\x15?\"\x0f \x85\xb7\xf6\xc3\r\xf26!\xc25\xdcg;\xcc\xebb\xe0\xc8\xc1\xcc\xf8\x1f\x1f\xfb\xc33\xd5\x12\xb4o\xaa\xf7\xb5\x19\xbaM~D\xb2\xda0S\xf7tّ^\xe8ν-\b\x19\xabL\xad|\x88\x99\xd5J\xd1\xf2\x9c\x88\xd8Y\x9a5GB\x868\x1b\x0f\x18\v\xa6M\x94.?4\x05C\x80@U\xed\xf0o\x1c\x14<3M/b\xf5gQ\x06\xb7\x05B\xaf\x86\x19\xf5(\xbf\x92\x99\x1b\x9a!qI\xf4OS\xe7\xe08\xa8vL\xe3LO\xef\xa8\f\xf0\xae\xa0mŰ\x84\t}X\xc4\x1dvZ\xc2G<\x0e\xe4\x97\xf0^\x90M\x1e\xcf\xef\xeeD\x13\xe6vc\x99\r\x1e\xbc\x99\xe8\xe2SS\xcb\xdev\xa0gz{h\xc4\x15\xef\x81])}u\xa0\xe8n6\x18R\xeb\xbf\xf2\x8d\xdb\xf5ϨO\xff\xb6\x88v\\\x13=\x19wX\x83C\xea\xe8K\xfb\x82\x8c\xbce$~\x0eo\x7fS\xafC|\xabo\xe0o\x7f_\xfc\xdf\x00\xd8\x1ez3\xff\xb6\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xe36\f\xbd\xe7+\b\xf4\xb0\x97\xda\xd9m/\x85oE\xdaà\xedb0Y\xcc]\xb1\xe9\x84\x1dYRI*\xd3\xf4\xeb\vI\xf6$\x13;۴@\x13_,\x89\xe4\xe3#\xf9\xac\xaa\xaaV&\xd03\xb2\x90w\r\x98@\xf8\xa7\xa2KoR\xbf\xfc 5\xf9\xf5\xf1\xd3\xea\x85\\\xd7\xc0&\x8a\xfa\xe1\t\xc5Gn\xf1'\xecɑ\x92w\xab\x01\xd5tFM\xb3\x020\xcey5iY\xd2+@띲\xb7\x16\xb9ڣ\xab_\xe2\x0ew\x91l\x87\x9c\x9dO\xa1\x8f\x1f\xebO\xdf\xd5\x1fW\x00\xce\f\u0600 \x1f\x91E\x8dFa\xfc#\xa2\xa8\xd4G\xb4Ⱦ&\xbf\x92\x80m\xf2\xbfg\x1fC\x03\xe7\x8db?\xc6.\xb8\xb7\xd9\xd56\xbbz*\xae\xf2\xae%\xd1_n\x9d\xf8\x95\xc6S\xc1F6v\x19P> \a\xcf\xfa\xf9\x1c\xb4\x02\x11.;\xe4\xf6\xd1\x1a^4^\x01H\xeb\x036\x90m\x83i\xb1[\x01\xa4\xa4'\U000aa44b\xe3\xa7\xe2\xae=\xe0\x90INo>\xa0\xfb\xf1\xf1\xe1\xf9\xfb\xed\xbbe\x80\x0e\xa5e\n\xa9\x04\x8b\x99\x01\t\x18\x18Q\x80z0m\x8b\"\xd0Fft\n\x05%\x90\xeb=\x0f\xb9\x92o\xae\x01\xcc\xceG\x05= <g\xca\xc7\xcc\xea\xb7#\x81}@V\x9a\xd8\x18\xcd\xceMv\xb1z\x85\xf5CJ\xa7\xa4\x0f]\xea.\x94\x1ci\xa4\x04\xbb\x91\x01\xf0=\xe8\x81\x04\x18\x03\xa3\xa0\xd3k\x94\xe9\xf1=\x18\a~\xf7;\xb6Z\x8f<\b\xc8\xc1Gۥ\xa6<\"+0\xb6~\xef\xe8\xaf7ߒ\bIA\xadѩO\xce?r\x8a쌅\xa3\xb1\x11\xbf\x05\xe3:\x18\xcc\t\x18S\x14\x88\xee\xc2_>\"5\xfc\xe6\x193\x99\r\x1cT\x834\xeb\xf5\x9et\x1a\xae\xd6\x0fCt\xa4\xa7u\x9e\x13\xdaE\xf5,\xeb\x0e\x8fh\xd7B\xfb\xcap{ \xc5V#\xe3\xda\x04\xaa2t\x97\x12\x96z\xe8\xbe\xe1q\x1c\xe5\xc3;\xaczJ\x9d%\xca\xe4\xf6\x17\x1by \xbeR\x814\x0e\xa5?\x8aiI\xf4L4\xb9}.\xc9\xd3\xcf\xdb/0\x85\xce\xc5x\xe7\x14F\xdeφr.A\"\x8c\\\x8f\x9c\xed\xa0g?d\x9f\xe8\xba\xe0ɕ\xeej-\xa1\xbb\xa6_\xe2n \x95\xa9wS\xadj\xd8dŁ\x1dB\f\x9dQ\xecjxp\xb01\x03ڍ\x11\xfc\xdf\v\x90\x98\x96*\x11{_\t.\xc5\xf2\xfcK^\x9a\x91\xb5\x8b\x8dI\xe6n\xd4ka\xba\xb7\x01\xdbT\xc1Db\xb2\xa6\x9e\xda<\x1e\xd0{\x06\xb3dR߅$[\xfcK,\xa3\x92\x144W\xfa\xe2\xfb{\xd0,\xcbI\xfa\x87\x83\x11\xbc^\xbc\xc2\xf4\x98\xce\\Ƿ\xd4c{j-\x16\x17EM🡤?\xba8\xcccV\xf0\x19_\x17V\x1f\xd9'eͺ\x0epGo\x8cߛ=M\x1f\xcfۙ\x95S\xf9\x1bv)\xd5\x17\x02=:\x02\x8eΥ\xb9\x9d)dzfJ>;C\x8a\xc3\x02\x9aE<\x0f\xae\xf7I[դ\xc0F\xcb<\xe1X\xec1N\xc1\xb5\xe0\xf0v\xado\x89\xd7]\x84\x96'\x7fI\xff\x9bq\x92\x1bb\\\x8c]eT\x8b\x1b)\xe2\xc2ƍ\xf9\x1aQFk\xcd\xceb\x03\xcaqn]l\r\xb39]텩վЀ\xa2f\b\xcd\xea\xeb\x05\x9b\x19\xa49y=\xa0\xbb5\r\xf0jd\xe6\xf3\"2\xecN\xb7L7ow\xc0\xf9H\x95[F\x03I\xbb+\xa5\x05\xce\xee\"e\xb1z\xe5r\xb2x\xf3\x98\x11\xb2\xbd<;iƻј\xeef\xf5\xfd\x10\x16\x8b=[\xcc0\xbb\x8b\xf4D=\x9b=6\xa0\x1cq\xf5\xf7\x00\xb1J-\xe7\xa6\v\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VQo\xdbF\f~ׯ Ї\xbcTr\xbb\xbd\fz\x19\x8at\x0f\xc5\xda5h\xb2\xbc\x9fu\x94u\xf3\xe9N#yJ\xbd_?\xf0$Ŏc'.\xb0E\x06\x02\xdd\x1d?\x92\xdfG\xf2T\x96ea\x06w\x8f\xc4.\x86\x1a\xcc\xe0\xf0\xbb`\xd07\xae\xb6\xbfp\xe5\xe2j|_l]\xb05\\'\x96\xd8\x7fC\x8e\x89\x1a\xfc\x88\xad\vN\\\fE\x8fb\xac\x11S\x17\x00&\x84(F\x97Y_\x01\x9a\x18\x84\xa2\xf7H\xe5\x06C\xb5Mk\\'\xe7-R\x06_\\\x8f\xef\xaa\xf7?U\xef\n\x80`z\xaca\x8c>\xf5\xc8\xc1\f\xdcE\xf1\xb1\x990\xab\x11=R\xac\\,x\xc0F]l(\xa6\xa1\x86\xfd\xc6\x041\xbb\x9fB\xbf\xcfh\xb73\xda\xe7\x19-\x1f\xf0\x8e\xe5\xf7\x17\x0e}v,\xf9\xe0\xe0\x13\x19\x7f6\xb2|\x86\xbbH\xf2\xc7\xde{\t#\xfbiǅM\xf2\x86\xce\xd9\x17\x00\xdc\xc4\x01k\xc8\xe6\x83i\xd0\x16\x003?9\x99r\xa1\xe6\xfd\x84\xd8t\xd8g\xce\xf5-\x0e\x18>\xdc|\xba\xff\xf9\xf6\xc92\x80En\xc8\r\xea\xe3\\\x8a\xe0\x18\f,\x91\xc0C\x87\x84p\x9f\xf9\x04\x96H\xc8sЏ\xa0\x00K\xfc\\=.\x0e\x14\a$qK\xf2\xd3sP_\a\xabGq]i\xe8\xd3)\xb0ZX\xc8 \x1d.飝\xb3\x85\u0602t\x8e\x81p d\f\xb2\x17r\xff\xc4\x16L\x80\xb8\xfe\v\x1b\xa9\xe0\x16Ia\x80\xbb\x98\xbc\xd5z\x1c\x91\x04\b\x9b\xb8\t\xee\x9fGl\x06\x89٩7\x82\xb3\xe6\xfb\xc7\x05A\n\xc6\xc3h|·`\x82\x85\xde\xec\x80P\xbd@\n\ax\xf9\bW\xf0%\x12\x82\vm\xac\xa1\x13\x19\xb8^\xad6N\x96\xbejbߧ\xe0d\xb7\xca-\xe2\xd6I\"\xf1\xca\xe2\x88~\xc5nS\x1aj:'\xd8H\"\\\x99\xc1\x959\xf4\xa0\ts\xd5\xdb74w\"_=\x89UvZE,\xe4\xc2\xe6`#7\xc2\v\nh\x0fL\x850\x99N\x89\xee\x89va\x93\xd9\xf9\xf6\xdb\xed\x1d,\xae\xb3\x18O@a\xe6}o\xc8{\t\x940\x17Z\xa4l\a-\xc5>cb\xb0CtA\xf2K\xe3\x1d\x86c\xfa9\xad{'\xaa\xfb\xdf\tYT\xab\n\xae\xf3\xb0\x815B\x1a\xac\x11\xb4\x15|\npmz\xf4׆\xf1\x7f\x17@\x99\xe6R\x89\xbdL\x82\xc39\xb9\xffS\x94zf\xed`c\x19og\xf4:\xddɷ\x036O\x1aHQ\\\xeb\xe6\xcen#=A\x040K\x9f\x9f\xc6\xdb7\xf7\xf9\x06\x9f\x87|\xeb6ǫ\x00\xc6\xda|E\x18\x7fs\xd6\xf6\x05\xc2N\xe4}\x1dC\xeb6Z\xa8m$\x18(\x8e\xce\"\x95K\x9es$\x89\xe6\x84\x1dz\xcb\xd53\xc83\x9c\xeb\xaf!\xb4\xaa\xb1\xf1\xf5+\x91<\x1eT\xa7b\\\x98f\xd6\x1e \x97\x1e\xf5\xf3\x8c\r\x82\xc1\xe6\xa1~\xfcH\xcc5\xcch\xe1\xc1I75\xc7\xc1\xc5\x00p\x99\n\xfalqwj\xf9(\xf6\xbb\x0ea\x8b\xbbi\x9c\"06\x84\xa2\xf3\x8f\xd1k\xf3jgV\x00_\x12\x8b\x86fN\"\x82\x8e\bg\x17\xeb-\xee\x9e\x13\xfd\xaa\xb8\xf3}\xffz\xc8Wz/.\x01\x13\xb6H\x18\xe4d\x8b\xeb'\x06\x05\x14̟/66\xac\x13\xb6\xc1Ax\x15G\xa4\xd1\xe1\xc3\xea!\xd2օM\xa9\x84\x97S!\xf0JC\xe1՛\xfc\xefdD\x00w_?~\xadჵ\x10\xa5C\x82\xc4\xd8&\xbf\x14\xda\xc1m\xf7\x16t0\xbc\x85\xe4\xec\xafW\xc5\t\xa4\xd7x\x89Y+\xe3/\xe0F\xdb\u07b5;\xbd\xb9sPJ\xd1\xed\xa4J$й\xa9b\xf7\xb3\x9a\xd3|\xb0/h\xb5\x8eѣy^z:}\x1d\xe1\xd1=\xa2\xbfR\xcb\xe9G\xda\f\xe0{\xb9\x17\xaa\xec\xcdPN\xbe\x8d\xc4\xde5G\xa7\x97>\xaf\x8b\x17y\xb8\x99\x8f\xe9xP\x0e\x16\xb3\xa5l\xa6\xaf\x98\xfcMc6X\x15\x17+r:\xf1\xf2\xd1AqA\xd6,F\xd2Q\xcf^2ҳٜ\xe7z\x1e\xebM\"-\xff\x19\xf3\t$h\xb2\xff\xd1X\x1f:\xc3\xf8\n\xe7\xa7=ܨ\xe5\"\x83w-6\xbb\xc6\xe3\x04\b\xb1}\x06\xf9\x837\x91\xfe0\xa4\xfeyl%|\x18\x8d\xf3f\xed\xf1\xc4ޟ\xc1\x9c\xdd=+\xfeI=\x9f-2҈\xb6\x06\xa14y\x9e\xab\xac\x06\xa1\x84ſ\x03\x00\xec\xa0\xe0\xa1k\r\x00\x00"),
 }
 
 var CRDs = crds()
@@ -29,8 +29,8 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY_o\xe4\xb8\r\x7fϧ \xb6\x0fyY;\xb7ע(\xe6mw\xd2\x02Ao\xd3\xc1e\x91w٢=\xbaȒ*ɓ\xa6E\xbf{A\xc9\xf2\xf8\x8f&N\xf6z緑(\xf2'\x92\xfa\x91\xd2\x14EqŌxD\xeb\x84V;`F\xe0\xbf<*\xfa\xe5ʧ\xbf\xb8R\xe8\x9bӧ\xab'\xa1\xf8\x0e\xf6\xbd\xf3\xba\xfb\x19\x9d\xeem\x8d\xb7\xd8\b%\xbc\xd0\xea\xaaC\xcf8\xf3lw\x05\xc0\x94ҞѰ\xa3\x9f\x00\xb5V\xdej)\xd1\x16-\xaa\U000a9bf0\xea\x85\xe4h\x83\xf2d\xfa\xf4C\xf9\xe9\xc7\xf2\x87+\x00\xc5:\xdc\x01\xe9\xe3\xfaYI\u0378+O(\xd1\xeaR\xe8+g\xb0&ŭս\xd9\xc1y\".\x1c\x8cF\xc0\xb7̳\xdbAG\x18\x96\xc2\xf9\xbf\xaf\xa6~\x12·i#{\xcb\xe4\xc2v\x98qB\xb5\xbddv>w\x05\xe0jmp\a\xf7dڰ\x1ail\xd8S\x80R\x00\xe3<x\x89Ƀ\x15ʣ\xddk\xd9w\xc9;\x05pt\xb5\x15\xc6\a/La\x81\xf3\xcc\xf7\x0e\\_\x1f\x819\xb8\xc7\xe7\x9b;u\xb0\xba\xb5\xe8\",\x80_\x9cV\a\xe6\x8f;(\xa3xi\x8e\xcc\xe10\x1b]\xf9\x10&\x86!\xffBx\x9d\xb7B\xb59\x04\xdfD\x87\xc0{\x1bBH\xfb\xae\x11\xfcQ\xb89\xb4g\xe6\b\x9e\xf5\xc8/\x02\t\xf3\xa4\xcey֙%\xa2\xc9\xd2\b\x893\x8f9@{\xdd\x19\x89\x1e9T/\x1e\xd36\x1am;\xe6w \x94\xff\xf3\x9f.\xfbbpV\x19\x96\xdej5w\xcc\x17\x1a\x85\xc9pDBQj\xd1f\xbd\xa3=\x93\xbf\x06\x88'\x05_&\xeb#\x92\xa8w:\xbe\t\x85R\x0et\x03\xfe\x88\xf0\x85\xd5O\xbd\x81\a\xaf-k\x11~\xd2u\f\xdf\xf3\x11-\x06\x89*JP\xf6\x82\xa0\xd8i\x9b\r\x9d\xc1\xba\x8c\xb2\x83\xb2\xa4k\x11\xbf\xb9\xa1\xff{n\xd5\x16Y6\xb7\x12ՔABh\x95O\xb0\xcf-\xbe)\xb9\xa6NT\x9a\xe3\xc4c3L\u0081\xb1\xbaF\xe7^IxR0Cq\x7f\x1eX\xb9&J\x9c~d\xd2\x1c٧H2\xf5\x11;\xb6\x1bVh\x83\xea\xf3\xe1\xee\xf1\x8f\x0f\xb3a  \x06\xad\x17\x89\xeb\xe27\xa1\xf1\xc9(̷{M\n\xa3\x14p\xe2ota\xaf\x03c!\x1f0D\x97\b\a\x16\x8dE\x87\xcaOÜ>\xdd\x00S\xa0\xab_\xb0\xf6%<\xa0%5\xe0\x8e\xba\x97\x9ch\xff\x84փ\xc5Z\xb7J\xfc{\xd4\xed\xc0\xeb`T2\x8f\x03\xf1\x9e\xbf\xc0\x90\x8aI81\xd9\xe3G`\x8aC\xc7^\xc0\"Y\x81^M\xf4\x05\x11W\xc2Wm\x11\x84j\xf4\x0e\x8e\xde\x1b\xb7\xbb\xb9i\x85O\xe5\xab\xd6]\xd7+\xe1_nB%\x12U\xef\xb5u7\x1cO(o\x9ch\vf\xeb\xa3\xf0X\xfb\xde\xe2\r3\xa2\b\xd0U(ae\xc7\xff`\x87\x82\xe7\xaegXW\x11\x8d_\xa8<\xafD\x80\xca\x0f\xa5\x13\x1b\x96\xc6]\x9c\x1dMC䝟\xff\xfa\xf0\r\x92\xe9\x10\x8c\xa5\xf7\x83\xdf\xcf\v\xdd9\x04\xe40\xa1\x1a\xb41\x88\x8d\xd5]Љ\x8a\x1b-\x94\x0f?j)P-\xdd\xef\xfa\xaa\x13\x9e\xe2\xfe\xcf\x1e\x9d\xa7X\x95\xb0\x0f5\x1d*\x84\xde\xd0A\xe2%\xdc)س\x0e\xe5\x9e9\xfc\xcd\x03@\x9ev\x059\xf6m!\x98\xb6#K\xe1\xe8\xb5\xc9D\xea'.\xc4k\xca\x02\x0f\x06k\n\x1dy\x8f\x96\x89F\f<\xdbh\vl&[\xceT\xe6\x8f,}Y\xae]\n-0}ɭI\xc0Ԅ\xd1\x06\xd2wQr\xa5\x14@^,\x14\x16\x8dv\xc2k\xfbr.\x17\xe5JÅ\x00\xd0W3U\xa3\xdc\xd8\xc9>\b\x81P\x9c<\x89c\xde\x11ED\x05\x01\x93V\xad\xa6sq\xd9\xc1\xf1\xbb\xf3\xb4\x8a\x12ա\xa7=\xa9,\x93\v\x05\xe7>\n\xa6\xfd\xd2rg\x95\xd6\x12ْ\xf7(\xb7\xbe\xea\x13ur\xaa\x11\xedz\x8fӖ\xefR\xe07ܗIÉI\xda\x05\xe5\x1c!):\x1a/RB\x12\xf16\xa2\x1d\x8al\xc6h#Prw)\x96\xab\xf3\x916\x1c\xacl\x84sD\x99\x8e\xc7P^B\xd7\x11\x14P`\x89G\\h\xe7h2\x830\xa6`\tw\xcdD\xa3p\xf0\xe1\x03h\v\x1fb\xcb\xff\xe1cL\xd7^H_\b5\xb1\x91\xd1\xf8,\xa4Lvߕ\xc5\x14\xbd\xb1\xcdн\xdfp\xc0?\x16\xe2\v?x\xea\x7f\xc2\u07bd\x86g&\xfcX\xee2\x98G\xd3\xee#T\xd8\x10\xc5Z\xf4\xbdUt\x12\xd0Z\xa2\x1c\x17T\xea\u07bfkSN1\xe3\x8e\xda\xdf\xddnl\xe7a\x14L\xecrw\x9b\xb8\xe51Da\xa4\x98A\x12\xbc\xce\x05\x94\xa0G\x0e\t\xc5\xe8}hC\x05\x1c/X[\x90\xe7\xd2\t\xb7\xb6\xa2\x15\xd4V\xa8q\xe6Ly'\xba\x90\xe5\x12Q\xb8\xb0?\xe4Л\b\x9c(\x86\xaak\x85\xc0EӠE\xe5c}\x8d\x86\x0f\x8f\xfbkw6\x92\xd3\xd9L0\x84\x0e\xabc\xc6 \xa7\xbe\x98\";8\xea].\xf2̶\xe8\x1f\xc366\xfc\xf3m\"\x9a\x9cC\x95\x9b.1T\b\x86\xe8F\x8dpx\xdcS\a\x96\xd9\xc6\xe1q\x8d\xf0r\x95\x83\xa1\xe1\xbd\x10\xc1\x15\xcaU\xfc\x06<\xaf9v\x83N\x01\xcc\xe9\r\x96\x0f\x8f\xb9B:\xba\x03\xfc\x91y\x92\x18.(P\xbdduB:\x1fC8\xbf\x0fo\xfd&\xc0\xfbW\x11\uf5d0/\xe0\xad^~5d*\xde\xc2\"_\xa3.^\x89\\\x01\xe6\x94\x1d\xac\xdf^\xa2\xf2\x96\x8b|w\xb5\x90YR\xfcb\xfaL\x96ˉ9\xd3,f\xa7G\xf2Mmh\xb8B\xbe\xb5\x11\x8d\x0fCC\xd8\xeb\xde\x06\x1a\x1a\x9e\x8b\xe8V\xf6]\xadh\x1d\x1fZ\xa6w\xea\xad\xf6m\xbd\"\xdc\xf7,\x9f\xd4;\x96\x12*^\xec\xd3kN\xae\x7f;\xeb\x8bK\x03=\x92:\xe4\x80'T@\xad6\x13\x12y\xd2\xe9J\xf8F\xddx\xb8\xf8\\/\xafH\xc1߃\xa2Pv\xa9gʀ^\xafK\xaf:t\xdd)H\xc5JB\xf5R\xb2J\xe2\x0e\xbc\xed/\xf5\x8fكҡs\xac\xdd\"\xea\xafQ*^\x15\x87%\xc0*j*\x96=\xed\xb5\x1bb\xff\xae\xa2\xa14\xdf\xc2p\xafy\x00\xa0\xbe\xe3\x95\xe4]XB\x0f\xbe\x01\xe6@2\xb9\x9c\x1f\xa1\xbd~=@\xd5w9f\xba\xc7\xe7\xcc\xe8\xe7\xbaF\x93c\xcb\x02\x0e\x16\r\xb3٩\xd5\xf3\xect2^vrę\xe6\xb2:\xc7\xf7\xcf\xcc\xdc\xdf\xc2ax\x97\xa7\a|[\xceN\xb7\xa3\xa3\x96\xe90\x87'J\xd5w\x15Z\xf2xx\x04M\xaeO,\x999\x80L\xf1Y\xc8\xce\x1aƞ0\xa8\xa2\x93LU*^\xe0R\x97̅3\x92\xe5\x8al\xdaɬ}9\x1f\x90D:#\xbd\xbf\xb7_\x19\x9f\x8c\xf3E8\xf7\ue6cb\xc2\xf4\x05w1?>\x05\xff6\x16^\xb9\xd0͟\xe6\xb7Z\xea\x99\xf0\x16\xc1\x0f\xff\n\xe4\xe8}\xca\xd4k^\x9e\x9b\xf9=)9\xeb\xa8\xd5`@\xce'\xba\x87g\x95\xe9H_\x8d\x8f\x85;\xf8\xcf\x7f\xaf\xfe\x17\x00\x00\xff\xff\x9f\xc23\x7f`\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY\xcbsۼ\x11\xbf\xfb\xaf\xd8I\x0f\xb9X\xf4\x97\xaf\x9dNG\xb7Dng4\xfd\xe2z\xa2\xd4w\x88\\\x91\x88A\x80\xc5C\xae\xdb\xe9\xff\xdeY<(> \xd1J\x93\xf2\xe0\xb1\x00\xecb\x1f\xd8\xdf\xee\x02\xab\xd5\xea\x86u\xfc\t\xb5\xe1J\xae\x81u\x1c\xffiQ\xd2/S<\xff\xc9\x14\\\xdd\x1d?\xdc<sY\xada\xe3\x8cU\xed\x174\xca\xe9\x12\xef\xf1\xc0%\xb7\\ɛ\x16-\xab\x98e\xeb\x1b\x00&\xa5\xb2\x8c\x86\r\xfd\x04(\x95\xb4Z\t\x81zU\xa3,\x9e\xdd\x1e\xf7\x8e\x8b\n\xb5g\x9e\xb6>\xfeR|\xf8\xb5\xf8\xe5\x06@\xb2\x16\xd7@\xfc\\'\x14\xabLqD\x81Z\x15\\ݘ\x0eKb[k\xe5\xba5\x9c&\x02Y\xdc2\x88{\xcf,\xfb\xbb\xe7\xe0\a\x057\xf6\xaf\x93\x89߸\xb1~\xb2\x13N31\xdaՏ\x1b.k'\x98\x1e\xce\xdc\x00\x98Ru\xb8\x86\aڲc%\xd2X\xd4ċ\xb0\x02VU\xde6L<j.-\xea\x8d\x12\xaeM6YA\x85\xa6Լ\xb3^\xf7\x93@`,\xb3\u0380qe\x03\xcc\xc0\x03\xbe\xdcm\xe5\xa3V\xb5F\x13D\x02\xf8f\x94|d\xb6YC\x11\x96\x17]\xc3\f\xc6\xd9`\xbe\x9d\x9f\x88C\xf6\x95\xa45VsY\xe7\xf6\xff\xca[\x84\xcai\xef6ҹD\xb0\r7C\xc1^\x98!\xe1\xb4\xc5\xea\xac\x18~\x9e\x98\x19\xcb\xdan*π4\bT1\x8b9q6\xaa\xed\x04Z\xac`\xffj1)qP\xbaev\r\\\xda?\xfe\xe1\xbc%\xa2\xa9\nOz\xaf\xe4\xd8,\x9fh\x14\x06\xc3A\x12\xf2P\x8d:k\x1be\x99\xf8_\x04\xb1\xc4\xe0Ӏ>H\x12\xf8\x0e\xc7\x17E\xa1\xe3\x06\xea\x00\xb6A\xf8\xc4\xcag\xd7\xc1\xce*\xcdj\x84\xdfT\x19\x9c\xf7Ҡ\x8e\xceۇ%\xa6QNT\xb0O\x1a\x03\x18\xabt\u058b\x1d\x96E\xa0\x8a|\x13ۉ+\xc7{\xfe\xe0CVjd\xd9C\x96P\xa6\xf0+\xb8\x92\xf9\x93\xf6\xb1\xc67\x9d\xb2\xa15\xa5\xaa\xb07\x1d\x0e%\xe2\x06:\xadJ4\xe6¹'\xf2\x91\f\x0f\xa7\x81\x99Y\u008a\xe3\xafLt\r\xfb\x10P\xa6l\xb0e\xebH\xa1:\x94\x1f\x1f\xb7O\xbfߍ\x86\x81\x04\xe9P[\x9e`.|\x03\xfc\x1e\x8c\xc2X\xd9\xf7\xc40\xac\x82\x8a\x80\x1b\x8d\xd74\x82\x16VQ\x86`\x10n@c\xa7Ѡ\xb4C\x17\xa7O\x1d\x80IP\xfboX\xda\x02v\xa8\x89M:h\xa5\x92G\xd4\x164\x96\xaa\x96\xfc_=o\x03V\xf9M\x05\xb3\x18q\xf7\xf4y\x90\x94L\xc0\x91\t\x87\xb7\xc0d\x05-{\x05\x8d\xb4\v89\xe0痘\x02>+\x8d\xc0\xe5A\xad\xa1\xb1\xb63뻻\x9a۔\xb7JնNr\xfbz\xe7S\x10\xdf;\xab\xb4\xb9\xab\xf0\x88\xe2\xce\xf0z\xc5t\xd9p\x8b\xa5u\x1a\xefX\xc7W^t\xe9sW\xd1V\xbf\xd31ә\xf7#Yg\x1e\r\x9fO:\x17<@\xb9\x87\x8e\x13\x8b\xa4A\x8b\x93\xa1i\x88\xac\xf3\xe5ϻ\xaf\x90\xb6\xf6ΘZ\xdf\xdb\xfdDhN. \x83qy@\x1d\x9cxЪ\xf5<QV\x9d\xe2\xd2\xfa\x1f\xa5\xe0(\xa7\xe67n\xdfrK~\xff\x87Cc\xc9W\x05l|2\x87=\x82\xeb(\x8c\xaa\x02\xb6\x126\xacE\xb1a\x06\x7f\xba\x03\xc8\xd2fE\x86}\x9b\v\x86u\xc8tq\xb0\xda`\"\x95\x12g\xfcu\u0080]\x87%9\x8elGD\xfc\xc0#\xd8\x1e\x94\x066XY\x8c\xd8\xe5Õ\xbe,\xc6N\x17M\xe4\xf9\x94\xa3Ib\xc9\x01\x96%\xd8\x0f+gL\x01\xc44W\xf44\x1a;e\xb8U\xfa\x95\x18\x874Q\xcc8\x9c1>}%\x93%\x8a\x05M6~\x11pY\x91\x1d\xb1?s\x04\x0f\x81\x81\x97I\xc9ZQL\x9c3o\xf8\xb6\x96h\xe8\x88\x1a\xb4\xa4\x91\xcc 8\x97p*\xa2`X,M\xb5\xda+%\x90M\xf1\xae4|'Yg\x1ae\x17t\xdb\x1e \xad\xfc\xfa\xda!m\xbe\xd9mo\xe9O\x1a\xa7sq\xe4U\x04`\n\x1e*'\xe6 \v\x01hi\xd1f\xb7\x05\x13\xc9\xe7F\x90N\b\xb6\x17\xb8\x06\xab\xdd\\\xb1\xf3ǐ\xbe\xc4v#\x98\xc9.\x98(\x18\x0f\xa0_\x9e;}\x89\x1f\x94~\x85m\xd8\x14iz\x83S\xfa\xa1\xa2x@\xc4\xfb\xf4\x0f/\xdc6Y\xca\v\xc7\x0fbq\x93\x04\xfc\x11\xfa\xc4b'\xa8\xa3\x0e\x17\x94y|\xdax}\x974#T\xfe\x1e\xcd\x02\xcb\xf3\aq\xa6\xdbӈ \xa7\xddD\xcas\xca)\x8a/\xc2\b\xac\xc0u\xd7\xcbN\x01\xce5Vs\x99W#\x7fe\xa6\xc7J\x9f\x89\xda\x19\xb6{S0\xcb>\xab#u^\xf2\xc0\xeb\xf9\xde\xc3\x16\xedR\x88\\Tm\x963\x06[\x92\xc5)E\x90$\xab\x96\xc6W)\x7fP\x95t\xe0u\xac\x863\x9b\x1e8\x8a\xca\\\x1d\xec\v\xf6\xf0B,@X\xafDJv\x11\xa9\x88\x1e<\x83x \x9c\xf1\x1d\x1aMf\x14\b)\xa5 D<q\xe4\x06\u07bd\x03\xa5\xe1]\xe8\xdc\xdf݆\xf4㸰+.\a{d8\xbep!ҾWe%rn\xdf0(\xb7\x84\xe1\x7f\x9b,\x9f\xd8\xc1R\x1f\xe3u\xb7\n^\x18\xb7}\xe9\x9a\x03\xf0\xc4\xcb\xdc\xc2\x1e\x0fT.i\xb4NK\xcal\xa85\x15\x10ƳT.\x03\xed\x17\x942\x834\xb3\xa0\xd04#y-\xe8\xff)f\x0f\x03=\xa3\x8c뮓\xd0W\xb0\xfd\x1dɒ\x90\xe3\xd5IN\xa5yͩ-\x90\xfd̩l\t\xe0\x90\x914v\xbf\x1e\xae<\xde\x16T(\xa4\x1a\x8e\x00\xf0Ď\"4lN\x00Nm\xc7f\xb7\xcd\xf0\xec)\xaa\x18_\x99\xe8\\\xb4\xc6\xe3\xd3\xe6Mv Q2xM\xc3/\r/\x9b\xb1\xdff-\x82\x97\x85=\xa3/Q\xaf\x103\x0fԫ|\xc1:Y3\x8d\xb2\xc9\xf4\xf0\xbcN\xa7Ʈ\xcf\xce>>m\xdeT\xd4\xfb\x86\xfcme}\xb8i\x8bV.\x9d\xd6(m\xba\x7f\xa3\x0e\xf7;\n\xfb2\xdc\\\r\xef&\x96\x8a\xe19\x85\xef\x9cu5@\x1b\x96\nt\x7f?\x92n\xc7r\xe5\xf0\x89]\xa0\xf4\x9d<q\xc3\n\xf0\x88\x12\xa8ka\\\x10r{\x96\xa6\x98\xd2\xe4é\xe7\x12Q,\\\x85\xa6\x9e5\x8a\x97n\x04\xbe\xd2\xe1\xf4-\xe9{s\x81\xa7\aQ\n\xbf\x8c\x11\xe6':]\xbbQ#\xba\xca2}Sn\xcc\x06g_+|A\xe3D&A\xfc\xc4Z!l\x19\xba-\x93\xad\x15.\xf7\b\xcc\x00\x03\x1d\x98D\x98\xb8\xd42}\x7f\x01Ѣ1\xac^\xc2\xf1\xcfaU\xb8\xe9\x88$\xc0\xf6\x94GǢ\xbd71خ\x82Q\xa9\xaa%\t\x1eT巗W_\xf0]%I\xc7l\xb3 \xc9#\xb3M\x02\x98\x83\x13\xc2\xd3̲n,\xc2\xf7H\xd1\xf4\xa3\x92\xafor\x97ģ59\x00ķ\x1c$\x94\xae\xcd\x15\xf4\x0f\xf8\x92\x19\xfdX\x96\xd8ٌf+x\xd4\xd81\x9d\x9d\x9a=}\f'\xc3=B.\xd0\xd2\\\x96g\xff\xba\x90\x99\xfb\x8b\x87ƫ\xec\x1c\xe5[2u\xba|h\x94H\xc8\xee\x1f\x00\xa4k\xf7\xa8\xc9\xde\xfe\x89aܘ\xe5\xa2]V#w\r\xe8\xfb\x82\xc7s\"\x14\xa6\xa63\\\x8e\xa4z\xb5\xe2\xa6\x13\xec5\xc38)2D\x9bA\xdc&\x84OI~~\x1e.\xdf3\xf4\xcf1\xf9\xbe5\xf7\xa6\x92\xf3\xc1\xf0ud2\xdf?\xb3\xfc\x9c\x1d.\x00c\x8a\xe4\xed\xfd\x1b\v\xf1\xed}\x8a:^\xa1\xb4\xd4[h\x9f\b\xc7U\x9d\xbc\xd8Z\rn\xeb\xae+DG\x8ftK\x12\x8f\x16/T&\xf1y0W\x97\xec(\xc4\tX\xfc\x95\xf4f\xfa\x80sۿ\a1\x1b\xef\xc5ˆɚ\x02BRr\xf3\xc91\xc7xVj\x8c\n\x8b\xb1\xf8\xffϚ\"{\\f\x83^\xf2j\xc0;ކ\fGܾ\x7f\x87Xÿ\xffs\xf3\xdf\x00\x00\x00\xff\xff\xdct8M\xb4\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYMo\xe4\xb8\x11\xbd\xf7\xaf(L\x0es\x19\xc9;\x9b \bt\x9bi'\x80\x91\x9dIcm\xf8N\x89%5w(\x92!\xa9v\x9c \xff=(\x8al}\xb1\xdd\xf6lv%]\x9a\x1f\x8f\x8f\xafȪ\"\xbb(\x8a\x1d3\xe2\x11\xad\x13ZU\xc0\x8c\xc0\x7fyT\xf4˕\xdf\xfe\xe2J\xa1oN\x1fw߄\xe2\x15\xec\a\xe7u\xff3:=\xd8\x06o\xb1\x15Jx\xa1ծG\xcf8\xf3\xac\xda\x010\xa5\xb4gT\xec\xe8'@\xa3\x95\xb7ZJ\xb4E\x87\xaa\xfc6\xd4X\x0fBr\xb4\x01<\r}\xfa\xa1\xfc\xf8c\xf9\xc3\x0e@\xb1\x1e+ <\xae\x9f\x94Ԍ\xbb\xf2\x84\x12\xad.\x85\xde9\x83\r\x01wV\x0f\xa6\x82\xa9b\xec\x18\a\x1d\t\xdf2\xcfn#F(\x96\xc2\xf9\xbfo\xaa~\x12·j#\a\xcb\xe4j\xecP\xe3\x84\xea\x06\xc9\xec\xb2n\a\xe0\x1am\xb0\x82\xaf\xacGgX\x83|\a\x10\xe7\x14\xa8\x14\xc08\x0f*1y\xb0By\xb4{-\x87>\xa9S\x00G\xd7Xa\xa8ɒ\x168\xcf\xfc\xe0\xc0\r\xcd\x11\x98\x83\xaf\xf8ts\xa7\x0eVw\x16\xddH\v\xe0\x17\xa7Ձ\xf9c\x05\xe5ؼ4G\xe60֒\"\x15܇\x8aX䟉\xaf\xf3V\xa8.\xc7\xe0A\xf4\b|\xb0\xc1\x84\xe0\x84j\x10\xfcQ\xb8%\xb5'戞\xf5\xc8/\x12\t\xf5\x04\xe7<\xeb͚Ѭ\xebH\x893\x8f9B{\xdd\x1b\x89\x1e9\xd4\xcf\x1eӼ[m{\xe6+\x10\xca\xff\xf9O\x17)\x98(V\x19\xba\xdej\xb5\x14\xe63\x95¬xdBV\xea\xd0f\xd5ў\xc9_C\xc4\x13\xc0\xe7Y\xff\x91\xc9\x03\x15ü\xfc*\x15Zr\xa0[\xf0G\x84Ϭ\xf96\x18\xb8\xf7ڲ\x0e\xe1'\u074c\xe6{:\xa2%\xf3!\xd4c\vZ\xbd \xc8v\xdafMg\xb0)Ƕ\x11,a\xad\xec\xb7\x1c\xe8\xff\xbe\xb6\x1a\x8b,\xbb\xb6\x92\xab)C\v\xa1U~\x81}\xea\xf0U\x8bk.\xa2\xd2\x1cg\x8a-8\t\a\xc6\xea\x06\x9d˪\x166XI\x00\xb1rd\xf1u*\xd8H3\xb68\xfdȤ9\xb2\x8f\xa1\xc85G\xec\x83\x13\xa5_ڠ\xfat\xb8{\xfc\xe3\xfd\xa2\x18\x88\x88A\xebE\xf2u\xe3;s\xe3\xb3RXN\xf7=\x01\x8e\xad\x80\x93\xffF\x17\xe6\x1a=\x16\xf2\xc8a\x94D8\xb0h,:T~n\xe6\xf4\xea\x16\x98\x02]\xff\x82\x8d/\xe1\x1e-\xc1\x80;\xeaArr\xfb'\xb4\x1e,6\xbaS\xe2\xdfgl\a^\x87A%\xf3\x18\x1d\xef\xf4҂\xb7\x8aI819\xe0\a`\x8aCϞ\xc1\"\x8d\x02\x83\x9a\xe1\x85&\xae\x84/\xda\"\b\xd5\xea\n\x8e\xde\x1bW\xdd\xdct§\xf0\xd5\xe8\xbe\x1f\x94\xf0\xcf7!\x12\x89z\xf0ں\x1b\x8e'\x947Nt\x05\xb3\xcdQxl\xfc`\xf1\x86\x19Q\x04\xea\x8a&\xecʞ\xff\xc1ƀ\xe7\xde/\xb8n,:~!\xf2\xbc`\x01\n?\xb4\x03Y\xec:Nt\x12\x9a\x8aH\x9d\x9f\xffz\xff\x00i\xe8\xb0K\x16\xa0\x10u\x9f:\xba\xc9\x04$\x98P-\xda\xd0\x0fZ\xab\xfb\xa08*n\xb4P>\xfch\xa4@\xb5\x96\xdf\ru/<\xd9\xfd\x9f\x03:O\xb6*a\x1fb:\xd4\b\x83\xa1\x8d\xc4K\xb8S\xb0g=\xca=s\xf8\x9b\x1b\x80\x94v\x05\t\xfb:\x13\xccӑ\xe9!\x94*\xaa6\xabH\xf9\xc4\x05{ͽ\xc0\xbd\xc1\x86LG\xeaQ7ъ\xe8g[m\x81-\xbcX\xb9\x80\xccoYz\xb3\xbev\xddh\xc5\xe9s\xaeO\"\xa6f\x1e-:}r\xf6\xec\xec\x10\xe7\xafL\x9d7\x81¢\xd1Nxm\x9f\xa7p\xb1\x9c\xd3\v\x06\xa0\xafa\xaaAye&\xfb\xd0\b\x84\xe2\xa4$\x9e\xd7\x1d\xb9\x88\x11 ,U\xad:M\xfb\xe2\xb2\xc0\xe3{\xe7\xa1a\x8a\x16\xaaCO\xae\\e=\xb9P0\xe5Q0ϗ\xa6g\x9cY\xad\xb5D\xb6\xf6{\xb4\xb6\xbe\xe8\x13er\xaa\x15\xddv\x8e\xf3\x94\xef\x92\xe1\xafȷ\x12\xeav9$ل\xd6\x1c1)z\xa2R\xa4\x05I\x8e\xb7\x15]\f\xb2\x99A[\x81\x92\xbbK\xb6\xdc\xec\x8f4\xe10J\xf5J\x96i{\xc4\xf0B\xfd!\x00\x90aɏ\xb8\x90\xceQ\xe5\x061\xed\x89\x12\xee\xda\x19\xa2p\xf0\xee\x1dh\v\xefƔ\xff\xdd\a\xea\rt\x94\xf0\x85P\xb312\x88OB\xca4n\xb9{\x83\x19\xc8z\xe74C\x0f\xfe\x8a\x00\xffX5_\xe9\xe0)\xff!\x0e$\xc3\x13\x13\xfe\x1c\xee6\xb0\xb3\xa1\xdd\a\xa8\xb1\xa5\x18g\xd1\x0fV\xd1N@k\xc9\xe5\xb8\x00\xa9\a\xff\xa6I9Ō;j\x7fw{e:\xf7\xe7\x86ɻ\xdc\xdd&\xdf\xf2\x18\xac\x90\xdcE\x82\x04\xaf7\x90@\xca[\x1c}H\bFoc\x1b\x82\xef\xf9\x80u\x8d\xf2\xb2u⭭\xe8\x04\xa5\x15\xea\\3\xb9\xbc\x13\x1d\xc8r\vQ\xb80?\xe40\x98\x918\xdc\xf9\x90\x8e\xd4\b\\\xb4-ZT>\xd4ā\x0f\x8f\xfb\xf7n\x1a$\x87\xd9\xce8\x84\f\xabg\xc6 \xa73\x17Y6\n\xf5&\x89<\xb3\x1d\xfa\xc70\x8d+\xfa<̚&q(u\xa2\xd3\x14\x05\x82h\xdd\x11\x11\x0e\x8f{\xca\xc06\x90\x00\x87\xc7-\xc3\xcbQ.%\xbc\x17,\xb8a\xb9\xb1_\xe4s\xc6\xc8B\xbc\xa0\x10}\xe6\xf4\x8a\x91\x0f\x8f\xb9@z\x96\x03\xfc\x91y\x10\xe7\x03\n\xd4\xcfYLH\xfb#\x9a\xf3\xfb\xf8\xae\x12\x93\v\x84\xf7/2ޯ)g!\x81\xbc\xf1\xaf\xa5L\xc1[X\\\xa5\xbf\xf4\x15\x93\xf53u\xe6\x94-l^\x1f\xa2\xf2#\x17P\xe72\xa5U\x9b\xb5\x8b_UO\xcer]\xb1\xf44\xab\xda\xf9\x96ܽb\x0e\xe3=A\xb5\xbbh\xe7y\x123^\xe8$\xb37\x83\rn(^\x17ѩ\xec\xbbR\xd1f\xbch\x89J\x843u\xb5{q\xed\xed\xb7=\xc2y\xcf\xf2Y\xbcciA\x8d\a\xfbt\x9b\xb3u\x1f0\xc3\vq\x8d&8\xc2!\a<\xa1\x02J\xb5\x99\x90\xc8\x13\xa6+ၲ\xf1p\xf6|\xefv\v\xb8\xf0%\xa0\x10v)gʐ\xde\xf6K\xb7:t\xdc)\bb\xd3B\rR\xb2Zb\x05\xde\x0e\xb8{\xc3F\xe9\xd19\xd6\xe1\x15m\xbf\x8c\xad\x88:K]\x80ՔT\xacs\xda\xf7..\x9f\xf2-4\xe8\xb6\xe2\n\a\xba\xbf \x02\xea;nI\xde\xc4%\xe4\xe0W\xc8\x1c\xa8Mn͟\xa9\u0379l\x87G5\xf4\xdb!\n\xbaV͔~j\x1a49oY\xc0\xc1\xa2a\xd3\xed\xd9\xf4\x14\xb3cE\xa6r<\xecl'?\xd5e1\xe3z\xcd\xd6\xfd\x8d\x89\\\xa7\x97\x94\x8e\xfc\xae\x89\x1d\x9b\xc1Q˴\x99\xc3\x15\xa5\x1a\xfa\x1a-)\x1e.A\x93\xf4\xc9KnP\xe9\x0f\x01\xbe0ل\x10\xf7p\xbcإ\x9dLQj<\xc0\xa5,\x99\vg$\xcb\x05\xd94\x93E\xfa2m\x90\b>\xa5\xd1o\xcdW\xceWƹ\xca\xfc\xbd\xef\xf2\xd9\xde\xe0.\x9f\xe9*\xf8\xb7\x19\xe1b\xb4\x04X^\xcd_Y\v\xf7\x8b\xc6\xd7\x1c|\xfcW`\xab6,<\xf5\xd6//\x87\xf9=]rV\xa8Ma`\xceg\xd8\xf1Ze^2\xd4\xe7\xcb\xc2\n\xfe\xf3\xdf\xdd\xff\x06\x00\x9f\xc23\x7f`\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4ZKs\x1b\xb9\x11\xbe\xf3Wt9\a_\xc4\xd1z\x93J\xa5x\xb3\xa9\xa4\x8a\x95\xb5\xa32\x15\xdd\xc1\x99\x9e\x19\xac1\xc0\x04\x0f*J*\xff=\xd5xp^ )n\xbc\x1bR\x17\xe2\xf1\xa1_\xf8\xd0hh\xbd^\xafXϟQ\x1b\xae\xe4\x06X\xcf\xf1\x9f\x16%\xfd2ŷ?\x99\x82\xab\xfb\xe3\x87\xd57.\xab\rl\x9d\xb1\xaa\xfb\x8aF9]\xe2\x03\xd6\\r˕\\uhY\xc5,۬\x00\x98\x94\xca2j6\xf4\x13\xa0T\xd2j%\x04\xeau\x83\xb2\xf8\xe6\x0exp\\T\xa8=xZ\xfa\xf8C\xf1\xe1\xc7\xe2\x87\x15\x80d\x1dn\x80\xf0\\/\x14\xabLqD\x81Z\x15\\\xadL\x8f%\xc16Z\xb9~\x03CG\x98\x16\x97\f\xe2>0\xcb\xfe\xee\x11|\xa3\xe0\xc6\xfeu\xd6\xf1\x137\xd6w\xf6\xc2i&&\xab\xfav\xc3e\xe3\x04\xd3\xe3\x9e\x15\x80)U\x8f\x1b\xf8\xc2:4=+\xb1Z\x01DM\xbc\bk`U\xe5m\xc3ģ\xe6Ң\xde*\xe1\xbad\x935ThJ\xcd{\x1a2\x16\b\x8ce\xd6\x190\xael\x81\x19\xf8\x82/\xf7;\xf9\xa8U\xa3\xd1\x04\x91\x00~6J>2\xdbn\xa0\bË\xbee\x06c/\xd9a\x03{\xdf\x11\x9b\xec+Ik\xac\xe6\xb2ɭ\xff\xc4;\x84\xcai\xef60\\\x96\b\xb6\xe5f,\xd8\v3$\x9c\xb6X\x9d\x15\xc3\xf7\x13\x98\xb1\xac\xeb\xe7\xf2\x8c\xa6\x06\x81*f1'\xceVu\xbd@\x8b\x15\x1c^-&\xadk\xa5;f7\xc0\xa5\xfd\xe3\x1fΊ\xd0GS\x15~ꃒS\xb3|\xa2V\x185\aI\xc8C\r\xea\xacm\x94e\xe2\x7f\x11\xc4\x12\xc0\xa7\xd1\xfc \xc9\x135ø\xfd\xaa(\x14n\xa0j\xb0-\xc2'V~s=\xec\xadҬA\xf8I\x95\xc1y/-\xea\xe8\xbcC\x18bZ\xe5D\x05\x87\xa41\x80\xb1Jg\xbd\xd8cY\x84Y\x117\xc1\xce\\9]\xf3;\aY\xa9\x91e\x83,\xb1L\xe1Gp%\xf3\x91\xf6\xb1\xc17E\xd9ؚRUx2\x1d\x8e%\xe2\x06z\xadJ4&k1\xbf\xcb\n\x9a\x1e;\x83\f_\x86\x86\x85Y\u0088\xe3\x8fL\xf4-\xfb\xe0\x9bL\xd9b\xe7ٓ~\xa9\x1e\xe5\xc7\xc7\xdd\xf3\xef\xf7\x93f AzԖ'\x9a\v\xdf\x11\x7f\x8fZa\xaa\xec{\x02\f\xa3\xa0\"\xe2F\xe35\x8d\xa4\x85U\x94!\x18\x84\x1b\xd0\xd8k4(\xed\xd8\xc5\xe9\xabj`\x12\xd4\xe1g,m\x01{\xd4\x04\x93\x02\xadT\xf2\x88ڂ\xc6R5\x92\xff\xeb\x84m\xc0*\xbf\xa8`\x16#\xef\x0e_\x8a{-\x99\x80#\x13\x0e\xef\x80\xc9\n:\xf6\n\x1ai\x15pr\x84燘\x02>+\x8d\xc0e\xad6\xd0Zۛ\xcd\xfd}\xc3m:\xb7J\xd5uNr\xfbz\xef\x8f ~pVis_\xe1\x11Ž\xe1͚\xe9\xb2\xe5\x16K\xeb4\u07b3\x9e\xaf\xbd\xe8\x92\x146EW\xfdNǓμ\x9fȺ\xf0h\xf8\xf3\x87\xce\x05\x0f\xd0\xd9\x03\xdc\x00\x8bS\x83\xa2\x83\xa1\xa9\x89\xac\xf3\xf5\xcf\xfb'HK\xfb\x1d2\x01\x85h\xf7a\xa2\x19\\@\x06\xe3\xb2F\xed\xe7A\xadU\xe7-\x8e\xb2\xea\x15\x97\xd6\xff(\x05G97\xbfq\x87\x8e[\xf2\xfb?\x1c\x1aK\xbe*`\xeb\x0fs8 \xb8\x9e\xb6QU\xc0N\u0096u(\xb6\xcc\xe0\xaf\xee\x00\xb2\xb4Y\x93a\xdf\xe6\x82q\x1e2|\be\x13\xad6\xeaH\xa9\xc4\x19\x7f\r\x1c\xb0\xef\xb1$Ǒ\xedh\x12\xafy$\xdbZi`#\xb6(&p\xf9\xedJ\xdf,\xc7\xce\a\xcd\xe4\xf9\x94\x9b\x93Ē#.K\xb4\x1f\x18|\x01\n \xd2\xe4\x81\xf0\xe2\x1c\x8d\xbd2\xdc*\xfdJ\xc0ᘘ\xeat\xc1\xf8\xf4W2Y\xa2\xb8\xa2\xc9\xd6\x0f\x02.+\xb2#\x9eb\x8e\xe8!\x00\xf80U\xb2Q\xb4'Ι7|w\x16J&)D\rZ\xa2p\x99ap.aH\xa2`\x9c,\r\x9f\xa0\xd5A)\x81l\xcew\xa5\xe1{\xc9z\xd3*{E\xb7]\ri\xe4\xd3k\x8fd\xc6\xed~w\a\xdb\xfd.\xb5\x13\x8d\x1fy\x15\t\x98\xd8Kw9\x92\x8dDK\xdal\xf7;0q\xfa\xd2\b\xd2\t\xc1\x0e\x027`\xb5[*v>\f\xe9\x9b`\xb7\x82\x99쀙\x821\x00\xfd\xf0\\\xf4%<(\xfd\b۲9Ӥ\x0f\x8d>RR<\x9a\xc4O\xc7?\xbcp\xdbfg^\b\xbf\x94\xdc$\x01\xbf\x87>1\xc1\n\xea\xa8:\x8b\x18\x94y|\xdez}\xafiF\xac\xfcK4\v\xc6Ja\xf4\x06ݞ'\x13r\xdaͤ\xccB\x02\xed\xcbC\xe0\b\xac\xc0\xf5\xab̐˲\xd3\x06\xe7\x1ag\xc7#\xfd\xad'\xfe\xcatO\x95^\f8\xc3\xed\xf4G\a\xc1gu\xa4\x9b\x97\xacy\xb3\\{|E\xbb\xb4E.\xaa61\xf8\xc3tI\xb28\x1d\x11$ɺ#Q\xd6\xe9\xfc\xa0[q͛\x98\rg\x16\xad9\x8a\xcaܼٯ\xd8\xc3\v\xb1y\xa3\x12鰋LEZ\x80\a\x88\x01ጿ\xa1Q\xe7\x021\x9dq\x05\xec\xea\x11\"7\xf0\xee\x1d(\r\xef\xc2\xcd\xfd\xdd\x1d\xcd\x06\xaa\a\xd85\x97\xa352\x88/\\\x88\xb4n\xb1\xba\xc1K\xe4\xdcӅA9{\xc5\x00\x7f\x9b\r\x9f\xd9\xc1\xd2=\x86d 3\xbc0nO\xa9\xeb\x02v\xb4\xb4\xb9\x83\x03֔\xafj\xb4NK:\xd9PkJ \x8c\x87T\xceޤTڳO\xe4\xf1\xcb\n\xcdO$29!\x9f8.\xf6O6\xfa\x02\x12\xc0\xf5\xb7I\xe8\x93\xe7S\x8d䚐\xd3\xd1IN\xa5y\xc3\xe9Z O=C\xda\x12\xc8a\x81\v\x10o\xbf\x9e\xae|\x16\\\xc0\xce&HC\xf4>\xc0\xd1\x0e\r\x8b\x13\x81ӵc\xbb\xdfe0O3\xaa\xb8\xbf\xcc/\xb0\xc6\xe3\xf3\xf6Mv Q2|M\xcd/-/۩\xdf\x16W\x04\xfa\xb3\xec\x1bJ*V\xdc$f0\xe9V(\x89\xb7\xa7:\xcf\xc3\xe4\xbb\xf1\x8fSʳ@\x84y\x124;\x99J\x12\xe4f\x16\xbc\x9c\xf2\xf4t\xe75\x16\xa5\r\"\xe6\xc6\xcct}\x9cM\x99\xfb\x86Ip\U000a072c\xe0\xf1\xf9R\xd2\xd3*Q\x8d\xe8tv\xb2\xde\x01\x16M\x01\x8c@\xc6\xd9\x020\x90\xcc\xf2c.\xd4\xc7\\@l̢\xe1\x93o,j\xe8\x85k\xb8\xf4\\Lte\x00\xbb\u07be\x06\xee\rqy\x068\x86\xa1\xf7B\x05\xb6\xd5\xca5\xad\x97o\x1b\xb8:\xcc.\xe0\xc9\a'AW\x18JwJ\x9eE\x9d%\xe8e,\xf7e\xf6\xd3\xff;\u07fb\x92\xbe\xc6;\xfa\xd4J\x94\xb8\x9e1\xf5\x18\xfb\f\xac\xaa3\xc8\xf9\x13\xef\xaaq\xce&\x03\xf9|l\x1d\xcf\xec\xd9\x1ds6f~\x98κS(>\xbd\xf6S\xf7\xaf\xc1L\x19>\xdb\xfb\xf8\xbc]\xbdA\x85Pcݬκy\b\xafP\bO\xae.\x9d\xd6(m*\xb3S!k\x14\x8a\xc5\xeam,\x12#6\x1a\xc1\x97 7\xab\x8ba\xb7]\xce\xf0\x052\x1dـ2\x00`\xd1\x01\xa1\f\x9a\x8a\xe09\xc7\x0fp>w \xed\x02\x1aV\x80G\x94@\xc5\t\xc6\x05%h\x1e\xd2\x14\xf39\x19\xd41JLV\x9c\xb7K*ME\xf1R\xe1\x8f6\xbd\xf1ſ\xf7\xe6\x02&E/\x9dC9#,\xb7A\xaa\xaeS\xbdi\x9d\x05}S\n\x9c\xdd\x12\xa7+\xc1W4N\xd8\xdf\xf4J\x10\x96\xf4t\x85&{%\xb8\\\n`T9\xd4\x01$\x92Ĺ\xc0}\xb3\x912\xd4@E4cXs\xed\xf4\xff\x1cF\x91\x7fY\x9a\x02\xec@\xe9\xf2T\xb4\xf7&n\xb6bu\x83\x15\xa9\xa2~E\x02\xaa\xb1\xd3\xf2\xf2\xe6:\xfeM\x92\xf4T\xe9\xbf,\t\xbdO$\x82\xa9\x9d\x10~N\x12\xe9t6ǌ怴\x9b\xbeW\x8e\xedkY\xd7ģ19\x02<\x99m\xb0\xd3rq\x94\xae[.\xb0\xa6\x97\xc9L\xebǲ\xc4~x\xbd\x19>kx\xd4س\xe1\xddi\xf8\xacGŹLg(\x17.U\x1f\xfa\xb2\x98\x91l\xb2}\x7fa<7钝\xa3|\xd7L\x1d\x87\x8d\xf2<\xff\xfc\a\xd2u\a\xd4do\xff\x92\x98\f\x7f\xf6\x02Cא\xb1\xbbF\xf3O\xf7\x1a\x8fD,L\xb9G\xa8\x81\xa6ki\xc5M/\xd8k\x068)2ɹ\x87}\x9b\x18>\x1d\xf2ō\xb9\xf5\xe9\xd55י\x7f:\x9d~\x96\x8f\xa0\xd3\xcf\xf0\x9a\xfa\xeb\xacp\x81\x18\xd3N\xde=\\\x89\x82\x94z\xef\x1eҮ\xe3\x15\xbdj\xd4\x1c5\x99`vy\x93\x17+(\xa3\xa2|qK\xc4N\xdf\xe2\xafI<\x19|%3\x89\xff\x05\xb0\x94\x06`O[\x9c\x88\xc5\xdfZ\xb6\xf3wڻӳ/\xb3\xf1\xf9\xabl\x99l\xd0P¢1\x1c\x8e9\xe0E\xaa1I,\xa6\xe2\xff\x969E6\\\x16\x8d^\xf2j\x84\x1do\x19\xe3\x16w8=7n\xe0\xdf\xffY\xfdw\x00<\xbd\xfe̛#\x00\x00"),
 }
 
 var CRDs = crds()
@@ -17,14 +17,17 @@ limitations under the License.
 package credentials
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -35,6 +38,11 @@ type FileStore interface {
 	// Path returns a path on disk where the secret key defined by
 	// the given selector is serialized.
 	Path(selector *corev1api.SecretKeySelector) (string, error)
+
+	// WebIdentityPath returns a path on disk where an AWS shared config
+	// file is serialized, whose default profile assumes the role of the
+	// given web identity with its token.
+	WebIdentityPath(webIdentity *velerov1api.WebIdentity) (string, error)
 }
 
 type namespacedFileStore struct {
@@ -86,3 +94,43 @@ func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (strin
 
 	return keyFilePath, nil
 }
+
+// WebIdentityPath returns a path on disk where an AWS shared config
+// file is serialized, whose default profile assumes the role of the
+// given web identity with its token. The AWS SDK exchanges the token
+// for short-lived credentials and refreshes them before they expire.
+func (n *namespacedFileStore) WebIdentityPath(webIdentity *velerov1api.WebIdentity) (string, error) {
+	if webIdentity == nil || webIdentity.RoleARN == "" {
+		return "", errors.New("web identity role ARN is required")
+	}
+	if strings.ContainsAny(webIdentity.RoleARN+webIdentity.TokenFile, "\r\n") {
+		return "", errors.New("web identity role ARN and token file can't contain line breaks")
+	}
+
+	config := WebIdentitySharedConfig(webIdentity)
+
+	// the name is derived from the content so that the locations assuming
+	// the same role share the file and concurrent writes are identical
+	sum := sha256.Sum256([]byte(config))
+	keyFilePath := filepath.Join(n.fsRoot, fmt.Sprintf("web-identity-%x", sum[:8]))
+
+	// the file isn't rewritten if it's already there, since the plugins may be reading it
+	if existing, err := n.fs.ReadFile(keyFilePath); err == nil && string(existing) == config {
+		return keyFilePath, nil
+	}
+
+	file, err := n.fs.OpenFile(keyFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to open web identity config file for writing")
+	}
+
+	if _, err := file.Write([]byte(config)); err != nil {
+		return "", errors.Wrap(err, "unable to write web identity config to store")
+	}
+
+	if err := file.Close(); err != nil {
+		return "", errors.Wrap(err, "unable to close web identity config file")
+	}
+
+	return keyFilePath, nil
+}
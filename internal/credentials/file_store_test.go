@@ -18,11 +18,17 @@ package credentials
 
 import (
 	"context"
+	"io"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -91,3 +97,85 @@ func TestNamespacedFileStore(t *testing.T) {
 		})
 	}
 }
+
+// openCountingFileSystem counts the files opened for writing.
+type openCountingFileSystem struct {
+	*velerotest.FakeFileSystem
+	openFileCalls int
+}
+
+func (fs *openCountingFileSystem) OpenFile(name string, flag int, perm os.FileMode) (io.WriteCloser, error) {
+	fs.openFileCalls++
+	return fs.FakeFileSystem.OpenFile(name, flag, perm)
+}
+
+func TestNamespacedFileStoreWebIdentityPath(t *testing.T) {
+	testCases := []struct {
+		name             string
+		webIdentity      *velerov1api.WebIdentity
+		wantErr          string
+		expectedContents string
+	}{
+		{
+			name:        "returns an error if the role ARN is empty",
+			webIdentity: &velerov1api.WebIdentity{},
+			wantErr:     "web identity role ARN is required",
+		},
+		{
+			name:        "returns an error if the role ARN has a line break",
+			webIdentity: &velerov1api.WebIdentity{RoleARN: "role\n[other]"},
+			wantErr:     "web identity role ARN and token file can't contain line breaks",
+		},
+		{
+			name:        "writes a config file with the default token file and session duration",
+			webIdentity: &velerov1api.WebIdentity{RoleARN: "arn:aws:iam::123456789012:role/velero"},
+			expectedContents: `[default]
+role_arn = arn:aws:iam::123456789012:role/velero
+web_identity_token_file = /var/run/secrets/velero/serviceaccount/token
+role_session_name = velero
+duration_seconds = 3600
+`,
+		},
+		{
+			name: "writes a config file with the token file and session duration of the web identity",
+			webIdentity: &velerov1api.WebIdentity{
+				RoleARN:         "arn:aws:iam::123456789012:role/velero",
+				TokenFile:       "/var/run/token",
+				SessionDuration: &metav1.Duration{Duration: 2 * time.Hour},
+			},
+			expectedContents: `[default]
+role_arn = arn:aws:iam::123456789012:role/velero
+web_identity_token_file = /var/run/token
+role_session_name = velero
+duration_seconds = 7200
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := &openCountingFileSystem{FakeFileSystem: velerotest.NewFakeFileSystem()}
+			fileStore, err := NewNamespacedFileStore(velerotest.NewFakeControllerRuntimeClient(t), "ns1", "/tmp/credentials", fs)
+			require.NoError(t, err)
+
+			path, err := fileStore.WebIdentityPath(tc.webIdentity)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(path, "/tmp/credentials/ns1/web-identity-"))
+
+			contents, err := fs.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedContents, string(contents))
+
+			// the file isn't rewritten when it's requested again
+			require.Equal(t, 1, fs.openFileCalls)
+			again, err := fileStore.WebIdentityPath(tc.webIdentity)
+			require.NoError(t, err)
+			require.Equal(t, path, again)
+			require.Equal(t, 1, fs.openFileCalls)
+		})
+	}
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 	v1 "k8s.io/api/core/v1"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // FileStore is an autogenerated mock type for the FileStore type
@@ -33,6 +35,27 @@ func (_m *FileStore) Path(selector *v1.SecretKeySelector) (string, error) {
 	return r0, r1
 }
 
+// WebIdentityPath provides a mock function with given fields: webIdentity
+func (_m *FileStore) WebIdentityPath(webIdentity *velerov1.WebIdentity) (string, error) {
+	ret := _m.Called(webIdentity)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*velerov1.WebIdentity) string); ok {
+		r0 = rf(webIdentity)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*velerov1.WebIdentity) error); ok {
+		r1 = rf(webIdentity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewFileStore interface {
 	mock.TestingT
 	Cleanup(func())
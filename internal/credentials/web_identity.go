@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// WebIdentitySessionName is the name of the sessions of the roles
+	// assumed with a web identity token.
+	WebIdentitySessionName = "velero"
+
+	defaultWebIdentitySessionDuration = time.Hour
+)
+
+// WebIdentityTokenFile returns the path of the token of the web identity.
+func WebIdentityTokenFile(webIdentity *velerov1api.WebIdentity) string {
+	if webIdentity.TokenFile != "" {
+		return webIdentity.TokenFile
+	}
+	return velerov1api.DefaultWebIdentityTokenFile
+}
+
+// WebIdentitySessionDuration returns how long the short-lived credentials
+// obtained with the web identity are valid.
+func WebIdentitySessionDuration(webIdentity *velerov1api.WebIdentity) time.Duration {
+	if webIdentity.SessionDuration != nil && webIdentity.SessionDuration.Duration > 0 {
+		return webIdentity.SessionDuration.Duration
+	}
+	return defaultWebIdentitySessionDuration
+}
+
+// WebIdentitySharedConfig returns the content of an AWS shared config
+// file whose default profile assumes the role of the web identity.
+func WebIdentitySharedConfig(webIdentity *velerov1api.WebIdentity) string {
+	lines := []string{
+		"[default]",
+		fmt.Sprintf("role_arn = %s", webIdentity.RoleARN),
+		fmt.Sprintf("web_identity_token_file = %s", WebIdentityTokenFile(webIdentity)),
+		fmt.Sprintf("role_session_name = %s", WebIdentitySessionName),
+		fmt.Sprintf("duration_seconds = %d", int64(WebIdentitySessionDuration(webIdentity).Seconds())),
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// ValidateLocationCredential returns an error if the backup storage location
+// defines both a static credential and a web identity.
+func ValidateLocationCredential(location *velerov1api.BackupStorageLocation) error {
+	if location.Spec.Credential != nil && location.Spec.WebIdentity != nil {
+		return errors.Errorf("backup storage location %s can't define both a credential and a web identity", location.Name)
+	}
+	return nil
+}
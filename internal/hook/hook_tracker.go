@@ -0,0 +1,169 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// maxHookOutputLength is the maximum number of bytes of a hook's stdout
+// and stderr that are kept in its execution record.
+const maxHookOutputLength = 4096
+
+// HookTracker records the exec hook executions of a backup or restore.
+// It is safe for concurrent use. A nil HookTracker discards all records.
+type HookTracker struct {
+	lock       sync.Mutex
+	executions []results.HookExecution
+}
+
+// NewHookTracker creates a new HookTracker.
+func NewHookTracker() *HookTracker {
+	return &HookTracker{}
+}
+
+// Record adds a hook execution to the tracker.
+func (t *HookTracker) Record(execution results.HookExecution) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.executions = append(t.executions, execution)
+}
+
+// Stat returns the number of hooks attempted and the number of hooks that failed.
+func (t *HookTracker) Stat() (attempted int, failed int) {
+	if t == nil {
+		return 0, 0
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for i := range t.executions {
+		if t.executions[i].Failed() {
+			failed++
+		}
+	}
+	return len(t.executions), failed
+}
+
+// Executions returns a copy of the recorded hook executions, in the order they were recorded.
+func (t *HookTracker) Executions() []results.HookExecution {
+	if t == nil {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	executions := make([]results.HookExecution, len(t.executions))
+	copy(executions, t.executions)
+	return executions
+}
+
+// HookStatus returns the counters of the tracker as they are reported in
+// backup and restore status, or nil if no hook was attempted.
+func (t *HookTracker) HookStatus() *velerov1api.HookStatus {
+	attempted, failed := t.Stat()
+	if attempted == 0 {
+		return nil
+	}
+	return &velerov1api.HookStatus{
+		HooksAttempted: attempted,
+		HooksFailed:    failed,
+	}
+}
+
+// AddToHookStatus returns the hook status with the counters of the tracker added to it. It is
+// used for hooks that run after the status of the backup or restore was first set.
+func (t *HookTracker) AddToHookStatus(status *velerov1api.HookStatus) *velerov1api.HookStatus {
+	attempted, failed := t.Stat()
+	if attempted == 0 {
+		return status
+	}
+	if status == nil {
+		status = &velerov1api.HookStatus{}
+	}
+	status.HooksAttempted += attempted
+	status.HooksFailed += failed
+	return status
+}
+
+// executeAndRecord runs an exec hook with the executor and records the execution in the tracker.
+// The container, output and exit code of the hook are only recorded if the executor is a
+// podexec.ResultPodCommandExecutor.
+func executeAndRecord(
+	tracker *HookTracker,
+	executor podexec.PodCommandExecutor,
+	log logrus.FieldLogger,
+	item map[string]interface{},
+	namespace, name, hookName, hookSource string,
+	phase hookPhase,
+	hook *velerov1api.ExecHook,
+) error {
+	start := time.Now()
+	var result *podexec.ExecResult
+	var err error
+	if resultExecutor, ok := executor.(podexec.ResultPodCommandExecutor); ok {
+		result, err = resultExecutor.ExecutePodCommandWithResult(log, item, namespace, name, hookName, hook)
+	} else {
+		err = executor.ExecutePodCommand(log, item, namespace, name, hookName, hook)
+	}
+
+	execution := results.HookExecution{
+		HookName:       hookName,
+		HookSource:     hookSource,
+		Phase:          string(phase),
+		Namespace:      namespace,
+		Pod:            name,
+		Container:      hook.Container,
+		Command:        hook.Command,
+		ExitCode:       -1,
+		StartTimestamp: metav1.NewTime(start),
+		Duration:       metav1.Duration{Duration: time.Since(start).Round(time.Millisecond)},
+	}
+	if result != nil {
+		execution.Container = result.Container
+		execution.ExitCode = result.ExitCode
+		execution.Stdout = truncateHookOutput(result.Stdout)
+		execution.Stderr = truncateHookOutput(result.Stderr)
+	}
+	if err != nil {
+		execution.Error = err.Error()
+	}
+	tracker.Record(execution)
+
+	return err
+}
+
+// truncateHookOutput keeps the end of the output, which usually holds the
+// reason a command failed.
+func truncateHookOutput(output string) string {
+	if len(output) <= maxHookOutputLength {
+		return output
+	}
+	return "..." + output[len(output)-maxHookOutputLength:]
+}
@@ -0,0 +1,145 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestHookTracker(t *testing.T) {
+	var nilTracker *HookTracker
+	nilTracker.Record(results.HookExecution{HookName: "h1"})
+	attempted, failed := nilTracker.Stat()
+	assert.Equal(t, 0, attempted)
+	assert.Equal(t, 0, failed)
+	assert.Nil(t, nilTracker.HookStatus())
+
+	tracker := NewHookTracker()
+	assert.Nil(t, tracker.HookStatus())
+
+	tracker.Record(results.HookExecution{HookName: "h1"})
+	tracker.Record(results.HookExecution{HookName: "h2", Error: "command terminated with exit code 1"})
+	tracker.Record(results.HookExecution{HookName: "h3"})
+
+	assert.Equal(t, &velerov1api.HookStatus{HooksAttempted: 3, HooksFailed: 1}, tracker.HookStatus())
+
+	executions := tracker.Executions()
+	require.Len(t, executions, 3)
+	assert.Equal(t, "h2", executions[1].HookName)
+
+	// the returned list is a copy
+	executions[0].HookName = "changed"
+	assert.Equal(t, "h1", tracker.Executions()[0].HookName)
+}
+
+type resultPodCommandExecutor struct {
+	velerotest.MockPodCommandExecutor
+}
+
+func (e *resultPodCommandExecutor) ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *velerov1api.ExecHook) (*podexec.ExecResult, error) {
+	args := e.Called(log, item, namespace, name, hookName, hook)
+	var result *podexec.ExecResult
+	if r, ok := args.Get(0).(*podexec.ExecResult); ok {
+		result = r
+	}
+	return result, args.Error(1)
+}
+
+func TestAddToHookStatus(t *testing.T) {
+	tracker := NewHookTracker()
+	assert.Nil(t, tracker.AddToHookStatus(nil))
+
+	tracker.Record(results.HookExecution{HookName: "h1"})
+	tracker.Record(results.HookExecution{HookName: "h2", Error: "command terminated with exit code 1"})
+	assert.Equal(t, &velerov1api.HookStatus{HooksAttempted: 2, HooksFailed: 1}, tracker.AddToHookStatus(nil))
+	assert.Equal(t, &velerov1api.HookStatus{HooksAttempted: 5, HooksFailed: 1}, tracker.AddToHookStatus(&velerov1api.HookStatus{HooksAttempted: 3}))
+}
+
+func TestHandleHooksRecordsExecutions(t *testing.T) {
+	pod := velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns","name":"name"}}`)
+	hooks := []ResourceHook{
+		{
+			Name: "freeze",
+			Pre: []velerov1api.BackupResourceHook{
+				{Exec: &velerov1api.ExecHook{Command: []string{"fsfreeze", "--freeze", "/data"}, OnError: velerov1api.HookErrorModeContinue}},
+				{Exec: &velerov1api.ExecHook{Command: []string{"sync"}, OnError: velerov1api.HookErrorModeContinue}},
+			},
+		},
+	}
+
+	executor := new(resultPodCommandExecutor)
+	defer executor.AssertExpectations(t)
+	executor.On("ExecutePodCommandWithResult", mock.Anything, mock.Anything, "ns", "name", "freeze", hooks[0].Pre[0].Exec).
+		Return(&podexec.ExecResult{Container: "app", Stderr: strings.Repeat("x", maxHookOutputLength+10), ExitCode: 1}, errors.New("command terminated with exit code 1"))
+	executor.On("ExecutePodCommandWithResult", mock.Anything, mock.Anything, "ns", "name", "freeze", hooks[0].Pre[1].Exec).
+		Return(&podexec.ExecResult{Container: "app", Stdout: "ok"}, nil)
+
+	tracker := NewHookTracker()
+	h := &DefaultItemHookHandler{PodCommandExecutor: executor, HookTracker: tracker}
+	require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, pod, hooks, PhasePre))
+
+	assert.Equal(t, &velerov1api.HookStatus{HooksAttempted: 2, HooksFailed: 1}, tracker.HookStatus())
+
+	executions := tracker.Executions()
+	require.Len(t, executions, 2)
+
+	assert.Equal(t, "freeze", executions[0].HookName)
+	assert.Equal(t, "backupSpec", executions[0].HookSource)
+	assert.Equal(t, "pre", executions[0].Phase)
+	assert.Equal(t, "ns", executions[0].Namespace)
+	assert.Equal(t, "name", executions[0].Pod)
+	assert.Equal(t, "app", executions[0].Container)
+	assert.Equal(t, []string{"fsfreeze", "--freeze", "/data"}, executions[0].Command)
+	assert.Equal(t, 1, executions[0].ExitCode)
+	assert.Equal(t, "command terminated with exit code 1", executions[0].Error)
+	assert.Equal(t, "..."+strings.Repeat("x", maxHookOutputLength), executions[0].Stderr)
+
+	assert.False(t, executions[1].Failed())
+	assert.Equal(t, 0, executions[1].ExitCode)
+	assert.Equal(t, "ok", executions[1].Stdout)
+}
+
+func TestHandleHooksRecordsInvalidHook(t *testing.T) {
+	obj := velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns","name":"name","annotations":{"hook.backup.velero.io/command":"/bin/ls","hook.backup.velero.io/on-error":"Fail"}}}`)
+
+	executor := new(velerotest.MockPodCommandExecutor)
+	executor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns", "name", "<from-annotation>", mock.Anything).
+		Return(errors.New("no such container: \"foo\""))
+
+	tracker := NewHookTracker()
+	h := &DefaultItemHookHandler{PodCommandExecutor: executor, HookTracker: tracker}
+	assert.Error(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, obj, nil, PhasePre))
+
+	executions := tracker.Executions()
+	require.Len(t, executions, 1)
+	assert.Equal(t, "annotation", executions[0].HookSource)
+	assert.Equal(t, -1, executions[0].ExitCode)
+	assert.True(t, executions[0].Failed())
+}
@@ -189,6 +189,8 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+	// HookTracker records the hook executions. Optional.
+	HookTracker *HookTracker
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
@@ -225,7 +227,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 				"hookPhase":  phase,
 			},
 		)
-		if err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); err != nil {
+		if err := executeAndRecord(h.HookTracker, h.PodCommandExecutor, hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", "annotation", phase, hookFromAnnotations); err != nil {
 			hookLog.WithError(err).Error("Error executing hook")
 			if hookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
 				return err
@@ -258,7 +260,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 							"hookPhase":  phase,
 						},
 					)
-					err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, hook.Exec)
+					err := executeAndRecord(h.HookTracker, h.PodCommandExecutor, hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, "backupSpec", phase, hook.Exec)
 					if err != nil {
 						hookLog.WithError(err).Error("Error executing hook")
 						if hook.Exec.OnError == velerov1api.HookErrorModeFail {
@@ -0,0 +1,384 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+const (
+	// DefaultScopeHookTimeout is how long Velero waits for a scope hook to complete
+	// when the hook does not specify a timeout.
+	DefaultScopeHookTimeout = 10 * time.Minute
+
+	defaultScopeHookPollInterval = 2 * time.Second
+
+	// defaultScopeHookJobTTL is how long a finished hook Job is kept before Kubernetes
+	// deletes it, when the Job template does not specify it.
+	defaultScopeHookJobTTL = int32(time.Hour / time.Second)
+)
+
+// ScopeHookHandler runs hooks that apply to a whole backup or restore rather than
+// to individual items.
+type ScopeHookHandler interface {
+	// HandleHooks runs the hooks in order and returns a status for each hook that ran.
+	// Errors from hooks whose OnError mode is Continue are returned as warnings. The
+	// first error from a hook whose OnError mode is Fail stops execution and is returned
+	// as err. The executions of Exec hooks are recorded in the tracker, which may be nil.
+	HandleHooks(
+		ctx context.Context,
+		log logrus.FieldLogger,
+		hooks []velerov1api.ScopeHook,
+		phase hookPhase,
+		labels map[string]string,
+		tracker *HookTracker,
+	) (statuses []velerov1api.ScopeHookStatus, warnings []error, err error)
+}
+
+// DefaultScopeHookHandler runs Job hooks with a Kubernetes client, Exec hooks with a
+// pod command executor and HTTP hooks with an HTTP client.
+type DefaultScopeHookHandler struct {
+	Client             kbclient.Client
+	PodCommandExecutor podexec.PodCommandExecutor
+	// HTTPClient sends the requests of the HTTP hooks. If it's nil, a client timing out
+	// after the timeout of the hook is used.
+	HTTPClient   *http.Client
+	PollInterval time.Duration
+}
+
+var _ ScopeHookHandler = &DefaultScopeHookHandler{}
+
+func (h *DefaultScopeHookHandler) HandleHooks(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	hooks []velerov1api.ScopeHook,
+	phase hookPhase,
+	labels map[string]string,
+	tracker *HookTracker,
+) ([]velerov1api.ScopeHookStatus, []error, error) {
+	var statuses []velerov1api.ScopeHookStatus
+	var warnings []error
+	for i := range hooks {
+		hook := hooks[i]
+		hookLog := log.WithFields(logrus.Fields{
+			"hookName":  hook.Name,
+			"hookPhase": phase,
+		})
+		hookLog.Info("Running scope hook")
+
+		status := velerov1api.ScopeHookStatus{
+			Name:           hook.Name,
+			Phase:          string(phase),
+			StartTimestamp: &metav1.Time{Time: time.Now()},
+		}
+		err := h.handleHook(ctx, hookLog, hook, phase, labels, tracker)
+		status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if err == nil {
+			status.Result = velerov1api.ScopeHookResultSucceeded
+			statuses = append(statuses, status)
+			hookLog.Info("Scope hook completed")
+			continue
+		}
+
+		err = errors.Wrapf(err, "%s hook %s failed", phase, hook.Name)
+		status.Result = velerov1api.ScopeHookResultFailed
+		status.Error = err.Error()
+		statuses = append(statuses, status)
+		if hook.OnError == velerov1api.HookErrorModeFail {
+			hookLog.WithError(err).Error("Error running scope hook")
+			return statuses, warnings, err
+		}
+		hookLog.WithError(err).Warn("Error running scope hook")
+		warnings = append(warnings, err)
+	}
+	return statuses, warnings, nil
+}
+
+func (h *DefaultScopeHookHandler) handleHook(ctx context.Context, log logrus.FieldLogger, hook velerov1api.ScopeHook, phase hookPhase, labels map[string]string, tracker *HookTracker) error {
+	timeout := hook.Timeout.Duration
+	if timeout == 0 {
+		timeout = DefaultScopeHookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch {
+	case hook.Job != nil:
+		return h.runJobHook(ctx, log, hook, labels)
+	case hook.Exec != nil:
+		return h.runExecHook(ctx, log, hook, phase, timeout, tracker)
+	case hook.HTTP != nil:
+		return h.runHTTPHook(ctx, hook.HTTP, timeout)
+	default:
+		return errors.New("hook has no job, exec or http action")
+	}
+}
+
+func (h *DefaultScopeHookHandler) runJobHook(ctx context.Context, log logrus.FieldLogger, hook velerov1api.ScopeHook, labels map[string]string) error {
+	template, err := decodeJobTemplate(hook.Job.JobTemplate.Raw)
+	if err != nil {
+		return err
+	}
+
+	job := &batchv1api.Job{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	job.Name = ""
+	job.GenerateName = fmt.Sprintf("velero-hook-%s-", hook.Name)
+	job.Namespace = hook.Job.Namespace
+	if job.Labels == nil {
+		job.Labels = make(map[string]string)
+	}
+	for k, v := range labels {
+		job.Labels[k] = v
+	}
+	if job.Spec.Template.Spec.RestartPolicy == "" {
+		job.Spec.Template.Spec.RestartPolicy = corev1api.RestartPolicyNever
+	}
+	if job.Spec.TTLSecondsAfterFinished == nil {
+		ttl := defaultScopeHookJobTTL
+		job.Spec.TTLSecondsAfterFinished = &ttl
+	}
+
+	if err := h.Client.Create(ctx, job); err != nil {
+		return errors.Wrap(err, "error creating hook job")
+	}
+	log.Infof("Created hook job %s/%s", job.Namespace, job.Name)
+
+	interval := h.PollInterval
+	if interval == 0 {
+		interval = defaultScopeHookPollInterval
+	}
+
+	var jobErr error
+	err = wait.PollImmediateUntil(interval, func() (bool, error) {
+		current := &batchv1api.Job{}
+		if err := h.Client.Get(ctx, kbclient.ObjectKeyFromObject(job), current); err != nil {
+			return false, errors.Wrapf(err, "error getting hook job %s/%s", job.Namespace, job.Name)
+		}
+		for _, condition := range current.Status.Conditions {
+			if condition.Status != corev1api.ConditionTrue {
+				continue
+			}
+			switch condition.Type {
+			case batchv1api.JobComplete:
+				return true, nil
+			case batchv1api.JobFailed:
+				jobErr = errors.Errorf("hook job %s/%s failed: %s", job.Namespace, job.Name, condition.Message)
+				return true, nil
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		err = errors.Errorf("timed out waiting for hook job %s/%s to complete", job.Namespace, job.Name)
+	}
+	if err == nil {
+		err = jobErr
+	}
+	if err != nil {
+		// the job must not keep running once the hook is reported as failed
+		h.deleteJob(log, job)
+	}
+	return err
+}
+
+// deleteJob deletes the job of a failed hook together with its pods. The context of the
+// hook may be done already, so the job is deleted with a context of its own.
+func (h *DefaultScopeHookHandler) deleteJob(log logrus.FieldLogger, job *batchv1api.Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := h.Client.Delete(ctx, job, kbclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		log.WithError(err).Warnf("Error deleting hook job %s/%s", job.Namespace, job.Name)
+		return
+	}
+	log.Infof("Deleted hook job %s/%s", job.Namespace, job.Name)
+}
+
+func (h *DefaultScopeHookHandler) runExecHook(ctx context.Context, log logrus.FieldLogger, hook velerov1api.ScopeHook, phase hookPhase, timeout time.Duration, tracker *HookTracker) error {
+	if h.PodCommandExecutor == nil {
+		return errors.New("exec hooks are not supported by this handler")
+	}
+
+	pod, err := h.getExecHookPod(ctx, hook.Exec)
+	if err != nil {
+		return err
+	}
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	execHook := &velerov1api.ExecHook{
+		Container: hook.Exec.Container,
+		Command:   hook.Exec.Command,
+		OnError:   hook.OnError,
+		Timeout:   metav1.Duration{Duration: timeout},
+	}
+	return executeAndRecord(tracker, h.PodCommandExecutor, log, item, pod.Namespace, pod.Name, hook.Name, "scopeHook", phase, execHook)
+}
+
+// getExecHookPod returns the pod an exec hook runs in, either by name or as the first
+// running pod by name that matches the hook's label selector.
+func (h *DefaultScopeHookHandler) getExecHookPod(ctx context.Context, hook *velerov1api.ScopeExecHook) (*corev1api.Pod, error) {
+	if hook.Pod != "" {
+		pod := new(corev1api.Pod)
+		if err := h.Client.Get(ctx, kbclient.ObjectKey{Namespace: hook.Namespace, Name: hook.Pod}, pod); err != nil {
+			return nil, errors.Wrapf(err, "error getting pod %s/%s", hook.Namespace, hook.Pod)
+		}
+		return pod, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(hook.LabelSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing label selector")
+	}
+	pods := new(corev1api.PodList)
+	if err := h.Client.List(ctx, pods, kbclient.InNamespace(hook.Namespace), kbclient.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrapf(err, "error listing pods in namespace %s", hook.Namespace)
+	}
+
+	var running []corev1api.Pod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1api.PodRunning {
+			running = append(running, pod)
+		}
+	}
+	if len(running) == 0 {
+		return nil, errors.Errorf("no running pod in namespace %s matches selector %s", hook.Namespace, selector)
+	}
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].Name < running[j].Name
+	})
+	return &running[0], nil
+}
+
+func (h *DefaultScopeHookHandler) runHTTPHook(ctx context.Context, hook *velerov1api.HTTPHook, timeout time.Duration) error {
+	method := hook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, hook.URL, strings.NewReader(hook.Body))
+	if err != nil {
+		return errors.Wrap(err, "error creating hook request")
+	}
+	for k, v := range hook.Headers {
+		req.Header.Set(k, v)
+	}
+
+	client := h.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error sending hook request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("hook request to %s returned status %s", hook.URL, resp.Status)
+	}
+	return nil
+}
+
+func decodeJobTemplate(raw []byte) (*batchv1api.JobTemplateSpec, error) {
+	template := new(batchv1api.JobTemplateSpec)
+	if err := json.Unmarshal(raw, template); err != nil {
+		return nil, errors.Wrap(err, "error decoding job template")
+	}
+	if len(template.Spec.Template.Spec.Containers) == 0 {
+		return nil, errors.New("job template has no containers")
+	}
+	return template, nil
+}
+
+// ValidateScopeHooks checks that each hook has a valid name and specifies exactly one valid action,
+// and returns an error for each hook that does not.
+func ValidateScopeHooks(hooks []velerov1api.ScopeHook) []error {
+	var errs []error
+	for _, hook := range hooks {
+		if hook.Name == "" {
+			errs = append(errs, errors.New("scope hook must have a name"))
+			continue
+		}
+		// the name is used in the names of the hook jobs
+		if msgs := validation.IsDNS1123Label(hook.Name); len(msgs) > 0 {
+			errs = append(errs, errors.Errorf("scope hook name %q is invalid: %s", hook.Name, strings.Join(msgs, "; ")))
+			continue
+		}
+		actions := 0
+		for _, set := range []bool{hook.Job != nil, hook.Exec != nil, hook.HTTP != nil} {
+			if set {
+				actions++
+			}
+		}
+		if actions != 1 {
+			errs = append(errs, errors.Errorf("scope hook %s must specify exactly one of job, exec or http", hook.Name))
+			continue
+		}
+		if hook.Job != nil {
+			if hook.Job.Namespace == "" {
+				errs = append(errs, errors.Errorf("scope hook %s must specify the job namespace", hook.Name))
+			}
+			if _, err := decodeJobTemplate(hook.Job.JobTemplate.Raw); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid job template for scope hook %s", hook.Name))
+			}
+		}
+		if hook.Exec != nil {
+			if hook.Exec.Namespace == "" {
+				errs = append(errs, errors.Errorf("scope hook %s must specify the pod namespace", hook.Name))
+			}
+			if (hook.Exec.Pod == "") == (hook.Exec.LabelSelector == nil) {
+				errs = append(errs, errors.Errorf("scope hook %s must specify exactly one of pod or labelSelector", hook.Name))
+			}
+			if len(hook.Exec.Command) == 0 {
+				errs = append(errs, errors.Errorf("scope hook %s must specify a command", hook.Name))
+			}
+		}
+		if hook.HTTP != nil {
+			if u, err := url.Parse(hook.HTTP.URL); err != nil || u.Scheme == "" || u.Host == "" {
+				errs = append(errs, errors.Errorf("scope hook %s has an invalid url %q", hook.Name, hook.HTTP.URL))
+			}
+		}
+	}
+	return errs
+}
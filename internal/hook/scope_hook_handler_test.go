@@ -0,0 +1,320 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const testJobTemplate = `{"spec":{"template":{"spec":{"containers":[{"name":"scale-down","image":"bitnami/kubectl"}]}}}}`
+
+func TestHandleScopeHooksHTTP(t *testing.T) {
+	var gotMethod, gotHeader, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotHeader = r.Header.Get("X-Token")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		hooks          []velerov1api.ScopeHook
+		expectWarnings int
+		expectErr      bool
+		expectMethod   string
+	}{
+		{
+			name: "successful request defaults to POST",
+			hooks: []velerov1api.ScopeHook{
+				{
+					Name: "warmup",
+					HTTP: &velerov1api.HTTPHook{
+						URL:     server.URL + "/ok",
+						Headers: map[string]string{"X-Token": "abc"},
+						Body:    "hello",
+					},
+				},
+			},
+			expectMethod: http.MethodPost,
+		},
+		{
+			name: "failed request with onError Continue is a warning",
+			hooks: []velerov1api.ScopeHook{
+				{
+					Name: "warmup",
+					HTTP: &velerov1api.HTTPHook{URL: server.URL + "/fail", Method: http.MethodPut},
+				},
+			},
+			expectWarnings: 1,
+			expectMethod:   http.MethodPut,
+		},
+		{
+			name: "failed request with onError Fail stops execution",
+			hooks: []velerov1api.ScopeHook{
+				{
+					Name:    "warmup",
+					HTTP:    &velerov1api.HTTPHook{URL: server.URL + "/fail"},
+					OnError: velerov1api.HookErrorModeFail,
+				},
+				{
+					Name: "never-run",
+					HTTP: &velerov1api.HTTPHook{URL: server.URL + "/ok", Method: http.MethodGet},
+				},
+			},
+			expectErr:    true,
+			expectMethod: http.MethodPost,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotMethod, gotHeader, gotBody = "", "", ""
+			h := &DefaultScopeHookHandler{}
+
+			statuses, warnings, err := h.HandleHooks(context.Background(), velerotest.NewLogger(), tc.hooks, PhasePost, nil, nil)
+
+			assert.Len(t, warnings, tc.expectWarnings)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectMethod, gotMethod)
+			require.Len(t, statuses, 1)
+			assert.Equal(t, "post", statuses[0].Phase)
+			if tc.expectErr || tc.expectWarnings > 0 {
+				assert.Equal(t, velerov1api.ScopeHookResultFailed, statuses[0].Result)
+				assert.NotEmpty(t, statuses[0].Error)
+			} else {
+				assert.Equal(t, velerov1api.ScopeHookResultSucceeded, statuses[0].Result)
+			}
+			if tc.hooks[0].HTTP.Headers != nil {
+				assert.Equal(t, "abc", gotHeader)
+				assert.Equal(t, "hello", gotBody)
+			}
+		})
+	}
+}
+
+func TestHandleScopeHooksJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		onError       velerov1api.HookErrorMode
+		conditionType batchv1api.JobConditionType
+		timeout       time.Duration
+		expectWarning bool
+		expectErr     bool
+		expectDeleted bool
+	}{
+		{
+			name:          "job completes",
+			conditionType: batchv1api.JobComplete,
+		},
+		{
+			name:          "job fails with onError Continue",
+			conditionType: batchv1api.JobFailed,
+			expectWarning: true,
+			expectDeleted: true,
+		},
+		{
+			name:          "job fails with onError Fail",
+			onError:       velerov1api.HookErrorModeFail,
+			conditionType: batchv1api.JobFailed,
+			expectErr:     true,
+			expectDeleted: true,
+		},
+		{
+			name:          "job times out",
+			onError:       velerov1api.HookErrorModeFail,
+			timeout:       100 * time.Millisecond,
+			expectErr:     true,
+			expectDeleted: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t)
+			h := &DefaultScopeHookHandler{
+				Client:       client,
+				PollInterval: 10 * time.Millisecond,
+			}
+			hooks := []velerov1api.ScopeHook{
+				{
+					Name: "scale-down",
+					Job: &velerov1api.JobHook{
+						Namespace:   "ns-1",
+						JobTemplate: runtime.RawExtension{Raw: []byte(testJobTemplate)},
+					},
+					OnError: tc.onError,
+					Timeout: metav1.Duration{Duration: tc.timeout},
+				},
+			}
+
+			// mark the job finished as soon as it shows up
+			stop := make(chan struct{})
+			defer close(stop)
+			if tc.conditionType != "" {
+				go func() {
+					for {
+						select {
+						case <-stop:
+							return
+						case <-time.After(5 * time.Millisecond):
+						}
+						jobs := new(batchv1api.JobList)
+						if err := client.List(context.Background(), jobs); err != nil || len(jobs.Items) == 0 {
+							continue
+						}
+						job := jobs.Items[0]
+						job.Status.Conditions = []batchv1api.JobCondition{{Type: tc.conditionType, Status: corev1api.ConditionTrue}}
+						_ = client.Update(context.Background(), &job)
+						return
+					}
+				}()
+			}
+
+			_, warnings, err := h.HandleHooks(context.Background(), velerotest.NewLogger(), hooks, PhasePre, map[string]string{velerov1api.RestoreNameLabel: "restore-1"}, nil)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.expectWarning {
+				assert.Len(t, warnings, 1)
+			} else {
+				assert.Empty(t, warnings)
+			}
+
+			jobs := new(batchv1api.JobList)
+			require.NoError(t, client.List(context.Background(), jobs, kbclient.InNamespace("ns-1")))
+			if tc.expectDeleted {
+				assert.Empty(t, jobs.Items)
+				return
+			}
+			require.Len(t, jobs.Items, 1)
+			assert.Equal(t, "restore-1", jobs.Items[0].Labels[velerov1api.RestoreNameLabel])
+			assert.Equal(t, corev1api.RestartPolicyNever, jobs.Items[0].Spec.Template.Spec.RestartPolicy)
+			require.NotNil(t, jobs.Items[0].Spec.TTLSecondsAfterFinished)
+			assert.Equal(t, int32(3600), *jobs.Items[0].Spec.TTLSecondsAfterFinished)
+		})
+	}
+}
+
+func TestHandleScopeHooksExec(t *testing.T) {
+	pod := func(name string, phase corev1api.PodPhase) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "db", Name: name, Labels: map[string]string{"app": "db"}},
+			Spec:       corev1api.PodSpec{Containers: []corev1api.Container{{Name: "db"}}},
+			Status:     corev1api.PodStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		exec      *velerov1api.ScopeExecHook
+		expectPod string
+		expectErr bool
+	}{
+		{
+			name:      "pod by name",
+			exec:      &velerov1api.ScopeExecHook{Namespace: "db", Pod: "db-2", Command: []string{"freeze"}},
+			expectPod: "db-2",
+		},
+		{
+			name:      "first running pod matching the selector",
+			exec:      &velerov1api.ScopeExecHook{Namespace: "db", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}, Command: []string{"freeze"}},
+			expectPod: "db-1",
+		},
+		{
+			name:      "no running pod matches the selector",
+			exec:      &velerov1api.ScopeExecHook{Namespace: "db", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}}, Command: []string{"freeze"}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, pod("db-0", corev1api.PodPending), pod("db-1", corev1api.PodRunning), pod("db-2", corev1api.PodRunning))
+			executor := new(velerotest.MockPodCommandExecutor)
+			if tc.expectPod != "" {
+				executor.On("ExecutePodCommand", mock.Anything, mock.Anything, "db", tc.expectPod, "freeze", mock.Anything).Return(nil)
+			}
+			h := &DefaultScopeHookHandler{Client: client, PodCommandExecutor: executor}
+			hooks := []velerov1api.ScopeHook{{Name: "freeze", Exec: tc.exec, OnError: velerov1api.HookErrorModeFail}}
+
+			tracker := NewHookTracker()
+			statuses, _, err := h.HandleHooks(context.Background(), velerotest.NewLogger(), hooks, PhasePre, nil, tracker)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				assert.Empty(t, tracker.Executions())
+			} else {
+				assert.NoError(t, err)
+				executions := tracker.Executions()
+				require.Len(t, executions, 1)
+				assert.Equal(t, "freeze", executions[0].HookName)
+				assert.Equal(t, "scopeHook", executions[0].HookSource)
+				assert.Equal(t, "pre", executions[0].Phase)
+				assert.Equal(t, tc.expectPod, executions[0].Pod)
+			}
+			require.Len(t, statuses, 1)
+			executor.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateScopeHooks(t *testing.T) {
+	hooks := []velerov1api.ScopeHook{
+		{Name: "valid-http", HTTP: &velerov1api.HTTPHook{URL: "https://example.com/warmup"}},
+		{Name: "valid-job", Job: &velerov1api.JobHook{Namespace: "ns-1", JobTemplate: runtime.RawExtension{Raw: []byte(testJobTemplate)}}},
+		{HTTP: &velerov1api.HTTPHook{URL: "https://example.com"}},
+		{Name: "no-action"},
+		{Name: "bad-url", HTTP: &velerov1api.HTTPHook{URL: "not a url"}},
+		{Name: "no-containers", Job: &velerov1api.JobHook{Namespace: "ns-1", JobTemplate: runtime.RawExtension{Raw: []byte(`{}`)}}},
+		{Name: "valid-exec", Exec: &velerov1api.ScopeExecHook{Namespace: "db", Pod: "db-0", Command: []string{"freeze"}}},
+		{Name: "exec-without-pod", Exec: &velerov1api.ScopeExecHook{Namespace: "db", Command: []string{"freeze"}}},
+		{Name: "two-actions", Exec: &velerov1api.ScopeExecHook{Namespace: "db", Pod: "db-0", Command: []string{"freeze"}}, HTTP: &velerov1api.HTTPHook{URL: "https://example.com"}},
+		{Name: "Invalid_Name", HTTP: &velerov1api.HTTPHook{URL: "https://example.com"}},
+	}
+
+	errs := ValidateScopeHooks(hooks)
+	assert.Len(t, errs, 7)
+}
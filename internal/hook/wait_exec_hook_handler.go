@@ -23,6 +23,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
@@ -30,6 +31,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 type WaitExecHookHandler interface {
@@ -58,6 +60,8 @@ var _ ListWatchFactory = &DefaultListWatchFactory{}
 type DefaultWaitExecHookHandler struct {
 	ListWatchFactory   ListWatchFactory
 	PodCommandExecutor podexec.PodCommandExecutor
+	// HookTracker records the hook executions. Optional.
+	HookTracker *HookTracker
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -158,6 +162,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					err := fmt.Errorf("hook %s in container %s expired before executing", hook.HookName, hook.Hook.Container)
 					hookLog.Error(err)
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
+						e.HookTracker.Record(unexecutedHookExecution(pod, hook, err))
 						errors = append(errors, err)
 						cancel()
 						return
@@ -169,7 +174,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					OnError:   hook.Hook.OnError,
 					Timeout:   hook.Hook.ExecTimeout,
 				}
-				if err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); err != nil {
+				if err := executeAndRecord(e.HookTracker, e.PodCommandExecutor, hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, hook.HookSource, PhasePost, eh); err != nil {
 					hookLog.WithError(err).Error("Error executing hook")
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
@@ -221,6 +226,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				},
 			)
 			hookLog.Error(err)
+			e.HookTracker.Record(unexecutedHookExecution(pod, hook, err))
 			if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 				errors = append(errors, err)
 			}
@@ -230,6 +236,22 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	return errors
 }
 
+// unexecutedHookExecution is the execution record of a hook that never ran in the pod.
+func unexecutedHookExecution(pod *v1.Pod, hook PodExecRestoreHook, err error) results.HookExecution {
+	return results.HookExecution{
+		HookName:       hook.HookName,
+		HookSource:     hook.HookSource,
+		Phase:          string(PhasePost),
+		Namespace:      pod.Namespace,
+		Pod:            pod.Name,
+		Container:      hook.Hook.Container,
+		Command:        hook.Hook.Command,
+		ExitCode:       -1,
+		Error:          err.Error(),
+		StartTimestamp: metav1.Now(),
+	}
+}
+
 func podHasContainer(pod *v1.Pod, containerName string) bool {
 	if pod == nil {
 		return false
@@ -70,6 +70,42 @@ type BackupRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// RecentMaintenance is the history of the latest maintenance runs, ordered
+	// by their start time.
+	// +optional
+	RecentMaintenance []BackupRepositoryMaintenanceStatus `json:"recentMaintenance,omitempty"`
+}
+
+// BackupRepositoryMaintenanceResult represents the result of a repository maintenance run.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type BackupRepositoryMaintenanceResult string
+
+const (
+	BackupRepositoryMaintenanceSucceeded BackupRepositoryMaintenanceResult = "Succeeded"
+	BackupRepositoryMaintenanceFailed    BackupRepositoryMaintenanceResult = "Failed"
+)
+
+// BackupRepositoryMaintenanceStatus is the status of a repository maintenance run.
+type BackupRepositoryMaintenanceStatus struct {
+	// Result is the result of the maintenance run.
+	// +optional
+	Result BackupRepositoryMaintenanceResult `json:"result,omitempty"`
+
+	// StartTimestamp is the time the maintenance run started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompleteTimestamp is the time the maintenance run completed.
+	// +optional
+	// +nullable
+	CompleteTimestamp *metav1.Time `json:"completeTimestamp,omitempty"`
+
+	// Message is a message about the maintenance run, e.g. the error
+	// that made it fail.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
@@ -175,6 +175,16 @@ type BackupSpec struct {
 	// If DataMover is "" or "velero", the built-in data mover will be used.
 	// +optional
 	DataMover string `json:"datamover,omitempty"`
+
+	// Cancel indicates request to cancel the ongoing backup. It can be set
+	// when the backup is in New, InProgress or WaitingForPluginOperations phase.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// Paused indicates request to suspend the progress checking of the
+	// asynchronous BackupItemAction operations of the backup until it is unset.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -183,6 +193,15 @@ type BackupHooks struct {
 	// +optional
 	// +nullable
 	Resources []BackupResourceHookSpec `json:"resources,omitempty"`
+
+	// PreBackup is a list of ScopeHooks to execute once before any item is backed up.
+	// +optional
+	PreBackup []ScopeHook `json:"preBackup,omitempty"`
+
+	// PostBackup is a list of ScopeHooks to execute once after all items have been
+	// backed up, or after all async plugin operations have completed if there are any.
+	// +optional
+	PostBackup []ScopeHook `json:"postBackup,omitempty"`
 }
 
 // BackupResourceHookSpec defines one or more BackupResourceHooks that should be executed based on
@@ -272,7 +291,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Cancelled;Deleting
 type BackupPhase string
 
 const (
@@ -331,6 +350,10 @@ const (
 	// prevented it from completing successfully.
 	BackupPhaseFailed BackupPhase = "Failed"
 
+	// BackupPhaseCancelled means the backup was cancelled by the user
+	// before it completed.
+	BackupPhaseCancelled BackupPhase = "Cancelled"
+
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
@@ -434,6 +457,93 @@ type BackupStatus struct {
 	// BackupItemAction operations for this backup which ended with an error.
 	// +optional
 	BackupItemOperationsFailed int `json:"backupItemOperationsFailed,omitempty"`
+
+	// ScopeHooks records the execution of the backup's pre-backup and post-backup hooks.
+	// +optional
+	// +nullable
+	ScopeHooks []ScopeHookStatus `json:"scopeHooks,omitempty"`
+
+	// HookStatus contains information about the status of the exec hooks.
+	// +optional
+	// +nullable
+	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// RetainUntil is the time until which the backup's data in the backup storage
+	// location is locked by object lock. The backup isn't deleted before it.
+	// +optional
+	// +nullable
+	RetainUntil *metav1.Time `json:"retainUntil,omitempty"`
+
+	// FailoverFrom is the name of the backup storage location the backup targeted, which
+	// was unavailable when the backup started. The backup is stored in one of its failover
+	// locations instead, which is the StorageLocation of the backup's spec.
+	// +optional
+	FailoverFrom string `json:"failoverFrom,omitempty"`
+
+	// Compression is the compression of the backup's contents tarball. The tarball of
+	// the backups which don't have it is compressed with gzip.
+	// +optional
+	// +nullable
+	Compression *BackupCompression `json:"compression,omitempty"`
+
+	// ContentsLayout is how the contents of the backup are stored in the backup storage
+	// location. The contents of the backups which don't have it are stored in a single tarball.
+	// +optional
+	ContentsLayout BackupContentsLayout `json:"contentsLayout,omitempty"`
+}
+
+// BackupContentsLayout is how the contents of a backup are stored in the backup storage location.
+// +kubebuilder:validation:Enum=Single;PerNamespace
+type BackupContentsLayout string
+
+const (
+	// BackupContentsLayoutSingle means the contents are stored in a single tarball.
+	BackupContentsLayoutSingle BackupContentsLayout = "Single"
+
+	// BackupContentsLayoutPerNamespace means the resources of each namespace are stored in their
+	// own tarball, and the cluster-scoped resources in another one, along with an index of the
+	// tarballs, so that restores only download the tarballs of the namespaces they restore.
+	BackupContentsLayoutPerNamespace BackupContentsLayout = "PerNamespace"
+)
+
+// CompressionAlgorithm is the algorithm the contents tarball of a backup is compressed with.
+// +kubebuilder:validation:Enum=none;gzip;zstd
+type CompressionAlgorithm string
+
+const (
+	// CompressionAlgorithmNone means the tarball isn't compressed.
+	CompressionAlgorithmNone CompressionAlgorithm = "none"
+
+	// CompressionAlgorithmGzip means the tarball is compressed with gzip.
+	CompressionAlgorithmGzip CompressionAlgorithm = "gzip"
+
+	// CompressionAlgorithmZstd means the tarball is compressed with zstd.
+	CompressionAlgorithmZstd CompressionAlgorithm = "zstd"
+)
+
+// BackupCompression is the compression of the contents tarball of a backup.
+type BackupCompression struct {
+	// Algorithm is the algorithm the tarball is compressed with.
+	Algorithm CompressionAlgorithm `json:"algorithm"`
+
+	// Level is the compression level, from 1 to 9 for gzip and from 1 to 22 for zstd.
+	// The default level of the algorithm is used if it's zero.
+	// +optional
+	Level int `json:"level,omitempty"`
+}
+
+// HookStatus stores information about the status of the exec hooks
+// run in pods during a backup or restore.
+type HookStatus struct {
+	// HooksAttempted is the total number of attempted hooks.
+	// Specifically, HooksAttempted represents the number of hooks that failed to execute
+	// and the number of hooks that executed successfully.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the total number of hooks which ended with an error.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
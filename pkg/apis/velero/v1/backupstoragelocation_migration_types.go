@@ -0,0 +1,163 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// BackupStorageLocationMigrationSpec is the specification of a migration of the backups
+// and the backup repositories from a backup storage location to another.
+type BackupStorageLocationMigrationSpec struct {
+	// SourceLocation is the name of the backup storage location the backups and the backup
+	// repositories are copied from.
+	SourceLocation string `json:"sourceLocation"`
+
+	// TargetLocation is the name of the backup storage location the backups and the backup
+	// repositories are copied to.
+	TargetLocation string `json:"targetLocation"`
+
+	// MarkSourceReadOnly sets the access mode of the source location to ReadOnly once all
+	// the backups and the backup repositories are copied and verified.
+	// +optional
+	MarkSourceReadOnly bool `json:"markSourceReadOnly,omitempty"`
+}
+
+// BackupStorageLocationMigrationPhase represents the lifecycle phase of a BackupStorageLocationMigration.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;PartiallyFailed;Failed
+type BackupStorageLocationMigrationPhase string
+
+const (
+	// BackupStorageLocationMigrationPhaseNew means the migration has not been processed yet.
+	BackupStorageLocationMigrationPhaseNew BackupStorageLocationMigrationPhase = "New"
+
+	// BackupStorageLocationMigrationPhaseInProgress means the migration is being processed.
+	BackupStorageLocationMigrationPhaseInProgress BackupStorageLocationMigrationPhase = "InProgress"
+
+	// BackupStorageLocationMigrationPhaseCompleted means all the backups and the backup
+	// repositories were migrated.
+	BackupStorageLocationMigrationPhaseCompleted BackupStorageLocationMigrationPhase = "Completed"
+
+	// BackupStorageLocationMigrationPhasePartiallyFailed means some of the backups or the
+	// backup repositories failed to be migrated.
+	BackupStorageLocationMigrationPhasePartiallyFailed BackupStorageLocationMigrationPhase = "PartiallyFailed"
+
+	// BackupStorageLocationMigrationPhaseFailed means the migration failed to start, or its
+	// locations were deleted before it finished.
+	BackupStorageLocationMigrationPhaseFailed BackupStorageLocationMigrationPhase = "Failed"
+)
+
+// BackupStorageLocationMigrationStatus is the current status of a BackupStorageLocationMigration.
+type BackupStorageLocationMigrationStatus struct {
+	// Phase is the current state of the migration.
+	// +optional
+	Phase BackupStorageLocationMigrationPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the migration was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the migration was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// TotalBackups is the number of the backups stored in the source location.
+	// +optional
+	TotalBackups int `json:"totalBackups,omitempty"`
+
+	// MigratedBackups is the number of the backups copied to the target location so far.
+	// +optional
+	MigratedBackups int `json:"migratedBackups,omitempty"`
+
+	// TotalRepositories is the number of the backup repositories of the source location.
+	// +optional
+	TotalRepositories int `json:"totalRepositories,omitempty"`
+
+	// MigratedRepositories is the number of the backup repositories copied to the target
+	// location so far.
+	// +optional
+	MigratedRepositories int `json:"migratedRepositories,omitempty"`
+
+	// Backups are the backups of the source location processed so far, which are skipped
+	// when the migration is resumed.
+	// +optional
+	// +nullable
+	Backups []BackupStorageLocationMigrationItem `json:"backups,omitempty"`
+
+	// Repositories are the backup repositories of the source location processed so far,
+	// which are skipped when the migration is resumed.
+	// +optional
+	// +nullable
+	Repositories []BackupStorageLocationMigrationItem `json:"repositories,omitempty"`
+
+	// Errors contains any errors that were encountered during the migration.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+}
+
+// BackupStorageLocationMigrationItem is the result of the migration of a backup or a backup repository.
+type BackupStorageLocationMigrationItem struct {
+	// Name is the name of the backup or of the backup repository.
+	Name string `json:"name"`
+
+	// Error is the error the migration of the item failed with, it's empty if the item was
+	// migrated.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// TODO(2.0) After converting all resources to use the runtime-controller client, the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=bslm
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.sourceLocation",description="The backup storage location migrated from"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetLocation",description="The backup storage location migrated to"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="The status of the migration"
+// +kubebuilder:printcolumn:name="Backups",type="integer",JSONPath=".status.migratedBackups",description="The number of the migrated backups"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// BackupStorageLocationMigration is a request to copy the backups and the backup repositories
+// of a backup storage location to another, and to move their API objects to it.
+type BackupStorageLocationMigration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupStorageLocationMigrationSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupStorageLocationMigrationStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// BackupStorageLocationMigrationList is a list of BackupStorageLocationMigrations.
+type BackupStorageLocationMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupStorageLocationMigration `json:"items"`
+}
@@ -0,0 +1,177 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupStorageLocationTestSpec is the specification of a compatibility test of a backup
+// storage location.
+type BackupStorageLocationTestSpec struct {
+	// BackupStorageLocation is the name of the backup storage location to test.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// LargeObjectSize is the size of the object uploaded to test the multipart uploads.
+	// Defaults to 100Mi.
+	// +optional
+	// +nullable
+	LargeObjectSize *resource.Quantity `json:"largeObjectSize,omitempty"`
+
+	// SkipRepository skips the checks of the storage operations the backup repositories rely on.
+	// +optional
+	SkipRepository bool `json:"skipRepository,omitempty"`
+}
+
+// BackupStorageLocationTestPhase represents the lifecycle phase of a BackupStorageLocationTest.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
+type BackupStorageLocationTestPhase string
+
+const (
+	// BackupStorageLocationTestPhaseNew means the test has not been processed yet.
+	BackupStorageLocationTestPhaseNew BackupStorageLocationTestPhase = "New"
+
+	// BackupStorageLocationTestPhaseInProgress means the test is being run.
+	BackupStorageLocationTestPhaseInProgress BackupStorageLocationTestPhase = "InProgress"
+
+	// BackupStorageLocationTestPhaseCompleted means all the checks were run, whether they
+	// passed or not.
+	BackupStorageLocationTestPhaseCompleted BackupStorageLocationTestPhase = "Completed"
+
+	// BackupStorageLocationTestPhaseFailed means the test failed to start.
+	BackupStorageLocationTestPhaseFailed BackupStorageLocationTestPhase = "Failed"
+)
+
+// BackupStorageLocationTestComponent is the component of Velero a check is run for.
+// +kubebuilder:validation:Enum=ObjectStore;Repository
+type BackupStorageLocationTestComponent string
+
+const (
+	// BackupStorageLocationTestComponentObjectStore means the check is run through the
+	// object store plugin of the location.
+	BackupStorageLocationTestComponentObjectStore BackupStorageLocationTestComponent = "ObjectStore"
+
+	// BackupStorageLocationTestComponentRepository means the check is run through the
+	// storage backend of the backup repositories.
+	BackupStorageLocationTestComponentRepository BackupStorageLocationTestComponent = "Repository"
+)
+
+// BackupStorageLocationTestCheckResult is the result of a check.
+// +kubebuilder:validation:Enum=Passed;Failed
+type BackupStorageLocationTestCheckResult string
+
+const (
+	BackupStorageLocationTestCheckResultPassed BackupStorageLocationTestCheckResult = "Passed"
+	BackupStorageLocationTestCheckResultFailed BackupStorageLocationTestCheckResult = "Failed"
+)
+
+// BackupStorageLocationTestCheck is a check of a compatibility test.
+type BackupStorageLocationTestCheck struct {
+	// Component is the component of Velero the check is run for.
+	Component BackupStorageLocationTestComponent `json:"component"`
+
+	// Name is the name of the check.
+	Name string `json:"name"`
+
+	// Result is the result of the check.
+	Result BackupStorageLocationTestCheckResult `json:"result"`
+
+	// Message describes why the check failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Duration is how long the check took.
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+}
+
+// BackupStorageLocationTestStatus is the current status of a BackupStorageLocationTest.
+type BackupStorageLocationTestStatus struct {
+	// Phase is the current state of the test.
+	// +optional
+	Phase BackupStorageLocationTestPhase `json:"phase,omitempty"`
+
+	// Message describes why the test failed to start.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp records the time the test was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the test was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Checks are the checks run by the test, in the order they were run.
+	// +optional
+	// +nullable
+	Checks []BackupStorageLocationTestCheck `json:"checks,omitempty"`
+
+	// PassedChecks is the number of the checks which passed.
+	// +optional
+	PassedChecks int `json:"passedChecks,omitempty"`
+
+	// FailedChecks is the number of the checks which failed.
+	// +optional
+	FailedChecks int `json:"failedChecks,omitempty"`
+}
+
+// TODO(2.0) After converting all resources to use the runtime-controller client, the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=bslt
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".spec.backupStorageLocation",description="The backup storage location tested"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="The status of the test"
+// +kubebuilder:printcolumn:name="Passed",type="integer",JSONPath=".status.passedChecks",description="The number of the checks which passed"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedChecks",description="The number of the checks which failed"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// BackupStorageLocationTest is a request to run a suite of checks against a backup storage
+// location, through its object store plugin and the storage backend of the backup repositories,
+// to verify the storage behaves as Velero expects.
+type BackupStorageLocationTest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupStorageLocationTestSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupStorageLocationTestStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// BackupStorageLocationTestList is a list of BackupStorageLocationTests.
+type BackupStorageLocationTestList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupStorageLocationTest `json:"items"`
+}
@@ -35,6 +35,13 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
 
+	// WebIdentity makes the location use short-lived credentials, obtained by exchanging a
+	// projected service account token for the credentials of a role, instead of a static
+	// credential. It can't be used together with Credential.
+	// +optional
+	// +nullable
+	WebIdentity *WebIdentity `json:"webIdentity,omitempty"`
+
 	StorageType `json:",inline"`
 
 	// Default indicates this location is the default backup storage location.
@@ -54,8 +61,77 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// UsageReportFrequency defines how frequently to calculate the size of the data stored in
+	// the object storage, which requires reading the attributes of all the objects of the location.
+	// A value of 0 or no value disables the usage reporting. The usage is only reported for the
+	// object store plugins implementing the v2 object store API, e.g. the built-in filesystem
+	// object store, it's never reported for the v1 plugins, which include the plugins of all the
+	// supported cloud providers.
+	// +optional
+	// +nullable
+	UsageReportFrequency *metav1.Duration `json:"usageReportFrequency,omitempty"`
+
+	// FailoverLocations are the names of the backup storage locations, in priority order,
+	// the backups targeting this location are stored in when it's unavailable at backup
+	// time. The first available one of them is used.
+	// +optional
+	// +nullable
+	FailoverLocations []string `json:"failoverLocations,omitempty"`
+
+	// ObjectLock makes the backup data written to the location, including the backup
+	// repositories, immutable for a retention period by the object lock of the storage.
+	// +optional
+	// +nullable
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
 }
 
+// DefaultWebIdentityTokenFile is the path the projected service account token is mounted
+// at in the Velero and node-agent pods by default.
+const DefaultWebIdentityTokenFile = "/var/run/secrets/velero/serviceaccount/token"
+
+// WebIdentity defines the role assumed with a web identity token to access a backup storage location.
+type WebIdentity struct {
+	// RoleARN is the identifier of the role assumed with the web identity token.
+	RoleARN string `json:"roleARN"`
+
+	// TokenFile is the path of the web identity token in the Velero and node-agent pods,
+	// usually a projected service account token. Defaults to
+	// /var/run/secrets/velero/serviceaccount/token.
+	// +optional
+	TokenFile string `json:"tokenFile,omitempty"`
+
+	// SessionDuration is how long the short-lived credentials are valid. The credentials are
+	// refreshed before they expire. Defaults to 1 hour.
+	// +optional
+	// +nullable
+	SessionDuration *metav1.Duration `json:"sessionDuration,omitempty"`
+}
+
+// ObjectLock defines the object lock retention of the backup data written to a backup storage location.
+type ObjectLock struct {
+	// Mode is the object lock mode of the backup data.
+	Mode ObjectLockMode `json:"mode"`
+
+	// RetentionPeriod is how long the backup data is locked after it is written. It must be
+	// at least 24 hours.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
+}
+
+// ObjectLockMode is the object lock mode of the backup data.
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance means the locked backup data can be overwritten or deleted
+	// by the users with special permissions before the retention expires.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance means the locked backup data can't be overwritten or deleted
+	// by any user before the retention expires.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
 type BackupStorageLocationStatus struct {
 	// Phase is the current state of the BackupStorageLocation.
@@ -78,6 +154,47 @@ type BackupStorageLocationStatus struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 
+	// LastSuccessfulValidationTime is the last time the backup storage location was validated
+	// successfully.
+	// +optional
+	// +nullable
+	LastSuccessfulValidationTime *metav1.Time `json:"lastSuccessfulValidationTime,omitempty"`
+
+	// LastFailedValidationTime is the last time the validation of the backup storage location
+	// failed.
+	// +optional
+	// +nullable
+	LastFailedValidationTime *metav1.Time `json:"lastFailedValidationTime,omitempty"`
+
+	// ConsecutiveFailures is the number of the validations of the backup storage location
+	// which failed since the last successful one.
+	// +optional
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+
+	// ListLatency is the time taken to list the objects of the backup storage location during
+	// the last validation.
+	// +optional
+	// +nullable
+	ListLatency *metav1.Duration `json:"listLatency,omitempty"`
+
+	// PutLatency is the time taken to write an object to the backup storage location during
+	// the last validation. It's not measured for the ReadOnly locations.
+	// +optional
+	// +nullable
+	PutLatency *metav1.Duration `json:"putLatency,omitempty"`
+
+	// BackupCount is the number of the backups stored in the backup storage location, as of
+	// the last successful validation.
+	// +optional
+	BackupCount int `json:"backupCount,omitempty"`
+
+	// Usage is the size of the data stored in the backup storage location. It's only reported
+	// when the UsageReportFrequency of the location is set, and the sizes are only set for the
+	// object store plugins implementing the v2 object store API.
+	// +optional
+	// +nullable
+	Usage *BackupStorageLocationUsage `json:"usage,omitempty"`
+
 	// LastSyncedRevision is the value of the `metadata/revision` file in the backup
 	// storage location the last time the BSL's contents were synced into the cluster.
 	//
@@ -94,6 +211,28 @@ type BackupStorageLocationStatus struct {
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
 }
 
+// BackupStorageLocationUsage is the size of the data stored in a backup storage location.
+type BackupStorageLocationUsage struct {
+	// BackupBytes is the size, in bytes, of the backups and restores data.
+	// +optional
+	BackupBytes int64 `json:"backupBytes,omitempty"`
+
+	// RepositoryBytes is the size, in bytes, of the backup repositories.
+	// +optional
+	RepositoryBytes int64 `json:"repositoryBytes,omitempty"`
+
+	// LastUpdateTime is the last time the usage was calculated.
+	// +optional
+	// +nullable
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Message is the reason the usage is unavailable, e.g. the object store plugin of the
+	// location implements the v1 object store API, which can't list the sizes of the objects.
+	// The sizes aren't set if it's set.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // TODO(2.0) After converting all resources to use the runtime-controller client,
 // the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
 // +genclient
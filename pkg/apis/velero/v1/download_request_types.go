@@ -25,20 +25,25 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupContentsIndex;BackupContentsArchive;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;BackupHookResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;RestoreItemStatus;RestoreHookResults;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents
 type DownloadTargetKind string
 
 const (
 	DownloadTargetKindBackupLog                       DownloadTargetKind = "BackupLog"
 	DownloadTargetKindBackupContents                  DownloadTargetKind = "BackupContents"
+	DownloadTargetKindBackupContentsIndex             DownloadTargetKind = "BackupContentsIndex"
+	DownloadTargetKindBackupContentsArchive           DownloadTargetKind = "BackupContentsArchive"
 	DownloadTargetKindBackupVolumeSnapshots           DownloadTargetKind = "BackupVolumeSnapshots"
 	DownloadTargetKindBackupItemOperations            DownloadTargetKind = "BackupItemOperations"
 	DownloadTargetKindBackupResourceList              DownloadTargetKind = "BackupResourceList"
 	DownloadTargetKindBackupResults                   DownloadTargetKind = "BackupResults"
+	DownloadTargetKindBackupHookResults               DownloadTargetKind = "BackupHookResults"
 	DownloadTargetKindRestoreLog                      DownloadTargetKind = "RestoreLog"
 	DownloadTargetKindRestoreResults                  DownloadTargetKind = "RestoreResults"
 	DownloadTargetKindRestoreResourceList             DownloadTargetKind = "RestoreResourceList"
 	DownloadTargetKindRestoreItemOperations           DownloadTargetKind = "RestoreItemOperations"
+	DownloadTargetKindRestoreItemStatus               DownloadTargetKind = "RestoreItemStatus"
+	DownloadTargetKindRestoreHookResults              DownloadTargetKind = "RestoreHookResults"
 	DownloadTargetKindCSIBackupVolumeSnapshots        DownloadTargetKind = "CSIBackupVolumeSnapshots"
 	DownloadTargetKindCSIBackupVolumeSnapshotContents DownloadTargetKind = "CSIBackupVolumeSnapshotContents"
 )
@@ -51,6 +56,12 @@ type DownloadTarget struct {
 
 	// Name is the name of the kubernetes resource with which the file is associated.
 	Name string `json:"name"`
+
+	// Archive is the archive of a backup whose contents are split per namespace, which is
+	// downloaded by the BackupContentsArchive kind. The archives of a backup are listed by
+	// its BackupContentsIndex.
+	// +optional
+	Archive string `json:"archive,omitempty"`
 }
 
 // DownloadRequestPhase represents the lifecycle phase of a DownloadRequest.
@@ -83,8 +83,21 @@ const (
 	// The format is <namespace>/<name>.
 	PVCNamespaceNameLabel = "velero.io/pvc-namespace-name"
 
+	// ExcludeFromBackupLabel is the label key used to exclude a resource from backups
+	// when its value is "true".
+	ExcludeFromBackupLabel = "velero.io/exclude-from-backup"
+
 	// ResourceUsageLabel is the label key to explain the Velero resource usage.
 	ResourceUsageLabel = "velero.io/resource-usage"
+
+	// RepositoryNameLabel is the label key used to identify the backup repository
+	// that a repository maintenance job runs for.
+	RepositoryNameLabel = "velero.io/repo-name"
+
+	// RepositoryMaintenanceRequestAnnotation is the annotation key used to request
+	// a repository maintenance job for a backup repository regardless of its
+	// maintenance frequency. The value is the time of the request.
+	RepositoryMaintenanceRequestAnnotation = "velero.io/maintenance-requested"
 )
 
 type AsyncOperationIDPrefix string
@@ -51,6 +51,11 @@ type PodVolumeBackupSpec struct {
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// Cancel indicates request to cancel the ongoing PodVolumeBackup. It can be set
+	// when the PodVolumeBackup is in New or InProgress phase.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
@@ -64,6 +69,10 @@ const (
 	PodVolumeBackupPhaseFailed     PodVolumeBackupPhase = "Failed"
 )
 
+// PodVolumeBackupCanceledMessage is the status message of a PodVolumeBackup that is failed
+// because it is canceled, either before it is started or while it is in progress.
+const PodVolumeBackupCanceledMessage = "PodVolumeBackup is canceled"
+
 // PodVolumeBackupStatus is the current status of a PodVolumeBackup.
 type PodVolumeBackupStatus struct {
 	// Phase is the current state of the PodVolumeBackup.
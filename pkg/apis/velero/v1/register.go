@@ -45,17 +45,19 @@ func newTypeInfo(pluralName string, itemType, itemListType runtime.Object) typeI
 // API group, keyed on Kind.
 func CustomResources() map[string]typeInfo {
 	return map[string]typeInfo{
-		"Backup":                 newTypeInfo("backups", &Backup{}, &BackupList{}),
-		"Restore":                newTypeInfo("restores", &Restore{}, &RestoreList{}),
-		"Schedule":               newTypeInfo("schedules", &Schedule{}, &ScheduleList{}),
-		"DownloadRequest":        newTypeInfo("downloadrequests", &DownloadRequest{}, &DownloadRequestList{}),
-		"DeleteBackupRequest":    newTypeInfo("deletebackuprequests", &DeleteBackupRequest{}, &DeleteBackupRequestList{}),
-		"PodVolumeBackup":        newTypeInfo("podvolumebackups", &PodVolumeBackup{}, &PodVolumeBackupList{}),
-		"PodVolumeRestore":       newTypeInfo("podvolumerestores", &PodVolumeRestore{}, &PodVolumeRestoreList{}),
-		"BackupRepository":       newTypeInfo("backuprepositories", &BackupRepository{}, &BackupRepositoryList{}),
-		"BackupStorageLocation":  newTypeInfo("backupstoragelocations", &BackupStorageLocation{}, &BackupStorageLocationList{}),
-		"VolumeSnapshotLocation": newTypeInfo("volumesnapshotlocations", &VolumeSnapshotLocation{}, &VolumeSnapshotLocationList{}),
-		"ServerStatusRequest":    newTypeInfo("serverstatusrequests", &ServerStatusRequest{}, &ServerStatusRequestList{}),
+		"Backup":                         newTypeInfo("backups", &Backup{}, &BackupList{}),
+		"Restore":                        newTypeInfo("restores", &Restore{}, &RestoreList{}),
+		"Schedule":                       newTypeInfo("schedules", &Schedule{}, &ScheduleList{}),
+		"DownloadRequest":                newTypeInfo("downloadrequests", &DownloadRequest{}, &DownloadRequestList{}),
+		"DeleteBackupRequest":            newTypeInfo("deletebackuprequests", &DeleteBackupRequest{}, &DeleteBackupRequestList{}),
+		"PodVolumeBackup":                newTypeInfo("podvolumebackups", &PodVolumeBackup{}, &PodVolumeBackupList{}),
+		"PodVolumeRestore":               newTypeInfo("podvolumerestores", &PodVolumeRestore{}, &PodVolumeRestoreList{}),
+		"BackupRepository":               newTypeInfo("backuprepositories", &BackupRepository{}, &BackupRepositoryList{}),
+		"BackupStorageLocation":          newTypeInfo("backupstoragelocations", &BackupStorageLocation{}, &BackupStorageLocationList{}),
+		"BackupStorageLocationMigration": newTypeInfo("backupstoragelocationmigrations", &BackupStorageLocationMigration{}, &BackupStorageLocationMigrationList{}),
+		"BackupStorageLocationTest":      newTypeInfo("backupstoragelocationtests", &BackupStorageLocationTest{}, &BackupStorageLocationTestList{}),
+		"VolumeSnapshotLocation":         newTypeInfo("volumesnapshotlocations", &VolumeSnapshotLocation{}, &VolumeSnapshotLocationList{}),
+		"ServerStatusRequest":            newTypeInfo("serverstatusrequests", &ServerStatusRequest{}, &ServerStatusRequestList{}),
 	}
 }
 
@@ -117,11 +117,151 @@ type RestoreSpec struct {
 	// The default value is 1 hour.
 	// +optional
 	ItemOperationTimeout metav1.Duration `json:"itemOperationTimeout,omitempty"`
+
+	// Cancel indicates request to cancel the ongoing restore. It can be set
+	// when the restore is in New, InProgress or WaitingForPluginOperations phase.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// Paused indicates request to suspend the progress checking of the
+	// asynchronous RestoreItemAction operations of the restore until it is unset.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
+
+	// PreRestore is a list of ScopeHooks to execute once before any item is restored.
+	// +optional
+	PreRestore []ScopeHook `json:"preRestore,omitempty"`
+
+	// PostRestore is a list of ScopeHooks to execute once after all items have been
+	// restored and all async plugin operations have completed.
+	// +optional
+	PostRestore []ScopeHook `json:"postRestore,omitempty"`
+}
+
+// ScopeHook is a hook that runs once for the whole operation rather than once per item.
+// Exactly one of Job, Exec or HTTP must be specified.
+type ScopeHook struct {
+	// Name is the name of this hook.
+	Name string `json:"name"`
+
+	// Job defines a hook that runs a Kubernetes Job to completion.
+	// +optional
+	Job *JobHook `json:"job,omitempty"`
+
+	// Exec defines a hook that executes a command in a designated pod.
+	// +optional
+	Exec *ScopeExecHook `json:"exec,omitempty"`
+
+	// HTTP defines a hook that sends an HTTP request.
+	// +optional
+	HTTP *HTTPHook `json:"http,omitempty"`
+
+	// OnError specifies how Velero should behave if it encounters an error executing this hook.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the hook to complete before
+	// considering the execution a failure.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// JobHook is a hook that creates a Job from a template and waits for it to finish.
+type JobHook struct {
+	// Namespace is the namespace the Job is created in.
+	Namespace string `json:"namespace"`
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// JobTemplate is the batch/v1 JobTemplateSpec the Job is created from.
+	JobTemplate runtime.RawExtension `json:"jobTemplate"`
+}
+
+// ScopeExecHook is a hook that uses the pod exec API to execute a command in a single
+// designated pod. Exactly one of Pod or LabelSelector must be specified.
+type ScopeExecHook struct {
+	// Namespace is the namespace of the pod.
+	Namespace string `json:"namespace"`
+
+	// Pod is the name of the pod.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// LabelSelector selects the pod. If it matches more than one running pod, the first
+	// one by name is used.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// Container is the container in the pod where the command should be executed. If not specified,
+	// the pod's first container is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Command is the command and arguments to execute.
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+}
+
+// ScopeHookResult is the result of executing a scope hook.
+// +kubebuilder:validation:Enum=Succeeded;Failed;Started
+type ScopeHookResult string
+
+const (
+	ScopeHookResultSucceeded ScopeHookResult = "Succeeded"
+	ScopeHookResultFailed    ScopeHookResult = "Failed"
+
+	// ScopeHookResultStarted is recorded before a hook is executed, it's only kept if Velero
+	// stopped before the result of the hook was known.
+	ScopeHookResultStarted ScopeHookResult = "Started"
+)
+
+// ScopeHookStatus records the execution of a scope hook.
+type ScopeHookStatus struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+
+	// Phase is when the hook was executed, either "pre" or "post".
+	Phase string `json:"phase"`
+
+	// Result is the result of executing the hook.
+	Result ScopeHookResult `json:"result"`
+
+	// Error is the error the hook failed with.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// StartTimestamp records the time the hook was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the hook was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// HTTPHook is a hook that sends an HTTP request and expects a 2xx response.
+type HTTPHook struct {
+	// URL is the URL the request is sent to.
+	URL string `json:"url"`
+
+	// Method is the HTTP method of the request. Defaults to POST.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Headers are added to the request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is the body of the request.
+	// +optional
+	Body string `json:"body,omitempty"`
 }
 
 type RestoreStatusSpec struct {
@@ -225,7 +365,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed;Cancelled
 type RestorePhase string
 
 const (
@@ -265,6 +405,10 @@ const (
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
 
+	// RestorePhaseCancelled means the restore was cancelled by the user
+	// before it completed.
+	RestorePhaseCancelled RestorePhase = "Cancelled"
+
 	// PolicyTypeNone means velero will not overwrite the resource
 	// in cluster with the one in backup whether changed/unchanged.
 	PolicyTypeNone PolicyType = "none"
@@ -334,6 +478,16 @@ type RestoreStatus struct {
 	// RestoreItemAction operations for this restore which ended with an error.
 	// +optional
 	RestoreItemOperationsFailed int `json:"restoreItemOperationsFailed,omitempty"`
+
+	// ScopeHooks records the execution of the restore's pre-restore and post-restore hooks.
+	// +optional
+	// +nullable
+	ScopeHooks []ScopeHookStatus `json:"scopeHooks,omitempty"`
+
+	// HookStatus contains information about the status of the exec hooks.
+	// +optional
+	// +nullable
+	HookStatus *HookStatus `json:"hookStatus,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
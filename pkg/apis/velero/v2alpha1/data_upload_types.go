@@ -32,6 +32,11 @@ type DataUploadSpec struct {
 	// +nullable
 	CSISnapshot *CSISnapshotSpec `json:"csiSnapshot"`
 
+	// If SnapshotType is VolumeClone, VolumeClone provides the information of the volume clone.
+	// +optional
+	// +nullable
+	VolumeClone *VolumeCloneSpec `json:"volumeClone,omitempty"`
+
 	// SourcePVC is the name of the PVC which the snapshot is taken for.
 	SourcePVC string `json:"sourcePVC"`
 
@@ -65,7 +70,8 @@ type DataUploadSpec struct {
 type SnapshotType string
 
 const (
-	SnapshotTypeCSI SnapshotType = "CSI"
+	SnapshotTypeCSI         SnapshotType = "CSI"
+	SnapshotTypeVolumeClone SnapshotType = "VolumeClone"
 )
 
 // CSISnapshotSpec is the specification for a CSI snapshot.
@@ -81,6 +87,22 @@ type CSISnapshotSpec struct {
 	SnapshotClass string `json:"snapshotClass"`
 }
 
+// VolumeCloneSpec is the specification for a volume clone, which is a copy of the
+// source volume that is backed up instead of the source volume itself.
+type VolumeCloneSpec struct {
+	// PersistentVolume is the name of an unbound PV that holds the data to be backed up,
+	// e.g. a PV created from a native snapshot by a VolumeSnapshotter plugin.
+	// If it is empty, the source PVC is cloned through a PVC data source.
+	// The PV is deleted once the DataUpload completes.
+	// +optional
+	PersistentVolume string `json:"persistentVolume,omitempty"`
+
+	// StorageClass is the name of the storage class that the source PVC is cloned with.
+	// If it is empty, the storage class of the source PVC is used.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
 // DataUploadPhase represents the lifecycle phase of a DataUpload.
 // +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Canceling;Canceled;Completed;Failed
 type DataUploadPhase string
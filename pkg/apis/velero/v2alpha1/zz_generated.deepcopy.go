@@ -224,6 +224,11 @@ func (in *DataUploadSpec) DeepCopyInto(out *DataUploadSpec) {
 		*out = new(CSISnapshotSpec)
 		**out = **in
 	}
+	if in.VolumeClone != nil {
+		in, out := &in.VolumeClone, &out.VolumeClone
+		*out = new(VolumeCloneSpec)
+		**out = **in
+	}
 	if in.DataMoverConfig != nil {
 		in, out := &in.DataMoverConfig, &out.DataMoverConfig
 		*out = new(map[string]string)
@@ -297,3 +302,18 @@ func (in *TargetVolumeSpec) DeepCopy() *TargetVolumeSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeCloneSpec) DeepCopyInto(out *VolumeCloneSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeCloneSpec.
+func (in *VolumeCloneSpec) DeepCopy() *VolumeCloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeCloneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// ValidateCompression checks that the compression algorithm is supported and the level
+// is in its range.
+func ValidateCompression(compression *velerov1api.BackupCompression) error {
+	if compression == nil {
+		return nil
+	}
+
+	var maxLevel int
+	switch compression.Algorithm {
+	case velerov1api.CompressionAlgorithmNone:
+		if compression.Level != 0 {
+			return errors.New("compression level can't be set without a compression algorithm")
+		}
+		return nil
+	case velerov1api.CompressionAlgorithmGzip:
+		maxLevel = gzip.BestCompression
+	case velerov1api.CompressionAlgorithmZstd:
+		maxLevel = 22
+	default:
+		return errors.Errorf("unsupported compression algorithm %q, supported algorithms are %q, %q and %q", compression.Algorithm,
+			velerov1api.CompressionAlgorithmNone, velerov1api.CompressionAlgorithmGzip, velerov1api.CompressionAlgorithmZstd)
+	}
+
+	if compression.Level < 0 || compression.Level > maxLevel {
+		return errors.Errorf("%s compression level must be between 1 and %d", compression.Algorithm, maxLevel)
+	}
+
+	return nil
+}
+
+// NewCompressWriter returns a writer compressing what is written to it with the compression
+// before writing it to w. The tarball is compressed with gzip if compression is nil. Closing
+// the writer flushes the compressed data, but doesn't close w.
+func NewCompressWriter(w io.Writer, compression *velerov1api.BackupCompression) (io.WriteCloser, error) {
+	if err := ValidateCompression(compression); err != nil {
+		return nil, err
+	}
+
+	algorithm, level := velerov1api.CompressionAlgorithmGzip, 0
+	if compression != nil {
+		algorithm, level = compression.Algorithm, compression.Level
+	}
+
+	switch algorithm {
+	case velerov1api.CompressionAlgorithmNone:
+		return nopWriteCloser{w}, nil
+	case velerov1api.CompressionAlgorithmZstd:
+		if level == 0 {
+			return zstd.NewWriter(w)
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	default:
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		return gzip.NewWriterLevel(w, level)
+	}
+}
+
+// NewDecompressReader returns a reader decompressing r. The compression is detected from the
+// magic number at the start of r, so that the tarballs of all the backups are read whatever
+// they are compressed with. r is read as is if it isn't compressed.
+func NewDecompressReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "error reading the compression header")
+	}
+	// even a tarball without any file isn't empty
+	if len(magic) == 0 {
+		return nil, errors.WithStack(io.ErrUnexpectedEOF)
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, zstdMagic):
+		decoder, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating zstd reader")
+		}
+		return decoder.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
+
+// ContentsFileExtension returns the extension of a file holding the contents tarball of
+// a backup compressed with the compression.
+func ContentsFileExtension(compression *velerov1api.BackupCompression) string {
+	if compression == nil {
+		return ".tar.gz"
+	}
+
+	switch compression.Algorithm {
+	case velerov1api.CompressionAlgorithmNone:
+		return ".tar"
+	case velerov1api.CompressionAlgorithmZstd:
+		return ".tar.zst"
+	default:
+		return ".tar.gz"
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
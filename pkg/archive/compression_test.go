@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidateCompression(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression *velerov1api.BackupCompression
+		expectedErr string
+	}{
+		{
+			name: "nil compression is valid",
+		},
+		{
+			name:        "no compression",
+			compression: &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmNone},
+		},
+		{
+			name:        "no compression with a level",
+			compression: &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmNone, Level: 1},
+			expectedErr: "compression level can't be set without a compression algorithm",
+		},
+		{
+			name:        "gzip with the default level",
+			compression: &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmGzip},
+		},
+		{
+			name:        "gzip level out of range",
+			compression: &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 10},
+			expectedErr: "gzip compression level must be between 1 and 9",
+		},
+		{
+			name:        "zstd with the highest level",
+			compression: &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 22},
+		},
+		{
+			name:        "negative zstd level",
+			compression: &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: -1},
+			expectedErr: "zstd compression level must be between 1 and 22",
+		},
+		{
+			name:        "unsupported algorithm",
+			compression: &velerov1api.BackupCompression{Algorithm: "lz4"},
+			expectedErr: `unsupported compression algorithm "lz4", supported algorithms are "none", "gzip" and "zstd"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateCompression(tc.compression)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCompressAndDecompress(t *testing.T) {
+	data := bytes.Repeat([]byte("velero backup contents "), 1000)
+
+	tests := []struct {
+		name          string
+		compression   *velerov1api.BackupCompression
+		expectedMagic []byte
+		expectedExt   string
+	}{
+		{
+			name:          "nil compression is gzip",
+			expectedMagic: gzipMagic,
+			expectedExt:   ".tar.gz",
+		},
+		{
+			name:          "gzip",
+			compression:   &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 9},
+			expectedMagic: gzipMagic,
+			expectedExt:   ".tar.gz",
+		},
+		{
+			name:          "zstd",
+			compression:   &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmZstd},
+			expectedMagic: zstdMagic,
+			expectedExt:   ".tar.zst",
+		},
+		{
+			name:          "zstd with a level",
+			compression:   &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 19},
+			expectedMagic: zstdMagic,
+			expectedExt:   ".tar.zst",
+		},
+		{
+			name:          "none",
+			compression:   &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmNone},
+			expectedMagic: data[:4],
+			expectedExt:   ".tar",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w, err := NewCompressWriter(buf, tc.compression)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			assert.True(t, bytes.HasPrefix(buf.Bytes(), tc.expectedMagic))
+			assert.Equal(t, tc.expectedExt, ContentsFileExtension(tc.compression))
+
+			r, err := NewDecompressReader(buf)
+			require.NoError(t, err)
+			defer r.Close()
+			read, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, read)
+		})
+	}
+}
+
+func TestNewCompressWriterInvalidCompression(t *testing.T) {
+	_, err := NewCompressWriter(new(bytes.Buffer), &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 12})
+	assert.EqualError(t, err, "gzip compression level must be between 1 and 9")
+}
+
+func TestNewDecompressReaderEmpty(t *testing.T) {
+	_, err := NewDecompressReader(new(bytes.Buffer))
+	assert.Error(t, err)
+}
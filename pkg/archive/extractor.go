@@ -18,7 +18,6 @@ package archive
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"io"
 	"path/filepath"
 
@@ -41,16 +40,16 @@ func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
 	}
 }
 
-// UnzipAndExtractBackup extracts a reader on a gzipped tarball to a local temp directory
+// UnzipAndExtractBackup extracts a reader on a compressed tarball to a local temp directory
 func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
-	gzr, err := gzip.NewReader(src)
+	cr, err := NewDecompressReader(src)
 	if err != nil {
-		e.log.Infof("error creating gzip reader: %v", err)
+		e.log.Infof("error creating decompress reader: %v", err)
 		return "", err
 	}
-	defer gzr.Close()
+	defer cr.Close()
 
-	return e.readBackup(tar.NewReader(gzr))
+	return e.readBackup(tar.NewReader(cr))
 }
 
 func (e *Extractor) writeFile(target string, tarRdr *tar.Reader) error {
@@ -0,0 +1,256 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ClusterArchive is the archive holding the cluster-scoped resources and the metadata of a
+// backup whose contents are split per namespace.
+const ClusterArchive = "cluster"
+
+// NamespaceArchive returns the archive holding the resources of a namespace of a backup whose
+// contents are split per namespace.
+func NamespaceArchive(namespace string) string {
+	return path.Join(velerov1api.NamespaceScopedDir, namespace)
+}
+
+// IsValidArchive returns whether archive is the cluster archive or the archive of a namespace.
+func IsValidArchive(archive string) bool {
+	if archive == ClusterArchive {
+		return true
+	}
+	namespace := strings.TrimPrefix(archive, velerov1api.NamespaceScopedDir+"/")
+	return namespace != archive && len(validation.IsDNS1123Label(namespace)) == 0
+}
+
+// Index lists the archives of a backup whose contents are split per namespace.
+type Index struct {
+	// Namespaces are the namespaces having resources in the backup, the resources of each
+	// namespace are in their own archive.
+	Namespaces []string `json:"namespaces"`
+}
+
+// Archives returns the cluster archive and the archives of the namespaces includeNamespace
+// returns true for. All the archives are returned if includeNamespace is nil.
+func (i *Index) Archives(includeNamespace func(namespace string) bool) []string {
+	archives := []string{ClusterArchive}
+	for _, namespace := range i.Namespaces {
+		if includeNamespace == nil || includeNamespace(namespace) {
+			archives = append(archives, NamespaceArchive(namespace))
+		}
+	}
+	return archives
+}
+
+// SplitByNamespace splits a compressed tarball of a backup into an archive per namespace holding
+// the namespace's resources, and the cluster archive holding everything else. The archives are
+// compressed with the compression and passed to put one at a time. The tarball is extracted to
+// a temp directory first, since the items of a namespace aren't next to each other in it.
+func (e *Extractor) SplitByNamespace(src io.Reader, compression *velerov1api.BackupCompression, put func(archive string, contents io.Reader) error) (*Index, error) {
+	cr, err := NewDecompressReader(src)
+	if err != nil {
+		e.log.Infof("error creating decompress reader: %v", err)
+		return nil, err
+	}
+	defer cr.Close()
+
+	dir, err := e.fs.TempDir("", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp dir")
+	}
+	defer e.fs.RemoveAll(dir)
+
+	// only the names of the files of each archive are kept in memory
+	files := map[string][]string{}
+	tr := tar.NewReader(cr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tar")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		target := filepath.Join(dir, header.Name) //nolint:gosec
+		if err := e.fs.MkdirAll(filepath.Dir(target), header.FileInfo().Mode()); err != nil {
+			return nil, errors.Wrap(err, "error creating directory")
+		}
+		if err := e.writeFile(target, tr); err != nil {
+			return nil, errors.Wrap(err, "error writing file")
+		}
+
+		archive := ClusterArchive
+		if namespace := itemNamespace(header.Name); namespace != "" {
+			archive = NamespaceArchive(namespace)
+		}
+		files[archive] = append(files[archive], header.Name)
+	}
+
+	index := &Index{}
+	for archive := range files {
+		if archive != ClusterArchive {
+			index.Namespaces = append(index.Namespaces, strings.TrimPrefix(archive, velerov1api.NamespaceScopedDir+"/"))
+		}
+	}
+	sort.Strings(index.Namespaces)
+
+	for _, archive := range index.Archives(nil) {
+		if err := e.putArchive(dir, files[archive], compression, func(r io.Reader) error {
+			return put(archive, r)
+		}); err != nil {
+			return nil, errors.Wrapf(err, "error putting archive %s", archive)
+		}
+	}
+
+	return index, nil
+}
+
+// putArchive streams a compressed tarball of the extracted files to put.
+func (e *Extractor) putArchive(dir string, names []string, compression *velerov1api.BackupCompression, put func(io.Reader) error) error {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+
+	go func() {
+		done <- writeArchive(pw, compression, names, func(name string) ([]byte, error) {
+			return e.fs.ReadFile(filepath.Join(dir, name))
+		})
+	}()
+
+	err := put(pr)
+	// unblock the writes if put stopped before reading everything
+	pr.CloseWithError(errors.New("the archive wasn't read to the end"))
+	if writeErr := <-done; err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+func writeArchive(pw *io.PipeWriter, compression *velerov1api.BackupCompression, names []string, readFile func(string) ([]byte, error)) error {
+	err := func() error {
+		cw, err := NewCompressWriter(pw, compression)
+		if err != nil {
+			return err
+		}
+		tw := tar.NewWriter(cw)
+
+		for _, name := range names {
+			data, err := readFile(name)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if err := tw.WriteHeader(&tar.Header{
+				Name:     name,
+				Size:     int64(len(data)),
+				Typeflag: tar.TypeReg,
+				Mode:     0755,
+				ModTime:  time.Now(),
+			}); err != nil {
+				return errors.WithStack(err)
+			}
+			if _, err := tw.Write(data); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		if err := tw.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(cw.Close())
+	}()
+
+	pw.CloseWithError(err)
+	return err
+}
+
+// MergeArchives writes the items of the archives of a backup whose contents are split per
+// namespace to w as a single uncompressed tarball. The archives are opened one at a time.
+func MergeArchives(w io.Writer, archives []string, open func(archive string) (io.ReadCloser, error)) error {
+	tw := tar.NewWriter(w)
+
+	for _, archive := range archives {
+		if err := copyArchive(tw, archive, open); err != nil {
+			return errors.Wrapf(err, "error merging archive %s", archive)
+		}
+	}
+
+	return errors.WithStack(tw.Close())
+}
+
+func copyArchive(tw *tar.Writer, archive string, open func(archive string) (io.ReadCloser, error)) error {
+	rc, err := open(archive)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	cr, err := NewDecompressReader(rc)
+	if err != nil {
+		return err
+	}
+	defer cr.Close()
+
+	tr := tar.NewReader(cr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec
+			return errors.WithStack(err)
+		}
+	}
+}
+
+// itemNamespace returns the namespace of the item at a path of a backup tarball, or an empty
+// string if the path isn't the one of a namespace-scoped item. The paths of the items are
+// resources/<group-resource>/[<version>/]namespaces/<namespace>/<name>.json.
+func itemNamespace(name string) string {
+	parts := strings.Split(name, "/")
+	if len(parts) < 5 || parts[0] != velerov1api.ResourcesDir {
+		return ""
+	}
+
+	for i := 2; i <= 3 && i+2 < len(parts); i++ {
+		if parts[i] == velerov1api.NamespaceScopedDir {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,179 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+var splitTestFiles = map[string]string{
+	"metadata/version":                                              "1.1.0",
+	"resources/namespaces/cluster/ns-1.json":                        "namespace ns-1",
+	"resources/persistentvolumes/cluster/pv-1.json":                 "pv-1",
+	"resources/pods/namespaces/ns-1/pod-1.json":                     "pod-1",
+	"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json": "pod-1 preferred",
+	"resources/configmaps/namespaces/ns-2/cm-1.json":                "cm-1",
+}
+
+func newSplitTestTarball(t *testing.T, compression *velerov1api.BackupCompression) *bytes.Buffer {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	cw, err := NewCompressWriter(buf, compression)
+	require.NoError(t, err)
+	tw := tar.NewWriter(cw)
+	for name, data := range splitTestFiles {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(data)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, cw.Close())
+
+	return buf
+}
+
+func readTarball(t *testing.T, r io.Reader) map[string]string {
+	t.Helper()
+
+	cr, err := NewDecompressReader(r)
+	require.NoError(t, err)
+	defer cr.Close()
+
+	files := map[string]string{}
+	tr := tar.NewReader(cr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(data)
+	}
+}
+
+func TestSplitByNamespaceAndMergeArchives(t *testing.T) {
+	compression := &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmZstd}
+	ext := NewExtractor(test.NewLogger(), test.NewFakeFileSystem())
+
+	archives := map[string][]byte{}
+	var order []string
+	index, err := ext.SplitByNamespace(newSplitTestTarball(t, compression), compression, func(archive string, contents io.Reader) error {
+		data, err := io.ReadAll(contents)
+		if err != nil {
+			return err
+		}
+		archives[archive] = data
+		order = append(order, archive)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ns-1", "ns-2"}, index.Namespaces)
+	assert.Equal(t, []string{"cluster", "namespaces/ns-1", "namespaces/ns-2"}, order)
+
+	assert.True(t, bytes.HasPrefix(archives[ClusterArchive], zstdMagic))
+	assert.Equal(t, map[string]string{
+		"metadata/version":                              "1.1.0",
+		"resources/namespaces/cluster/ns-1.json":        "namespace ns-1",
+		"resources/persistentvolumes/cluster/pv-1.json": "pv-1",
+	}, readTarball(t, bytes.NewReader(archives[ClusterArchive])))
+	assert.Equal(t, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json":                     "pod-1",
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json": "pod-1 preferred",
+	}, readTarball(t, bytes.NewReader(archives[NamespaceArchive("ns-1")])))
+	assert.Equal(t, map[string]string{
+		"resources/configmaps/namespaces/ns-2/cm-1.json": "cm-1",
+	}, readTarball(t, bytes.NewReader(archives[NamespaceArchive("ns-2")])))
+
+	merged := new(bytes.Buffer)
+	var opened []string
+	require.NoError(t, MergeArchives(merged, index.Archives(func(namespace string) bool { return namespace == "ns-2" }), func(archive string) (io.ReadCloser, error) {
+		opened = append(opened, archive)
+		return io.NopCloser(bytes.NewReader(archives[archive])), nil
+	}))
+
+	assert.Equal(t, []string{"cluster", "namespaces/ns-2"}, opened)
+	assert.Equal(t, map[string]string{
+		"metadata/version":                               "1.1.0",
+		"resources/namespaces/cluster/ns-1.json":         "namespace ns-1",
+		"resources/persistentvolumes/cluster/pv-1.json":  "pv-1",
+		"resources/configmaps/namespaces/ns-2/cm-1.json": "cm-1",
+	}, readTarball(t, merged))
+}
+
+func TestSplitByNamespacePutError(t *testing.T) {
+	ext := NewExtractor(test.NewLogger(), test.NewFakeFileSystem())
+
+	_, err := ext.SplitByNamespace(newSplitTestTarball(t, nil), nil, func(archive string, contents io.Reader) error {
+		if archive == NamespaceArchive("ns-1") {
+			return errors.New("fake-error")
+		}
+		_, err := io.Copy(io.Discard, contents)
+		return err
+	})
+	assert.EqualError(t, err, "error putting archive namespaces/ns-1: fake-error")
+}
+
+func TestMergeArchivesOpenError(t *testing.T) {
+	err := MergeArchives(io.Discard, []string{ClusterArchive}, func(string) (io.ReadCloser, error) {
+		return nil, errors.New("fake-error")
+	})
+	assert.EqualError(t, err, "error merging archive cluster: fake-error")
+}
+
+func TestItemNamespace(t *testing.T) {
+	tests := map[string]string{
+		"metadata/version":                                              "",
+		"resources/namespaces/cluster/ns-1.json":                        "",
+		"resources/namespaces/v1-preferredversion/cluster/ns-1.json":    "",
+		"resources/pods/namespaces/ns-1/pod-1.json":                     "ns-1",
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json": "ns-1",
+		"resources/pods/namespaces/ns-1":                                "",
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, itemNamespace(name), name)
+	}
+}
+
+func TestIsValidArchive(t *testing.T) {
+	for archive, valid := range map[string]bool{
+		"cluster":                 true,
+		"namespaces/ns-1":         true,
+		"":                        false,
+		"namespaces/":             false,
+		"namespaces/ns-1/other":   false,
+		"namespaces/../cluster":   false,
+		"../other-backup/cluster": false,
+		"resources/ns-1":          false,
+	} {
+		assert.Equal(t, valid, IsValidArchive(archive), archive)
+	}
+}
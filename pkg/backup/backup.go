@@ -18,7 +18,6 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -37,6 +36,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -172,8 +172,8 @@ type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (vsv1.VolumeSnapshotter, error)
 }
 
-// Backup backs up the items specified in the Backup, placing them in a gzip-compressed tar file
-// written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
+// Backup backs up the items specified in the Backup, placing them in a tar file compressed with
+// the compression of the backup status, and written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
@@ -188,10 +188,13 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	backupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
 	volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressedData, err := archive.NewCompressWriter(backupFile, backupRequest.Status.Compression)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
+	tw := tar.NewWriter(compressedData)
 	defer tw.Close()
 
 	log.Info("Writing backup version file")
@@ -221,7 +224,6 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 	log.Infof("Backing up all volumes using pod volume backup: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToFsBackup))
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from getResourceHooks")
@@ -246,7 +248,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	ctx, cancelFunc := context.WithTimeout(backupRequest.Context(), podVolumeTimeout)
 	defer cancelFunc()
 
 	var podVolumeBackupper podvolume.Backupper
@@ -301,6 +303,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		volumeSnapshotterGetter:  volumeSnapshotterGetter,
 		itemHookHandler: &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
+			HookTracker:        backupRequest.GetHookTracker(),
 		},
 	}
 
@@ -356,6 +359,11 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	backedUpGroupResources := map[schema.GroupResource]bool{}
 
 	for i, item := range items {
+		if backupRequest.IsCancelled() {
+			log.Infof("Backup is canceled, skipping the remaining %d items", len(items)-i)
+			break
+		}
+
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
 			"resource":  item.groupResource.String(),
@@ -411,7 +419,8 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	// We should only need to do this if we've backed up at least one item for the resource
 	// and the CRD type(this is the CRD type itself) is neither included or excluded.
 	// When it's included, the resource's CRD is already handled. When it's excluded, no need to check.
-	if !backupRequest.ResourceIncludesExcludes.ShouldExclude(kuberesource.CustomResourceDefinitions.String()) &&
+	if !backupRequest.IsCancelled() &&
+		!backupRequest.ResourceIncludesExcludes.ShouldExclude(kuberesource.CustomResourceDefinitions.String()) &&
 		!backupRequest.ResourceIncludesExcludes.ShouldInclude(kuberesource.CustomResourceDefinitions.String()) {
 		for gr := range backedUpGroupResources {
 			kb.backupCRD(log, gr, itemBackupper)
@@ -540,18 +549,21 @@ func (kb *kubernetesBackupper) FinalizeBackup(log logrus.FieldLogger,
 	outBackupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
 	asyncBIAOperations []*itemoperation.BackupOperation) error {
-	gzw := gzip.NewWriter(outBackupFile)
-	defer gzw.Close()
-	tw := tar.NewWriter(gzw)
+	cw, err := archive.NewCompressWriter(outBackupFile, backupRequest.Status.Compression)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer cw.Close()
+	tw := tar.NewWriter(cw)
 	defer tw.Close()
 
-	gzr, err := gzip.NewReader(inBackupFile)
+	cr, err := archive.NewDecompressReader(inBackupFile)
 	if err != nil {
-		log.Infof("error creating gzip reader: %v", err)
+		log.Infof("error creating decompress reader: %v", err)
 		return err
 	}
-	defer gzr.Close()
-	tr := tar.NewReader(gzr)
+	defer cr.Close()
+	tr := tar.NewReader(cr)
 
 	backupRequest.ResolvedActions, err = backupItemActionResolver.ResolveActions(kb.discoveryHelper, log)
 	if err != nil {
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	kbClient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -48,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
@@ -57,7 +59,12 @@ import (
 
 const (
 	mustIncludeAdditionalItemAnnotation = "backup.velero.io/must-include-additional-items"
-	excludeFromBackupLabel              = "velero.io/exclude-from-backup"
+	excludeFromBackupLabel              = velerov1api.ExcludeFromBackupLabel
+
+	// defaultSnapshotVolumeTimeout is how long creating a volume from a native snapshot is retried
+	// for while the snapshot isn't ready, when the backup doesn't set the CSI snapshot timeout.
+	defaultSnapshotVolumeTimeout = 10 * time.Minute
+	snapshotVolumeInterval       = 5 * time.Second
 )
 
 // itemBackupper can back up individual items to a tar writer.
@@ -329,6 +336,15 @@ func (ib *itemBackupper) executeActions(
 			log.Infof("Skip executing Backup Item Action: %s of resource %s: %s/%s for the matched resource policies", actionName, groupResource, namespace, name)
 			continue
 		}
+		if actionName == VolumeCloneActionName && groupResource == kuberesource.PersistentVolumeClaims && ib.podVolumeSnapshotTracker.Has(namespace, name) {
+			log.Infof("Skip executing Backup Item Action: %s of PVC %s/%s because the volume is being backed up with pod volume backup", actionName, namespace, name)
+			continue
+		}
+		if actionName == VolumeCloneActionName && groupResource == kuberesource.PersistentVolumeClaims && !finalize {
+			if err := ib.createSnapshotVolume(obj, log); err != nil {
+				return nil, itemFiles, errors.Wrapf(err, "error creating the volume to move the data of PVC %s/%s from", namespace, name)
+			}
+		}
 
 		updatedItem, additionalItemIdentifiers, operationID, postOperationItems, err := action.Execute(obj, ib.backupRequest.Backup)
 
@@ -484,11 +500,10 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		return nil
 	}
 
-	// TODO: Snapshot data mover is only supported for CSI plugin scenario by now.
-	// Need to add a mechanism to choose running which plugin for resources.
-	// After that, this warning can be removed.
-	if boolptr.IsSetToTrue(ib.backupRequest.Spec.SnapshotMoveData) {
-		log.Warnf("VolumeSnapshotter plugin doesn't support data movement.")
+	// the data of claimed volumes is moved by the VolumeCloneAction of the PVC
+	if pv.Spec.ClaimRef != nil && movesDataByVolumeClone(ib.backupRequest.Backup, pv) {
+		log.Info("Skipping snapshot of persistent volume because its data is moved from a clone of the volume.")
+		return nil
 	}
 
 	if ib.backupRequest.ResPolicies != nil {
@@ -501,6 +516,46 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		}
 	}
 
+	pvFailureDomainZone := pvZone(pv, log)
+
+	volumeSnapshotter, volumeID, location := ib.getVolumeSnapshotter(obj, log)
+	if volumeSnapshotter == nil {
+		// the PV may still has change to be snapshotted by CSI plugin's `PVCBackupItemAction` in PVC backup logic
+		log.Info("Persistent volume is not a supported volume type for Velero-native volumeSnapshotter snapshot, skipping.")
+		return nil
+	}
+
+	log = log.WithField("volumeID", volumeID)
+
+	tags := ib.snapshotTags(pv)
+
+	log.Info("Getting volume information")
+	volumeType, iops, err := volumeSnapshotter.GetVolumeInfo(volumeID, pvFailureDomainZone)
+	if err != nil {
+		return errors.WithMessage(err, "error getting volume info")
+	}
+
+	log.Info("Snapshotting persistent volume")
+	snapshot := volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops)
+
+	var errs []error
+	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
+		snapshot.Status.Phase = volume.SnapshotPhaseFailed
+	} else {
+		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+		snapshot.Status.ProviderSnapshotID = snapshotID
+	}
+	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+
+	// nil errors are automatically removed
+	return kubeerrs.NewAggregate(errs)
+}
+
+// pvZone returns the availability zone of the volume of a PersistentVolume, or an empty
+// string if it's unknown.
+func pvZone(pv *corev1api.PersistentVolume, log logrus.FieldLogger) string {
 	// TODO: -- once failure-domain.beta.kubernetes.io/zone is no longer
 	// supported in any velero-supported version of Kubernetes, remove fallback checking of it
 	pvFailureDomainZone, labelFound := pv.Labels[zoneLabel]
@@ -518,12 +573,13 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 			}
 		}
 	}
+	return pvFailureDomainZone
+}
 
-	var (
-		volumeID, location string
-		volumeSnapshotter  vsv1.VolumeSnapshotter
-	)
-
+// getVolumeSnapshotter returns the volume snapshotter of the first volume snapshot location
+// which supports the volume of the PersistentVolume, together with the ID of the volume and
+// the name of the location. The volume snapshotter is nil if no location supports the volume.
+func (ib *itemBackupper) getVolumeSnapshotter(obj runtime.Unstructured, log logrus.FieldLogger) (vsv1.VolumeSnapshotter, string, string) {
 	for _, snapshotLocation := range ib.backupRequest.SnapshotLocations {
 		log := log.WithField("volumeSnapshotLocation", snapshotLocation.Name)
 
@@ -533,7 +589,8 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 			continue
 		}
 
-		if volumeID, err = bs.GetVolumeID(obj); err != nil {
+		volumeID, err := bs.GetVolumeID(obj)
+		if err != nil {
 			log.WithError(err).Errorf("Error attempting to get volume ID for persistent volume")
 			continue
 		}
@@ -543,53 +600,136 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		}
 
 		log.Infof("Got volume ID for persistent volume")
-		volumeSnapshotter = bs
-		location = snapshotLocation.Name
-		break
+		return bs, volumeID, snapshotLocation.Name
 	}
 
-	if volumeSnapshotter == nil {
-		// the PV may still has change to be snapshotted by CSI plugin's `PVCBackupItemAction` in PVC backup logic
-		log.Info("Persistent volume is not a supported volume type for Velero-native volumeSnapshotter snapshot, skipping.")
-		return nil
-	}
-
-	log = log.WithField("volumeID", volumeID)
+	return nil, "", ""
+}
 
-	// create tags from the backup's labels
+// snapshotTags returns the tags of the native snapshots of the volume of the PersistentVolume,
+// created from the backup's labels.
+func (ib *itemBackupper) snapshotTags(pv *corev1api.PersistentVolume) map[string]string {
 	tags := map[string]string{}
 	for k, v := range ib.backupRequest.GetLabels() {
 		tags[k] = v
 	}
 	tags["velero.io/backup"] = ib.backupRequest.Name
 	tags["velero.io/pv"] = pv.Name
+	return tags
+}
 
-	log.Info("Getting volume information")
-	volumeType, iops, err := volumeSnapshotter.GetVolumeInfo(volumeID, pvFailureDomainZone)
+// createSnapshotVolume creates a PV from a native snapshot of the volume of a PVC whose data is
+// moved by the VolumeCloneAction, if the volume isn't a CSI one. Only CSI volumes can be cloned
+// through a PVC data source, so the VolumeCloneAction moves the data of any other volume from
+// this PV, which it finds by the backup and PVC UID labels.
+func (ib *itemBackupper) createSnapshotVolume(obj runtime.Unstructured, log logrus.FieldLogger) error {
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if pvc.Status.Phase != corev1api.ClaimBound || pvc.Spec.VolumeName == "" {
+		return nil
+	}
+
+	pv := new(corev1api.PersistentVolume)
+	if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		return errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+
+	if pv.Spec.CSI != nil || !movesDataByVolumeClone(ib.backupRequest.Backup, pv) {
+		return nil
+	}
+
+	log = log.WithField("persistentVolume", pv.Name)
+
+	pvObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pv)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	volumeSnapshotter, volumeID, _ := ib.getVolumeSnapshotter(&unstructured.Unstructured{Object: pvObj}, log)
+	if volumeSnapshotter == nil {
+		return errors.Errorf("no volume snapshot location supports the volume of persistent volume %s, its data can't be moved", pv.Name)
+	}
+
+	log = log.WithField("volumeID", volumeID)
+	zone := pvZone(pv, log)
+
+	volumeType, iops, err := volumeSnapshotter.GetVolumeInfo(volumeID, zone)
 	if err != nil {
 		return errors.WithMessage(err, "error getting volume info")
 	}
 
-	log.Info("Snapshotting persistent volume")
-	snapshot := volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops)
+	log.Info("Snapshotting persistent volume to move its data")
+	snapshotID, err := volumeSnapshotter.CreateSnapshot(volumeID, zone, ib.snapshotTags(pv))
+	if err != nil {
+		return errors.Wrap(err, "error taking snapshot of volume")
+	}
 
-	var errs []error
-	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
+	// the volume holds a copy of the data, so the snapshot isn't needed once the volume is created
+	defer func() {
+		if err := volumeSnapshotter.DeleteSnapshot(snapshotID); err != nil {
+			log.WithError(err).Warnf("Error deleting snapshot %s of volume", snapshotID)
+		}
+	}()
+
+	// the snapshot is taken asynchronously, so the volume can't be created from it until it's
+	// ready on some storage providers
+	var cloneVolumeID string
+	timeout := ib.backupRequest.Spec.CSISnapshotTimeout.Duration
+	if timeout == 0 {
+		timeout = defaultSnapshotVolumeTimeout
+	}
+	err = wait.PollImmediate(snapshotVolumeInterval, timeout, func() (bool, error) {
+		var err error
+		if cloneVolumeID, err = volumeSnapshotter.CreateVolumeFromSnapshot(snapshotID, volumeType, zone, iops); err != nil {
+			log.WithError(err).Debugf("Error creating volume from snapshot %s, retrying", snapshotID)
+			return false, nil
+		}
+		return true, nil
+	})
 	if err != nil {
-		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
-		snapshot.Status.Phase = volume.SnapshotPhaseFailed
-	} else {
-		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
-		snapshot.Status.ProviderSnapshotID = snapshotID
+		return errors.Wrapf(err, "error creating volume from snapshot %s", snapshotID)
+	}
+
+	clonePV := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "velero-clone-",
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel:        label.GetValidName(ib.backupRequest.Name),
+				velerov1api.BackupUIDLabel:         string(ib.backupRequest.UID),
+				velerov1api.PVCUIDLabel:            string(pvc.UID),
+				velerov1api.ExcludeFromBackupLabel: "true",
+			},
+		},
+		Spec: *pv.Spec.DeepCopy(),
 	}
-	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	clonePV.Spec.ClaimRef = nil
+	clonePV.Spec.PersistentVolumeReclaimPolicy = corev1api.PersistentVolumeReclaimDelete
 
-	// nil errors are automatically removed
-	return kubeerrs.NewAggregate(errs)
+	clonePVObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(clonePV)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	updated, err := volumeSnapshotter.SetVolumeID(&unstructured.Unstructured{Object: clonePVObj}, cloneVolumeID)
+	if err != nil {
+		return errors.Wrapf(err, "error setting volume ID %s on the clone of persistent volume %s", cloneVolumeID, pv.Name)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(updated.UnstructuredContent(), clonePV); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := ib.kbClient.Create(context.Background(), clonePV); err != nil {
+		return errors.Wrapf(err, "error creating the clone of persistent volume %s", pv.Name)
+	}
+
+	log.WithField("clonePersistentVolume", clonePV.Name).Info("Created a persistent volume from the snapshot of the volume")
+	return nil
 }
 
 func (ib *itemBackupper) getMatchAction(obj runtime.Unstructured, groupResource schema.GroupResource, backupItemActionName string) (*resourcepolicies.Action, error) {
-	if ib.backupRequest.ResPolicies != nil && groupResource == kuberesource.PersistentVolumeClaims && (backupItemActionName == "velero.io/csi-pvc-backupper" || backupItemActionName == "velero.io/vsphere-pvc-backupper") {
+	if ib.backupRequest.ResPolicies != nil && groupResource == kuberesource.PersistentVolumeClaims && (backupItemActionName == "velero.io/csi-pvc-backupper" || backupItemActionName == "velero.io/vsphere-pvc-backupper" || backupItemActionName == VolumeCloneActionName) {
 		pvc := corev1api.PersistentVolumeClaim{}
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &pvc); err != nil {
 			return nil, errors.WithStack(err)
@@ -17,15 +17,24 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kbClient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	vsmocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/volumesnapshotter/v1"
+	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func Test_resourceKey(t *testing.T) {
@@ -170,3 +179,79 @@ func Test_zoneFromPVNodeAffinity(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateSnapshotVolume(t *testing.T) {
+	nativePV := builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).
+		ClaimRef("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("topology.kubernetes.io/zone", "zone-1")).Result()
+	csiPV := builder.ForPersistentVolume("pv-2").CSI("csi.example.com", "handle").ClaimRef("ns-1", "pvc-2").Result()
+
+	pvc := func(name, volumeName string) runtime.Unstructured {
+		pvc := builder.ForPersistentVolumeClaim("ns-1", name).VolumeName(volumeName).Phase(corev1api.ClaimBound).Result()
+		pvc.UID = "uid-" + types.UID(name)
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: obj}
+	}
+
+	newItemBackupper := func(backup *velerov1api.Backup, snapshotter vsv1.VolumeSnapshotter) *itemBackupper {
+		backup.UID = "backup-uid"
+		return &itemBackupper{
+			backupRequest: &Request{
+				Backup:            backup,
+				SnapshotLocations: []*velerov1api.VolumeSnapshotLocation{builder.ForVolumeSnapshotLocation("velero", "vsl-1").Provider("provider-1").Result()},
+			},
+			kbClient:                velerotest.NewFakeControllerRuntimeClient(t, nativePV, csiPV),
+			volumeSnapshotterGetter: volumeSnapshotterGetter{"provider-1": snapshotter},
+		}
+	}
+
+	t.Run("volume is created from a native snapshot", func(t *testing.T) {
+		snapshotter := new(vsmocks.VolumeSnapshotter)
+		defer snapshotter.AssertExpectations(t)
+		snapshotter.On("Init", mock.Anything).Return(nil)
+		snapshotter.On("GetVolumeID", mock.Anything).Return("vol-1", nil)
+		snapshotter.On("GetVolumeInfo", "vol-1", "zone-1").Return("gp2", (*int64)(nil), nil)
+		snapshotter.On("CreateSnapshot", "vol-1", "zone-1", mock.Anything).Return("snap-1", nil)
+		snapshotter.On("CreateVolumeFromSnapshot", "snap-1", "gp2", "zone-1", (*int64)(nil)).Return("vol-2", nil)
+		snapshotter.On("SetVolumeID", mock.Anything, "vol-2").Return(func(pv runtime.Unstructured, _ string) runtime.Unstructured { return pv }, nil)
+		snapshotter.On("DeleteSnapshot", "snap-1").Return(nil)
+
+		ib := newItemBackupper(builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result(), snapshotter)
+		require.NoError(t, ib.createSnapshotVolume(pvc("pvc-1", "pv-1"), velerotest.NewLogger()))
+
+		pvs := new(corev1api.PersistentVolumeList)
+		require.NoError(t, ib.kbClient.List(context.Background(), pvs, kbClient.MatchingLabels{velerov1api.PVCUIDLabel: "uid-pvc-1"}))
+		require.Len(t, pvs.Items, 1)
+		assert.Equal(t, "backup-uid", pvs.Items[0].Labels[velerov1api.BackupUIDLabel])
+		assert.Equal(t, "true", pvs.Items[0].Labels[velerov1api.ExcludeFromBackupLabel])
+		assert.Nil(t, pvs.Items[0].Spec.ClaimRef)
+		assert.Equal(t, corev1api.PersistentVolumeReclaimDelete, pvs.Items[0].Spec.PersistentVolumeReclaimPolicy)
+	})
+
+	t.Run("CSI volume is cloned through a PVC data source", func(t *testing.T) {
+		snapshotter := new(vsmocks.VolumeSnapshotter)
+		defer snapshotter.AssertExpectations(t)
+
+		ib := newItemBackupper(builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result(), snapshotter)
+		require.NoError(t, ib.createSnapshotVolume(pvc("pvc-2", "pv-2"), velerotest.NewLogger()))
+	})
+
+	t.Run("snapshot data isn't moved", func(t *testing.T) {
+		snapshotter := new(vsmocks.VolumeSnapshotter)
+		defer snapshotter.AssertExpectations(t)
+
+		ib := newItemBackupper(builder.ForBackup("velero", "backup-1").Result(), snapshotter)
+		require.NoError(t, ib.createSnapshotVolume(pvc("pvc-1", "pv-1"), velerotest.NewLogger()))
+	})
+
+	t.Run("no volume snapshot location supports the volume", func(t *testing.T) {
+		snapshotter := new(vsmocks.VolumeSnapshotter)
+		defer snapshotter.AssertExpectations(t)
+		snapshotter.On("Init", mock.Anything).Return(nil)
+		snapshotter.On("GetVolumeID", mock.Anything).Return("", nil)
+
+		ib := newItemBackupper(builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result(), snapshotter)
+		assert.EqualError(t, ib.createSnapshotVolume(pvc("pvc-1", "pv-1"), velerotest.NewLogger()),
+			"no volume snapshot location supports the volume of persistent volume pv-1, its data can't be moved")
+	})
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
@@ -54,6 +56,10 @@ type Request struct {
 	CSISnapshots              []snapshotv1api.VolumeSnapshot
 	itemOperationsList        *[]*itemoperation.BackupOperation
 	ResPolicies               *resourcepolicies.Policies
+	hookTracker               *hook.HookTracker
+	ctxOnce                   sync.Once
+	ctx                       context.Context
+	cancelFunc                context.CancelFunc
 }
 
 // GetItemOperationsList returns ItemOperationsList, initializing it if necessary
@@ -65,6 +71,35 @@ func (r *Request) GetItemOperationsList() *[]*itemoperation.BackupOperation {
 	return r.itemOperationsList
 }
 
+// GetHookTracker returns the tracker of the backup's exec hook executions, initializing it if necessary
+func (r *Request) GetHookTracker() *hook.HookTracker {
+	if r.hookTracker == nil {
+		r.hookTracker = hook.NewHookTracker()
+	}
+	return r.hookTracker
+}
+
+// Context returns the context of the backup, it is done once the backup is canceled
+func (r *Request) Context() context.Context {
+	r.ctxOnce.Do(r.initContext)
+	return r.ctx
+}
+
+// Cancel requests to cancel the backup, it is safe to be called from other goroutines
+func (r *Request) Cancel() {
+	r.ctxOnce.Do(r.initContext)
+	r.cancelFunc()
+}
+
+// IsCancelled returns whether the backup is canceled
+func (r *Request) IsCancelled() bool {
+	return r.Context().Err() != nil
+}
+
+func (r *Request) initContext() {
+	r.ctx, r.cancelFunc = context.WithCancel(context.Background())
+}
+
 // BackupResourceList returns the list of backed up resources grouped by the API
 // Version and Kind
 func (r *Request) BackupResourceList() map[string][]string {
@@ -80,3 +80,17 @@ func TestRequest_BackupResourceListEntriesSorted(t *testing.T) {
 		"v1/Pod": {"ns1/pod1", "ns2/pod2"},
 	}, req.BackupResourceList())
 }
+
+func TestRequest_Cancel(t *testing.T) {
+	req := Request{}
+	assert.False(t, req.IsCancelled())
+	assert.NoError(t, req.Context().Err())
+
+	req.Cancel()
+	assert.True(t, req.IsCancelled())
+	assert.Error(t, req.Context().Err())
+
+	// canceling again is a no-op
+	req.Cancel()
+	assert.True(t, req.IsCancelled())
+}
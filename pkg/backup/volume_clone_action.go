@@ -0,0 +1,273 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// VolumeCloneActionName is the name the VolumeCloneAction is registered with.
+const VolumeCloneActionName = "velero.io/volume-clone-pvc-backupper"
+
+// VolumeCloneAction moves the data of the PVCs whose volumes are not snapshotted by the CSI
+// plugin with the snapshot data mover. It creates a DataUpload of type VolumeClone for each
+// PVC, which the node-agent backs up from a clone of the volume. The clone of a CSI volume is
+// created through a PVC data source, while the clone of any other volume is the PV that the
+// item backupper creates from a native snapshot of the volume before the action runs.
+type VolumeCloneAction struct {
+	log       logrus.FieldLogger
+	client    kbclient.Client
+	namespace string
+}
+
+// NewVolumeCloneAction creates a new VolumeCloneAction that creates DataUploads in the given namespace.
+func NewVolumeCloneAction(logger logrus.FieldLogger, client kbclient.Client, namespace string) *VolumeCloneAction {
+	return &VolumeCloneAction{
+		log:       logger,
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Name returns the name of the action.
+func (a *VolumeCloneAction) Name() string {
+	return "VolumeCloneAction"
+}
+
+// AppliesTo returns a ResourceSelector that applies only to PVCs.
+func (a *VolumeCloneAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}, nil
+}
+
+// Execute creates a DataUpload for the PVC if the backup moves snapshot data and the volume
+// of the PVC is not snapshotted by the CSI plugin. The DataUpload is backed up once it is done,
+// so that the data can be restored from it.
+func (a *VolumeCloneAction) Execute(item runtime.Unstructured, backup *velerov1api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+	a.log.Info("Executing VolumeCloneAction")
+
+	if backup.Status.Phase == velerov1api.BackupPhaseFinalizing || backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed {
+		return item, nil, "", nil, nil
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pvc); err != nil {
+		return nil, nil, "", nil, errors.Wrap(err, "unable to convert unstructured item to persistent volume claim")
+	}
+
+	if pvc.Status.Phase != corev1api.ClaimBound || pvc.Spec.VolumeName == "" {
+		return item, nil, "", nil, nil
+	}
+
+	pv := new(corev1api.PersistentVolume)
+	if err := a.client.Get(context.Background(), kbclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		return nil, nil, "", nil, errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+
+	if !movesDataByVolumeClone(backup, pv) {
+		return item, nil, "", nil, nil
+	}
+
+	log := a.log.WithField("pvc", pvc.Namespace+"/"+pvc.Name)
+
+	// only CSI volumes can be cloned through a PVC data source
+	volumeClone := &velerov2alpha1api.VolumeCloneSpec{}
+	if pv.Spec.CSI == nil {
+		clonePV, err := a.getSnapshotVolume(backup, pvc)
+		if err != nil {
+			return nil, nil, "", nil, err
+		}
+		volumeClone.PersistentVolume = clonePV
+	}
+
+	operationID := label.GetValidName(string(velerov1api.AsyncOperationIDPrefixDataUpload) + string(backup.UID) + "." + string(pvc.UID))
+	dataUpload := &velerov2alpha1api.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: backup.Name + "-",
+			Namespace:    a.namespace,
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel:       label.GetValidName(backup.Name),
+				velerov1api.BackupUIDLabel:        string(backup.UID),
+				velerov1api.PVCUIDLabel:           string(pvc.UID),
+				velerov1api.AsyncOperationIDLabel: operationID,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "Backup",
+					Name:       backup.Name,
+					UID:        backup.UID,
+					Controller: boolptr.True(),
+				},
+			},
+		},
+		Spec: velerov2alpha1api.DataUploadSpec{
+			SnapshotType:          velerov2alpha1api.SnapshotTypeVolumeClone,
+			VolumeClone:           volumeClone,
+			SourcePVC:             pvc.Name,
+			SourceNamespace:       pvc.Namespace,
+			DataMover:             backup.Spec.DataMover,
+			BackupStorageLocation: backup.Spec.StorageLocation,
+			OperationTimeout:      backup.Spec.CSISnapshotTimeout,
+		},
+	}
+
+	if err := a.client.Create(context.Background(), dataUpload); err != nil {
+		return nil, nil, "", nil, errors.Wrapf(err, "error creating DataUpload for PVC %s/%s", pvc.Namespace, pvc.Name)
+	}
+
+	log.WithField("dataUpload", dataUpload.Name).Info("DataUpload is created to move the data of a clone of the volume")
+
+	itemsToUpdate := []velero.ResourceIdentifier{
+		{
+			GroupResource: schema.GroupResource{
+				Group:    velerov2alpha1api.SchemeGroupVersion.Group,
+				Resource: "datauploads",
+			},
+			Namespace: dataUpload.Namespace,
+			Name:      dataUpload.Name,
+		},
+	}
+
+	return item, nil, operationID, itemsToUpdate, nil
+}
+
+// getSnapshotVolume returns the name of the PV created from a native snapshot of the volume
+// of the PVC for the backup.
+func (a *VolumeCloneAction) getSnapshotVolume(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim) (string, error) {
+	list := new(corev1api.PersistentVolumeList)
+	if err := a.client.List(context.Background(), list, kbclient.MatchingLabels{
+		velerov1api.BackupUIDLabel: string(backup.UID),
+		velerov1api.PVCUIDLabel:    string(pvc.UID),
+	}); err != nil {
+		return "", errors.Wrapf(err, "error listing the volumes created from the snapshot of PVC %s/%s", pvc.Namespace, pvc.Name)
+	}
+
+	if len(list.Items) == 0 {
+		return "", errors.Errorf("no volume was created from a snapshot of PVC %s/%s", pvc.Namespace, pvc.Name)
+	}
+	if len(list.Items) > 1 {
+		return "", errors.Errorf("multiple volumes were created from the snapshot of PVC %s/%s", pvc.Namespace, pvc.Name)
+	}
+
+	return list.Items[0].Name, nil
+}
+
+// Progress reports the progress of the DataUpload of the operation.
+func (a *VolumeCloneAction) Progress(operationID string, backup *velerov1api.Backup) (velero.OperationProgress, error) {
+	progress := velero.OperationProgress{}
+
+	dataUpload, err := a.getDataUpload(operationID)
+	if err != nil {
+		return progress, err
+	}
+
+	progress.Description = string(dataUpload.Status.Phase)
+	progress.OperationUnits = "Bytes"
+	progress.NCompleted = dataUpload.Status.Progress.BytesDone
+	progress.NTotal = dataUpload.Status.Progress.TotalBytes
+
+	if dataUpload.Status.StartTimestamp != nil {
+		progress.Started = dataUpload.Status.StartTimestamp.Time
+	}
+	progress.Updated = time.Now()
+	if dataUpload.Status.CompletionTimestamp != nil {
+		progress.Updated = dataUpload.Status.CompletionTimestamp.Time
+	}
+
+	switch dataUpload.Status.Phase {
+	case velerov2alpha1api.DataUploadPhaseCompleted:
+		progress.Completed = true
+	case velerov2alpha1api.DataUploadPhaseFailed, velerov2alpha1api.DataUploadPhaseCanceled:
+		progress.Completed = true
+		progress.Err = dataUpload.Status.Message
+		if progress.Err == "" {
+			progress.Err = "DataUpload is " + string(dataUpload.Status.Phase)
+		}
+	}
+
+	return progress, nil
+}
+
+// Cancel requests the cancellation of the DataUpload of the operation.
+func (a *VolumeCloneAction) Cancel(operationID string, backup *velerov1api.Backup) error {
+	dataUpload, err := a.getDataUpload(operationID)
+	if err != nil {
+		return err
+	}
+
+	original := dataUpload.DeepCopy()
+	dataUpload.Spec.Cancel = true
+	if err := a.client.Patch(context.Background(), dataUpload, kbclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error canceling DataUpload %s", dataUpload.Name)
+	}
+
+	return nil
+}
+
+func (a *VolumeCloneAction) getDataUpload(operationID string) (*velerov2alpha1api.DataUpload, error) {
+	if operationID == "" {
+		return nil, errors.New("operation ID is empty")
+	}
+
+	list := new(velerov2alpha1api.DataUploadList)
+	if err := a.client.List(context.Background(), list, kbclient.InNamespace(a.namespace), kbclient.MatchingLabels{velerov1api.AsyncOperationIDLabel: operationID}); err != nil {
+		return nil, errors.Wrapf(err, "error listing DataUploads of operation %s", operationID)
+	}
+
+	if len(list.Items) == 0 {
+		return nil, errors.Errorf("no DataUpload found for operation %s", operationID)
+	}
+	if len(list.Items) > 1 {
+		return nil, errors.Errorf("multiple DataUploads found for operation %s", operationID)
+	}
+
+	return &list.Items[0], nil
+}
+
+// movesDataByVolumeClone returns true if the data of the volume is moved by the VolumeCloneAction
+// instead of being kept as the snapshot of a VolumeSnapshotter plugin. CSI volumes are left to
+// the CSI plugin when the CSI feature is enabled.
+func movesDataByVolumeClone(backup *velerov1api.Backup, pv *corev1api.PersistentVolume) bool {
+	if !boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData) || boolptr.IsSetToFalse(backup.Spec.SnapshotVolumes) {
+		return false
+	}
+
+	if !datamover.IsBuiltInDataMover(backup.Spec.DataMover) {
+		return false
+	}
+
+	return !features.IsEnabled(velerov1api.CSIFeatureFlag) || pv.Spec.CSI == nil
+}
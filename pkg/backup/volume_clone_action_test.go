@@ -0,0 +1,200 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestMovesDataByVolumeClone(t *testing.T) {
+	nativePV := builder.ForPersistentVolume("pv-1").Result()
+	csiPV := builder.ForPersistentVolume("pv-2").CSI("csi.example.com", "handle").Result()
+
+	tests := []struct {
+		name       string
+		backup     *velerov1api.Backup
+		pv         *corev1api.PersistentVolume
+		csiEnabled bool
+		expected   bool
+	}{
+		{
+			name:     "snapshot data isn't moved",
+			backup:   builder.ForBackup("velero", "backup-1").Result(),
+			pv:       nativePV,
+			expected: false,
+		},
+		{
+			name:     "not the built-in data mover",
+			backup:   builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).DataMover("other").Result(),
+			pv:       nativePV,
+			expected: false,
+		},
+		{
+			name:     "volume snapshots are disabled",
+			backup:   builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).SnapshotVolumes(false).Result(),
+			pv:       nativePV,
+			expected: false,
+		},
+		{
+			name:     "native volume",
+			backup:   builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result(),
+			pv:       nativePV,
+			expected: true,
+		},
+		{
+			name:       "CSI volume is left to the CSI plugin",
+			backup:     builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result(),
+			pv:         csiPV,
+			csiEnabled: true,
+			expected:   false,
+		},
+		{
+			name:     "CSI volume without the CSI feature",
+			backup:   builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result(),
+			pv:       csiPV,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.csiEnabled {
+				features.Enable(velerov1api.CSIFeatureFlag)
+				defer features.Disable(velerov1api.CSIFeatureFlag)
+			}
+			assert.Equal(t, tc.expected, movesDataByVolumeClone(tc.backup, tc.pv))
+		})
+	}
+}
+
+func TestVolumeCloneActionExecute(t *testing.T) {
+	pv := builder.ForPersistentVolume("pv-1").Result()
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Phase(corev1api.ClaimBound).Result()
+	pvc.UID = "pvc-uid"
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+	require.NoError(t, err)
+
+	backup := builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).StorageLocation("default").Result()
+	backup.UID = "backup-uid"
+
+	clonePV := builder.ForPersistentVolume("clone-1").ObjectMeta(builder.WithLabels(velerov1api.BackupUIDLabel, "backup-uid", velerov1api.PVCUIDLabel, "pvc-uid")).Result()
+
+	client := velerotest.NewFakeControllerRuntimeClient(t, pv, clonePV)
+	action := NewVolumeCloneAction(velerotest.NewLogger(), client, "velero")
+
+	_, _, operationID, itemsToUpdate, err := action.Execute(&unstructured.Unstructured{Object: item}, builder.ForBackup("velero", "backup-1").Result())
+	require.NoError(t, err)
+	assert.Empty(t, operationID)
+	assert.Empty(t, itemsToUpdate)
+
+	_, _, operationID, itemsToUpdate, err = action.Execute(&unstructured.Unstructured{Object: item}, backup)
+	require.NoError(t, err)
+	assert.Equal(t, "du-backup-uid.pvc-uid", operationID)
+
+	list := new(velerov2alpha1api.DataUploadList)
+	require.NoError(t, client.List(context.Background(), list, kbclient.InNamespace("velero")))
+	require.Len(t, list.Items, 1)
+	dataUpload := list.Items[0]
+	assert.Equal(t, velerov2alpha1api.SnapshotTypeVolumeClone, dataUpload.Spec.SnapshotType)
+	assert.Equal(t, "clone-1", dataUpload.Spec.VolumeClone.PersistentVolume)
+	assert.Equal(t, "pvc-1", dataUpload.Spec.SourcePVC)
+	assert.Equal(t, "ns-1", dataUpload.Spec.SourceNamespace)
+	assert.Equal(t, "default", dataUpload.Spec.BackupStorageLocation)
+	assert.Equal(t, operationID, dataUpload.Labels[velerov1api.AsyncOperationIDLabel])
+
+	require.Len(t, itemsToUpdate, 1)
+	assert.Equal(t, "datauploads.velero.io", itemsToUpdate[0].GroupResource.String())
+	assert.Equal(t, dataUpload.Name, itemsToUpdate[0].Name)
+
+	progress, err := action.Progress(operationID, backup)
+	require.NoError(t, err)
+	assert.False(t, progress.Completed)
+
+	dataUpload.Status.Phase = velerov2alpha1api.DataUploadPhaseFailed
+	dataUpload.Status.Message = "fake-error"
+	require.NoError(t, client.Update(context.Background(), &dataUpload))
+	progress, err = action.Progress(operationID, backup)
+	require.NoError(t, err)
+	assert.True(t, progress.Completed)
+	assert.Equal(t, "fake-error", progress.Err)
+
+	require.NoError(t, action.Cancel(operationID, backup))
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKeyFromObject(&dataUpload), &dataUpload))
+	assert.True(t, dataUpload.Spec.Cancel)
+
+	_, err = action.Progress("du-other", backup)
+	assert.EqualError(t, err, "no DataUpload found for operation du-other")
+}
+
+func TestVolumeCloneActionExecuteVolumeClone(t *testing.T) {
+	tests := []struct {
+		name        string
+		pv          *corev1api.PersistentVolume
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "CSI volume is cloned through a PVC data source",
+			pv:       builder.ForPersistentVolume("pv-1").CSI("csi.example.com", "handle").Result(),
+			expected: "",
+		},
+		{
+			name:        "no volume was created from a native snapshot",
+			pv:          builder.ForPersistentVolume("pv-1").Result(),
+			expectedErr: "no volume was created from a snapshot of PVC ns-1/pvc-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Phase(corev1api.ClaimBound).Result()
+			pvc.UID = "pvc-uid"
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+			require.NoError(t, err)
+
+			backup := builder.ForBackup("velero", "backup-1").SnapshotMoveData(true).Result()
+			backup.UID = "backup-uid"
+
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.pv)
+			_, _, _, _, err = NewVolumeCloneAction(velerotest.NewLogger(), client, "velero").Execute(&unstructured.Unstructured{Object: item}, backup)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			list := new(velerov2alpha1api.DataUploadList)
+			require.NoError(t, client.List(context.Background(), list, kbclient.InNamespace("velero")))
+			require.Len(t, list.Items, 1)
+			assert.Equal(t, tc.expected, list.Items[0].Spec.VolumeClone.PersistentVolume)
+		})
+	}
+}
@@ -246,12 +246,24 @@ func (b *BackupBuilder) Expiration(val time.Time) *BackupBuilder {
 	return b
 }
 
+// RetainUntil sets the Backup's object lock retain until time.
+func (b *BackupBuilder) RetainUntil(val time.Time) *BackupBuilder {
+	b.object.Status.RetainUntil = &metav1.Time{Time: val}
+	return b
+}
+
 // StartTimestamp sets the Backup's start timestamp.
 func (b *BackupBuilder) StartTimestamp(val time.Time) *BackupBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
 	return b
 }
 
+// ScopeHookStatuses appends to the Backup's scope hook statuses.
+func (b *BackupBuilder) ScopeHookStatuses(statuses ...velerov1api.ScopeHookStatus) *BackupBuilder {
+	b.object.Status.ScopeHooks = append(b.object.Status.ScopeHooks, statuses...)
+	return b
+}
+
 // CompletionTimestamp sets the Backup's completion timestamp.
 func (b *BackupBuilder) CompletionTimestamp(val time.Time) *BackupBuilder {
 	b.object.Status.CompletionTimestamp = &metav1.Time{Time: val}
@@ -299,3 +311,15 @@ func (b *BackupBuilder) DataMover(name string) *BackupBuilder {
 	b.object.Spec.DataMover = name
 	return b
 }
+
+// Cancel sets the Backup's cancel flag.
+func (b *BackupBuilder) Cancel(val bool) *BackupBuilder {
+	b.object.Spec.Cancel = val
+	return b
+}
+
+// Paused sets the Backup's paused flag.
+func (b *BackupBuilder) Paused(val bool) *BackupBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
@@ -105,12 +105,33 @@ func (b *BackupStorageLocationBuilder) AccessMode(accessMode velerov1api.BackupS
 	return b
 }
 
+// ObjectLock sets the BackupStorageLocation's object lock mode and retention period.
+func (b *BackupStorageLocationBuilder) ObjectLock(mode velerov1api.ObjectLockMode, retentionPeriod time.Duration) *BackupStorageLocationBuilder {
+	b.object.Spec.ObjectLock = &velerov1api.ObjectLock{
+		Mode:            mode,
+		RetentionPeriod: metav1.Duration{Duration: retentionPeriod},
+	}
+	return b
+}
+
 // ValidationFrequency sets the BackupStorageLocation's validation frequency.
 func (b *BackupStorageLocationBuilder) ValidationFrequency(frequency time.Duration) *BackupStorageLocationBuilder {
 	b.object.Spec.ValidationFrequency = &metav1.Duration{Duration: frequency}
 	return b
 }
 
+// UsageReportFrequency sets the BackupStorageLocation's usage report frequency.
+func (b *BackupStorageLocationBuilder) UsageReportFrequency(frequency time.Duration) *BackupStorageLocationBuilder {
+	b.object.Spec.UsageReportFrequency = &metav1.Duration{Duration: frequency}
+	return b
+}
+
+// FailoverLocations sets the BackupStorageLocation's failover locations.
+func (b *BackupStorageLocationBuilder) FailoverLocations(names ...string) *BackupStorageLocationBuilder {
+	b.object.Spec.FailoverLocations = names
+	return b
+}
+
 // LastValidationTime sets the BackupStorageLocation's last validated time.
 func (b *BackupStorageLocationBuilder) LastValidationTime(lastValidated time.Time) *BackupStorageLocationBuilder {
 	b.object.Status.LastValidationTime = &metav1.Time{Time: lastValidated}
@@ -128,3 +149,9 @@ func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeyS
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// WebIdentity sets the BackupStorageLocation's web identity.
+func (b *BackupStorageLocationBuilder) WebIdentity(webIdentity *velerov1api.WebIdentity) *BackupStorageLocationBuilder {
+	b.object.Spec.WebIdentity = webIdentity
+	return b
+}
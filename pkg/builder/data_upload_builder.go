@@ -113,3 +113,18 @@ func (d *DataUploadBuilder) CSISnapshot(cSISnapshot *velerov2alpha1api.CSISnapsh
 	d.object.Spec.CSISnapshot = cSISnapshot
 	return d
 }
+
+// VolumeClone sets the DataUpload's VolumeClone.
+func (d *DataUploadBuilder) VolumeClone(volumeClone *velerov2alpha1api.VolumeCloneSpec) *DataUploadBuilder {
+	d.object.Spec.VolumeClone = volumeClone
+	return d
+}
+
+// ObjectMeta applies functional options to the DataUpload's ObjectMeta.
+func (d *DataUploadBuilder) ObjectMeta(opts ...ObjectMetaOpt) *DataUploadBuilder {
+	for _, opt := range opts {
+		opt(d.object)
+	}
+
+	return d
+}
@@ -60,3 +60,9 @@ func (b *DownloadRequestBuilder) Target(targetKind velerov1api.DownloadTargetKin
 	b.object.Spec.Target.Name = targetName
 	return b
 }
+
+// TargetArchive sets the DownloadRequest's target archive.
+func (b *DownloadRequestBuilder) TargetArchive(archive string) *DownloadRequestBuilder {
+	b.object.Spec.Target.Archive = archive
+	return b
+}
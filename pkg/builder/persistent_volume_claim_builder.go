@@ -69,6 +69,12 @@ func (b *PersistentVolumeClaimBuilder) StorageClass(name string) *PersistentVolu
 	return b
 }
 
+// VolumeMode sets the PersistentVolumeClaim's volume mode.
+func (b *PersistentVolumeClaimBuilder) VolumeMode(volumeMode corev1api.PersistentVolumeMode) *PersistentVolumeClaimBuilder {
+	b.object.Spec.VolumeMode = &volumeMode
+	return b
+}
+
 // Phase sets the PersistentVolumeClaim's status Phase.
 func (b *PersistentVolumeClaimBuilder) Phase(phase corev1api.PersistentVolumeClaimPhase) *PersistentVolumeClaimBuilder {
 	b.object.Status.Phase = phase
@@ -103,3 +103,9 @@ func (b *PodVolumeBackupBuilder) UploaderType(uploaderType string) *PodVolumeBac
 	b.object.Spec.UploaderType = uploaderType
 	return b
 }
+
+// Cancel sets the PodVolumeBackup's cancel flag.
+func (b *PodVolumeBackupBuilder) Cancel(val bool) *PodVolumeBackupBuilder {
+	b.object.Spec.Cancel = val
+	return b
+}
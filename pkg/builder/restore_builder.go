@@ -171,3 +171,27 @@ func (b *RestoreBuilder) ItemOperationTimeout(timeout time.Duration) *RestoreBui
 	b.object.Spec.ItemOperationTimeout.Duration = timeout
 	return b
 }
+
+// PreRestoreHooks appends to the Restore's pre-restore scope hooks.
+func (b *RestoreBuilder) PreRestoreHooks(hooks ...velerov1api.ScopeHook) *RestoreBuilder {
+	b.object.Spec.Hooks.PreRestore = append(b.object.Spec.Hooks.PreRestore, hooks...)
+	return b
+}
+
+// PostRestoreHooks appends to the Restore's post-restore scope hooks.
+func (b *RestoreBuilder) PostRestoreHooks(hooks ...velerov1api.ScopeHook) *RestoreBuilder {
+	b.object.Spec.Hooks.PostRestore = append(b.object.Spec.Hooks.PostRestore, hooks...)
+	return b
+}
+
+// Cancel sets the Restore's cancel flag.
+func (b *RestoreBuilder) Cancel(val bool) *RestoreBuilder {
+	b.object.Spec.Cancel = val
+	return b
+}
+
+// Paused sets the Restore's paused flag.
+func (b *RestoreBuilder) Paused(val bool) *RestoreBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
@@ -36,6 +36,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewCancelCommand(f, "cancel"),
+		NewPauseCommand(f, "pause"),
+		NewResumeCommand(f, "resume"),
 	)
 
 	return c
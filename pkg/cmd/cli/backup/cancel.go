@@ -0,0 +1,148 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewCancelCommand creates the command for canceling backups
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("cancel", "backup")
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Cancel ongoing backups",
+		Long: `Cancel ongoing backups.
+
+Only the backups in New, InProgress or WaitingForPluginOperations phase can be canceled.
+The items already backed up are kept, and the backup ends in the Cancelled phase.`,
+		Example: `  # Cancel a backup named "backup-1".
+  velero backup cancel backup-1
+
+  # Cancel backups named "backup-1" and "backup-2".
+  velero backup cancel backup-1 backup-2
+
+  # Cancel all backups labeled with "foo=bar".
+  velero backup cancel --selector foo=bar
+
+  # Cancel all ongoing backups.
+  velero backup cancel --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runCancel(f, o))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+func runCancel(f client.Factory, o *cli.SelectOptions) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	backups, errs := selectBackups(f, o)
+	if len(backups) == 0 {
+		fmt.Println("No backups found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	for _, backup := range backups {
+		if !isBackupCancelable(backup) {
+			fmt.Printf("Backup %s is in %s phase which can't be canceled, skip\n", backup.Name, backup.Status.Phase)
+			continue
+		}
+		if backup.Spec.Cancel {
+			fmt.Printf("Backup %s is already being canceled, skip\n", backup.Name)
+			continue
+		}
+		backup.Spec.Cancel = true
+		if _, err := client.VeleroV1().Backups(backup.Namespace).Update(context.TODO(), backup, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update backup %s", backup.Name))
+			continue
+		}
+		fmt.Printf("Request to cancel backup %q submitted successfully.\n", backup.Name)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
+
+// selectBackups gets the backups specified by the names, the --all flag or the --selector flag
+func selectBackups(f client.Factory, o *cli.SelectOptions) ([]*velerov1api.Backup, []error) {
+	client, err := f.Client()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var (
+		backups []*velerov1api.Backup
+		errs    []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			backup, err := client.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			backups = append(backups, backup)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := client.VeleroV1().Backups(f.Namespace()).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			return nil, append(errs, errors.WithStack(err))
+		}
+
+		for i := range res.Items {
+			backups = append(backups, &res.Items[i])
+		}
+	}
+	return backups, errs
+}
+
+func isBackupCancelable(backup *velerov1api.Backup) bool {
+	switch backup.Status.Phase {
+	case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress,
+		velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	versionedmocks "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/mocks"
+	velerov1mocks "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1/mocks"
+)
+
+func TestCancelCommand(t *testing.T) {
+	inProgress := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result()
+	completed := builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").Phase(velerov1api.BackupPhaseCompleted).Result()
+
+	f := &factorymocks.Factory{}
+	backups := &velerov1mocks.BackupInterface{}
+	veleroV1 := &velerov1mocks.VeleroV1Interface{}
+	client := &versionedmocks.Interface{}
+	backups.On("Get", mock.Anything, "backup-1", mock.Anything).Return(inProgress, nil)
+	backups.On("Get", mock.Anything, "backup-2", mock.Anything).Return(completed, nil)
+	backups.On("Update", mock.Anything, mock.MatchedBy(func(b *velerov1api.Backup) bool {
+		return b.Name == "backup-1" && b.Spec.Cancel
+	}), mock.Anything).Return(inProgress, nil)
+	veleroV1.On("Backups", mock.Anything).Return(backups, nil)
+	client.On("VeleroV1").Return(veleroV1, nil)
+	f.On("Client").Return(client, nil)
+	f.On("Namespace").Return(velerov1api.DefaultNamespace)
+
+	c := NewCancelCommand(f, "cancel")
+	assert.Equal(t, "Cancel ongoing backups", c.Short)
+
+	o := cli.NewSelectOptions("cancel", "backup")
+	assert.NoError(t, o.Complete([]string{"backup-1", "backup-2"}))
+	assert.NoError(t, o.Validate())
+	assert.NoError(t, runCancel(f, o))
+
+	backups.AssertNumberOfCalls(t, "Update", 1)
+	assert.True(t, inProgress.Spec.Cancel)
+	assert.False(t, completed.Spec.Cancel)
+}
+
+func TestPauseCommand(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result()
+
+	f := &factorymocks.Factory{}
+	backups := &velerov1mocks.BackupInterface{}
+	veleroV1 := &velerov1mocks.VeleroV1Interface{}
+	client := &versionedmocks.Interface{}
+	backups.On("Get", mock.Anything, "backup-1", mock.Anything).Return(backup, nil)
+	backups.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(backup, nil)
+	veleroV1.On("Backups", mock.Anything).Return(backups, nil)
+	client.On("VeleroV1").Return(veleroV1, nil)
+	f.On("Client").Return(client, nil)
+	f.On("Namespace").Return(velerov1api.DefaultNamespace)
+
+	o := cli.NewSelectOptions("pause", "backup")
+	assert.NoError(t, o.Complete([]string{"backup-1"}))
+
+	assert.NoError(t, runPause(f, o, true))
+	assert.True(t, backup.Spec.Paused)
+
+	// pausing a paused backup doesn't update it again
+	assert.NoError(t, runPause(f, o, true))
+	backups.AssertNumberOfCalls(t, "Update", 1)
+
+	assert.NoError(t, runPause(f, o, false))
+	assert.False(t, backup.Spec.Paused)
+	backups.AssertNumberOfCalls(t, "Update", 2)
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package backup
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -29,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
@@ -67,6 +71,8 @@ type DownloadOptions struct {
 	InsecureSkipTLSVerify bool
 	writeOptions          int
 	caCertFile            string
+	contentsLayout        velerov1api.BackupContentsLayout
+	compression           *velerov1api.BackupCompression
 }
 
 func NewDownloadOptions() *DownloadOptions {
@@ -76,7 +82,7 @@ func NewDownloadOptions() *DownloadOptions {
 }
 
 func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
-	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory, or the extension of the backup's compression, e.g. <NAME>-data.tar.zst.")
 	flags.BoolVar(&o.Force, "force", o.Force, "Forces the download and will overwrite file if it exists already.")
 	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
 	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
@@ -87,10 +93,22 @@ func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Fac
 	veleroClient, err := f.Client()
 	cmd.CheckError(err)
 
-	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{}); err != nil {
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{})
+	if err != nil {
 		return err
 	}
 
+	o.contentsLayout = backup.Status.ContentsLayout
+	o.compression = backup.Status.Compression
+
+	if o.Output == "" {
+		path, err := os.Getwd()
+		if err != nil {
+			return errors.Wrapf(err, "error getting current directory")
+		}
+		o.Output = filepath.Join(path, fmt.Sprintf("%s-data%s", o.Name, archive.ContentsFileExtension(backup.Status.Compression)))
+	}
+
 	return nil
 }
 
@@ -102,14 +120,6 @@ func (o *DownloadOptions) Complete(args []string) error {
 		o.writeOptions = os.O_RDWR | os.O_CREATE | os.O_TRUNC
 	}
 
-	if o.Output == "" {
-		path, err := os.Getwd()
-		if err != nil {
-			return errors.Wrapf(err, "error getting current directory")
-		}
-		o.Output = filepath.Join(path, fmt.Sprintf("%s-data.tar.gz", o.Name))
-	}
-
 	return nil
 }
 
@@ -123,7 +133,14 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	}
 	defer backupDest.Close()
 
-	err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), o.Name, velerov1api.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	stream := func(target velerov1api.DownloadTarget, w io.Writer) error {
+		return downloadrequest.StreamTarget(context.Background(), kbClient, f.Namespace(), target, w, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	}
+	if o.contentsLayout == velerov1api.BackupContentsLayoutPerNamespace {
+		err = downloadArchives(o.Name, o.compression, stream, backupDest)
+	} else {
+		err = stream(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: o.Name}, backupDest)
+	}
 	if err != nil {
 		os.Remove(o.Output)
 		cmd.CheckError(err)
@@ -132,3 +149,34 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup %s has been successfully downloaded to %s\n", o.Name, backupDest.Name())
 	return nil
 }
+
+// downloadArchives downloads the archives of a backup whose contents are split per namespace one
+// at a time, and merges them into a single tarball compressed like the backup, so that it has the
+// same content as the tarball of a backup which isn't split.
+func downloadArchives(name string, compression *velerov1api.BackupCompression, stream func(velerov1api.DownloadTarget, io.Writer) error, w io.Writer) error {
+	indexJSON := new(bytes.Buffer)
+	if err := stream(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContentsIndex, Name: name}, indexJSON); err != nil {
+		return errors.Wrap(err, "error downloading the index of the backup contents")
+	}
+	index := &archive.Index{}
+	if err := json.Unmarshal(indexJSON.Bytes(), index); err != nil {
+		return errors.Wrap(err, "error decoding the index of the backup contents")
+	}
+
+	cw, err := archive.NewCompressWriter(w, compression)
+	if err != nil {
+		return err
+	}
+
+	if err := archive.MergeArchives(cw, index.Archives(nil), func(contentsArchive string) (io.ReadCloser, error) {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(stream(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContentsArchive, Name: name, Archive: contentsArchive}, pw))
+		}()
+		return pr, nil
+	}); err != nil {
+		return err
+	}
+
+	return cw.Close()
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package backup
 
 import (
+	"archive/tar"
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strconv"
@@ -26,6 +29,7 @@ import (
 	flag "github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
@@ -33,6 +37,7 @@ import (
 	veleroexec "github.com/vmware-tanzu/velero/pkg/util/exec"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	versionedmocks "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/mocks"
 	velerov1mocks "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1/mocks"
@@ -110,3 +115,73 @@ func TestNewDownloadCommand(t *testing.T) {
 	}
 	t.Fatalf("process ran with err %v, want backup delete successfully", err)
 }
+
+// newTestArchive returns a tarball with the files, compressed with the compression.
+func newTestArchive(t *testing.T, compression *velerov1api.BackupCompression, files ...string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	cw, err := archive.NewCompressWriter(buf, compression)
+	require.NoError(t, err)
+	tw := tar.NewWriter(cw)
+	for _, file := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: file, Size: int64(len(file)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(file))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, cw.Close())
+	return buf.Bytes()
+}
+
+func TestDownloadArchives(t *testing.T) {
+	archives := map[string][]byte{
+		"cluster":         newTestArchive(t, nil, "metadata/version", "resources/namespaces/cluster/ns-1.json"),
+		"namespaces/ns-1": newTestArchive(t, nil, "resources/pods/namespaces/ns-1/pod-1.json"),
+	}
+	stream := func(target velerov1api.DownloadTarget, w io.Writer) error {
+		assert.Equal(t, "backup-1", target.Name)
+		switch target.Kind {
+		case velerov1api.DownloadTargetKindBackupContentsIndex:
+			_, err := w.Write([]byte(`{"namespaces":["ns-1"]}`))
+			return err
+		case velerov1api.DownloadTargetKindBackupContentsArchive:
+			_, err := w.Write(archives[target.Archive])
+			return err
+		default:
+			return fmt.Errorf("unexpected kind %s", target.Kind)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, downloadArchives("backup-1", nil, stream, buf))
+
+	// the merged tarball is compressed like the backup
+	cr, err := archive.NewDecompressReader(buf)
+	require.NoError(t, err)
+	defer cr.Close()
+	var files []string
+	tr := tar.NewReader(cr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		files = append(files, header.Name)
+	}
+	assert.Equal(t, []string{"metadata/version", "resources/namespaces/cluster/ns-1.json", "resources/pods/namespaces/ns-1/pod-1.json"}, files)
+}
+
+func TestDownloadArchivesError(t *testing.T) {
+	stream := func(target velerov1api.DownloadTarget, w io.Writer) error {
+		if target.Kind == velerov1api.DownloadTargetKindBackupContentsIndex {
+			_, err := w.Write([]byte(`{"namespaces":["ns-1"]}`))
+			return err
+		}
+		return fmt.Errorf("download error")
+	}
+
+	err := downloadArchives("backup-1", nil, stream, io.Discard)
+	assert.ErrorContains(t, err, "download error")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewPauseCommand creates the command for pausing backups
+func NewPauseCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("pause", "backup")
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Pause the progress checking of the asynchronous operations of backups",
+		Long: `Pause the progress checking of the asynchronous operations of backups.
+
+A paused backup stays in the WaitingForPluginOperations phase until it is resumed. The item
+operation timeout of the backup still counts while it is paused.`,
+		Example: `  # Pause a backup named "backup-1".
+  velero backup pause backup-1
+
+  # Pause backups named "backup-1" and "backup-2".
+  velero backup pause backup-1 backup-2
+
+  # Pause all backups labeled with "foo=bar".
+  velero backup pause --selector foo=bar
+
+  # Pause all backups.
+  velero backup pause --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(f, o, true))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+func runPause(f client.Factory, o *cli.SelectOptions, paused bool) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	backups, errs := selectBackups(f, o)
+	if len(backups) == 0 {
+		fmt.Println("No backups found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	msg := "paused"
+	if !paused {
+		msg = "resumed"
+	}
+	for _, backup := range backups {
+		if backup.Spec.Paused == paused {
+			fmt.Printf("Backup %s is already %s, skip\n", backup.Name, msg)
+			continue
+		}
+		backup.Spec.Paused = paused
+		if _, err := client.VeleroV1().Backups(backup.Namespace).Update(context.TODO(), backup, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update backup %s", backup.Name))
+			continue
+		}
+		fmt.Printf("Backup %s %s successfully\n", backup.Name, msg)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
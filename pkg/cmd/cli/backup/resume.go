@@ -0,0 +1,55 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewResumeCommand creates the command for resuming paused backups
+func NewResumeCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("resume", "backup")
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Resume the progress checking of the asynchronous operations of paused backups",
+		Example: `  # Resume a backup named "backup-1".
+  velero backup resume backup-1
+
+  # Resume backups named "backup-1" and "backup-2".
+  velero backup resume backup-1 backup-2
+
+  # Resume all backups labeled with "foo=bar".
+  velero backup resume --selector foo=bar
+
+  # Resume all backups.
+  velero backup resume --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(f, o, false))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
@@ -33,7 +33,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewCreateCommand(f, "create"),
 		NewDeleteCommand(f, "delete"),
 		NewGetCommand(f, "get"),
+		NewMigrateCommand(f, "migrate"),
 		NewSetCommand(f, "set"),
+		NewTestCommand(f, "test"),
 	)
 
 	return c
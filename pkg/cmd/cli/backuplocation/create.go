@@ -72,6 +72,12 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	ObjectLockMode                        *flag.Enum
+	ObjectLockRetentionPeriod             time.Duration
+	FailoverLocations                     []string
+	WebIdentityRoleARN                    string
+	WebIdentityTokenFile                  string
+	WebIdentitySessionDuration            time.Duration
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -84,6 +90,11 @@ func NewCreateOptions() *CreateOptions {
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		ObjectLockMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeCompliance),
+		),
 	}
 }
 
@@ -103,6 +114,16 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.Var(
+		o.ObjectLockMode,
+		"object-lock-mode",
+		fmt.Sprintf("Object lock mode of the backup data written to the location, the bucket must have object lock enabled. Valid values are %s. Optional.", strings.Join(o.ObjectLockMode.AllowedValues(), ",")),
+	)
+	flags.DurationVar(&o.ObjectLockRetentionPeriod, "object-lock-retention-period", o.ObjectLockRetentionPeriod, "How long the backup data written to the location is locked, at least 24 hours. Required if --object-lock-mode is set.")
+	flags.StringVar(&o.WebIdentityRoleARN, "web-identity-role-arn", o.WebIdentityRoleARN, "The role assumed with the projected service account token to get short-lived credentials for the location, instead of a static credential. Optional.")
+	flags.StringVar(&o.WebIdentityTokenFile, "web-identity-token-file", o.WebIdentityTokenFile, fmt.Sprintf("The path of the web identity token in the Velero and node-agent pods. Optional. Default: %s.", velerov1api.DefaultWebIdentityTokenFile))
+	flags.DurationVar(&o.WebIdentitySessionDuration, "web-identity-session-duration", o.WebIdentitySessionDuration, "How long the short-lived credentials obtained with the web identity are valid. Optional. Default: 1 hour.")
+	flags.StringSliceVar(&o.FailoverLocations, "failover-locations", o.FailoverLocations, "Comma-separated list of backup storage locations, in priority order, to store the backups targeting this location in when it's unavailable. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -126,6 +147,44 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.ObjectLockMode.String() != "" && o.ObjectLockRetentionPeriod < 24*time.Hour {
+		return errors.New("--object-lock-retention-period must be at least 24 hours when --object-lock-mode is set")
+	}
+
+	if o.ObjectLockMode.String() == "" && o.ObjectLockRetentionPeriod != 0 {
+		return errors.New("--object-lock-mode is required when --object-lock-retention-period is set")
+	}
+
+	if o.WebIdentityRoleARN != "" && len(o.Credential.Data()) > 0 {
+		return errors.New("--credential and --web-identity-role-arn can't be used together")
+	}
+
+	if o.WebIdentityRoleARN == "" && (o.WebIdentityTokenFile != "" || o.WebIdentitySessionDuration != 0) {
+		return errors.New("--web-identity-role-arn is required when --web-identity-token-file or --web-identity-session-duration is set")
+	}
+
+	if err := validateFailoverLocations(o.Name, o.FailoverLocations); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFailoverLocations checks that the failover locations of a backup storage location
+// are distinct and don't include the location itself.
+func validateFailoverLocations(name string, failoverLocations []string) error {
+	seen := make(map[string]bool, len(failoverLocations))
+	for _, failover := range failoverLocations {
+		switch {
+		case failover == "":
+			return errors.New("--failover-locations can't contain empty names")
+		case failover == name:
+			return errors.Errorf("backup storage location %s can't be a failover location of itself", name)
+		case seen[failover]:
+			return errors.Errorf("--failover-locations contains %s more than once", failover)
+		}
+		seen[failover] = true
+	}
 	return nil
 }
 
@@ -162,9 +221,10 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 					CACert: caCertData,
 				},
 			},
-			Config:     o.Config.Data(),
-			Default:    o.DefaultBackupStorageLocation,
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:            o.Config.Data(),
+			Default:           o.DefaultBackupStorageLocation,
+			AccessMode:        velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			FailoverLocations: o.FailoverLocations,
 		},
 	}
 
@@ -176,6 +236,23 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	if o.ObjectLockMode.String() != "" {
+		backupStorageLocation.Spec.ObjectLock = &velerov1api.ObjectLock{
+			Mode:            velerov1api.ObjectLockMode(o.ObjectLockMode.String()),
+			RetentionPeriod: metav1.Duration{Duration: o.ObjectLockRetentionPeriod},
+		}
+	}
+
+	if o.WebIdentityRoleARN != "" {
+		backupStorageLocation.Spec.WebIdentity = &velerov1api.WebIdentity{
+			RoleARN:   o.WebIdentityRoleARN,
+			TokenFile: o.WebIdentityTokenFile,
+		}
+		if o.WebIdentitySessionDuration != 0 {
+			backupStorageLocation.Spec.WebIdentity.SessionDuration = &metav1.Duration{Duration: o.WebIdentitySessionDuration}
+		}
+	}
+
 	for secretName, secretKey := range o.Credential.Data() {
 		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
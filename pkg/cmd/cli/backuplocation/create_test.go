@@ -104,6 +104,93 @@ func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	assert.Equal(t, map[string]string{"key": "value"}, bsl.Labels)
 }
 
+func TestBuildBackupStorageLocationSetsObjectLock(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.ObjectLock)
+
+	assert.NoError(t, o.ObjectLockMode.Set(string(velerov1api.ObjectLockModeCompliance)))
+	o.ObjectLockRetentionPeriod = 720 * time.Hour
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.ObjectLock{
+		Mode:            velerov1api.ObjectLockModeCompliance,
+		RetentionPeriod: metav1.Duration{Duration: 720 * time.Hour},
+	}, bsl.Spec.ObjectLock)
+}
+
+func TestBuildBackupStorageLocationSetsWebIdentity(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.WebIdentity)
+
+	o.WebIdentityRoleARN = "arn:aws:iam::123456789012:role/velero"
+	o.WebIdentitySessionDuration = 2 * time.Hour
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.WebIdentity{
+		RoleARN:         "arn:aws:iam::123456789012:role/velero",
+		SessionDuration: &metav1.Duration{Duration: 2 * time.Hour},
+	}, bsl.Spec.WebIdentity)
+}
+
+func TestValidateFailoverLocations(t *testing.T) {
+	tests := []struct {
+		name              string
+		failoverLocations []string
+		expectedErr       string
+	}{
+		{
+			name: "no failover locations",
+		},
+		{
+			name:              "valid failover locations",
+			failoverLocations: []string{"secondary", "tertiary"},
+		},
+		{
+			name:              "empty name",
+			failoverLocations: []string{"secondary", ""},
+			expectedErr:       "--failover-locations can't contain empty names",
+		},
+		{
+			name:              "location itself",
+			failoverLocations: []string{"primary"},
+			expectedErr:       "backup storage location primary can't be a failover location of itself",
+		},
+		{
+			name:              "duplicated name",
+			failoverLocations: []string{"secondary", "secondary"},
+			expectedErr:       "--failover-locations contains secondary more than once",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateFailoverLocations("primary", tc.failoverLocations)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestBuildBackupStorageLocationSetsFailoverLocations(t *testing.T) {
+	o := NewCreateOptions()
+	o.FailoverLocations = []string{"secondary", "tertiary"}
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"secondary", "tertiary"}, bsl.Spec.FailoverLocations)
+}
+
 func TestCreateCommand_Run(t *testing.T) {
 	// create a factory
 	f := &factorymocks.Factory{}
@@ -0,0 +1,173 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewMigrateCommand(f client.Factory, use string) *cobra.Command {
+	o := NewMigrateOptions()
+
+	c := &cobra.Command{
+		Use:   use + " SOURCE TARGET",
+		Short: "Migrate the backups and backup repositories of a backup storage location to another",
+		Long: `Migrate the backups and backup repositories of a backup storage location to another.
+
+The Velero server copies every backup and backup repository stored in the source location to the
+target location, verifies the copies, and moves the backups, pod volume backups and backup
+repositories to the target location. The data in the source location is left in place.`,
+		Example: `  # Migrate the backups of location "old" to location "new".
+  velero backup-location migrate old new
+
+  # Migrate the backups of location "old" to location "new", wait for the migration to finish,
+  # and mark location "old" read-only if everything was migrated.
+  velero backup-location migrate old new --mark-source-read-only --wait`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type MigrateOptions struct {
+	SourceLocation     string
+	TargetLocation     string
+	MarkSourceReadOnly bool
+	Wait               bool
+}
+
+func NewMigrateOptions() *MigrateOptions {
+	return &MigrateOptions{}
+}
+
+func (o *MigrateOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.MarkSourceReadOnly, "mark-source-read-only", o.MarkSourceReadOnly, "Set the access mode of the source location to ReadOnly once everything is migrated. Optional.")
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the migration to finish. Optional.")
+}
+
+func (o *MigrateOptions) Complete(args []string, f client.Factory) error {
+	o.SourceLocation = args[0]
+	o.TargetLocation = args[1]
+	return nil
+}
+
+func (o *MigrateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.SourceLocation == o.TargetLocation {
+		return errors.New("the source and target locations must be different")
+	}
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{o.SourceLocation, o.TargetLocation} {
+		location := &velerov1api.BackupStorageLocation{}
+		if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, location); err != nil {
+			return errors.Wrapf(err, "error getting backup storage location %s", name)
+		}
+		if name == o.TargetLocation && location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			return errors.Errorf("target location %s is in read-only mode", name)
+		}
+	}
+
+	return nil
+}
+
+func (o *MigrateOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	migration := &velerov1api.BackupStorageLocationMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    f.Namespace(),
+			GenerateName: o.SourceLocation + "-",
+		},
+		Spec: velerov1api.BackupStorageLocationMigrationSpec{
+			SourceLocation:     o.SourceLocation,
+			TargetLocation:     o.TargetLocation,
+			MarkSourceReadOnly: o.MarkSourceReadOnly,
+		},
+	}
+	if err := kbClient.Create(context.Background(), migration, &kbclient.CreateOptions{}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Migration %q of backup storage location %q to %q submitted successfully.\n", migration.Name, o.SourceLocation, o.TargetLocation)
+
+	if !o.Wait {
+		fmt.Printf("Run `kubectl -n %s get backupstoragelocationmigrations %s` to check its status.\n", migration.Namespace, migration.Name)
+		return nil
+	}
+
+	fmt.Println("Waiting for the migration to finish. You may safely press ctrl-c to stop waiting - the migration will continue in the background.")
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	key := kbclient.ObjectKey{Namespace: migration.Namespace, Name: migration.Name}
+	for range ticker.C {
+		fmt.Print(".")
+
+		if err := kbClient.Get(context.Background(), key, migration); err != nil {
+			return errors.WithStack(err)
+		}
+
+		switch migration.Status.Phase {
+		case velerov1api.BackupStorageLocationMigrationPhaseCompleted,
+			velerov1api.BackupStorageLocationMigrationPhasePartiallyFailed,
+			velerov1api.BackupStorageLocationMigrationPhaseFailed:
+			printMigrationResult(migration)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func printMigrationResult(migration *velerov1api.BackupStorageLocationMigration) {
+	fmt.Printf("\nMigration completed with status: %s.\n", migration.Status.Phase)
+	fmt.Printf("Backups migrated: %d of %d\n", migration.Status.MigratedBackups, migration.Status.TotalBackups)
+	fmt.Printf("Backup repositories migrated: %d of %d\n", migration.Status.MigratedRepositories, migration.Status.TotalRepositories)
+
+	if len(migration.Status.Errors) > 0 {
+		fmt.Println("Errors:")
+		for _, err := range migration.Status.Errors {
+			fmt.Printf("  %s\n", err)
+		}
+	}
+}
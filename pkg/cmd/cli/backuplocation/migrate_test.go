@@ -0,0 +1,99 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+)
+
+func TestMigrateOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr string
+	}{
+		{
+			name:      "same source and target location",
+			args:      []string{"old", "old"},
+			expectErr: "the source and target locations must be different",
+		},
+		{
+			name:      "source location not found",
+			args:      []string{"missing", "new"},
+			expectErr: "error getting backup storage location missing",
+		},
+		{
+			name:      "read-only target location",
+			args:      []string{"old", "read-only"},
+			expectErr: "target location read-only is in read-only mode",
+		},
+		{
+			name: "migration is created",
+			args: []string{"old", "new"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				builder.ForBackupStorageLocation("velero", "old").Result(),
+				builder.ForBackupStorageLocation("velero", "new").Result(),
+				builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			).Build()
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return("velero")
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			c := NewMigrateCommand(f, "migrate")
+			o := NewMigrateOptions()
+			flags := new(flag.FlagSet)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse([]string{"--mark-source-read-only"}))
+
+			require.NoError(t, o.Complete(tc.args, f))
+			err := o.Validate(c, tc.args, f)
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, o.Run(c, f))
+
+			migrations := &velerov1api.BackupStorageLocationMigrationList{}
+			require.NoError(t, kbClient.List(context.Background(), migrations))
+			require.Len(t, migrations.Items, 1)
+			assert.Equal(t, velerov1api.BackupStorageLocationMigrationSpec{
+				SourceLocation:     "old",
+				TargetLocation:     "new",
+				MarkSourceReadOnly: true,
+			}, migrations.Items[0].Spec)
+		})
+	}
+}
@@ -59,6 +59,7 @@ type SetOptions struct {
 	CACertFile                   string
 	Credential                   flag.Map
 	DefaultBackupStorageLocation bool
+	FailoverLocations            []string
 }
 
 func NewSetOptions() *SetOptions {
@@ -71,6 +72,7 @@ func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
+	flags.StringSliceVar(&o.FailoverLocations, "failover-locations", o.FailoverLocations, "Sets the comma-separated list of backup storage locations, in priority order, to store the backups targeting this location in when it's unavailable. Set it to an empty value to remove the failover locations. Optional.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -78,6 +80,10 @@ func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory)
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if err := validateFailoverLocations(o.Name, o.FailoverLocations); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -144,6 +150,10 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	if c.Flags().Changed("failover-locations") {
+		location.Spec.FailoverLocations = o.FailoverLocations
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewTestCommand(f client.Factory, use string) *cobra.Command {
+	o := NewTestOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Test the compatibility of a backup storage location with Velero",
+		Long: `Test the compatibility of a backup storage location with Velero.
+
+The Velero server runs a suite of checks against the location through its object store plugin:
+putting, getting, listing and deleting objects, ranged reads, listing with prefixes and delimiters,
+multipart uploads of a large object, downloading with a signed URL, and the consistency of reads and
+lists right after writes and deletes. It then checks the reads, writes and lists the backup
+repositories rely on through their storage backend. The checks write to a dedicated directory of the
+location and delete what they wrote, the backups and the backup repositories aren't touched.
+
+The result of each check is reported as a compatibility matrix.`,
+		Example: `  # Test location "default".
+  velero backup-location test default
+
+  # Test location "default" with a 1Gi object for the multipart uploads, skipping the checks of the
+  # backup repositories.
+  velero backup-location test default --large-object-size 1Gi --skip-repository`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type TestOptions struct {
+	Name            string
+	LargeObjectSize string
+	SkipRepository  bool
+	Wait            bool
+
+	largeObjectSize *resource.Quantity
+	out             io.Writer
+}
+
+func NewTestOptions() *TestOptions {
+	return &TestOptions{
+		Wait: true,
+		out:  os.Stdout,
+	}
+}
+
+func (o *TestOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.LargeObjectSize, "large-object-size", o.LargeObjectSize, "Size of the object uploaded to check the multipart uploads, e.g. 1Gi. Defaults to 100Mi. Optional.")
+	flags.BoolVar(&o.SkipRepository, "skip-repository", o.SkipRepository, "Skip the checks of the storage operations the backup repositories rely on. Optional.")
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the test to finish and print its result. Optional.")
+}
+
+func (o *TestOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *TestOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.LargeObjectSize != "" {
+		size, err := resource.ParseQuantity(o.LargeObjectSize)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --large-object-size %q", o.LargeObjectSize)
+		}
+		if size.Sign() <= 0 {
+			return errors.New("--large-object-size must be greater than zero")
+		}
+		o.largeObjectSize = &size
+	}
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}, location); err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", o.Name)
+	}
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("backup storage location %s is in read-only mode", o.Name)
+	}
+
+	return nil
+}
+
+func (o *TestOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	test := &velerov1api.BackupStorageLocationTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    f.Namespace(),
+			GenerateName: o.Name + "-",
+		},
+		Spec: velerov1api.BackupStorageLocationTestSpec{
+			BackupStorageLocation: o.Name,
+			LargeObjectSize:       o.largeObjectSize,
+			SkipRepository:        o.SkipRepository,
+		},
+	}
+	if err := kbClient.Create(context.Background(), test, &kbclient.CreateOptions{}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Fprintf(o.out, "Test %q of backup storage location %q submitted successfully.\n", test.Name, o.Name)
+
+	if !o.Wait {
+		fmt.Fprintf(o.out, "Run `kubectl -n %s get backupstoragelocationtests %s -o yaml` to check its result.\n", test.Namespace, test.Name)
+		return nil
+	}
+
+	fmt.Fprintln(o.out, "Waiting for the test to finish. You may safely press ctrl-c to stop waiting - the test will continue in the background.")
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	key := kbclient.ObjectKey{Namespace: test.Namespace, Name: test.Name}
+	for range ticker.C {
+		fmt.Fprint(o.out, ".")
+
+		if err := kbClient.Get(context.Background(), key, test); err != nil {
+			return errors.WithStack(err)
+		}
+
+		switch test.Status.Phase {
+		case velerov1api.BackupStorageLocationTestPhaseCompleted,
+			velerov1api.BackupStorageLocationTestPhaseFailed:
+			fmt.Fprintln(o.out)
+			return printTestResult(o.out, test)
+		}
+	}
+
+	return nil
+}
+
+// printTestResult prints the compatibility matrix of a test, and returns an error if the test
+// failed or any of its checks failed.
+func printTestResult(w io.Writer, test *velerov1api.BackupStorageLocationTest) error {
+	if test.Status.Phase == velerov1api.BackupStorageLocationTestPhaseFailed {
+		return errors.Errorf("test %s failed: %s", test.Name, test.Status.Message)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "COMPONENT\tCHECK\tRESULT\tDURATION\tMESSAGE")
+	for _, check := range test.Status.Checks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", check.Component, check.Name, check.Result, check.Duration.Duration, check.Message)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nChecks passed: %d, failed: %d\n", test.Status.PassedChecks, test.Status.FailedChecks)
+
+	if test.Status.FailedChecks > 0 {
+		return errors.Errorf("backup storage location %s failed %d of the checks", test.Spec.BackupStorageLocation, test.Status.FailedChecks)
+	}
+
+	return nil
+}
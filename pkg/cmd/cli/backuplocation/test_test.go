@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+)
+
+func TestTestOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		flags     []string
+		expectErr string
+	}{
+		{
+			name:      "location not found",
+			args:      []string{"missing"},
+			expectErr: "error getting backup storage location missing",
+		},
+		{
+			name:      "read-only location",
+			args:      []string{"read-only"},
+			expectErr: "backup storage location read-only is in read-only mode",
+		},
+		{
+			name:      "invalid large object size",
+			args:      []string{"default"},
+			flags:     []string{"--large-object-size", "big"},
+			expectErr: `invalid --large-object-size "big"`,
+		},
+		{
+			name:      "zero large object size",
+			args:      []string{"default"},
+			flags:     []string{"--large-object-size", "0"},
+			expectErr: "--large-object-size must be greater than zero",
+		},
+		{
+			name:  "test is created",
+			args:  []string{"default"},
+			flags: []string{"--large-object-size", "1Gi", "--skip-repository"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			).Build()
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return("velero")
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			c := NewTestCommand(f, "test")
+			o := NewTestOptions()
+			o.out = new(bytes.Buffer)
+			flags := new(flag.FlagSet)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse(append(tc.flags, "--wait=false")))
+
+			require.NoError(t, o.Complete(tc.args, f))
+			err := o.Validate(c, tc.args, f)
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, o.Run(c, f))
+
+			tests := &velerov1api.BackupStorageLocationTestList{}
+			require.NoError(t, kbClient.List(context.Background(), tests))
+			require.Len(t, tests.Items, 1)
+			size := resource.MustParse("1Gi")
+			assert.Equal(t, velerov1api.BackupStorageLocationTestSpec{
+				BackupStorageLocation: "default",
+				LargeObjectSize:       &size,
+				SkipRepository:        true,
+			}, tests.Items[0].Spec)
+		})
+	}
+}
+
+func TestPrintTestResult(t *testing.T) {
+	test := &velerov1api.BackupStorageLocationTest{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-abcde"},
+		Spec:       velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default"},
+		Status: velerov1api.BackupStorageLocationTestStatus{
+			Phase: velerov1api.BackupStorageLocationTestPhaseCompleted,
+			Checks: []velerov1api.BackupStorageLocationTestCheck{
+				{
+					Component: velerov1api.BackupStorageLocationTestComponentObjectStore,
+					Name:      "PutObject",
+					Result:    velerov1api.BackupStorageLocationTestCheckResultPassed,
+					Duration:  metav1.Duration{Duration: 120 * time.Millisecond},
+				},
+				{
+					Component: velerov1api.BackupStorageLocationTestComponentRepository,
+					Name:      "ListBlobs",
+					Result:    velerov1api.BackupStorageLocationTestCheckResultFailed,
+					Message:   "listed blobs [], expected [velero-list-a velero-list-b]",
+				},
+			},
+			PassedChecks: 1,
+			FailedChecks: 1,
+		},
+	}
+
+	out := new(bytes.Buffer)
+	err := printTestResult(out, test)
+	assert.EqualError(t, err, "backup storage location default failed 1 of the checks")
+	assert.Equal(t, "COMPONENT    CHECK      RESULT  DURATION  MESSAGE\n"+
+		"ObjectStore  PutObject  Passed  120ms     \n"+
+		"Repository   ListBlobs  Failed  0s        listed blobs [], expected [velero-list-a velero-list-b]\n"+
+		"\n"+
+		"Checks passed: 1, failed: 1\n", out.String())
+
+	test.Status = velerov1api.BackupStorageLocationTestStatus{
+		Phase:   velerov1api.BackupStorageLocationTestPhaseFailed,
+		Message: "backup storage location default is in read-only mode",
+	}
+	err = printTestResult(new(bytes.Buffer), test)
+	assert.EqualError(t, err, "test default-abcde failed: backup storage location default is in read-only mode")
+}
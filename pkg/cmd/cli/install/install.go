@@ -28,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/velero/internal/velero"
@@ -37,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/filesystem"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -79,6 +81,12 @@ type Options struct {
 	Features                        string
 	DefaultVolumesToFsBackup        bool
 	UploaderType                    string
+	PrivilegedNodeAgent             bool
+	NodeAgentConfigMap              string
+	FilesystemPVC                   string
+	FilesystemNFS                   string
+	FilesystemHostPath              string
+	ServiceAccountTokenAudience     string
 }
 
 // BindFlags adds command line values to the options struct.
@@ -109,6 +117,12 @@ func (o *Options) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.RestoreOnly, "restore-only", o.RestoreOnly, "Run the server in restore-only mode. Optional.")
 	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Generate resources, but don't send them to the cluster. Use with -o. Optional.")
 	flags.BoolVar(&o.UseNodeAgent, "use-node-agent", o.UseNodeAgent, "Create Velero node-agent daemonset. Optional. Velero node-agent hosts Velero modules that need to run in one or more nodes(i.e. Restic, Kopia).")
+	flags.BoolVar(&o.PrivilegedNodeAgent, "privileged-node-agent", o.PrivilegedNodeAgent, "Run the node-agent pods in privileged mode. This is required to back up and restore block mode volumes with the data mover. Optional.")
+	flags.StringVar(&o.NodeAgentConfigMap, "node-agent-configmap", o.NodeAgentConfigMap, "The name of the ConfigMap in the Velero namespace that holds the node-agent configs, e.g. the data path concurrency and the load affinity. The ConfigMap is not created by the install. Optional.")
+	flags.StringVar(&o.FilesystemPVC, "filesystem-pvc", o.FilesystemPVC, fmt.Sprintf("The name of the PersistentVolumeClaim in the Velero namespace to mount into the Velero and node-agent pods at %s, which is the default root of the %s provider. Optional.", filesystem.DefaultRoot, filesystem.ProviderName))
+	flags.StringVar(&o.FilesystemNFS, "filesystem-nfs", o.FilesystemNFS, fmt.Sprintf("The NFS share to mount into the Velero and node-agent pods at %s, which is the default root of the %s provider. Optional. Format is server:/path", filesystem.DefaultRoot, filesystem.ProviderName))
+	flags.StringVar(&o.FilesystemHostPath, "filesystem-host-path", o.FilesystemHostPath, fmt.Sprintf("The directory of the nodes to mount into the Velero and node-agent pods at %s, which is the default root of the %s provider. Only suitable for single node test clusters. Optional.", filesystem.DefaultRoot, filesystem.ProviderName))
+	flags.StringVar(&o.ServiceAccountTokenAudience, "service-account-token-audience", o.ServiceAccountTokenAudience, fmt.Sprintf("The audience of the service account token projected into the Velero and node-agent pods at %s, which backup storage locations with a web identity exchange for short-lived credentials. Optional.", velerov1api.DefaultWebIdentityTokenFile))
 	flags.BoolVar(&o.Wait, "wait", o.Wait, "Wait for Velero deployment to be ready. Optional.")
 	flags.DurationVar(&o.DefaultRepoMaintenanceFrequency, "default-repo-maintain-frequency", o.DefaultRepoMaintenanceFrequency, "How often 'maintain' is run for backup repositories by default. Optional.")
 	flags.DurationVar(&o.GarbageCollectionFrequency, "garbage-collection-frequency", o.GarbageCollectionFrequency, "How often the garbage collection runs for expired backups.(default 1h)")
@@ -179,6 +193,10 @@ func (o *Options) AsVeleroOptions() (*install.VeleroOptions, error) {
 	if err != nil {
 		return nil, err
 	}
+	filesystemVolume, err := o.filesystemVolume()
+	if err != nil {
+		return nil, err
+	}
 
 	return &install.VeleroOptions{
 		Namespace:                       o.Namespace,
@@ -206,9 +224,44 @@ func (o *Options) AsVeleroOptions() (*install.VeleroOptions, error) {
 		Features:                        strings.Split(o.Features, ","),
 		DefaultVolumesToFsBackup:        o.DefaultVolumesToFsBackup,
 		UploaderType:                    o.UploaderType,
+		PrivilegedNodeAgent:             o.PrivilegedNodeAgent,
+		NodeAgentConfigMap:              o.NodeAgentConfigMap,
+		FilesystemVolume:                filesystemVolume,
+		ServiceAccountTokenAudience:     o.ServiceAccountTokenAudience,
 	}, nil
 }
 
+// filesystemVolume returns the source of the volume storing the buckets of the filesystem
+// object store, or nil if none is specified.
+func (o *Options) filesystemVolume() (*corev1.VolumeSource, error) {
+	switch {
+	case o.FilesystemPVC != "":
+		return &corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: o.FilesystemPVC},
+		}, nil
+	case o.FilesystemNFS != "":
+		server, path, found := strings.Cut(o.FilesystemNFS, ":")
+		if !found || server == "" || !strings.HasPrefix(path, "/") {
+			return nil, errors.Errorf("invalid NFS share %q, the format is server:/path", o.FilesystemNFS)
+		}
+		return &corev1.VolumeSource{
+			NFS: &corev1.NFSVolumeSource{Server: server, Path: path},
+		}, nil
+	case o.FilesystemHostPath != "":
+		hostPathType := corev1.HostPathDirectoryOrCreate
+		return &corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: o.FilesystemHostPath, Type: &hostPathType},
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// isFilesystemProvider returns true if the provider is the built-in filesystem object store.
+func (o *Options) isFilesystemProvider() bool {
+	return o.ProviderName == filesystem.ProviderName || "velero.io/"+o.ProviderName == filesystem.ProviderName
+}
+
 // NewCommand creates a cobra command.
 func NewCommand(f client.Factory) *cobra.Command {
 	o := NewInstallOptions()
@@ -386,11 +439,31 @@ func (o *Options) Validate(c *cobra.Command, args []string, f client.Factory) er
 		}
 	}
 
+	filesystemVolumes := 0
+	for _, v := range []string{o.FilesystemPVC, o.FilesystemNFS, o.FilesystemHostPath} {
+		if v != "" {
+			filesystemVolumes++
+		}
+	}
+	if filesystemVolumes > 1 {
+		return errors.New("only one of --filesystem-pvc, --filesystem-nfs and --filesystem-host-path can be used")
+	}
+
+	if o.isFilesystemProvider() {
+		if o.UseVolumeSnapshots {
+			return errors.Errorf("--use-volume-snapshots=false is required with the %s provider", filesystem.ProviderName)
+		}
+		if filesystemVolumes == 0 && o.BackupStorageConfig.Data()["root"] == "" {
+			return errors.Errorf("one of --filesystem-pvc, --filesystem-nfs or --filesystem-host-path is required with the %s provider", filesystem.ProviderName)
+		}
+	}
+
 	if o.NoDefaultBackupLocation && !o.UseVolumeSnapshots {
 		if o.ProviderName != "" {
 			return errors.New("--provider must be empty when using --no-default-backup-location and --use-volume-snapshots=false")
 		}
-	} else {
+	} else if !o.isFilesystemProvider() {
+		// the filesystem object store is built into the Velero server
 		if len(o.Plugins) == 0 {
 			return errors.New("--plugins flag is required")
 		}
@@ -53,8 +53,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/signals"
 	"github.com/vmware-tanzu/velero/pkg/controller"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
@@ -72,11 +77,16 @@ const (
 	defaultCredentialsDirectory = "/tmp/credentials"
 
 	defaultResourceTimeout = 10 * time.Minute
+
+	// defaultDataPathConcurrentNum is the number of the data path loads that run concurrently in a node
+	// if it is not configured by the node-agent configs
+	defaultDataPathConcurrentNum = 1
 )
 
 type nodeAgentServerConfig struct {
 	metricsAddress  string
 	resourceTimeout time.Duration
+	configMapName   string
 }
 
 func NewServerCommand(f client.Factory) *cobra.Command {
@@ -110,6 +120,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
 	command.Flags().DurationVar(&config.resourceTimeout, "resource-timeout", config.resourceTimeout, "How long to wait for resource processes which are not covered by other specific timeout parameters. Default is 10 minutes.")
+	command.Flags().StringVar(&config.configMapName, "node-agent-configmap", config.configMapName, "The name of the ConfigMap in the Velero namespace that holds the node-agent configs, e.g. the data path concurrency and the load affinity. Optional.")
 
 	return command
 }
@@ -127,6 +138,7 @@ type nodeAgentServer struct {
 	config            nodeAgentServerConfig
 	kubeClient        kubernetes.Interface
 	csiSnapshotClient *snapshotv1client.Clientset
+	dataPathConfigs   *nodeagent.Configs
 }
 
 func newNodeAgentServer(logger logrus.FieldLogger, factory client.Factory, config nodeAgentServerConfig) (*nodeAgentServer, error) {
@@ -201,6 +213,14 @@ func newNodeAgentServer(logger logrus.FieldLogger, factory client.Factory, confi
 	if err != nil {
 		return nil, err
 	}
+
+	if config.configMapName != "" {
+		s.dataPathConfigs, err = nodeagent.GetConfigs(s.ctx, s.namespace, s.kubeClient.CoreV1(), config.configMapName)
+		if err != nil {
+			s.logger.WithError(err).Warn("Failed to get node-agent configs, the default configs are used")
+		}
+	}
+
 	return s, nil
 }
 
@@ -245,22 +265,33 @@ func (s *nodeAgentServer) run() {
 
 	credentialGetter := &credentials.CredentialGetter{FromFile: credentialFileStore, FromSecret: credSecretStore}
 	repoEnsurer := repository.NewEnsurer(s.mgr.GetClient(), s.logger, s.config.resourceTimeout)
+
+	// the data path loads of all the controllers share the concurrency of the node
+	dataPathMgr := datapath.NewManagerWithCheckpointInterval(s.getDataPathConcurrentNum(defaultDataPathConcurrentNum), s.getCheckpointInterval())
+
+	var loadAffinity []*nodeagent.LoadAffinity
+	var podConfig *nodeagent.PodConfig
+	if s.dataPathConfigs != nil {
+		loadAffinity = s.dataPathConfigs.LoadAffinity
+		podConfig = s.dataPathConfigs.PodConfig
+	}
+
 	pvbReconciler := controller.NewPodVolumeBackupReconciler(s.mgr.GetClient(), repoEnsurer,
-		credentialGetter, s.nodeName, s.mgr.GetScheme(), s.metrics, s.logger)
+		credentialGetter, s.nodeName, s.mgr.GetScheme(), s.metrics, dataPathMgr, s.logger)
 
 	if err := pvbReconciler.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.PodVolumeBackup)
 	}
 
-	if err = controller.NewPodVolumeRestoreReconciler(s.mgr.GetClient(), repoEnsurer, credentialGetter, s.logger).SetupWithManager(s.mgr); err != nil {
+	if err = controller.NewPodVolumeRestoreReconciler(s.mgr.GetClient(), repoEnsurer, credentialGetter, dataPathMgr, s.logger).SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the pod volume restore controller")
 	}
 
-	if err = controller.NewDataUploadReconciler(s.mgr.GetClient(), s.kubeClient, s.csiSnapshotClient.SnapshotV1(), repoEnsurer, clock.RealClock{}, credentialGetter, s.nodeName, s.fileSystem, s.logger).SetupWithManager(s.mgr); err != nil {
+	if err = controller.NewDataUploadReconciler(s.mgr.GetClient(), s.kubeClient, s.csiSnapshotClient.SnapshotV1(), repoEnsurer, clock.RealClock{}, credentialGetter, s.nodeName, s.fileSystem, dataPathMgr, loadAffinity, podConfig, s.logger).SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the data upload controller")
 	}
 
-	if err = controller.NewDataDownloadReconciler(s.mgr.GetClient(), s.kubeClient, repoEnsurer, credentialGetter, s.nodeName, s.logger).SetupWithManager(s.mgr); err != nil {
+	if err = controller.NewDataDownloadReconciler(s.mgr.GetClient(), s.kubeClient, repoEnsurer, credentialGetter, s.nodeName, dataPathMgr, loadAffinity, podConfig, s.logger).SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the data download controller")
 	}
 
@@ -319,7 +350,8 @@ func (s *nodeAgentServer) validatePodVolumesHostPath(client kubernetes.Interface
 }
 
 // if there is a restarting during the reconciling of pvbs/pvrs/etc, these CRs may be stuck in progress status
-// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue
+// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue,
+// except the backups by kopia, which are handed back to the controllers to resume from their last checkpoints
 func (s *nodeAgentServer) markInProgressCRsFailed() {
 	// the function is called before starting the controller manager, the embedded client isn't ready to use, so create a new one here
 	client, err := ctrlclient.New(s.mgr.GetConfig(), ctrlclient.Options{Scheme: s.mgr.GetScheme()})
@@ -331,6 +363,8 @@ func (s *nodeAgentServer) markInProgressCRsFailed() {
 	s.markInProgressPVBsFailed(client)
 
 	s.markInProgressPVRsFailed(client)
+
+	s.resumeInProgressDataUploads(client)
 }
 
 func (s *nodeAgentServer) markInProgressPVBsFailed(client ctrlclient.Client) {
@@ -349,6 +383,17 @@ func (s *nodeAgentServer) markInProgressPVBsFailed(client ctrlclient.Client) {
 			continue
 		}
 
+		if pvb.Spec.UploaderType == uploader.KopiaType {
+			if err := controller.ResetPVBForResume(s.ctx, client, &pvbs.Items[i],
+				fmt.Sprintf("get a podvolumebackup with status %q during the server starting, resume it from the last checkpoint", velerov1api.PodVolumeBackupPhaseInProgress),
+				s.logger); err != nil {
+				s.logger.WithError(errors.WithStack(err)).Errorf("failed to patch podvolumebackup %q", pvb.GetName())
+				continue
+			}
+			s.logger.WithField("podvolumebackup", pvb.GetName()).Warn(pvbs.Items[i].Status.Message)
+			continue
+		}
+
 		if err := controller.UpdatePVBStatusToFailed(s.ctx, client, &pvbs.Items[i],
 			fmt.Sprintf("get a podvolumebackup with status %q during the server starting, mark it as %q", velerov1api.PodVolumeBackupPhaseInProgress, velerov1api.PodVolumeBackupPhaseFailed),
 			time.Now(), s.logger); err != nil {
@@ -394,3 +439,70 @@ func (s *nodeAgentServer) markInProgressPVRsFailed(client ctrlclient.Client) {
 		s.logger.WithField("podvolumerestore", pvr.GetName()).Warn(pvr.Status.Message)
 	}
 }
+
+func (s *nodeAgentServer) resumeInProgressDataUploads(client ctrlclient.Client) {
+	dus := &velerov2alpha1api.DataUploadList{}
+	if err := client.List(s.ctx, dus, &ctrlclient.MatchingFields{"metadata.namespace": s.namespace}); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to list datauploads")
+		return
+	}
+	for i, du := range dus.Items {
+		if du.Status.Phase != velerov2alpha1api.DataUploadPhaseInProgress {
+			s.logger.Debugf("the status of dataupload %q is %q, skip", du.GetName(), du.Status.Phase)
+			continue
+		}
+		if du.Status.Node != s.nodeName {
+			s.logger.Debugf("the node of dataupload %q is %q, not %q, skip", du.GetName(), du.Status.Node, s.nodeName)
+			continue
+		}
+		if !datamover.IsBuiltInDataMover(du.Spec.DataMover) {
+			s.logger.Debugf("the data mover of dataupload %q is %q, skip", du.GetName(), du.Spec.DataMover)
+			continue
+		}
+
+		if err := controller.ResetDataUploadForResume(s.ctx, client, &dus.Items[i],
+			fmt.Sprintf("get a dataupload with status %q during the server starting, resume it from the last checkpoint", velerov2alpha1api.DataUploadPhaseInProgress),
+			s.logger); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Errorf("failed to patch dataupload %q", du.GetName())
+			continue
+		}
+		s.logger.WithField("dataupload", du.GetName()).Warn(dus.Items[i].Status.Message)
+	}
+}
+
+// getCheckpointInterval returns how often the kopia uploader saves the checkpoints of the backups in the current node,
+// 0 means the default interval of kopia
+func (s *nodeAgentServer) getCheckpointInterval() time.Duration {
+	if s.dataPathConfigs == nil || s.dataPathConfigs.CheckpointInterval == nil {
+		return 0
+	}
+
+	interval := s.dataPathConfigs.CheckpointInterval.Duration
+	if interval <= 0 || interval > kopia.MaxCheckpointInterval {
+		s.logger.Warnf("Checkpoint interval %v is not in (0, %v], use the default interval", interval, kopia.MaxCheckpointInterval)
+		return 0
+	}
+
+	s.logger.Infof("Use the checkpoint interval %v", interval)
+
+	return interval
+}
+
+// getDataPathConcurrentNum returns the number of the data path loads that run concurrently in the current node
+func (s *nodeAgentServer) getDataPathConcurrentNum(defaultNum int) int {
+	if s.dataPathConfigs == nil || s.dataPathConfigs.LoadConcurrency == nil {
+		s.logger.Infof("Concurrency configs are not found, use the default number %v", defaultNum)
+		return defaultNum
+	}
+
+	node, err := s.kubeClient.CoreV1().Nodes().Get(s.ctx, s.nodeName, metav1.GetOptions{})
+	if err != nil {
+		s.logger.WithError(err).Warnf("Failed to get node info for %s, use the default number %v", s.nodeName, defaultNum)
+		return defaultNum
+	}
+
+	num := nodeagent.GetConcurrentNum(s.dataPathConfigs.LoadConcurrency, node.Labels, defaultNum, s.logger)
+	s.logger.Infof("Use the data path concurrent number %v for node %s", num, s.nodeName)
+
+	return num
+}
@@ -20,13 +20,19 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -107,3 +113,97 @@ func Test_validatePodVolumesHostPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_markInProgressPVBsFailed(t *testing.T) {
+	newPVB := func(name string, phase velerov1api.PodVolumeBackupPhase, node string, uploaderType string) *velerov1api.PodVolumeBackup {
+		return builder.ForPodVolumeBackup("velero", name).Phase(phase).Node(node).UploaderType(uploaderType).Result()
+	}
+
+	client := testutil.NewFakeControllerRuntimeClient(t,
+		newPVB("pvb-kopia", velerov1api.PodVolumeBackupPhaseInProgress, "node-1", "kopia"),
+		newPVB("pvb-restic", velerov1api.PodVolumeBackupPhaseInProgress, "node-1", "restic"),
+		newPVB("pvb-other-node", velerov1api.PodVolumeBackupPhaseInProgress, "node-2", "kopia"),
+		newPVB("pvb-completed", velerov1api.PodVolumeBackupPhaseCompleted, "node-1", "kopia"),
+	)
+
+	s := &nodeAgentServer{ctx: context.Background(), namespace: "velero", nodeName: "node-1", logger: testutil.NewLogger()}
+	s.markInProgressPVBsFailed(client)
+
+	expected := map[string]velerov1api.PodVolumeBackupPhase{
+		"pvb-kopia":      velerov1api.PodVolumeBackupPhaseNew,
+		"pvb-restic":     velerov1api.PodVolumeBackupPhaseFailed,
+		"pvb-other-node": velerov1api.PodVolumeBackupPhaseInProgress,
+		"pvb-completed":  velerov1api.PodVolumeBackupPhaseCompleted,
+	}
+	for name, phase := range expected {
+		pvb := &velerov1api.PodVolumeBackup{}
+		require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: name}, pvb))
+		assert.Equal(t, phase, pvb.Status.Phase, name)
+	}
+}
+
+func Test_resumeInProgressDataUploads(t *testing.T) {
+	newDU := func(name string, phase velerov2alpha1api.DataUploadPhase, node string, dataMover string) *velerov2alpha1api.DataUpload {
+		du := builder.ForDataUpload("velero", name).Phase(phase).DataMover(dataMover).Result()
+		du.Status.Node = node
+		return du
+	}
+
+	client := testutil.NewFakeControllerRuntimeClient(t,
+		newDU("du-in-progress", velerov2alpha1api.DataUploadPhaseInProgress, "node-1", ""),
+		newDU("du-other-node", velerov2alpha1api.DataUploadPhaseInProgress, "node-2", ""),
+		newDU("du-other-mover", velerov2alpha1api.DataUploadPhaseInProgress, "node-1", "other-mover"),
+		newDU("du-completed", velerov2alpha1api.DataUploadPhaseCompleted, "node-1", ""),
+	)
+
+	s := &nodeAgentServer{ctx: context.Background(), namespace: "velero", nodeName: "node-1", logger: testutil.NewLogger()}
+	s.resumeInProgressDataUploads(client)
+
+	expected := map[string]velerov2alpha1api.DataUploadPhase{
+		"du-in-progress": velerov2alpha1api.DataUploadPhasePrepared,
+		"du-other-node":  velerov2alpha1api.DataUploadPhaseInProgress,
+		"du-other-mover": velerov2alpha1api.DataUploadPhaseInProgress,
+		"du-completed":   velerov2alpha1api.DataUploadPhaseCompleted,
+	}
+	for name, phase := range expected {
+		du := &velerov2alpha1api.DataUpload{}
+		require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: name}, du))
+		assert.Equal(t, phase, du.Status.Phase, name)
+	}
+}
+
+func Test_getCheckpointInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		configs  *nodeagent.Configs
+		expected time.Duration
+	}{
+		{
+			name: "no configs",
+		},
+		{
+			name:    "no checkpoint interval",
+			configs: &nodeagent.Configs{},
+		},
+		{
+			name:     "valid checkpoint interval",
+			configs:  &nodeagent.Configs{CheckpointInterval: &metav1.Duration{Duration: 10 * time.Minute}},
+			expected: 10 * time.Minute,
+		},
+		{
+			name:    "negative checkpoint interval",
+			configs: &nodeagent.Configs{CheckpointInterval: &metav1.Duration{Duration: -time.Minute}},
+		},
+		{
+			name:    "checkpoint interval longer than kopia accepts",
+			configs: &nodeagent.Configs{CheckpointInterval: &metav1.Duration{Duration: time.Hour}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &nodeAgentServer{dataPathConfigs: test.configs, logger: testutil.NewLogger()}
+			assert.Equal(t, test.expected, s.getCheckpointInterval())
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// NewDescribeCommand creates the command for describing backup repositories together with the
+// statistics of the data in them
+func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
+	var (
+		listOptions kbclient.ListOptions
+		selector    string
+		stats       bool
+	)
+
+	c := &cobra.Command{
+		Use:   use + " [NAME1] [NAME2] [NAME...]",
+		Short: "Describe repositories",
+		Long: `Describe backup repositories.
+
+With --stats, each repository is opened read-only to show the
+statistics of the data stored in it, the snapshots per namespace and volume, and the
+snapshots that are not referenced by any backup in the cluster. Collecting the statistics
+iterates all the data in the repository, so it may take a while for large repositories.
+Statistics are only supported for kopia repositories.
+
+The credentials of the backup storage locations are written to a temporary directory while
+the statistics are collected, the directory is removed when the command exits.`,
+		Example: `  # Describe all the repositories.
+  velero repo describe
+
+  # Describe the repository named "repo-1" with the statistics of its data.
+  velero repo describe repo-1 --stats`,
+		Run: func(c *cobra.Command, args []string) {
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			if selector != "" {
+				parsed, err := labels.Parse(selector)
+				cmd.CheckError(err)
+				listOptions.LabelSelector = parsed
+			}
+
+			repos := new(velerov1api.BackupRepositoryList)
+			if len(args) > 0 {
+				for _, name := range args {
+					repo := new(velerov1api.BackupRepository)
+					err := kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, repo)
+					cmd.CheckError(err)
+					repos.Items = append(repos.Items, *repo)
+				}
+			} else {
+				listOptions.Namespace = f.Namespace()
+				cmd.CheckError(kbClient.List(context.TODO(), repos, &listOptions))
+			}
+
+			// cmd.CheckError exits without running the deferred functions, so it's not called before the
+			// credentials written by the stats collector are removed
+			cmd.CheckError(describeRepos(kbClient, f.Namespace(), repos, stats))
+		},
+	}
+
+	c.Flags().StringVarP(&selector, "selector", "l", selector, "Only show items matching this label selector.")
+	c.Flags().BoolVar(&stats, "stats", stats, "Open the repositories read-only to show the statistics of the data in them.")
+
+	return c
+}
+
+func describeRepos(kbClient kbclient.Client, namespace string, repos *velerov1api.BackupRepositoryList, stats bool) error {
+	var collector *statsCollector
+	if stats {
+		var err error
+		collector, err = newStatsCollector(kbClient, namespace)
+		if err != nil {
+			return err
+		}
+		defer collector.close()
+	}
+
+	for i := range repos.Items {
+		var repoStats *output.BackupRepoStatistics
+		if collector != nil {
+			repoStats = collector.collect(&repos.Items[i])
+		}
+
+		s := output.DescribeBackupRepository(&repos.Items[i], repoStats)
+		if i == 0 {
+			fmt.Print(s)
+		} else {
+			fmt.Printf("\n\n%s", s)
+		}
+	}
+
+	return nil
+}
+
+// statsCollector opens the backup repositories with the repository manager to collect their
+// statistics, the credentials of the repositories are written to a temporary directory, which
+// is removed when the collector is closed or the command is interrupted
+type statsCollector struct {
+	credentialsDir string
+	signals        chan os.Signal
+	repoManager    repository.Manager
+	owners         *repository.SnapshotOwners
+}
+
+func newStatsCollector(kbClient kbclient.Client, namespace string) (*statsCollector, error) {
+	backupList := new(velerov1api.BackupList)
+	if err := kbClient.List(context.TODO(), backupList, kbclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error to list backups")
+	}
+
+	credentialsDir, err := os.MkdirTemp("", "velero-repo-credentials")
+	if err != nil {
+		return nil, errors.Wrap(err, "error to create credentials directory")
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		if _, ok := <-signals; ok {
+			os.RemoveAll(credentialsDir)
+			os.Exit(1)
+		}
+	}()
+
+	collector := &statsCollector{
+		credentialsDir: credentialsDir,
+		signals:        signals,
+		// the backups in the backup storage locations can't be listed without the plugins, which only
+		// run in the Velero server, the snapshots are checked against the backups in the cluster only
+		owners: repository.NewSnapshotOwners(backupList.Items, nil),
+	}
+
+	credentialFileStore, err := credentials.NewNamespacedFileStore(kbClient, namespace, credentialsDir, filesystem.NewFileSystem())
+	if err != nil {
+		collector.close()
+		return nil, errors.Wrap(err, "error to create credential file store")
+	}
+
+	credentialSecretStore, err := credentials.NewNamespacedSecretStore(kbClient, namespace)
+	if err != nil {
+		collector.close()
+		return nil, errors.Wrap(err, "error to create credential secret store")
+	}
+
+	logger := logging.DefaultLogger(logrus.ErrorLevel, logging.FormatText)
+
+	collector.repoManager = repository.NewManager(
+		namespace,
+		kbClient,
+		repository.NewRepoLocker(),
+		repository.NewEnsurer(kbClient, logger, 0),
+		credentialFileStore,
+		credentialSecretStore,
+		logger,
+	)
+
+	return collector, nil
+}
+
+func (s *statsCollector) collect(repo *velerov1api.BackupRepository) *output.BackupRepoStatistics {
+	repoStats := &output.BackupRepoStatistics{Owners: s.owners}
+	if repo.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+		repoStats.Error = errors.Errorf("backup repository is in %s phase", repo.Status.Phase)
+		return repoStats
+	}
+
+	repoStats.Statistics, repoStats.Error = s.repoManager.GetStatistics(repo)
+	return repoStats
+}
+
+func (s *statsCollector) close() {
+	signal.Stop(s.signals)
+	close(s.signals)
+	os.RemoveAll(s.credentialsDir)
+}
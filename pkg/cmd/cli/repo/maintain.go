@@ -0,0 +1,86 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewMaintainCommand creates the command for requesting the maintenance of backup repositories
+func NewMaintainCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME [NAME...]",
+		Short: "Run maintenance on backup repositories",
+		Long: `Run maintenance on backup repositories regardless of their maintenance frequency.
+
+The maintenance runs as a job created by the Velero server, once the repository is ready
+and no other maintenance job of it is running. The result is shown in the repository's
+status.`,
+		Example: `  # Run maintenance on the repository named "repo-1".
+  velero repo maintain repo-1`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(runMaintain(f, args))
+		},
+	}
+
+	return c
+}
+
+func runMaintain(f client.Factory, names []string) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range names {
+		repo, err := client.VeleroV1().BackupRepositories(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+
+		if repo.Annotations == nil {
+			repo.Annotations = map[string]string{}
+		}
+		repo.Annotations[velerov1api.RepositoryMaintenanceRequestAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+		if _, err := client.VeleroV1().BackupRepositories(repo.Namespace).Update(context.TODO(), repo, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update backup repository %s", repo.Name))
+			continue
+		}
+
+		fmt.Printf("Request to run maintenance on backup repository %q submitted successfully.\n", repo.Name)
+		if repo.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+			fmt.Printf("Backup repository %q is in %s phase, the maintenance runs once it is ready.\n", repo.Name, repo.Status.Phase)
+		}
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
@@ -31,6 +31,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 
 	c.AddCommand(
 		NewGetCommand(f, "get"),
+		NewDescribeCommand(f, "describe"),
+		NewMaintainCommand(f, "maintain"),
 	)
 
 	return c
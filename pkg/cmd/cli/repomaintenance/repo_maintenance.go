@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repomaintenance
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerocli "github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+const (
+	// defaultCredentialsDirectory is the path on disk where credential
+	// files will be written to
+	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// terminationLogFile is the file that the result of the maintenance is written to, it is
+	// reported as the termination message of the maintenance job's pod
+	terminationLogFile = "/dev/termination-log"
+)
+
+type Options struct {
+	RepoName     string
+	LogLevelFlag *logging.LevelFlag
+	FormatFlag   *logging.FormatFlag
+}
+
+func (o *Options) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.RepoName, "repo-name", "", "The name of the backup repository to run maintenance for")
+	flags.Var(o.LogLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(o.LogLevelFlag.AllowedValues(), ", ")))
+	flags.Var(o.FormatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(o.FormatFlag.AllowedValues(), ", ")))
+}
+
+// NewCommand returns the command that runs the maintenance of a backup repository, it is run
+// by the repository maintenance jobs that the backup repository controller creates
+func NewCommand(f velerocli.Factory) *cobra.Command {
+	o := &Options{
+		LogLevelFlag: logging.LogLevelFlag(logrus.InfoLevel),
+		FormatFlag:   logging.NewFormatFlag(),
+	}
+
+	c := &cobra.Command{
+		Use:    "repo-maintenance",
+		Short:  "Run the maintenance of a backup repository",
+		Long:   "Run the maintenance of a backup repository",
+		Hidden: true,
+		Run: func(c *cobra.Command, args []string) {
+			o.Run(f)
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+func (o *Options) Run(f velerocli.Factory) {
+	logrus.SetOutput(os.Stdout)
+	logger := logging.DefaultLogger(o.LogLevelFlag.Parse(), o.FormatFlag.Parse())
+
+	err := o.runMaintenance(f, logger)
+	if err != nil {
+		logger.WithError(err).Error("Failed to run repository maintenance")
+		// the content of the termination log is the message of the failed maintenance
+		if writeErr := os.WriteFile(terminationLogFile, []byte(err.Error()), 0644); writeErr != nil {
+			logger.WithError(writeErr).Error("Failed to write the termination log")
+		}
+		os.Exit(1)
+	}
+
+	logger.Info("Repository maintenance completed")
+}
+
+func (o *Options) runMaintenance(f velerocli.Factory, logger logrus.FieldLogger) error {
+	if o.RepoName == "" {
+		return errors.New("repo-name is required")
+	}
+
+	cli, err := f.KubebuilderClient()
+	if err != nil {
+		return errors.Wrap(err, "error to create client")
+	}
+
+	namespace := f.Namespace()
+	repo := &velerov1api.BackupRepository{}
+	if err := cli.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: o.RepoName}, repo); err != nil {
+		return errors.Wrapf(err, "error to get backup repository %s", o.RepoName)
+	}
+
+	credentialFileStore, err := credentials.NewNamespacedFileStore(cli, namespace, defaultCredentialsDirectory, filesystem.NewFileSystem())
+	if err != nil {
+		return errors.Wrap(err, "error to create credential file store")
+	}
+
+	credentialSecretStore, err := credentials.NewNamespacedSecretStore(cli, namespace)
+	if err != nil {
+		return errors.Wrap(err, "error to create credential secret store")
+	}
+
+	repoManager := repository.NewManager(
+		namespace,
+		cli,
+		repository.NewRepoLocker(),
+		repository.NewEnsurer(cli, logger, 0),
+		credentialFileStore,
+		credentialSecretStore,
+		logger,
+	)
+
+	logger = logger.WithFields(logrus.Fields{
+		"repo":     repo.Name,
+		"repoType": repo.Spec.RepositoryType,
+	})
+	logger.Info("Running maintenance on backup repository")
+
+	return repoManager.PruneRepo(repo)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewCancelCommand creates the command for canceling restores
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("cancel", "restore")
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Cancel ongoing restores",
+		Long: `Cancel ongoing restores.
+
+Only the restores in New, InProgress or WaitingForPluginOperations phase can be canceled.
+The items already restored are kept, and the restore ends in the Cancelled phase.`,
+		Example: `  # Cancel a restore named "restore-1".
+  velero restore cancel restore-1
+
+  # Cancel restores named "restore-1" and "restore-2".
+  velero restore cancel restore-1 restore-2
+
+  # Cancel all restores labeled with "foo=bar".
+  velero restore cancel --selector foo=bar
+
+  # Cancel all ongoing restores.
+  velero restore cancel --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runCancel(f, o))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+func runCancel(f client.Factory, o *cli.SelectOptions) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	restores, errs := selectRestores(f, o)
+	if len(restores) == 0 {
+		fmt.Println("No restores found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	for _, restore := range restores {
+		if !isRestoreCancelable(restore) {
+			fmt.Printf("Restore %s is in %s phase which can't be canceled, skip\n", restore.Name, restore.Status.Phase)
+			continue
+		}
+		if restore.Spec.Cancel {
+			fmt.Printf("Restore %s is already being canceled, skip\n", restore.Name)
+			continue
+		}
+		restore.Spec.Cancel = true
+		if _, err := client.VeleroV1().Restores(restore.Namespace).Update(context.TODO(), restore, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update restore %s", restore.Name))
+			continue
+		}
+		fmt.Printf("Request to cancel restore %q submitted successfully.\n", restore.Name)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
+
+// selectRestores gets the restores specified by the names, the --all flag or the --selector flag
+func selectRestores(f client.Factory, o *cli.SelectOptions) ([]*velerov1api.Restore, []error) {
+	client, err := f.Client()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var (
+		restores []*velerov1api.Restore
+		errs     []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			restore, err := client.VeleroV1().Restores(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			restores = append(restores, restore)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := client.VeleroV1().Restores(f.Namespace()).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			return nil, append(errs, errors.WithStack(err))
+		}
+
+		for i := range res.Items {
+			restores = append(restores, &res.Items[i])
+		}
+	}
+	return restores, errs
+}
+
+func isRestoreCancelable(restore *velerov1api.Restore) bool {
+	switch restore.Status.Phase {
+	case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress,
+		velerov1api.RestorePhaseWaitingForPluginOperations, velerov1api.RestorePhaseWaitingForPluginOperationsPartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
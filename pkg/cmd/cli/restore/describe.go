@@ -29,13 +29,16 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	var (
 		listOptions           metav1.ListOptions
 		details               bool
+		itemOutcomes          []string
 		insecureSkipTLSVerify bool
+		outputFormat          = "plaintext"
 	)
 
 	config, err := client.LoadConfig()
@@ -54,6 +57,9 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 			kbClient, err := f.KubebuilderClient()
 			cmd.CheckError(err)
 
+			cmd.CheckError(output.ValidateDescribeOutputFormat(outputFormat))
+			cmd.CheckError(results.ValidateItemOutcomes(itemOutcomes))
+
 			var restores *velerov1api.RestoreList
 			if len(args) > 0 {
 				restores = new(velerov1api.RestoreList)
@@ -75,12 +81,18 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Fprintf(os.Stderr, "error getting PodVolumeRestores for restore %s: %v\n", restore.Name, err)
 				}
 
-				s := output.DescribeRestore(context.Background(), kbClient, &restores.Items[i], podvolumeRestoreList.Items, details, veleroClient, insecureSkipTLSVerify, caCertFile)
-				if first {
-					first = false
+				// structured output only applies to a single restore in case of OOM
+				if len(restores.Items) == 1 && outputFormat != "plaintext" {
+					s := output.DescribeRestoreInSF(context.Background(), kbClient, &restores.Items[i], podvolumeRestoreList.Items, details, itemOutcomes, insecureSkipTLSVerify, caCertFile, outputFormat)
 					fmt.Print(s)
 				} else {
-					fmt.Printf("\n\n%s", s)
+					s := output.DescribeRestore(context.Background(), kbClient, &restores.Items[i], podvolumeRestoreList.Items, details, itemOutcomes, veleroClient, insecureSkipTLSVerify, caCertFile)
+					if first {
+						first = false
+						fmt.Print(s)
+					} else {
+						fmt.Printf("\n\n%s", s)
+					}
 				}
 			}
 			cmd.CheckError(err)
@@ -89,8 +101,10 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
+	c.Flags().StringSliceVar(&itemOutcomes, "item-outcomes", itemOutcomes, "Only list restored items with one of these outcomes (created, updated, skipped-exists, skipped-filtered, failed) in the item status. Only applies with --details.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'plaintext, json, yaml'. 'json' and 'yaml' only apply to a single restore")
 
 	return c
 }
@@ -0,0 +1,96 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewPauseCommand creates the command for pausing restores
+func NewPauseCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("pause", "restore")
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Pause the progress checking of the asynchronous operations of restores",
+		Long: `Pause the progress checking of the asynchronous operations of restores.
+
+A paused restore stays in the WaitingForPluginOperations phase until it is resumed. The item
+operation timeout of the restore still counts while it is paused.`,
+		Example: `  # Pause a restore named "restore-1".
+  velero restore pause restore-1
+
+  # Pause restores named "restore-1" and "restore-2".
+  velero restore pause restore-1 restore-2
+
+  # Pause all restores labeled with "foo=bar".
+  velero restore pause --selector foo=bar
+
+  # Pause all restores.
+  velero restore pause --all`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(f, o, true))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+func runPause(f client.Factory, o *cli.SelectOptions, paused bool) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	restores, errs := selectRestores(f, o)
+	if len(restores) == 0 {
+		fmt.Println("No restores found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	msg := "paused"
+	if !paused {
+		msg = "resumed"
+	}
+	for _, restore := range restores {
+		if restore.Spec.Paused == paused {
+			fmt.Printf("Restore %s is already %s, skip\n", restore.Name, msg)
+			continue
+		}
+		restore.Spec.Paused = paused
+		if _, err := client.VeleroV1().Restores(restore.Namespace).Update(context.TODO(), restore, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update restore %s", restore.Name))
+			continue
+		}
+		fmt.Printf("Restore %s %s successfully\n", restore.Name, msg)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
@@ -35,6 +35,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDeleteCommand(f, "delete"),
+		NewCancelCommand(f, "cancel"),
+		NewPauseCommand(f, "pause"),
+		NewResumeCommand(f, "resume"),
 	)
 
 	return c
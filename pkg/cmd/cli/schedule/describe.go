@@ -30,7 +30,10 @@ import (
 )
 
 func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
-	var listOptions metav1.ListOptions
+	var (
+		listOptions  metav1.ListOptions
+		outputFormat = "plaintext"
+	)
 
 	c := &cobra.Command{
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
@@ -39,6 +42,8 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
+			cmd.CheckError(output.ValidateDescribeOutputFormat(outputFormat))
+
 			var schedules *v1.ScheduleList
 			if len(args) > 0 {
 				schedules = new(v1.ScheduleList)
@@ -54,6 +59,11 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 			first := true
 			for i := range schedules.Items {
+				if len(schedules.Items) == 1 && outputFormat != "plaintext" {
+					fmt.Print(output.DescribeScheduleInSF(&schedules.Items[i], outputFormat))
+					continue
+				}
+
 				s := output.DescribeSchedule(&schedules.Items[i])
 				if first {
 					first = false
@@ -67,6 +77,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
+	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'plaintext, json, yaml'. 'json' and 'yaml' only apply to a single schedule")
 
 	return c
 }
@@ -36,6 +36,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -53,9 +55,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -69,6 +73,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	fsobjectstore "github.com/vmware-tanzu/velero/pkg/objectstore/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
@@ -79,6 +84,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -130,15 +136,37 @@ type serverConfig struct {
 	formatFlag                                                              *logging.FormatFlag
 	repoMaintenanceFrequency                                                time.Duration
 	garbageCollectionFrequency                                              time.Duration
+	orphanedSnapshotCheckFrequency                                          time.Duration
+	orphanedSnapshotDryRun                                                  bool
 	itemOperationSyncFrequency                                              time.Duration
 	defaultVolumesToFsBackup                                                bool
 	uploaderType                                                            string
 	maxConcurrentK8SConnections                                             int
+	maintenanceJobCPURequest, maintenanceJobMemRequest                      string
+	maintenanceJobCPULimit, maintenanceJobMemLimit                          string
+	maintenanceJobNodeSelector                                              map[string]string
+	keepLatestMaintenanceJobs                                               int
+	backupCompression                                                       string
+	backupCompressionLevel                                                  int
+	backupStreamingUpload                                                   bool
+	backupContentsLayout                                                    string
+}
+
+// compression returns the compression of the backup tarballs, nil means the default gzip.
+func (c serverConfig) compression() *velerov1api.BackupCompression {
+	if c.backupCompression == "" {
+		return nil
+	}
+	return &velerov1api.BackupCompression{
+		Algorithm: velerov1api.CompressionAlgorithm(c.backupCompression),
+		Level:     c.backupCompressionLevel,
+	}
 }
 
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(':')
+		maintenanceNodeSelector = flag.NewMap()
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
 			pluginDir:                      "/plugins",
@@ -162,6 +190,13 @@ func NewCommand(f client.Factory) *cobra.Command {
 			defaultVolumesToFsBackup:       podvolume.DefaultVolumesToFsBackup,
 			uploaderType:                   uploader.ResticType,
 			maxConcurrentK8SConnections:    defaultMaxConcurrentK8SConnections,
+			maintenanceJobCPURequest:       "0",
+			maintenanceJobMemRequest:       "0",
+			maintenanceJobCPULimit:         "0",
+			maintenanceJobMemLimit:         "0",
+			keepLatestMaintenanceJobs:      repository.DefaultKeepLatestMaintenanceJobs,
+			backupCompression:              string(velerov1api.CompressionAlgorithmGzip),
+			backupContentsLayout:           string(velerov1api.BackupContentsLayoutSingle),
 		}
 	)
 
@@ -197,6 +232,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			config.maintenanceJobNodeSelector = maintenanceNodeSelector.Data()
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -226,12 +263,24 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.repoMaintenanceFrequency, "default-repo-maintain-frequency", config.repoMaintenanceFrequency, "How often 'maintain' is run for backup repositories by default.")
 	command.Flags().DurationVar(&config.garbageCollectionFrequency, "garbage-collection-frequency", config.garbageCollectionFrequency, "How often garbage collection is run for expired backups.")
+	command.Flags().DurationVar(&config.orphanedSnapshotCheckFrequency, "orphaned-snapshot-check-frequency", config.orphanedSnapshotCheckFrequency, "How often the backup repositories are checked for the snapshots not referenced by any backup. Default is 24 hours.")
+	command.Flags().BoolVar(&config.orphanedSnapshotDryRun, "orphaned-snapshot-dry-run", config.orphanedSnapshotDryRun, "Only report the orphaned snapshots in the backup repositories rather than forgetting them.")
 	command.Flags().DurationVar(&config.itemOperationSyncFrequency, "item-operation-sync-frequency", config.itemOperationSyncFrequency, "How often to check status on backup/restore operations after backup/restore processing. Default is 10 seconds")
 	command.Flags().BoolVar(&config.defaultVolumesToFsBackup, "default-volumes-to-fs-backup", config.defaultVolumesToFsBackup, "Backup all volumes with pod volume file system backup by default.")
 	command.Flags().StringVar(&config.uploaderType, "uploader-type", config.uploaderType, "Type of uploader to handle the transfer of data of pod volumes")
 	command.Flags().DurationVar(&config.defaultItemOperationTimeout, "default-item-operation-timeout", config.defaultItemOperationTimeout, "How long to wait on asynchronous BackupItemActions and RestoreItemActions to complete before timing out. Default is 4 hours")
 	command.Flags().DurationVar(&config.resourceTimeout, "resource-timeout", config.resourceTimeout, "How long to wait for resource processes which are not covered by other specific timeout parameters. Default is 10 minutes.")
 	command.Flags().IntVar(&config.maxConcurrentK8SConnections, "max-concurrent-k8s-connections", config.maxConcurrentK8SConnections, "Max concurrent connections number that Velero can create with kube-apiserver. Default is 30.")
+	command.Flags().StringVar(&config.maintenanceJobCPURequest, "maintenance-job-cpu-request", config.maintenanceJobCPURequest, "CPU request for the repository maintenance jobs. Default is no limit.")
+	command.Flags().StringVar(&config.maintenanceJobMemRequest, "maintenance-job-mem-request", config.maintenanceJobMemRequest, "Memory request for the repository maintenance jobs. Default is no limit.")
+	command.Flags().StringVar(&config.maintenanceJobCPULimit, "maintenance-job-cpu-limit", config.maintenanceJobCPULimit, "CPU limit for the repository maintenance jobs. Default is no limit.")
+	command.Flags().StringVar(&config.maintenanceJobMemLimit, "maintenance-job-mem-limit", config.maintenanceJobMemLimit, "Memory limit for the repository maintenance jobs. Default is no limit.")
+	command.Flags().Var(&maintenanceNodeSelector, "maintenance-job-node-selector", "Node selector of the repository maintenance jobs (key1=value1,key2=value2,...)")
+	command.Flags().IntVar(&config.keepLatestMaintenanceJobs, "keep-latest-maintenance-jobs", config.keepLatestMaintenanceJobs, "Number of the latest repository maintenance jobs, and the history of them, to keep for each repository. Default is 3.")
+	command.Flags().StringVar(&config.backupCompression, "backup-compression", config.backupCompression, "Compression of the backup tarballs. Valid values are none, gzip and zstd. Default is gzip.")
+	command.Flags().IntVar(&config.backupCompressionLevel, "backup-compression-level", config.backupCompressionLevel, "Compression level of the backup tarballs, 1-9 for gzip and 1-22 for zstd. Default is the default level of the compression.")
+	command.Flags().BoolVar(&config.backupStreamingUpload, "backup-streaming-upload", config.backupStreamingUpload, "Upload the backup tarballs while they're written rather than writing them to temp files first.")
+	command.Flags().StringVar(&config.backupContentsLayout, "backup-contents-layout", config.backupContentsLayout, "How the contents of the backups are stored. Valid values are Single, a single tarball, and PerNamespace, a tarball per namespace, so that restores only download the namespaces they restore. Default is Single.")
 
 	return command
 }
@@ -260,6 +309,7 @@ type server struct {
 	mgr                   manager.Manager
 	credentialFileStore   credentials.FileStore
 	credentialSecretStore credentials.SecretStore
+	filesystemSigningKey  []byte
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -267,6 +317,21 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	if err := archive.ValidateCompression(config.compression()); err != nil {
+		return nil, errors.Wrap(err, "invalid backup compression")
+	}
+
+	switch velerov1api.BackupContentsLayout(config.backupContentsLayout) {
+	case "", velerov1api.BackupContentsLayoutSingle:
+	case velerov1api.BackupContentsLayoutPerNamespace:
+		if config.backupStreamingUpload {
+			return nil, errors.New("backup-streaming-upload can't be used with the PerNamespace backup contents layout")
+		}
+	default:
+		return nil, errors.Errorf("invalid backup contents layout %q, valid values are %q and %q", config.backupContentsLayout,
+			velerov1api.BackupContentsLayoutSingle, velerov1api.BackupContentsLayoutPerNamespace)
+	}
+
 	if config.clientQPS < 0.0 {
 		return nil, errors.New("client-qps must be positive")
 	}
@@ -296,6 +361,13 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	// the key is passed to the filesystem object store in its config to sign the download
+	// URLs served by the server
+	filesystemSigningKey, err := fsobjectstore.NewSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
 	pluginRegistry := process.NewRegistry(config.pluginDir, logger, logger.Level)
 	if err := pluginRegistry.DiscoverPlugins(); err != nil {
 		return nil, err
@@ -330,12 +402,23 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		cancelFunc()
 		return nil, err
 	}
+	if err := batchv1api.AddToScheme(scheme); err != nil {
+		cancelFunc()
+		return nil, err
+	}
+	if err := appsv1api.AddToScheme(scheme); err != nil {
+		cancelFunc()
+		return nil, err
+	}
 
 	ctrl.SetLogger(logrusr.New(logger))
 
 	mgr, err := ctrl.NewManager(clientConfig, ctrl.Options{
 		Scheme:    scheme,
 		Namespace: f.Namespace(),
+		// the repository maintenance jobs are read from the API server directly so that a job
+		// just created is never missed, and the Velero deployment is only read occasionally
+		ClientDisableCacheFor: []ctrlclient.Object{&batchv1api.Job{}, &appsv1api.Deployment{}},
 	})
 	if err != nil {
 		cancelFunc()
@@ -376,6 +459,7 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		mgr:                   mgr,
 		credentialFileStore:   credentialFileStore,
 		credentialSecretStore: credentialSecretStore,
+		filesystemSigningKey:  filesystemSigningKey,
 	}
 
 	// Setup CSI snapshot client and lister
@@ -646,6 +730,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	go func() {
 		metricsMux := http.NewServeMux()
 		metricsMux.Handle("/metrics", promhttp.Handler())
+		metricsMux.Handle(fsobjectstore.DownloadPath, fsobjectstore.NewDownloadHandler(s.filesystemSigningKey, s.mgr.GetAPIReader(), s.namespace, s.logger))
 		s.logger.Infof("Starting metric server at address [%s]", s.metricsAddress)
 		server := &http.Server{
 			Addr:              s.metricsAddress,
@@ -665,7 +750,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
 	}
 
-	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
+	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore, s.filesystemSigningKey)
 
 	backupTracker := controller.NewBackupTracker()
 
@@ -675,18 +760,22 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// and BSL controller is mandatory for Velero to work.
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := map[string]struct{}{
-		controller.Backup:              {},
-		controller.BackupDeletion:      {},
-		controller.BackupFinalizer:     {},
-		controller.BackupOperations:    {},
-		controller.BackupRepo:          {},
-		controller.BackupSync:          {},
-		controller.DownloadRequest:     {},
-		controller.GarbageCollection:   {},
-		controller.Restore:             {},
-		controller.RestoreOperations:   {},
-		controller.Schedule:            {},
-		controller.ServerStatusRequest: {},
+		controller.Backup:                         {},
+		controller.BackupDeletion:                 {},
+		controller.BackupFinalizer:                {},
+		controller.BackupOperations:               {},
+		controller.BackupRepo:                     {},
+		controller.BackupStorageLocationMigration: {},
+		controller.BackupStorageLocationTest:      {},
+		controller.BackupStorageLocationUsage:     {},
+		controller.BackupSync:                     {},
+		controller.DownloadRequest:                {},
+		controller.GarbageCollection:              {},
+		controller.OrphanedSnapshot:               {},
+		controller.Restore:                        {},
+		controller.RestoreOperations:              {},
+		controller.Schedule:                       {},
+		controller.ServerStatusRequest:            {},
 	}
 
 	if s.config.restoreOnly {
@@ -696,7 +785,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			controller.BackupDeletion,
 			controller.BackupFinalizer,
 			controller.BackupOperations,
+			controller.BackupStorageLocationMigration,
 			controller.GarbageCollection,
+			controller.OrphanedSnapshot,
 			controller.Schedule,
 		)
 	}
@@ -718,12 +809,18 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		},
 		newPluginManager,
 		backupStoreGetter,
+		s.metrics,
 		s.logger,
 	)
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocation)
 	}
 
+	scopeHookHandler := &hook.DefaultScopeHookHandler{
+		Client:             s.mgr.GetClient(),
+		PodCommandExecutor: podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.Backup]; ok {
 		backupper, err := backup.NewKubernetesBackupper(
 			s.mgr.GetClient(),
@@ -768,6 +865,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.csiSnapshotClient,
 			s.credentialFileStore,
 			s.config.maxConcurrentK8SConnections,
+			scopeHookHandler,
+			s.config.compression(),
+			s.config.backupStreamingUpload,
+			velerov1api.BackupContentsLayout(s.config.backupContentsLayout),
 		).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.Backup)
 		}
@@ -789,6 +890,41 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupStorageLocationMigration]; ok {
+		if err := controller.NewBackupStorageLocationMigrationReconciler(
+			s.logger,
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+		).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocationMigration)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.BackupStorageLocationTest]; ok {
+		if err := controller.NewBackupStorageLocationTestReconciler(
+			s.logger,
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+			s.credentialFileStore,
+		).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocationTest)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.BackupStorageLocationUsage]; ok {
+		if err := controller.NewBackupStorageLocationUsageReconciler(
+			s.logger,
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+			s.metrics,
+		).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocationUsage)
+		}
+	}
+
 	backupOpsMap := itemoperationmap.NewBackupItemOperationsMap()
 	if _, ok := enabledRuntimeControllers[controller.BackupOperations]; ok {
 		r := controller.NewBackupOperationsReconciler(
@@ -799,6 +935,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			backupOpsMap,
+			backupTracker,
+			scopeHookHandler,
 		)
 		if err := r.SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupOperations)
@@ -835,6 +973,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.logger,
 			s.metrics,
+			scopeHookHandler,
 		)
 		if err := r.SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupFinalizer)
@@ -842,7 +981,20 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.BackupRepo]; ok {
-		if err := controller.NewBackupRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.repoManager).SetupWithManager(s.mgr); err != nil {
+		maintenanceJobResources, err := kube.ParseResourceRequirements(s.config.maintenanceJobCPURequest, s.config.maintenanceJobMemRequest, s.config.maintenanceJobCPULimit, s.config.maintenanceJobMemLimit)
+		if err != nil {
+			s.logger.Fatal(err, "invalid resource requirements for the repository maintenance jobs")
+		}
+
+		maintenanceConfig := repository.MaintenanceConfig{
+			Resources:      maintenanceJobResources,
+			NodeSelector:   s.config.maintenanceJobNodeSelector,
+			KeepLatestJobs: s.config.keepLatestMaintenanceJobs,
+			LogLevel:       s.logLevel.String(),
+			LogFormat:      s.config.formatFlag.String(),
+		}
+
+		if err := controller.NewBackupRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.repoManager, maintenanceConfig).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupRepo)
 		}
 	}
@@ -877,6 +1029,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			restoreOpsMap,
+			scopeHookHandler,
 		)
 		if err := r.SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupOperations)
@@ -905,6 +1058,23 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.OrphanedSnapshot]; ok {
+		r := controller.NewOrphanedSnapshotReconciler(
+			s.namespace,
+			s.logger,
+			s.mgr.GetClient(),
+			s.config.orphanedSnapshotCheckFrequency,
+			s.config.orphanedSnapshotDryRun,
+			s.repoManager,
+			newPluginManager,
+			backupStoreGetter,
+			s.metrics,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.OrphanedSnapshot)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.Restore]; ok {
 		restorer, err := restore.NewKubernetesRestorer(
 			s.discoveryHelper,
@@ -944,6 +1114,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.metrics,
 			s.config.formatFlag.Parse(),
 			s.config.defaultItemOperationTimeout,
+			scopeHookHandler,
 		)
 
 		if err = r.SetupWithManager(s.mgr); err != nil {
@@ -180,6 +180,29 @@ func Test_newServer(t *testing.T) {
 	}, logger)
 	assert.NotNil(t, err)
 
+	// invalid backup compression
+	_, err = newServer(factory, serverConfig{
+		uploaderType:           uploader.KopiaType,
+		backupCompression:      "gzip",
+		backupCompressionLevel: 10,
+	}, logger)
+	assert.EqualError(t, err, "invalid backup compression: gzip compression level must be between 1 and 9")
+
+	// invalid backup contents layout
+	_, err = newServer(factory, serverConfig{
+		uploaderType:         uploader.KopiaType,
+		backupContentsLayout: "invalid",
+	}, logger)
+	assert.EqualError(t, err, `invalid backup contents layout "invalid", valid values are "Single" and "PerNamespace"`)
+
+	// streaming upload with the backup contents split per namespace
+	_, err = newServer(factory, serverConfig{
+		uploaderType:          uploader.KopiaType,
+		backupStreamingUpload: true,
+		backupContentsLayout:  "PerNamespace",
+	}, logger)
+	assert.EqualError(t, err, "backup-streaming-upload can't be used with the PerNamespace backup contents layout")
+
 	// invalid clientQPS
 	_, err = newServer(factory, serverConfig{
 		uploaderType: uploader.KopiaType,
@@ -266,6 +289,8 @@ func Test_veleroResourcesExist(t *testing.T) {
 				{Kind: "PodVolumeRestore"},
 				{Kind: "BackupRepository"},
 				{Kind: "BackupStorageLocation"},
+				{Kind: "BackupStorageLocationMigration"},
+				{Kind: "BackupStorageLocationTest"},
 				{Kind: "VolumeSnapshotLocation"},
 				{Kind: "ServerStatusRequest"},
 			},
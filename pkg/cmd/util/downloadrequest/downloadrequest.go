@@ -43,13 +43,19 @@ var ErrNotFound = errors.New("file not found")
 var ErrDownloadRequestDownloadURLTimeout = errors.New("download request download url timeout, check velero server logs for errors. backup storage location may not be available")
 
 func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+	return StreamTarget(ctx, kbClient, namespace, velerov1api.DownloadTarget{Kind: kind, Name: name}, w, timeout, insecureSkipTLSVerify, caCertFile)
+}
+
+// StreamTarget is like Stream, for the targets which need more than a kind and a name, e.g. the
+// archives of a backup whose contents are split per namespace.
+func StreamTarget(ctx context.Context, kbClient kbclient.Client, namespace string, target velerov1api.DownloadTarget, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	reqName := fmt.Sprintf("%s-%s", name, uuid.String())
-	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
+	reqName := fmt.Sprintf("%s-%s", target.Name, uuid.String())
+	created := builder.ForDownloadRequest(namespace, reqName).Target(target.Kind, target.Name).TargetArchive(target.Archive).Result()
 
 	if err := kbClient.Create(context.Background(), created, &kbclient.CreateOptions{}); err != nil {
 		return errors.WithStack(err)
@@ -153,7 +159,9 @@ func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name strin
 	}
 
 	reader := resp.Body
-	if kind != velerov1api.DownloadTargetKindBackupContents {
+	// the contents of backups are compressed with the compression of the backup, which the
+	// callers handle
+	if target.Kind != velerov1api.DownloadTargetKindBackupContents && target.Kind != velerov1api.DownloadTargetKindBackupContentsArchive {
 		// need to decompress logs
 		gzipReader, err := gzip.NewReader(resp.Body)
 		if err != nil {
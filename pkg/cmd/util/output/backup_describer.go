@@ -71,6 +71,8 @@ func DescribeBackup(
 			phaseString = color.RedString(phaseString)
 		case velerov1api.BackupPhaseCompleted:
 			phaseString = color.GreenString(phaseString)
+		case velerov1api.BackupPhaseCancelled:
+			phaseString = color.YellowString(phaseString)
 		case velerov1api.BackupPhaseDeleting:
 		case velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
 		case velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed:
@@ -78,6 +80,12 @@ func DescribeBackup(
 		case velerov1api.BackupPhaseNew:
 		}
 
+		if phase != velerov1api.BackupPhaseCancelled && backup.Spec.Cancel {
+			phaseString += " (canceling)"
+		} else if backup.Spec.Paused {
+			phaseString += " (paused)"
+		}
+
 		logsNote := ""
 		if backup.Status.Phase == velerov1api.BackupPhaseFailed || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
 			logsNote = fmt.Sprintf(" (run `velero backup logs %s` for more information)", backup.Name)
@@ -290,6 +298,12 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		}
 	}
 
+	if len(spec.Hooks.PreBackup) > 0 || len(spec.Hooks.PostBackup) > 0 {
+		d.Println()
+		describeScopeHooks(d, "Pre-backup Hooks", spec.Hooks.PreBackup)
+		describeScopeHooks(d, "Post-backup Hooks", spec.Hooks.PostBackup)
+	}
+
 	if spec.OrderedResources != nil {
 		d.Println()
 		d.Printf("OrderedResources:\n")
@@ -299,12 +313,25 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 }
 
+func describeCompression(compression *velerov1api.BackupCompression) string {
+	if compression.Level == 0 {
+		return string(compression.Algorithm)
+	}
+	return fmt.Sprintf("%s (level %d)", compression.Algorithm, compression.Level)
+}
+
 // DescribeBackupStatus describes a backup status in human-readable format.
 func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
 	status := backup.Status
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	d.Printf("Backup Format Version:\t%s\n", status.FormatVersion)
+	if status.Compression != nil {
+		d.Printf("Compression:\t%s\n", describeCompression(status.Compression))
+	}
+	if status.ContentsLayout != "" {
+		d.Printf("Contents Layout:\t%s\n", status.ContentsLayout)
+	}
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
@@ -324,6 +351,12 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
 	d.Printf("Expiration:\t%s\n", status.Expiration)
+	if status.RetainUntil != nil {
+		d.Printf("Object Locked Until:\t%s\n", status.RetainUntil)
+	}
+	if status.FailoverFrom != "" {
+		d.Printf("Failed Over From:\t%s (unavailable when the backup started)\n", status.FailoverFrom)
+	}
 	d.Println()
 
 	if backup.Status.Progress != nil {
@@ -338,6 +371,10 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Println()
 	}
 
+	describeScopeHookStatuses(d, status.ScopeHooks)
+
+	describeHookStatus(ctx, kbClient, d, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupHookResults, status.HookStatus, details, insecureSkipTLSVerify, caCertPath)
+
 	describeBackupItemOperations(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertPath)
 
 	if details {
@@ -542,6 +579,7 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 	for _, phase := range []string{
 		string(velerov1api.PodVolumeBackupPhaseCompleted),
 		string(velerov1api.PodVolumeBackupPhaseFailed),
+		podVolumeBackupCanceled,
 		"In Progress",
 		string(velerov1api.PodVolumeBackupPhaseNew),
 	} {
@@ -572,6 +610,9 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 	}
 }
 
+// podVolumeBackupCanceled is the group of the pod volume backups canceled together with their backup
+const podVolumeBackupCanceled = "Canceled"
+
 func groupByPhase(backups []velerov1api.PodVolumeBackup) map[string][]velerov1api.PodVolumeBackup {
 	backupsByPhase := make(map[string][]velerov1api.PodVolumeBackup)
 
@@ -585,6 +626,10 @@ func groupByPhase(backups []velerov1api.PodVolumeBackup) map[string][]velerov1ap
 
 	for _, backup := range backups {
 		group := phaseToGroup[backup.Status.Phase]
+		// the backups failed because they are canceled are not reported as failed
+		if backup.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed && backup.Status.Message == velerov1api.PodVolumeBackupCanceledMessage {
+			group = podVolumeBackupCanceled
+		}
 		backupsByPhase[group] = append(backupsByPhase[group], backup)
 	}
 
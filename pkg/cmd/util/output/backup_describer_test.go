@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"text/tabwriter"
 	"time"
@@ -13,9 +14,11 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -524,3 +527,55 @@ func TestDescribeBackupItemOperation(t *testing.T) {
 	d.out.Flush()
 	assert.Equal(t, expected, d.buf.String())
 }
+
+func TestDescribeHookExecution(t *testing.T) {
+	started, err := time.Parse("2006-Jan-02", "2023-Jun-26")
+	require.NoError(t, err)
+	input := &results.HookExecution{
+		HookName:       "freeze",
+		HookSource:     "backupSpec",
+		Phase:          "pre",
+		Namespace:      "ns",
+		Pod:            "pod-1",
+		Container:      "app",
+		Command:        []string{"fsfreeze", "--freeze", "/data"},
+		ExitCode:       1,
+		Error:          "command terminated with exit code 1",
+		StartTimestamp: metav1.NewTime(started),
+		Duration:       metav1.Duration{Duration: 1500 * time.Millisecond},
+		Stderr:         "fsfreeze: /data: not a mount point\n",
+	}
+	expected := `  pre freeze in pod ns/pod-1:
+    Source:     backupSpec
+    Container:  app
+    Command:    fsfreeze --freeze /data
+    Started:    2023-06-26 00:00:00 +0000 UTC
+    Duration:   1.5s
+    Exit Code:  1
+    Error:      command terminated with exit code 1
+    Stderr:
+      fsfreeze: /data: not a mount point
+`
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeHookExecution(d, input)
+	d.out.Flush()
+	assert.Equal(t, expected, d.buf.String())
+}
+
+func TestDescribeHookStatusWithoutDetails(t *testing.T) {
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeHookStatus(context.Background(), nil, d, "velero", "backup-1", velerov1api.DownloadTargetKindBackupHookResults, nil, false, false, "")
+	describeHookStatus(context.Background(), nil, d, "velero", "backup-1", velerov1api.DownloadTargetKindBackupHookResults, &velerov1api.HookStatus{HooksAttempted: 3, HooksFailed: 1}, false, false, "")
+	d.out.Flush()
+	assert.Equal(t, "Hooks:  3 attempted, 1 failed (specify --details for more information)\n\n", d.buf.String())
+}
@@ -0,0 +1,312 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fatih/color"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+)
+
+// the tags that Velero saves together with the snapshots of the pod volume backups and the data uploads
+const (
+	snapshotTagNamespace = "ns"
+	snapshotTagPod       = "pod"
+	snapshotTagVolume    = "volume"
+	snapshotTagPVC       = "pvc"
+)
+
+// BackupRepoStatistics is the statistics of the data in a backup repository, together with
+// the backups that could own the snapshots, which are used to find out the orphaned snapshots
+type BackupRepoStatistics struct {
+	// Statistics is the statistics collected from the backup repository
+	Statistics *udmrepo.RepoStatistics
+
+	// Error is the error that happened when collecting the statistics
+	Error error
+
+	// Owners is the backups that could own the snapshots in the backup repository
+	Owners *repository.SnapshotOwners
+}
+
+// DescribeBackupRepository describes a backup repository in human-readable format, the statistics
+// are described only if they are collected
+func DescribeBackupRepository(repo *v1.BackupRepository, stats *BackupRepoStatistics) string {
+	return Describe(func(d *Describer) {
+		d.DescribeMetadata(repo.ObjectMeta)
+
+		d.Println()
+		phase := repo.Status.Phase
+		if phase == "" {
+			phase = v1.BackupRepositoryPhaseNew
+		}
+		phaseString := string(phase)
+		switch phase {
+		case v1.BackupRepositoryPhaseReady:
+			phaseString = color.GreenString(phaseString)
+		case v1.BackupRepositoryPhaseNotReady:
+			phaseString = color.RedString(phaseString)
+		}
+		d.Printf("Phase:\t%s\n", phaseString)
+		if repo.Status.Message != "" {
+			d.Printf("Message:\t%s\n", repo.Status.Message)
+		}
+
+		d.Println()
+		d.Printf("Repository Type:\t%s\n", repo.Spec.RepositoryType)
+		d.Printf("Backup Storage Location:\t%s\n", repo.Spec.BackupStorageLocation)
+		d.Printf("Volume Namespace:\t%s\n", repo.Spec.VolumeNamespace)
+		d.Printf("Repository Identifier:\t%s\n", repo.Spec.ResticIdentifier)
+
+		d.Println()
+		DescribeBackupRepositoryMaintenance(d, repo)
+
+		if stats != nil {
+			d.Println()
+			DescribeBackupRepositoryStatistics(d, stats)
+		}
+	})
+}
+
+// DescribeBackupRepositoryMaintenance describes the maintenance frequency and the recent maintenance
+// results of a backup repository
+func DescribeBackupRepositoryMaintenance(d *Describer, repo *v1.BackupRepository) {
+	d.Printf("Maintenance Frequency:\t%s\n", repo.Spec.MaintenanceFrequency.Duration)
+
+	lastMaintenance := "<never>"
+	if repo.Status.LastMaintenanceTime != nil && !repo.Status.LastMaintenanceTime.IsZero() {
+		lastMaintenance = repo.Status.LastMaintenanceTime.String()
+	}
+	d.Printf("Last Maintenance:\t%s\n", lastMaintenance)
+
+	if len(repo.Status.RecentMaintenance) == 0 {
+		d.Printf("Recent Maintenance:\t<none>\n")
+		return
+	}
+
+	d.Printf("Recent Maintenance:\n")
+	for i := len(repo.Status.RecentMaintenance) - 1; i >= 0; i-- {
+		maintenance := repo.Status.RecentMaintenance[i]
+
+		start := "<unknown>"
+		if maintenance.StartTimestamp != nil {
+			start = maintenance.StartTimestamp.String()
+		}
+
+		result := string(maintenance.Result)
+		switch maintenance.Result {
+		case v1.BackupRepositoryMaintenanceSucceeded:
+			result = color.GreenString(result)
+		case v1.BackupRepositoryMaintenanceFailed:
+			result = color.RedString(result)
+		}
+
+		line := fmt.Sprintf("\t%s\t%s", start, result)
+		if maintenance.StartTimestamp != nil && maintenance.CompleteTimestamp != nil {
+			line += fmt.Sprintf(" (took %s)", maintenance.CompleteTimestamp.Sub(maintenance.StartTimestamp.Time))
+		}
+		if maintenance.Message != "" {
+			line += fmt.Sprintf(": %s", maintenance.Message)
+		}
+		d.Printf("%s\n", line)
+	}
+}
+
+// repoVolumeStatistics is the statistics of the snapshots of one volume in a backup repository
+type repoVolumeStatistics struct {
+	name       string
+	snapshots  int
+	latest     udmrepo.SnapshotStatistics
+	hasLatest  bool
+	incomplete int
+}
+
+// DescribeBackupRepositoryStatistics describes the statistics of the data in a backup repository
+func DescribeBackupRepositoryStatistics(d *Describer, stats *BackupRepoStatistics) {
+	if stats.Error != nil {
+		d.Printf("Statistics:\t<error: %v>\n", stats.Error)
+		return
+	}
+
+	repoStats := stats.Statistics
+	if repoStats == nil {
+		d.Printf("Statistics:\t<none>\n")
+		return
+	}
+
+	var logicalSize int64
+	for _, snapshot := range repoStats.Snapshots {
+		if !snapshot.Incomplete {
+			logicalSize += snapshot.LogicalSize
+		}
+	}
+
+	d.Printf("Statistics:\n")
+	d.Printf("\tStored Size:\t%s (%d blobs)\n", formatSize(repoStats.BlobSize), repoStats.BlobCount)
+	d.Printf("\tPack Blobs:\t%s (%d blobs)\n", formatSize(repoStats.PackSize), repoStats.PackCount)
+	d.Printf("\tDeduplicated Size:\t%s (%d contents, %s after compression)\n",
+		formatSize(repoStats.ContentSize), repoStats.ContentCount, formatSize(repoStats.ContentStoredSize))
+	d.Printf("\tLogical Size:\t%s (sum of all the snapshots)\n", formatSize(logicalSize))
+	d.Printf("\tSnapshots:\t%d\n", len(repoStats.Snapshots))
+
+	d.Println()
+	describeSnapshotsByNamespace(d, repoStats.Snapshots)
+
+	d.Println()
+	describeOrphanedSnapshots(d, repoStats.Snapshots, stats.Owners)
+}
+
+func describeSnapshotsByNamespace(d *Describer, snapshots []udmrepo.SnapshotStatistics) {
+	if len(snapshots) == 0 {
+		d.Printf("Snapshots by Namespace:\t<none>\n")
+		return
+	}
+
+	volumesByNamespace := map[string]map[string]*repoVolumeStatistics{}
+	for _, snapshot := range snapshots {
+		namespace := snapshotNamespace(snapshot)
+		if volumesByNamespace[namespace] == nil {
+			volumesByNamespace[namespace] = map[string]*repoVolumeStatistics{}
+		}
+
+		name := snapshotVolume(snapshot)
+		volume := volumesByNamespace[namespace][name]
+		if volume == nil {
+			volume = &repoVolumeStatistics{name: name}
+			volumesByNamespace[namespace][name] = volume
+		}
+
+		volume.snapshots++
+		if snapshot.Incomplete {
+			volume.incomplete++
+			continue
+		}
+
+		if !volume.hasLatest || snapshot.StartTime.After(volume.latest.StartTime) {
+			volume.latest = snapshot
+			volume.hasLatest = true
+		}
+	}
+
+	namespaces := make([]string, 0, len(volumesByNamespace))
+	for namespace := range volumesByNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	d.Printf("Snapshots by Namespace:\n")
+	for _, namespace := range namespaces {
+		volumes := make([]*repoVolumeStatistics, 0, len(volumesByNamespace[namespace]))
+		var latestSize int64
+		for _, volume := range volumesByNamespace[namespace] {
+			volumes = append(volumes, volume)
+			latestSize += volume.latest.LogicalSize
+		}
+		sort.Slice(volumes, func(i, j int) bool {
+			return volumes[i].name < volumes[j].name
+		})
+
+		d.Printf("\t%s:\t%d volumes, latest size %s\n", namespace, len(volumes), formatSize(latestSize))
+		for _, volume := range volumes {
+			line := fmt.Sprintf("\t  %s:\t%d snapshots", volume.name, volume.snapshots)
+			if volume.incomplete > 0 {
+				line += fmt.Sprintf(" (%d incomplete)", volume.incomplete)
+			}
+			if volume.hasLatest {
+				line += fmt.Sprintf(", latest %s at %s", formatSize(volume.latest.LogicalSize), volume.latest.StartTime.UTC().Format("2006-01-02 15:04:05 -0700 MST"))
+			}
+			d.Printf("%s\n", line)
+		}
+	}
+}
+
+func describeOrphanedSnapshots(d *Describer, snapshots []udmrepo.SnapshotStatistics, owners *repository.SnapshotOwners) {
+	if owners == nil {
+		return
+	}
+
+	now := time.Now()
+	var orphaned []udmrepo.SnapshotStatistics
+	for _, snapshot := range snapshots {
+		if owners.IsOrphaned(snapshot.Tags, snapshot.EndTime, now) {
+			orphaned = append(orphaned, snapshot)
+		}
+	}
+
+	if len(orphaned) == 0 {
+		d.Printf("Orphaned Snapshots:\t<none>\n")
+		return
+	}
+
+	sort.Slice(orphaned, func(i, j int) bool {
+		return orphaned[i].StartTime.Before(orphaned[j].StartTime)
+	})
+
+	d.Printf("Orphaned Snapshots:\n")
+	for _, snapshot := range orphaned {
+		line := fmt.Sprintf("\t%s:\tbackup %s, volume %s/%s, %s",
+			snapshot.ID, snapshot.Tags[repository.SnapshotTagBackup], snapshotNamespace(snapshot), snapshotVolume(snapshot), formatSize(snapshot.LogicalSize))
+		if snapshot.Incomplete {
+			line += " (incomplete)"
+		}
+		d.Printf("%s\n", line)
+	}
+}
+
+func snapshotNamespace(snapshot udmrepo.SnapshotStatistics) string {
+	if namespace := snapshot.Tags[snapshotTagNamespace]; namespace != "" {
+		return namespace
+	}
+
+	return "<unknown>"
+}
+
+// snapshotVolume returns the name of the volume of a snapshot, that is pod/volume for the pod volume
+// backups, the PVC name for the data uploads, or the source path otherwise
+func snapshotVolume(snapshot udmrepo.SnapshotStatistics) string {
+	if pod, volume := snapshot.Tags[snapshotTagPod], snapshot.Tags[snapshotTagVolume]; pod != "" && volume != "" {
+		return pod + "/" + volume
+	}
+
+	if pvc := snapshot.Tags[snapshotTagPVC]; pvc != "" {
+		return pvc
+	}
+
+	return snapshot.Source
+}
+
+// formatSize formats a size in bytes with binary units
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
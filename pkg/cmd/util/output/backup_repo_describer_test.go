@@ -0,0 +1,180 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+)
+
+func TestDescribeBackupRepository(t *testing.T) {
+	start := metav1.NewTime(time.Date(2023, 6, 25, 15, 4, 5, 0, time.UTC))
+	complete := metav1.NewTime(start.Add(time.Minute))
+	snapshotTime := time.Date(2023, 6, 24, 0, 0, 0, 0, time.UTC)
+
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "repo-1",
+		},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+			ResticIdentifier:      "s3:s3.amazonaws.com/bucket/kopia/ns-1",
+			MaintenanceFrequency:  metav1.Duration{Duration: time.Hour},
+		},
+		Status: velerov1api.BackupRepositoryStatus{
+			Phase:               velerov1api.BackupRepositoryPhaseReady,
+			LastMaintenanceTime: &complete,
+			RecentMaintenance: []velerov1api.BackupRepositoryMaintenanceStatus{
+				{
+					Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+					StartTimestamp:    &start,
+					CompleteTimestamp: &complete,
+					Message:           "fake-error",
+				},
+			},
+		},
+	}
+
+	stats := &udmrepo.RepoStatistics{
+		BlobCount:         10,
+		BlobSize:          3 * 1024 * 1024,
+		PackCount:         8,
+		PackSize:          2 * 1024 * 1024,
+		ContentCount:      100,
+		ContentSize:       4 * 1024 * 1024,
+		ContentStoredSize: 2 * 1024 * 1024,
+		Snapshots: []udmrepo.SnapshotStatistics{
+			{
+				ID:          "snapshot-1",
+				Tags:        map[string]string{"backup": "backup-1", "ns": "ns-1", "pod": "pod-1", "volume": "vol-1"},
+				StartTime:   snapshotTime,
+				LogicalSize: 1024,
+			},
+			{
+				ID:          "snapshot-2",
+				Tags:        map[string]string{"backup": "backup-2", "ns": "ns-1", "pod": "pod-1", "volume": "vol-1"},
+				StartTime:   snapshotTime.Add(time.Hour),
+				LogicalSize: 2048,
+			},
+			{
+				ID:          "snapshot-3",
+				Tags:        map[string]string{"backup": "backup-2", "ns": "ns-1", "pvc": "pvc-1"},
+				StartTime:   snapshotTime.Add(time.Hour),
+				LogicalSize: 1024 * 1024,
+			},
+			{
+				ID:          "snapshot-4",
+				Source:      "/fake-path",
+				StartTime:   snapshotTime,
+				LogicalSize: 512,
+				Incomplete:  true,
+			},
+		},
+	}
+
+	expectWithoutStats := `Name:         repo-1
+Namespace:    velero
+Labels:       <none>
+Annotations:  <none>
+
+Phase:  Ready
+
+Repository Type:          kopia
+Backup Storage Location:  default
+Volume Namespace:         ns-1
+Repository Identifier:    s3:s3.amazonaws.com/bucket/kopia/ns-1
+
+Maintenance Frequency:  1h0m0s
+Last Maintenance:       2023-06-25 15:05:05 +0000 UTC
+Recent Maintenance:
+  2023-06-25 15:04:05 +0000 UTC  Failed (took 1m0s): fake-error
+`
+
+	expectStats := expectWithoutStats + `
+Statistics:
+  Stored Size:        3.0 MiB (10 blobs)
+  Pack Blobs:         2.0 MiB (8 blobs)
+  Deduplicated Size:  4.0 MiB (100 contents, 2.0 MiB after compression)
+  Logical Size:       1.0 MiB (sum of all the snapshots)
+  Snapshots:          4
+
+Snapshots by Namespace:
+  <unknown>:      1 volumes, latest size 0 B
+    /fake-path:   1 snapshots (1 incomplete)
+  ns-1:           2 volumes, latest size 1.0 MiB
+    pod-1/vol-1:  2 snapshots, latest 2.0 KiB at 2023-06-24 01:00:00 +0000 UTC
+    pvc-1:        1 snapshots, latest 1.0 MiB at 2023-06-24 01:00:00 +0000 UTC
+
+Orphaned Snapshots:
+  snapshot-1:  backup backup-1, volume ns-1/pod-1/vol-1, 1.0 KiB
+`
+
+	expectStatsErr := expectWithoutStats + `
+Statistics:  <error: fake-error>
+`
+
+	tests := []struct {
+		name   string
+		stats  *BackupRepoStatistics
+		expect string
+	}{
+		{
+			name:   "without statistics",
+			expect: expectWithoutStats,
+		},
+		{
+			name: "with statistics",
+			stats: &BackupRepoStatistics{
+				Statistics: stats,
+				Owners:     repository.NewSnapshotOwners([]velerov1api.Backup{*builder.ForBackup("velero", "backup-2").Result()}, nil),
+			},
+			expect: expectStats,
+		},
+		{
+			name: "failed to get statistics",
+			stats: &BackupRepoStatistics{
+				Error: errors.New("fake-error"),
+			},
+			expect: expectStatsErr,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, DescribeBackupRepository(repo, test.stats))
+		})
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0 B", formatSize(0))
+	assert.Equal(t, "1023 B", formatSize(1023))
+	assert.Equal(t, "1.5 KiB", formatSize(1536))
+	assert.Equal(t, "1.0 GiB", formatSize(1024*1024*1024))
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package output
 
 import (
+	"fmt"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -35,6 +38,13 @@ var (
 		{Name: "Last Validated"},
 		{Name: "Access Mode"},
 		{Name: "Default"},
+		// the health details are only shown with "-o wide"
+		{Name: "Last Successful Validation", Priority: 1},
+		{Name: "Consecutive Failures", Priority: 1},
+		{Name: "List Latency", Priority: 1},
+		{Name: "Put Latency", Priority: 1},
+		{Name: "Backups", Priority: 1},
+		{Name: "Used", Priority: 1},
 	}
 )
 
@@ -86,7 +96,38 @@ func printBackupStorageLocation(location *velerov1api.BackupStorageLocation) []m
 		LastValidatedStr,
 		accessMode,
 		isDefault,
+		formatTime(location.Status.LastSuccessfulValidationTime),
+		location.Status.ConsecutiveFailures,
+		formatLatency(location.Status.ListLatency),
+		formatLatency(location.Status.PutLatency),
+		location.Status.BackupCount,
+		formatUsage(location.Status.Usage),
 	)
 
 	return []metav1.TableRow{row}
 }
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return "<none>"
+	}
+	return t.String()
+}
+
+func formatLatency(latency *metav1.Duration) string {
+	if latency == nil {
+		return "<none>"
+	}
+	return latency.Duration.Round(time.Millisecond).String()
+}
+
+func formatUsage(usage *velerov1api.BackupStorageLocationUsage) string {
+	if usage == nil {
+		return "<none>"
+	}
+	if usage.Message != "" {
+		return "<unavailable>"
+	}
+	return fmt.Sprintf("%s (backups %s, repositories %s)", formatSize(usage.BackupBytes+usage.RepositoryBytes),
+		formatSize(usage.BackupBytes), formatSize(usage.RepositoryBytes))
+}
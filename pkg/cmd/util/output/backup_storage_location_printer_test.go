@@ -0,0 +1,58 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestPrintBackupStorageLocationWide(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result()
+	location.Status.ConsecutiveFailures = 3
+	location.Status.ListLatency = &metav1.Duration{Duration: 1234567 * time.Microsecond}
+	location.Status.BackupCount = 12
+	location.Status.Usage = &velerov1api.BackupStorageLocationUsage{BackupBytes: 1024, RepositoryBytes: 3 * 1024}
+
+	table := &metav1.Table{
+		ColumnDefinitions: backupStorageLocationColumns,
+		Rows:              printBackupStorageLocation(location),
+	}
+
+	var narrow, wide bytes.Buffer
+	require.NoError(t, printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, &narrow))
+	require.NoError(t, printers.NewTablePrinter(printers.PrintOptions{Wide: true}).PrintObj(table, &wide))
+
+	assert.NotContains(t, narrow.String(), "CONSECUTIVE FAILURES")
+	assert.Contains(t, wide.String(), "CONSECUTIVE FAILURES")
+	assert.Regexp(t, `Unavailable\s+Unknown\s+ReadWrite\s+<none>\s+3\s+1.235s\s+<none>\s+12\s+4.0 KiB \(backups 1.0 KiB, repositories 3.0 KiB\)`, wide.String())
+}
+
+func TestFormatUsage(t *testing.T) {
+	assert.Equal(t, "<none>", formatUsage(nil))
+	assert.Equal(t, "<unavailable>", formatUsage(&velerov1api.BackupStorageLocationUsage{Message: "not supported"}))
+	assert.Equal(t, "4.0 KiB (backups 1.0 KiB, repositories 3.0 KiB)", formatUsage(&velerov1api.BackupStorageLocationUsage{BackupBytes: 1024, RepositoryBytes: 3 * 1024}))
+}
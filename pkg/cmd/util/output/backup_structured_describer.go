@@ -34,6 +34,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -224,6 +225,14 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 	}
 	if len(spec.Hooks.Resources) > 0 {
 		hooksInfo["resources"] = hooksResources
+	}
+	if len(spec.Hooks.PreBackup) > 0 {
+		hooksInfo["preBackup"] = describeScopeHooksInSF(spec.Hooks.PreBackup)
+	}
+	if len(spec.Hooks.PostBackup) > 0 {
+		hooksInfo["postBackup"] = describeScopeHooksInSF(spec.Hooks.PostBackup)
+	}
+	if len(hooksInfo) > 0 {
 		backupSpecInfo["hooks"] = hooksInfo
 	}
 
@@ -242,6 +251,12 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	backupStatusInfo["backupFormatVersion"] = status.FormatVersion
+	if status.Compression != nil {
+		backupStatusInfo["compression"] = describeCompression(status.Compression)
+	}
+	if status.ContentsLayout != "" {
+		backupStatusInfo["contentsLayout"] = status.ContentsLayout
+	}
 
 	// "<n/a>" output should only be applicable for backups that failed validation
 	if status.StartTimestamp == nil || status.StartTimestamp.Time.IsZero() {
@@ -260,6 +275,10 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	// just display `<nil>`, though this should be temporary.
 	backupStatusInfo["expiration"] = status.Expiration.String()
 
+	if status.FailoverFrom != "" {
+		backupStatusInfo["failoverFrom"] = status.FailoverFrom
+	}
+
 	defer d.Describe("status", backupStatusInfo)
 
 	if backup.Status.Progress != nil {
@@ -272,10 +291,18 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 		}
 	}
 
+	if len(status.ScopeHooks) > 0 {
+		backupStatusInfo["scopeHooks"] = describeScopeHookStatusesInSF(status.ScopeHooks)
+	}
+
+	describeHookStatusInSF(ctx, kbClient, backupStatusInfo, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupHookResults, status.HookStatus, details, insecureSkipTLSVerify, caCertPath)
+
 	if details {
 		describeBackupResourceListInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
 	}
 
+	describeBackupItemOperationsInSF(ctx, kbClient, backupStatusInfo, backup, details, insecureSkipTLSVerify, caCertPath)
+
 	// In consideration of decoding structured output conveniently, the three separate fields were created here
 	// the field of "veleroNativeSnapshots" displays the brief snapshots info
 	// the field of "errorGettingSnapshots" displays the error message if it fails to get snapshot info
@@ -307,6 +334,87 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	}
 }
 
+func describeBackupItemOperationsInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	status := backup.Status
+	if status.BackupItemOperationsAttempted == 0 {
+		return
+	}
+
+	operationsInfo := make(map[string]interface{})
+	operationsInfo["attempted"] = status.BackupItemOperationsAttempted
+	operationsInfo["completed"] = status.BackupItemOperationsCompleted
+	operationsInfo["failed"] = status.BackupItemOperationsFailed
+	backupStatusInfo["backupItemOperations"] = operationsInfo
+
+	if !details {
+		return
+	}
+
+	// the field of 'errorGettingOperations' gives specific error message when it fails to get the operations
+	// the field of 'operations' lists the detailed operations info
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupItemOperations, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		operationsInfo["errorGettingOperations"] = fmt.Sprintf("<error getting operation info: %v>", err)
+		return
+	}
+
+	var operations []*itemoperation.BackupOperation
+	if err := json.NewDecoder(buf).Decode(&operations); err != nil {
+		operationsInfo["errorGettingOperations"] = fmt.Sprintf("<error reading operation info: %v>", err)
+		return
+	}
+
+	operationsDetails := make([]map[string]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		operationsDetails = append(operationsDetails, describeBackupItemOperationInSF(operation))
+	}
+	operationsInfo["operations"] = operationsDetails
+}
+
+func describeBackupItemOperationInSF(operation *itemoperation.BackupOperation) map[string]interface{} {
+	operationInfo := make(map[string]interface{})
+	operationInfo["resource"] = operation.Spec.ResourceIdentifier.GroupResource.String()
+	operationInfo["namespace"] = operation.Spec.ResourceIdentifier.Namespace
+	operationInfo["name"] = operation.Spec.ResourceIdentifier.Name
+	operationInfo["backupItemActionPlugin"] = operation.Spec.BackupItemAction
+	operationInfo["operationID"] = operation.Spec.OperationID
+	if len(operation.Spec.PostOperationItems) > 0 {
+		items := make([]string, 0, len(operation.Spec.PostOperationItems))
+		for _, item := range operation.Spec.PostOperationItems {
+			items = append(items, fmt.Sprintf("%s %s/%s", item, item.Namespace, item.Name))
+		}
+		operationInfo["itemsToUpdate"] = items
+	}
+	describeOperationStatusInSF(operationInfo, operation.Status)
+	return operationInfo
+}
+
+func describeOperationStatusInSF(operationInfo map[string]interface{}, status itemoperation.OperationStatus) {
+	operationInfo["phase"] = status.Phase
+	if status.Error != "" {
+		operationInfo["operationError"] = status.Error
+	}
+	if status.NTotal > 0 || status.NCompleted > 0 {
+		operationInfo["progress"] = map[string]interface{}{
+			"completed": status.NCompleted,
+			"total":     status.NTotal,
+			"units":     status.OperationUnits,
+		}
+	}
+	if status.Description != "" {
+		operationInfo["progressDescription"] = status.Description
+	}
+	if status.Created != nil {
+		operationInfo["created"] = status.Created.String()
+	}
+	if status.Started != nil {
+		operationInfo["started"] = status.Started.String()
+	}
+	if status.Updated != nil {
+		operationInfo["updated"] = status.Updated.String()
+	}
+}
+
 func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
 	// In consideration of decoding structured output conveniently, the two separate fields were created here(in func describeBackupResourceList, there is only one field describing either error message or resource list)
 	// the field of 'errorGettingResourceList' gives specific error message when it fails to get resources list
@@ -392,6 +500,7 @@ func DescribePodVolumeBackupsInSF(d *StructuredDescriber, backups []velerov1api.
 	for _, phase := range []string{
 		string(velerov1api.PodVolumeBackupPhaseCompleted),
 		string(velerov1api.PodVolumeBackupPhaseFailed),
+		podVolumeBackupCanceled,
 		"In Progress",
 		string(velerov1api.PodVolumeBackupPhaseNew),
 	} {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/fatih/color"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 type Describer struct {
@@ -136,13 +137,27 @@ func NewStructuredDescriber(format string) *StructuredDescriber {
 
 // DescribeInSF returns the structured output based on the func
 // that applies StructuredDescriber to collect outputs.
-// This function takes arg 'format' for future format extension.
+// The output is encoded as YAML if format is "yaml", and as JSON otherwise.
 func DescribeInSF(fn func(d *StructuredDescriber), format string) string {
 	d := NewStructuredDescriber(format)
 	fn(d)
+	if format == "yaml" {
+		return d.YAMLEncode()
+	}
 	return d.JSONEncode()
 }
 
+// ValidateDescribeOutputFormat returns an error if format is not a
+// supported output format of the describe commands.
+func ValidateDescribeOutputFormat(format string) error {
+	switch format {
+	case "plaintext", "json", "yaml":
+		return nil
+	default:
+		return fmt.Errorf("invalid output format '%s'. valid values are 'plaintext, json, yaml'", format)
+	}
+}
+
 // Describe adds all types of argument to d.output.
 func (d *StructuredDescriber) Describe(name string, arg interface{}) {
 	d.output[name] = arg
@@ -167,3 +182,12 @@ func (d *StructuredDescriber) JSONEncode() string {
 	_ = encoder.Encode(d.output)
 	return byteBuffer.String()
 }
+
+// YAMLEncode encodes d.output to yaml
+func (d *StructuredDescriber) YAMLEncode() string {
+	out, err := yaml.Marshal(d.output)
+	if err != nil {
+		return fmt.Sprintf("<error encoding output as yaml: %v>\n", err)
+	}
+	return string(out)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// getHookExecutions downloads the exec hook execution records of a backup or restore.
+func getHookExecutions(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, insecureSkipTLSVerify bool, caCertPath string) ([]results.HookExecution, error) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, namespace, name, kind, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		return nil, err
+	}
+
+	var executions []results.HookExecution
+	if err := json.NewDecoder(buf).Decode(&executions); err != nil {
+		return nil, err
+	}
+	return executions, nil
+}
+
+// describeHookStatus describes the exec hooks run in pods during a backup or restore.
+func describeHookStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, namespace, name string, kind velerov1api.DownloadTargetKind, hookStatus *velerov1api.HookStatus, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	if hookStatus == nil {
+		return
+	}
+
+	if !details {
+		d.Printf("Hooks:\t%d attempted, %d failed (specify --details for more information)\n", hookStatus.HooksAttempted, hookStatus.HooksFailed)
+		d.Println()
+		return
+	}
+
+	executions, err := getHookExecutions(ctx, kbClient, namespace, name, kind, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Printf("Hooks:\t%d attempted, %d failed <hook results not found>\n", hookStatus.HooksAttempted, hookStatus.HooksFailed)
+		} else {
+			d.Printf("Hooks:\t%d attempted, %d failed <error getting hook results: %v>\n", hookStatus.HooksAttempted, hookStatus.HooksFailed, err)
+		}
+		d.Println()
+		return
+	}
+
+	d.Printf("Hooks:\t%d attempted, %d failed\n", hookStatus.HooksAttempted, hookStatus.HooksFailed)
+	for i := range executions {
+		describeHookExecution(d, &executions[i])
+	}
+	d.Println()
+}
+
+func describeHookExecution(d *Describer, execution *results.HookExecution) {
+	d.Printf("\t%s %s in pod %s/%s:\n", execution.Phase, execution.HookName, execution.Namespace, execution.Pod)
+	d.Printf("\t\tSource:\t%s\n", execution.HookSource)
+	d.Printf("\t\tContainer:\t%s\n", execution.Container)
+	d.Printf("\t\tCommand:\t%s\n", strings.Join(execution.Command, " "))
+	d.Printf("\t\tStarted:\t%s\n", execution.StartTimestamp.Time)
+	d.Printf("\t\tDuration:\t%s\n", execution.Duration.Duration)
+	if execution.ExitCode >= 0 {
+		d.Printf("\t\tExit Code:\t%d\n", execution.ExitCode)
+	}
+	if execution.Failed() {
+		d.Printf("\t\tError:\t%s\n", execution.Error)
+	}
+	describeHookOutput(d, "Stdout", execution.Stdout)
+	describeHookOutput(d, "Stderr", execution.Stderr)
+}
+
+func describeHookOutput(d *Describer, title, output string) {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return
+	}
+	d.Printf("\t\t%s:\n", title)
+	for _, line := range strings.Split(output, "\n") {
+		d.Printf("\t\t\t%s\n", line)
+	}
+}
+
+// describeHookStatusInSF describes the exec hooks run in pods during a backup or restore
+// in structured format.
+func describeHookStatusInSF(ctx context.Context, kbClient kbclient.Client, statusInfo map[string]interface{}, namespace, name string, kind velerov1api.DownloadTargetKind, hookStatus *velerov1api.HookStatus, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	if hookStatus == nil {
+		return
+	}
+
+	hooksInfo := make(map[string]interface{})
+	hooksInfo["attempted"] = hookStatus.HooksAttempted
+	hooksInfo["failed"] = hookStatus.HooksFailed
+	statusInfo["hooks"] = hooksInfo
+
+	if !details {
+		return
+	}
+
+	// the field of 'errorGettingHookResults' gives specific error message when it fails to get the hook results
+	// the field of 'executions' lists the detailed hook executions
+	executions, err := getHookExecutions(ctx, kbClient, namespace, name, kind, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		hooksInfo["errorGettingHookResults"] = fmt.Sprintf("<error getting hook results: %v>", err)
+		return
+	}
+	hooksInfo["executions"] = executions
+}
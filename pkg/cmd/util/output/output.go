@@ -42,7 +42,7 @@ const (
 // BindFlags defines a set of output-specific flags within the provided
 // FlagSet.
 func BindFlags(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'json', and 'yaml'. 'table' is not valid for the install command.")
+	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'wide', 'json', and 'yaml'. 'wide' adds extra columns to the table of some resources. 'table' and 'wide' are not valid for the install command.")
 	labelColumns := flag.NewStringArray()
 	flags.VarP(&labelColumns, "label-columns", "L", "Accepts a comma separated list of labels that are going to be presented as columns. Names are case-sensitive. You can also use multiple flag options like -L label1 -L label2...")
 	flags.Bool("show-labels", false, "Show labels in the last column")
@@ -50,7 +50,7 @@ func BindFlags(flags *pflag.FlagSet) {
 
 // BindFlagsSimple defines the output format flag only.
 func BindFlagsSimple(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'json', and 'yaml'. 'table' is not valid for the install command.")
+	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'wide', 'json', and 'yaml'. 'wide' adds extra columns to the table of some resources. 'table' and 'wide' are not valid for the install command.")
 }
 
 // ClearOutputFlagDefault sets the current and default value
@@ -97,12 +97,12 @@ func validateOutputFlag(cmd *cobra.Command) error {
 	output := GetOutputFlagValue(cmd)
 	switch output {
 	case "", "json", "yaml":
-	case "table":
+	case "table", "wide":
 		if cmd.Name() == "install" {
-			return errors.New("'table' format is not supported with 'install' command")
+			return errors.Errorf("'%s' format is not supported with 'install' command", output)
 		}
 	default:
-		return errors.Errorf("invalid output format %q - valid values are 'table', 'json', and 'yaml'", output)
+		return errors.Errorf("invalid output format %q - valid values are 'table', 'wide', 'json', and 'yaml'", output)
 	}
 	return nil
 }
@@ -116,13 +116,13 @@ func PrintWithFormat(c *cobra.Command, obj runtime.Object) (bool, error) {
 	}
 
 	switch format {
-	case "table":
+	case "table", "wide":
 		return printTable(c, obj)
 	case "json", "yaml":
 		return printEncoded(obj, format)
 	}
 
-	return false, errors.Errorf("unsupported output format %q; valid values are 'table', 'json', and 'yaml'", format)
+	return false, errors.Errorf("unsupported output format %q; valid values are 'table', 'wide', 'json', and 'yaml'", format)
 }
 
 func printEncoded(obj runtime.Object, format string) (bool, error) {
@@ -241,6 +241,7 @@ func NewPrinter(cmd *cobra.Command) (printers.ResourcePrinter, error) {
 	options := printers.PrintOptions{
 		ShowLabels:   GetShowLabelsValue(cmd),
 		ColumnLabels: GetLabelColumnsValues(cmd),
+		Wide:         GetOutputFlagValue(cmd) == "wide",
 	}
 
 	printer := printers.NewTablePrinter(options)
@@ -82,6 +82,16 @@ func TestValidateFlags(t *testing.T) {
 			input:  cmdWithFormat("other", "table"),
 			hasErr: false,
 		},
+		{
+			name:   "install with wide format",
+			input:  cmdWithFormat("install", "wide"),
+			hasErr: true,
+		},
+		{
+			name:   "other with wide format",
+			input:  cmdWithFormat("other", "wide"),
+			hasErr: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -36,7 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
-func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
+func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details bool, itemOutcomes []string, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(restore.ObjectMeta)
 
@@ -51,6 +51,14 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
 			phaseString = color.RedString(phaseString)
+		case velerov1api.RestorePhaseCancelled:
+			phaseString = color.YellowString(phaseString)
+		}
+
+		if phase != velerov1api.RestorePhaseCancelled && restore.Spec.Cancel {
+			phaseString += " (canceling)"
+		} else if restore.Spec.Paused {
+			phaseString += " (paused)"
 		}
 
 		resultsNote := ""
@@ -160,12 +168,22 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		if len(restore.Spec.Hooks.PreRestore) > 0 || len(restore.Spec.Hooks.PostRestore) > 0 {
+			d.Println()
+			describeScopeHooks(d, "Pre-restore Hooks", restore.Spec.Hooks.PreRestore)
+			describeScopeHooks(d, "Post-restore Hooks", restore.Spec.Hooks.PostRestore)
+		}
+
 		d.Println()
+		describeScopeHookStatuses(d, restore.Status.ScopeHooks)
+		describeHookStatus(ctx, kbClient, d, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreHookResults, restore.Status.HookStatus, details, insecureSkipTLSVerify, caCertFile)
 		describeRestoreItemOperations(ctx, kbClient, d, restore, details, insecureSkipTLSVerify, caCertFile)
 
 		if details {
 			describeRestoreResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 			d.Println()
+			describeRestoreItemStatus(ctx, kbClient, d, restore, itemOutcomes, insecureSkipTLSVerify, caCertFile)
+			d.Println()
 		}
 	})
 }
@@ -370,3 +388,73 @@ func describeRestoreResourceList(ctx context.Context, kbClient kbclient.Client,
 		d.Printf("\t%s:\n\t\t- %s\n", gvk, strings.Join(resourceList[gvk], "\n\t\t- "))
 	}
 }
+
+// getRestoreItemStatus downloads the restore's item status list and returns the items
+// matching one of the outcomes, or all of them if no outcome is specified.
+func getRestoreItemStatus(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, outcomes []string, insecureSkipTLSVerify bool, caCertPath string) ([]results.ItemStatus, error) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreItemStatus, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		return nil, err
+	}
+
+	var itemStatus []results.ItemStatus
+	if err := json.NewDecoder(buf).Decode(&itemStatus); err != nil {
+		return nil, err
+	}
+
+	return filterRestoreItemStatus(itemStatus, outcomes), nil
+}
+
+func filterRestoreItemStatus(itemStatus []results.ItemStatus, outcomes []string) []results.ItemStatus {
+	if len(outcomes) == 0 {
+		return itemStatus
+	}
+
+	filtered := make([]results.ItemStatus, 0, len(itemStatus))
+	for _, item := range itemStatus {
+		for _, outcome := range outcomes {
+			if string(item.Outcome) == outcome {
+				filtered = append(filtered, item)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func describeRestoreItemStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, outcomes []string, insecureSkipTLSVerify bool, caCertPath string) {
+	itemStatus, err := getRestoreItemStatus(ctx, kbClient, restore, outcomes, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Item Status:\t<restore item status not found>")
+		} else {
+			d.Printf("Item Status:\t<error getting restore item status: %v>\n", err)
+		}
+		return
+	}
+
+	if len(itemStatus) == 0 {
+		d.Println("Item Status:\t<none>")
+		return
+	}
+
+	d.Println("Item Status:")
+	for _, item := range itemStatus {
+		describeRestoreItem(d, item)
+	}
+}
+
+func describeRestoreItem(d *Describer, item results.ItemStatus) {
+	name := item.Name
+	if item.Namespace != "" {
+		name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+	}
+	d.Printf("\t%s %s:\n", item.Resource, name)
+	d.Printf("\t\tOutcome:\t%s\n", item.Outcome)
+	if item.Reason != "" {
+		d.Printf("\t\tReason:\t%s\n", item.Reason)
+	}
+	if len(item.ModifiedBy) > 0 {
+		d.Printf("\t\tModified by:\t%s\n", strings.Join(item.ModifiedBy, ", "))
+	}
+}
@@ -181,3 +181,40 @@ func TestDescribePodVolumeRestores(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterRestoreItemStatus(t *testing.T) {
+	itemStatus := []results.ItemStatus{
+		{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-1", Outcome: results.ItemOutcomeCreated},
+		{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-2", Outcome: results.ItemOutcomeFailed, Reason: "error"},
+		{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-3", Outcome: results.ItemOutcomeSkippedExists},
+	}
+
+	assert.Equal(t, itemStatus, filterRestoreItemStatus(itemStatus, nil))
+	assert.Equal(t, []results.ItemStatus{itemStatus[1], itemStatus[2]}, filterRestoreItemStatus(itemStatus, []string{"failed", "skipped-exists"}))
+	assert.Empty(t, filterRestoreItemStatus(itemStatus, []string{"updated"}))
+}
+
+func TestDescribeRestoreItem(t *testing.T) {
+	input := results.ItemStatus{
+		Resource:   "v1/ConfigMap",
+		Namespace:  "ns-1",
+		Name:       "cm-1",
+		Outcome:    results.ItemOutcomeSkippedExists,
+		Reason:     "already exists in the cluster",
+		ModifiedBy: []string{"velero.io/change-storage-class", "velero.io/change-image-name"},
+	}
+	expect := `  v1/ConfigMap ns-1/cm-1:
+    Outcome:      skipped-exists
+    Reason:       already exists in the cluster
+    Modified by:  velero.io/change-storage-class, velero.io/change-image-name
+`
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeRestoreItem(d, input)
+	d.out.Flush()
+	assert.Equal(t, expect, d.buf.String())
+}
@@ -0,0 +1,483 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// DescribeRestoreInSF describes a restore in structured format.
+func DescribeRestoreInSF(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	restore *velerov1api.Restore,
+	podVolumeRestores []velerov1api.PodVolumeRestore,
+	details bool,
+	itemOutcomes []string,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+	outputFormat string,
+) string {
+	return DescribeInSF(func(d *StructuredDescriber) {
+		d.DescribeMetadata(restore.ObjectMeta)
+
+		phase := restore.Status.Phase
+		if phase == "" {
+			phase = velerov1api.RestorePhaseNew
+		}
+		d.Describe("phase", phase)
+
+		if len(restore.Status.ValidationErrors) > 0 {
+			d.Describe("validationErrors", restore.Status.ValidationErrors)
+		}
+
+		DescribeRestoreResultsInSF(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+
+		DescribeRestoreSpecInSF(d, restore.Spec)
+
+		DescribeRestoreStatusInSF(ctx, kbClient, d, restore, details, itemOutcomes, insecureSkipTLSVerify, caCertFile)
+
+		if len(podVolumeRestores) > 0 {
+			DescribePodVolumeRestoresInSF(d, podVolumeRestores, details)
+		}
+	}, outputFormat)
+}
+
+// DescribeRestoreSpecInSF describes a restore spec in structured format.
+func DescribeRestoreSpecInSF(d *StructuredDescriber, spec velerov1api.RestoreSpec) {
+	restoreSpecInfo := make(map[string]interface{})
+	var s string
+
+	restoreSpecInfo["backup"] = spec.BackupName
+	if spec.ScheduleName != "" {
+		restoreSpecInfo["schedule"] = spec.ScheduleName
+	}
+
+	// describe namespaces
+	namespaceInfo := make(map[string]string)
+	if len(spec.IncludedNamespaces) == 0 || (len(spec.IncludedNamespaces) == 1 && spec.IncludedNamespaces[0] == "*") {
+		s = "all namespaces found in the backup"
+	} else {
+		s = strings.Join(spec.IncludedNamespaces, ", ")
+	}
+	namespaceInfo["included"] = s
+	if len(spec.ExcludedNamespaces) == 0 {
+		s = emptyDisplay
+	} else {
+		s = strings.Join(spec.ExcludedNamespaces, ", ")
+	}
+	namespaceInfo["excluded"] = s
+	restoreSpecInfo["namespaces"] = namespaceInfo
+
+	// describe resources
+	resourcesInfo := make(map[string]string)
+	if len(spec.IncludedResources) == 0 {
+		s = "*"
+	} else {
+		s = strings.Join(spec.IncludedResources, ", ")
+	}
+	resourcesInfo["included"] = s
+	if len(spec.ExcludedResources) == 0 {
+		s = emptyDisplay
+	} else {
+		s = strings.Join(spec.ExcludedResources, ", ")
+	}
+	resourcesInfo["excluded"] = s
+	resourcesInfo["clusterScoped"] = BoolPointerString(spec.IncludeClusterResources, "excluded", "included", "auto")
+	restoreSpecInfo["resources"] = resourcesInfo
+
+	// describe namespace mappings
+	if len(spec.NamespaceMapping) > 0 {
+		restoreSpecInfo["namespaceMappings"] = spec.NamespaceMapping
+	}
+
+	// describe label selectors
+	s = emptyDisplay
+	if spec.LabelSelector != nil {
+		s = metav1.FormatLabelSelector(spec.LabelSelector)
+	}
+	restoreSpecInfo["labelSelector"] = s
+	if len(spec.OrLabelSelectors) > 0 {
+		orLabelSelectors := make([]string, 0, len(spec.OrLabelSelectors))
+		for _, selector := range spec.OrLabelSelectors {
+			orLabelSelectors = append(orLabelSelectors, metav1.FormatLabelSelector(selector))
+		}
+		restoreSpecInfo["orLabelSelectors"] = orLabelSelectors
+	}
+
+	restoreSpecInfo["restorePVs"] = BoolPointerString(spec.RestorePVs, "false", "true", "auto")
+
+	s = emptyDisplay
+	if spec.ExistingResourcePolicy != "" {
+		s = string(spec.ExistingResourcePolicy)
+	}
+	restoreSpecInfo["existingResourcePolicy"] = s
+	restoreSpecInfo["itemOperationTimeout"] = spec.ItemOperationTimeout.Duration.String()
+	restoreSpecInfo["preserveServiceNodePorts"] = BoolPointerString(spec.PreserveNodePorts, "false", "true", "auto")
+
+	// describe hooks
+	hooksResources := make(map[string]interface{})
+	for _, restoreResourceHookSpec := range spec.Hooks.Resources {
+		resourceDetails := make(map[string]interface{})
+
+		namespaceInfo := make(map[string]string)
+		if len(restoreResourceHookSpec.IncludedNamespaces) == 0 {
+			s = "*"
+		} else {
+			s = strings.Join(restoreResourceHookSpec.IncludedNamespaces, ", ")
+		}
+		namespaceInfo["included"] = s
+		if len(restoreResourceHookSpec.ExcludedNamespaces) == 0 {
+			s = emptyDisplay
+		} else {
+			s = strings.Join(restoreResourceHookSpec.ExcludedNamespaces, ", ")
+		}
+		namespaceInfo["excluded"] = s
+		resourceDetails["namespaces"] = namespaceInfo
+
+		resourcesInfo := make(map[string]string)
+		if len(restoreResourceHookSpec.IncludedResources) == 0 {
+			s = "*"
+		} else {
+			s = strings.Join(restoreResourceHookSpec.IncludedResources, ", ")
+		}
+		resourcesInfo["included"] = s
+		if len(restoreResourceHookSpec.ExcludedResources) == 0 {
+			s = emptyDisplay
+		} else {
+			s = strings.Join(restoreResourceHookSpec.ExcludedResources, ", ")
+		}
+		resourcesInfo["excluded"] = s
+		resourceDetails["resources"] = resourcesInfo
+
+		s = emptyDisplay
+		if restoreResourceHookSpec.LabelSelector != nil {
+			s = metav1.FormatLabelSelector(restoreResourceHookSpec.LabelSelector)
+		}
+		resourceDetails["labelSelector"] = s
+
+		execHooks := make([]map[string]interface{}, 0)
+		initHooks := make([]map[string]interface{}, 0)
+		for _, hook := range restoreResourceHookSpec.PostHooks {
+			if hook.Exec != nil {
+				execHook := make(map[string]interface{})
+				execHook["container"] = hook.Exec.Container
+				execHook["command"] = strings.Join(hook.Exec.Command, " ")
+				execHook["onError"] = hook.Exec.OnError
+				execHook["execTimeout"] = hook.Exec.ExecTimeout.Duration.String()
+				execHook["waitTimeout"] = hook.Exec.WaitTimeout.Duration.String()
+				execHooks = append(execHooks, execHook)
+			}
+			if hook.Init != nil {
+				initHook := make(map[string]interface{})
+				initHook["initContainers"] = len(hook.Init.InitContainers)
+				initHook["timeout"] = hook.Init.Timeout.Duration.String()
+				initHooks = append(initHooks, initHook)
+			}
+		}
+		resourceDetails["postExecHook"] = execHooks
+		resourceDetails["initHook"] = initHooks
+		hooksResources[restoreResourceHookSpec.Name] = resourceDetails
+	}
+	hooksInfo := make(map[string]interface{})
+	if len(spec.Hooks.Resources) > 0 {
+		hooksInfo["resources"] = hooksResources
+	}
+	if len(spec.Hooks.PreRestore) > 0 {
+		hooksInfo["preRestore"] = describeScopeHooksInSF(spec.Hooks.PreRestore)
+	}
+	if len(spec.Hooks.PostRestore) > 0 {
+		hooksInfo["postRestore"] = describeScopeHooksInSF(spec.Hooks.PostRestore)
+	}
+	if len(hooksInfo) > 0 {
+		restoreSpecInfo["hooks"] = hooksInfo
+	}
+
+	d.Describe("spec", restoreSpecInfo)
+}
+
+// DescribeRestoreStatusInSF describes a restore status in structured format.
+func DescribeRestoreStatusInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, restore *velerov1api.Restore, details bool, itemOutcomes []string, insecureSkipTLSVerify bool, caCertPath string) {
+	status := restore.Status
+	restoreStatusInfo := make(map[string]interface{})
+	defer d.Describe("status", restoreStatusInfo)
+
+	// "<n/a>" output should only be applicable for restore that failed validation
+	if status.StartTimestamp == nil || status.StartTimestamp.IsZero() {
+		restoreStatusInfo["started"] = "<n/a>"
+	} else {
+		restoreStatusInfo["started"] = status.StartTimestamp.Time.String()
+	}
+	if status.CompletionTimestamp == nil || status.CompletionTimestamp.IsZero() {
+		restoreStatusInfo["completed"] = "<n/a>"
+	} else {
+		restoreStatusInfo["completed"] = status.CompletionTimestamp.Time.String()
+	}
+
+	if status.FailureReason != "" {
+		restoreStatusInfo["failureReason"] = status.FailureReason
+	}
+
+	if status.Progress != nil {
+		if status.Phase == velerov1api.RestorePhaseInProgress {
+			restoreStatusInfo["estimatedTotalItemsToBeRestored"] = status.Progress.TotalItems
+			restoreStatusInfo["itemsRestoredSoFar"] = status.Progress.ItemsRestored
+		} else {
+			restoreStatusInfo["totalItemsToBeRestored"] = status.Progress.TotalItems
+			restoreStatusInfo["itemsRestored"] = status.Progress.ItemsRestored
+		}
+	}
+
+	if len(status.ScopeHooks) > 0 {
+		restoreStatusInfo["scopeHooks"] = describeScopeHookStatusesInSF(status.ScopeHooks)
+	}
+
+	describeHookStatusInSF(ctx, kbClient, restoreStatusInfo, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreHookResults, status.HookStatus, details, insecureSkipTLSVerify, caCertPath)
+
+	describeRestoreItemOperationsInSF(ctx, kbClient, restoreStatusInfo, restore, details, insecureSkipTLSVerify, caCertPath)
+
+	if details {
+		describeRestoreResourceListInSF(ctx, kbClient, restoreStatusInfo, restore, insecureSkipTLSVerify, caCertPath)
+		describeRestoreItemStatusInSF(ctx, kbClient, restoreStatusInfo, restore, itemOutcomes, insecureSkipTLSVerify, caCertPath)
+	}
+}
+
+func describeRestoreItemStatusInSF(ctx context.Context, kbClient kbclient.Client, restoreStatusInfo map[string]interface{}, restore *velerov1api.Restore, outcomes []string, insecureSkipTLSVerify bool, caCertPath string) {
+	// the field of 'errorGettingItemStatus' gives specific error message when it fails to get the item status list
+	// the field of 'itemStatus' lists the outcome of the restored items
+	itemStatus, err := getRestoreItemStatus(ctx, kbClient, restore, outcomes, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if err == downloadrequest.ErrNotFound {
+			restoreStatusInfo["errorGettingItemStatus"] = "<restore item status not found>"
+		} else {
+			restoreStatusInfo["errorGettingItemStatus"] = fmt.Sprintf("<error getting restore item status: %v>", err)
+		}
+		return
+	}
+	restoreStatusInfo["itemStatus"] = itemStatus
+}
+
+func describeRestoreItemOperationsInSF(ctx context.Context, kbClient kbclient.Client, restoreStatusInfo map[string]interface{}, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	status := restore.Status
+	if status.RestoreItemOperationsAttempted == 0 {
+		return
+	}
+
+	operationsInfo := make(map[string]interface{})
+	operationsInfo["attempted"] = status.RestoreItemOperationsAttempted
+	operationsInfo["completed"] = status.RestoreItemOperationsCompleted
+	operationsInfo["failed"] = status.RestoreItemOperationsFailed
+	restoreStatusInfo["restoreItemOperations"] = operationsInfo
+
+	if !details {
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreItemOperations, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		operationsInfo["errorGettingOperations"] = fmt.Sprintf("<error getting operation info: %v>", err)
+		return
+	}
+
+	var operations []*itemoperation.RestoreOperation
+	if err := json.NewDecoder(buf).Decode(&operations); err != nil {
+		operationsInfo["errorGettingOperations"] = fmt.Sprintf("<error reading operation info: %v>", err)
+		return
+	}
+
+	operationsDetails := make([]map[string]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		operationsDetails = append(operationsDetails, describeRestoreItemOperationInSF(operation))
+	}
+	operationsInfo["operations"] = operationsDetails
+}
+
+func describeRestoreItemOperationInSF(operation *itemoperation.RestoreOperation) map[string]interface{} {
+	operationInfo := make(map[string]interface{})
+	operationInfo["resource"] = operation.Spec.ResourceIdentifier.GroupResource.String()
+	operationInfo["namespace"] = operation.Spec.ResourceIdentifier.Namespace
+	operationInfo["name"] = operation.Spec.ResourceIdentifier.Name
+	operationInfo["restoreItemActionPlugin"] = operation.Spec.RestoreItemAction
+	operationInfo["operationID"] = operation.Spec.OperationID
+	describeOperationStatusInSF(operationInfo, operation.Status)
+	return operationInfo
+}
+
+func describeRestoreResourceListInSF(ctx context.Context, kbClient kbclient.Client, restoreStatusInfo map[string]interface{}, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			restoreStatusInfo["errorGettingResourceList"] = "<restore resource list not found>"
+		} else {
+			restoreStatusInfo["errorGettingResourceList"] = fmt.Sprintf("<error getting restore resource list: %v>", err)
+		}
+		return
+	}
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		restoreStatusInfo["errorGettingResourceList"] = fmt.Sprintf("<error reading restore resource list: %v>", err)
+		return
+	}
+	restoreStatusInfo["resourceList"] = resourceList
+}
+
+// DescribeRestoreResultsInSF describes errors and warnings of a restore in structured format.
+func DescribeRestoreResultsInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	var resultMap map[string]results.Result
+
+	errors, warnings := make(map[string]interface{}), make(map[string]interface{})
+	defer func() {
+		d.Describe("errors", errors)
+		d.Describe("warnings", warnings)
+	}()
+
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		errors["errorGettingErrors"] = fmt.Sprintf("<error getting errors: %v>", err)
+		warnings["errorGettingWarnings"] = fmt.Sprintf("<error getting warnings: %v>", err)
+		return
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&resultMap); err != nil {
+		errors["errorGettingErrors"] = fmt.Sprintf("<error decoding errors: %v>", err)
+		warnings["errorGettingWarnings"] = fmt.Sprintf("<error decoding warnings: %v>", err)
+		return
+	}
+
+	if restore.Status.Warnings > 0 {
+		describeResultInSF(warnings, resultMap["warnings"])
+	}
+	if restore.Status.Errors > 0 {
+		describeResultInSF(errors, resultMap["errors"])
+	}
+}
+
+// DescribePodVolumeRestoresInSF describes pod volume restores in structured format.
+func DescribePodVolumeRestoresInSF(d *StructuredDescriber, restores []velerov1api.PodVolumeRestore, details bool) {
+	podVolumeRestoresInfo := make(map[string]interface{})
+	// Get the type of pod volume uploader. Since the uploader only comes from a single source, we can
+	// take the uploader type from the first element of the array.
+	if len(restores) == 0 {
+		return
+	}
+	podVolumeRestoresInfo["type"] = restores[0].Spec.UploaderType
+
+	podVolumeRestoresDetails := make(map[string]interface{})
+	// separate restores by phase (combining <none> and New into a single group)
+	restoresByPhase := groupRestoresByPhase(restores)
+
+	// go through phases in a specific order
+	for _, phase := range []string{
+		string(velerov1api.PodVolumeRestorePhaseCompleted),
+		string(velerov1api.PodVolumeRestorePhaseFailed),
+		"In Progress",
+		string(velerov1api.PodVolumeRestorePhaseNew),
+	} {
+		if len(restoresByPhase[phase]) == 0 {
+			continue
+		}
+		// if we're not printing details, just report the phase and count
+		if !details {
+			podVolumeRestoresDetails[phase] = len(restoresByPhase[phase])
+			continue
+		}
+		// group the restores in the current phase by pod (i.e. "ns/name")
+		restoresByPod := new(volumesByPod)
+		for _, restore := range restoresByPhase[phase] {
+			restoresByPod.Add(restore.Spec.Pod.Namespace, restore.Spec.Pod.Name, restore.Spec.Volume, phase, restore.Status.Progress)
+		}
+
+		restoresByPods := make([]map[string]string, 0)
+		for _, restoreGroup := range restoresByPod.Sorted() {
+			restoresByPods = append(restoresByPods, map[string]string{restoreGroup.label: strings.Join(restoreGroup.volumes, ", ")})
+		}
+		podVolumeRestoresDetails[phase] = restoresByPods
+	}
+	podVolumeRestoresInfo["podVolumeRestoresDetails"] = podVolumeRestoresDetails
+
+	// the field of 'volumes' lists the restore of each volume, so that it doesn't need to be parsed
+	// out of the volumes grouped by pod
+	if details {
+		podVolumeRestoresInfo["volumes"] = describePodVolumeRestoreVolumesInSF(restores)
+	}
+	d.Describe("podVolumeRestores", podVolumeRestoresInfo)
+}
+
+func describePodVolumeRestoreVolumesInSF(restores []velerov1api.PodVolumeRestore) []map[string]interface{} {
+	sorted := make([]velerov1api.PodVolumeRestore, len(restores))
+	copy(sorted, restores)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Spec.Pod.Namespace != sorted[j].Spec.Pod.Namespace {
+			return sorted[i].Spec.Pod.Namespace < sorted[j].Spec.Pod.Namespace
+		}
+		if sorted[i].Spec.Pod.Name != sorted[j].Spec.Pod.Name {
+			return sorted[i].Spec.Pod.Name < sorted[j].Spec.Pod.Name
+		}
+		return sorted[i].Spec.Volume < sorted[j].Spec.Volume
+	})
+
+	volumes := make([]map[string]interface{}, 0, len(sorted))
+	for _, restore := range sorted {
+		phase := restore.Status.Phase
+		if phase == "" {
+			phase = velerov1api.PodVolumeRestorePhaseNew
+		}
+
+		volumeInfo := map[string]interface{}{
+			"podNamespace": restore.Spec.Pod.Namespace,
+			"podName":      restore.Spec.Pod.Name,
+			"volume":       restore.Spec.Volume,
+			"phase":        phase,
+			"snapshotID":   restore.Spec.SnapshotID,
+		}
+		if restore.Status.Progress.TotalBytes != 0 {
+			volumeInfo["progress"] = map[string]interface{}{
+				"bytesDone":  restore.Status.Progress.BytesDone,
+				"totalBytes": restore.Status.Progress.TotalBytes,
+			}
+		}
+		if restore.Status.Message != "" {
+			volumeInfo["message"] = restore.Status.Message
+		}
+		if restore.Status.StartTimestamp != nil {
+			volumeInfo["started"] = restore.Status.StartTimestamp.String()
+		}
+		if restore.Status.CompletionTimestamp != nil {
+			volumeInfo["completed"] = restore.Status.CompletionTimestamp.String()
+		}
+		volumes = append(volumes, volumeInfo)
+	}
+	return volumes
+}
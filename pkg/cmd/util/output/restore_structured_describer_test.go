@@ -0,0 +1,247 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+func TestDescribeRestoreSpecInSF(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").
+		Backup("backup-1").
+		IncludedNamespaces("inc-ns-1", "inc-ns-2").
+		ExcludedResources("exc-res-1").
+		NamespaceMappings("ns-1", "ns-2").
+		RestorePVs(true).
+		ExistingResourcePolicy("update").
+		ItemOperationTimeout(time.Hour).
+		Result()
+
+	expect := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"backup": "backup-1",
+			"namespaces": map[string]string{
+				"included": "inc-ns-1, inc-ns-2",
+				"excluded": emptyDisplay,
+			},
+			"resources": map[string]string{
+				"included":      "*",
+				"excluded":      "exc-res-1",
+				"clusterScoped": "auto",
+			},
+			"namespaceMappings":        map[string]string{"ns-1": "ns-2"},
+			"labelSelector":            emptyDisplay,
+			"restorePVs":               "true",
+			"existingResourcePolicy":   "update",
+			"itemOperationTimeout":     "1h0m0s",
+			"preserveServiceNodePorts": "auto",
+		},
+	}
+
+	d := NewStructuredDescriber("")
+	DescribeRestoreSpecInSF(d, restore.Spec)
+	assert.Equal(t, expect, d.output)
+}
+
+func TestDescribeRestoreItemOperationInSF(t *testing.T) {
+	input := &itemoperation.RestoreOperation{
+		Spec: itemoperation.RestoreOperationSpec{
+			RestoreName: "restore-1",
+			ResourceIdentifier: velero.ResourceIdentifier{
+				GroupResource: schema.GroupResource{Group: "velero.io", Resource: "datadownloads"},
+				Namespace:     "velero",
+				Name:          "dd-1",
+			},
+			RestoreItemAction: "velero.io/csi-pvc-restorer",
+			OperationID:       "op-1",
+		},
+		Status: itemoperation.OperationStatus{
+			Phase:          itemoperation.OperationPhaseInProgress,
+			NCompleted:     10,
+			NTotal:         100,
+			OperationUnits: "Bytes",
+		},
+	}
+
+	expect := map[string]interface{}{
+		"resource":                "datadownloads.velero.io",
+		"namespace":               "velero",
+		"name":                    "dd-1",
+		"restoreItemActionPlugin": "velero.io/csi-pvc-restorer",
+		"operationID":             "op-1",
+		"phase":                   itemoperation.OperationPhaseInProgress,
+		"progress": map[string]interface{}{
+			"completed": int64(10),
+			"total":     int64(100),
+			"units":     "Bytes",
+		},
+	}
+	assert.Equal(t, expect, describeRestoreItemOperationInSF(input))
+}
+
+func TestDescribePodVolumeRestoresInSF(t *testing.T) {
+	pvr1 := builder.ForPodVolumeRestore("velero", "pvr-1").
+		UploaderType("kopia").
+		Phase(velerov1api.PodVolumeRestorePhaseCompleted).
+		SnapshotID("snap-1").
+		Volume("vol-1").
+		PodName("pod-1").
+		PodNamespace("pod-ns-1").Result()
+	pvr2 := builder.ForPodVolumeRestore("velero", "pvr-2").
+		UploaderType("kopia").
+		Phase(velerov1api.PodVolumeRestorePhaseCompleted).
+		Volume("vol-2").
+		PodName("pod-2").
+		PodNamespace("pod-ns-1").Result()
+	pvr2.Status.Progress.BytesDone = 100
+	pvr2.Status.Progress.TotalBytes = 100
+	pvr2.Status.Message = "restored with warnings"
+
+	testcases := []struct {
+		name         string
+		inputPVRList []velerov1api.PodVolumeRestore
+		inputDetails bool
+		expect       map[string]interface{}
+	}{
+		{
+			name:         "empty list",
+			inputPVRList: []velerov1api.PodVolumeRestore{},
+			inputDetails: true,
+			expect:       map[string]interface{}{},
+		},
+		{
+			name:         "2 completed pvrs no details",
+			inputPVRList: []velerov1api.PodVolumeRestore{*pvr1, *pvr2},
+			inputDetails: false,
+			expect: map[string]interface{}{
+				"podVolumeRestores": map[string]interface{}{
+					"type": "kopia",
+					"podVolumeRestoresDetails": map[string]interface{}{
+						"Completed": 2,
+					},
+				},
+			},
+		},
+		{
+			name:         "2 completed pvrs with details",
+			inputPVRList: []velerov1api.PodVolumeRestore{*pvr1, *pvr2},
+			inputDetails: true,
+			expect: map[string]interface{}{
+				"podVolumeRestores": map[string]interface{}{
+					"type": "kopia",
+					"podVolumeRestoresDetails": map[string]interface{}{
+						"Completed": []map[string]string{
+							{"pod-ns-1/pod-1": "vol-1"},
+							{"pod-ns-1/pod-2": "vol-2"},
+						},
+					},
+					"volumes": []map[string]interface{}{
+						{
+							"podNamespace": "pod-ns-1",
+							"podName":      "pod-1",
+							"volume":       "vol-1",
+							"phase":        velerov1api.PodVolumeRestorePhaseCompleted,
+							"snapshotID":   "snap-1",
+						},
+						{
+							"podNamespace": "pod-ns-1",
+							"podName":      "pod-2",
+							"volume":       "vol-2",
+							"phase":        velerov1api.PodVolumeRestorePhaseCompleted,
+							"snapshotID":   "",
+							"progress": map[string]interface{}{
+								"bytesDone":  int64(100),
+								"totalBytes": int64(100),
+							},
+							"message": "restored with warnings",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := NewStructuredDescriber("")
+			DescribePodVolumeRestoresInSF(d, tc.inputPVRList, tc.inputDetails)
+			assert.Equal(tt, tc.expect, d.output)
+		})
+	}
+}
+
+func TestDescribeScheduleInSF(t *testing.T) {
+	input := builder.ForSchedule("velero", "schedule-1").
+		Phase(velerov1api.SchedulePhaseFailedValidation).
+		ValidationError("validation failed").Result()
+
+	expect := `metadata:
+  annotations: null
+  labels: null
+  name: schedule-1
+  namespace: velero
+paused: false
+phase: FailedValidation
+spec:
+  backupTemplate:
+    CSISnapshotTimeout: 0s
+    TTL: 0s
+    dataMover: <none>
+    labelSelector: <none>
+    namespaces:
+      excluded: <none>
+      included: '*'
+    resources:
+      clusterScoped: auto
+      excluded: <none>
+      included: '*'
+    storageLocation: ""
+    veleroNativeSnapshotPVs: auto
+    veleroSnapshotMoveData: auto
+  schedule: ""
+status:
+  lastBackup: <never>
+validationErrors:
+- validation failed
+`
+	assert.Equal(t, expect, DescribeScheduleInSF(input, "yaml"))
+}
+
+func TestDescribeScopeHooksInSF(t *testing.T) {
+	hooks := []velerov1api.ScopeHook{
+		{
+			Name:    "scale-down",
+			Job:     &velerov1api.JobHook{Namespace: "ns-1"},
+			OnError: velerov1api.HookErrorModeFail,
+			Timeout: metav1.Duration{Duration: time.Minute},
+		},
+		{
+			Name: "warmup",
+			HTTP: &velerov1api.HTTPHook{URL: "https://example.com/warmup"},
+		},
+	}
+
+	expect := []map[string]interface{}{
+		{
+			"name":    "scale-down",
+			"onError": velerov1api.HookErrorModeFail,
+			"timeout": "1m0s",
+			"job":     map[string]string{"namespace": "ns-1"},
+		},
+		{
+			"name":    "warmup",
+			"onError": velerov1api.HookErrorMode(""),
+			"timeout": "0s",
+			"http":    map[string]string{"method": "POST", "url": "https://example.com/warmup"},
+		},
+	}
+	assert.Equal(t, expect, describeScopeHooksInSF(hooks))
+}
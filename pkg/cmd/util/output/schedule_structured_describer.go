@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DescribeScheduleInSF describes a schedule in structured format.
+func DescribeScheduleInSF(schedule *v1.Schedule, outputFormat string) string {
+	return DescribeInSF(func(d *StructuredDescriber) {
+		d.DescribeMetadata(schedule.ObjectMeta)
+
+		phase := schedule.Status.Phase
+		if phase == "" {
+			phase = v1.SchedulePhaseNew
+		}
+		d.Describe("phase", phase)
+
+		if schedule.Spec.Template.ResourcePolicy != nil {
+			DescribeResourcePoliciesInSF(d, schedule.Spec.Template.ResourcePolicy)
+		}
+
+		if len(schedule.Status.ValidationErrors) > 0 {
+			d.Describe("validationErrors", schedule.Status.ValidationErrors)
+		}
+
+		d.Describe("paused", schedule.Spec.Paused)
+
+		DescribeScheduleSpecInSF(d, schedule.Spec)
+
+		DescribeScheduleStatusInSF(d, schedule.Status)
+	}, outputFormat)
+}
+
+// DescribeScheduleSpecInSF describes a schedule spec in structured format.
+func DescribeScheduleSpecInSF(d *StructuredDescriber, spec v1.ScheduleSpec) {
+	scheduleSpecInfo := make(map[string]interface{})
+	scheduleSpecInfo["schedule"] = spec.Schedule
+	if spec.UseOwnerReferencesInBackup != nil {
+		scheduleSpecInfo["useOwnerReferencesInBackup"] = *spec.UseOwnerReferencesInBackup
+	}
+
+	// the backup template is described with the same layout as a backup spec
+	templateDescriber := NewStructuredDescriber(d.format)
+	DescribeBackupSpecInSF(templateDescriber, spec.Template)
+	scheduleSpecInfo["backupTemplate"] = templateDescriber.output["spec"]
+
+	d.Describe("spec", scheduleSpecInfo)
+}
+
+// DescribeScheduleStatusInSF describes a schedule status in structured format.
+func DescribeScheduleStatusInSF(d *StructuredDescriber, status v1.ScheduleStatus) {
+	scheduleStatusInfo := make(map[string]interface{})
+	lastBackup := "<never>"
+	if status.LastBackup != nil && !status.LastBackup.Time.IsZero() {
+		lastBackup = status.LastBackup.Time.String()
+	}
+	scheduleStatusInfo["lastBackup"] = lastBackup
+	d.Describe("status", scheduleStatusInfo)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// describeScopeHooks describes a list of hooks that run once for a whole backup or restore.
+func describeScopeHooks(d *Describer, title string, hooks []velerov1api.ScopeHook) {
+	if len(hooks) == 0 {
+		d.Printf("%s:\t%s\n", title, emptyDisplay)
+		return
+	}
+
+	d.Printf("%s:\n", title)
+	for _, hook := range hooks {
+		d.Printf("\t%s:\n", hook.Name)
+		if hook.Job != nil {
+			d.Printf("\t\tJob Namespace:\t%s\n", hook.Job.Namespace)
+		}
+		if hook.Exec != nil {
+			d.Printf("\t\tExec Pod:\t%s\n", scopeExecHookPod(hook.Exec))
+			d.Printf("\t\tContainer:\t%s\n", hook.Exec.Container)
+			d.Printf("\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
+		}
+		if hook.HTTP != nil {
+			d.Printf("\t\tHTTP:\t%s %s\n", scopeHTTPHookMethod(hook.HTTP), hook.HTTP.URL)
+		}
+		d.Printf("\t\tOn Error:\t%s\n", hook.OnError)
+		d.Printf("\t\tTimeout:\t%s\n", hook.Timeout.Duration)
+	}
+}
+
+// describeScopeHookStatuses describes the recorded executions of a backup's or restore's scope hooks.
+func describeScopeHookStatuses(d *Describer, statuses []velerov1api.ScopeHookStatus) {
+	if len(statuses) == 0 {
+		return
+	}
+
+	d.Printf("Scope Hooks:\n")
+	for _, status := range statuses {
+		s := string(status.Result)
+		if status.Error != "" {
+			s = fmt.Sprintf("%s (%s)", s, status.Error)
+		}
+		d.Printf("\t%s %s:\t%s\n", status.Phase, status.Name, s)
+	}
+	d.Println()
+}
+
+// describeScopeHooksInSF describes a list of hooks that run once for a whole backup or restore
+// in structured format.
+func describeScopeHooksInSF(hooks []velerov1api.ScopeHook) []map[string]interface{} {
+	hooksInfo := make([]map[string]interface{}, 0, len(hooks))
+	for _, hook := range hooks {
+		hookInfo := map[string]interface{}{
+			"name":    hook.Name,
+			"onError": hook.OnError,
+			"timeout": hook.Timeout.Duration.String(),
+		}
+		if hook.Job != nil {
+			hookInfo["job"] = map[string]string{"namespace": hook.Job.Namespace}
+		}
+		if hook.Exec != nil {
+			hookInfo["exec"] = map[string]string{
+				"pod":       scopeExecHookPod(hook.Exec),
+				"container": hook.Exec.Container,
+				"command":   strings.Join(hook.Exec.Command, " "),
+			}
+		}
+		if hook.HTTP != nil {
+			hookInfo["http"] = map[string]string{"method": scopeHTTPHookMethod(hook.HTTP), "url": hook.HTTP.URL}
+		}
+		hooksInfo = append(hooksInfo, hookInfo)
+	}
+	return hooksInfo
+}
+
+// describeScopeHookStatusesInSF describes the recorded executions of a backup's or restore's
+// scope hooks in structured format.
+func describeScopeHookStatusesInSF(statuses []velerov1api.ScopeHookStatus) []map[string]interface{} {
+	statusesInfo := make([]map[string]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		statusInfo := map[string]interface{}{
+			"name":   status.Name,
+			"phase":  status.Phase,
+			"result": status.Result,
+		}
+		if status.Error != "" {
+			statusInfo["error"] = status.Error
+		}
+		if status.StartTimestamp != nil {
+			statusInfo["started"] = status.StartTimestamp.Time.String()
+		}
+		if status.CompletionTimestamp != nil {
+			statusInfo["completed"] = status.CompletionTimestamp.Time.String()
+		}
+		statusesInfo = append(statusesInfo, statusInfo)
+	}
+	return statusesInfo
+}
+
+func scopeExecHookPod(hook *velerov1api.ScopeExecHook) string {
+	if hook.Pod != "" {
+		return fmt.Sprintf("%s/%s", hook.Namespace, hook.Pod)
+	}
+	return fmt.Sprintf("%s/<%s>", hook.Namespace, metav1.FormatLabelSelector(hook.LabelSelector))
+}
+
+func scopeHTTPHookMethod(hook *velerov1api.HTTPHook) string {
+	if hook.Method == "" {
+		return http.MethodPost
+	}
+	return hook.Method
+}
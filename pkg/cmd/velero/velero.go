@@ -40,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/install"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/plugin"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/repo"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/repomaintenance"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/schedule"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/snapshotlocation"
@@ -118,6 +119,7 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 		cliclient.NewCommand(),
 		completion.NewCommand(),
 		repo.NewCommand(f),
+		repomaintenance.NewCommand(f),
 		bug.NewCommand(),
 		backuplocation.NewCommand(f),
 		snapshotlocation.NewCommand(f),
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -40,9 +41,11 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
@@ -54,6 +57,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
@@ -64,6 +68,9 @@ const (
 	backupResyncPeriod = time.Minute
 )
 
+// backupCancelCheckInterval is how often a running backup checks whether it's requested to be canceled
+var backupCancelCheckInterval = 5 * time.Second
+
 type backupReconciler struct {
 	ctx                         context.Context
 	logger                      logrus.FieldLogger
@@ -88,6 +95,10 @@ type backupReconciler struct {
 	volumeSnapshotClient        snapshotterClientSet.Interface
 	credentialFileStore         credentials.FileStore
 	maxConcurrentK8SConnections int
+	scopeHookHandler            hook.ScopeHookHandler
+	compression                 *velerov1api.BackupCompression
+	streamingUpload             bool
+	contentsLayout              velerov1api.BackupContentsLayout
 }
 
 func NewBackupReconciler(
@@ -113,6 +124,10 @@ func NewBackupReconciler(
 	volumeSnapshotClient snapshotterClientSet.Interface,
 	credentialStore credentials.FileStore,
 	maxConcurrentK8SConnections int,
+	scopeHookHandler hook.ScopeHookHandler,
+	compression *velerov1api.BackupCompression,
+	streamingUpload bool,
+	contentsLayout velerov1api.BackupContentsLayout,
 ) *backupReconciler {
 	b := &backupReconciler{
 		ctx:                         ctx,
@@ -138,6 +153,10 @@ func NewBackupReconciler(
 		volumeSnapshotClient:        volumeSnapshotClient,
 		credentialFileStore:         credentialStore,
 		maxConcurrentK8SConnections: maxConcurrentK8SConnections,
+		scopeHookHandler:            scopeHookHandler,
+		compression:                 compression,
+		streamingUpload:             streamingUpload,
+		contentsLayout:              contentsLayout,
 	}
 	b.updateTotalBackupMetric()
 	return b
@@ -240,6 +259,17 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, nil
 	}
 
+	if original.Spec.Cancel {
+		log.Info("Backup is canceled before it is started")
+		updated := original.DeepCopy()
+		updated.Status.Phase = velerov1api.BackupPhaseCancelled
+		updated.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+		if err := kubeutil.PatchResource(original, updated, b.kbClient); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating Backup status to %s", updated.Status.Phase)
+		}
+		return ctrl.Result{}, nil
+	}
+
 	log.Debug("Preparing backup request")
 	request := b.prepareBackupRequest(original, log)
 	if len(request.Status.ValidationErrors) > 0 {
@@ -269,7 +299,7 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	b.backupTracker.Add(request.Namespace, request.Name)
 	defer func() {
 		switch request.Status.Phase {
-		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhaseCancelled:
 			b.backupTracker.Delete(request.Namespace, request.Name)
 		}
 	}()
@@ -323,6 +353,10 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	// set backup major, minor, and patch version
 	request.Status.FormatVersion = pkgbackup.BackupFormatVersion
 
+	// record the compression of the tarball, so that it's read back the same way
+	request.Status.Compression = b.compression.DeepCopy()
+	request.Status.ContentsLayout = b.contentsLayout
+
 	if request.Spec.TTL.Duration == 0 {
 		// set default backup TTL
 		request.Spec.TTL.Duration = b.defaultBackupTTL
@@ -388,6 +422,14 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting backup storage location: %v", err))
 		}
 	} else {
+		if storageLocation.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable {
+			if failover := b.getFailoverStorageLocation(storageLocation, logger); failover != nil {
+				logger.Warnf("Backup storage location %s is unavailable, storing the backup in its failover location %s", storageLocation.Name, failover.Name)
+				request.Status.FailoverFrom = storageLocation.Name
+				request.Spec.StorageLocation = failover.Name
+				storageLocation = failover
+			}
+		}
 		request.StorageLocation = storageLocation
 
 		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
@@ -592,6 +634,31 @@ func (b *backupReconciler) validateAndGetSnapshotLocations(backup *velerov1api.B
 	return providerLocations, nil
 }
 
+// getFailoverStorageLocation returns the first available and writable failover location of
+// location, or nil if there isn't any.
+func (b *backupReconciler) getFailoverStorageLocation(location *velerov1api.BackupStorageLocation, logger logrus.FieldLogger) *velerov1api.BackupStorageLocation {
+	for _, name := range location.Spec.FailoverLocations {
+		failover := &velerov1api.BackupStorageLocation{}
+		if err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: location.Namespace,
+			Name:      name,
+		}, failover); err != nil {
+			logger.WithError(err).Warnf("Error getting failover backup storage location %s", name)
+			continue
+		}
+
+		if failover.Status.Phase != velerov1api.BackupStorageLocationPhaseAvailable ||
+			failover.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			logger.Debugf("Failover backup storage location %s isn't available for backups, skipping it", name)
+			continue
+		}
+
+		return failover
+	}
+
+	return nil
+}
+
 // runBackup runs and uploads a validated backup. Any error returned from this function
 // causes the backup to be Failed; if no error is returned, the backup's status's Errors
 // field is checked to see if the backup was a partial failure.
@@ -608,13 +675,6 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	}
 	defer backupLog.Dispose(b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)))
 
-	backupLog.Info("Setting up backup temp file")
-	backupFile, err := os.CreateTemp("", "")
-	if err != nil {
-		return errors.Wrap(err, "error creating temp file for backup")
-	}
-	defer closeAndRemoveFile(backupFile, backupLog)
-
 	backupLog.Info("Setting up plugin manager")
 	pluginManager := b.newPluginManager(backupLog)
 	defer pluginManager.CleanupClients()
@@ -640,12 +700,49 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	// the tarball is either uploaded while it's written, or written to a temp file and uploaded
+	// along with the other files of the backup
+	var backupFile *os.File
+	var contentsUpload *streamingUpload
+	var backupContents io.Writer
+	if b.streamingUpload {
+		// the objects of the backup are locked from the time they're uploaded
+		setBackupRetainUntil(backup.Backup, backup.StorageLocation, b.clock.Now())
+
+		backupLog.Info("Streaming the backup contents to the backup store")
+		contentsUpload = startStreamingUpload(backupStore, backup.Name, backupRetainUntil(backup.Backup))
+		backupContents = contentsUpload
+	} else {
+		backupLog.Info("Setting up backup temp file")
+		backupFile, err = os.CreateTemp("", "")
+		if err != nil {
+			return errors.Wrap(err, "error creating temp file for backup")
+		}
+		defer closeAndRemoveFile(backupFile, backupLog)
+		backupContents = backupFile
+	}
+
 	backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
 
+	stopCancelWatch := make(chan struct{})
+	defer close(stopCancelWatch)
+	go b.watchBackupCancel(backup, stopCancelWatch, backupLog)
+
 	var fatalErrs []error
-	if err := b.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, pluginManager); err != nil {
+	hookLog := b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+	preHookStatuses, preHookWarnings, err := b.scopeHookHandler.HandleHooks(b.ctx, hookLog, backup.Spec.Hooks.PreBackup, hook.PhasePre, backupScopeHookLabels(backup.Backup), backup.GetHookTracker())
+	backup.Status.ScopeHooks = append(backup.Status.ScopeHooks, preHookStatuses...)
+	logScopeHookFailures(backupLog, preHookWarnings, err)
+	if err != nil {
+		fatalErrs = append(fatalErrs, errors.Wrap(err, "error running pre-backup hooks"))
+	} else if err := b.backupper.BackupWithResolvers(backupLog, backup, backupContents, backupItemActionsResolver, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
+	if contentsUpload != nil {
+		if err := contentsUpload.finish(); err != nil {
+			fatalErrs = append(fatalErrs, errors.Wrap(err, "error uploading backup contents"))
+		}
+	}
 
 	// Empty slices here so that they can be passed in to the persistBackup call later, regardless of whether or not CSI's enabled.
 	// This way, we only make the Lister call if the feature flag's on.
@@ -707,6 +804,14 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		}
 	}
 
+	// the async operations and the pod volume/data mover backups started before the
+	// cancellation are not waited for
+	if backup.IsCancelled() {
+		backupLog.Info("Backup is canceled, canceling the ongoing asynchronous operations and data path")
+		cancelBackupItemOperations(backup.Backup, pluginManager, *backup.GetItemOperationsList())
+		cancelBackupDataPath(context.Background(), b.kbClient, backup.Backup, backupLog)
+	}
+
 	// Iterate over backup item operations and update progress.
 	// Any errors on operations at this point should be added to backup errors.
 	// If any operations are still not complete, then back will not be set to
@@ -722,6 +827,16 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	backup.Status.BackupItemOperationsCompleted = opsCompleted
 	backup.Status.BackupItemOperationsFailed = opsFailed
 
+	// post-backup hooks run once all items are backed up; if async operations are still
+	// running, the backup finalizer controller runs them when the operations finish. A
+	// failed backup never gets there, so its post-backup hooks always run here.
+	if !inProgressOperations || len(fatalErrs) > 0 {
+		postHookStatuses, postHookWarnings, err := b.scopeHookHandler.HandleHooks(b.ctx, hookLog, backup.Spec.Hooks.PostBackup, hook.PhasePost, backupScopeHookLabels(backup.Backup), backup.GetHookTracker())
+		backup.Status.ScopeHooks = append(backup.Status.ScopeHooks, postHookStatuses...)
+		logScopeHookFailures(backupLog, postHookWarnings, err)
+	}
+	backup.Status.HookStatus = backup.GetHookTracker().HookStatus()
+
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
 
@@ -739,6 +854,8 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	// artifacts to object storage so that the JSON representation of the
 	// backup in object storage has the terminal phase set.
 	switch {
+	case backup.IsCancelled():
+		backup.Status.Phase = velerov1api.BackupPhaseCancelled
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
@@ -758,10 +875,14 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
 	if backup.Status.Phase == velerov1api.BackupPhaseFailed ||
 		backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed ||
-		backup.Status.Phase == velerov1api.BackupPhaseCompleted {
+		backup.Status.Phase == velerov1api.BackupPhaseCompleted ||
+		backup.Status.Phase == velerov1api.BackupPhaseCancelled {
 		backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
 	}
 	recordBackupMetrics(backupLog, backup.Backup, backupFile, b.metrics, false)
+	if contentsUpload != nil {
+		b.metrics.SetBackupTarballSizeBytesGauge(backup.GetLabels()[velerov1api.ScheduleNameLabel], contentsUpload.size)
+	}
 
 	// re-instantiate the backup store because credentials could have changed since the original
 	// instantiation, if this was a long-running backup
@@ -771,11 +892,35 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
+	if !b.streamingUpload {
+		setBackupRetainUntil(backup.Backup, backup.StorageLocation, b.clock.Now())
+	}
+
+	// the archives the contents are split into are uploaded instead of the tarball
+	persistedContents := backupFile
+	var contentsIndex *archive.Index
+	if backup.Status.ContentsLayout == velerov1api.BackupContentsLayoutPerNamespace && backupFile != nil {
+		log := b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+		if contentsIndex, err = putBackupContentsArchives(backupStore, backup.Backup, backupFile, log); err != nil {
+			log.WithError(err).Error("Error splitting the backup contents per namespace, storing them in a single tarball")
+			backup.Status.ContentsLayout = velerov1api.BackupContentsLayoutSingle
+		} else {
+			persistedContents = nil
+		}
+	}
+
 	if logFile, err := backupLog.GetPersistFile(); err != nil {
 		fatalErrs = append(fatalErrs, errors.Wrap(err, "error getting backup log file"))
 	} else {
-		if errs := persistBackup(backup, backupFile, logFile, backupStore, volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses, results); len(errs) > 0 {
+		if errs := persistBackup(backup, persistedContents, contentsIndex, logFile, backupStore, volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses, results); len(errs) > 0 {
 			fatalErrs = append(fatalErrs, errs...)
+
+			// the uploaded contents are useless without the metadata of the backup
+			if contentsUpload != nil || contentsIndex != nil {
+				if err := backupStore.DeleteBackup(backup.Name); err != nil {
+					backupLog.WithError(err).Error("Error deleting the uploaded backup contents")
+				}
+			}
 		}
 	}
 
@@ -786,6 +931,57 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// watchBackupCancel checks the backup periodically until stop is closed, and cancels the running
+// backup request once the backup is requested to be canceled.
+func (b *backupReconciler) watchBackupCancel(backup *pkgbackup.Request, stop <-chan struct{}, log logrus.FieldLogger) {
+	wait.Until(func() {
+		if backup.IsCancelled() {
+			return
+		}
+
+		current := &velerov1api.Backup{}
+		if err := b.kbClient.Get(context.Background(), kbclient.ObjectKeyFromObject(backup.Backup), current); err != nil {
+			log.WithError(err).Warn("Failed to check whether the backup is canceled")
+			return
+		}
+
+		if current.Spec.Cancel {
+			log.Info("Backup is requested to be canceled")
+			backup.Cancel()
+		}
+	}, backupCancelCheckInterval, stop)
+}
+
+// backupScopeHookLabels returns the labels added to the objects created by the backup's scope hooks.
+func backupScopeHookLabels(backup *velerov1api.Backup) map[string]string {
+	return map[string]string{
+		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+		velerov1api.BackupUIDLabel:  string(backup.UID),
+	}
+}
+
+// scopeHookPhaseRan returns true if any scope hook of the given phase has a recorded status.
+// logScopeHookFailures adds the warnings and the error returned by scope hooks to the backup
+// log, which counts them as backup warnings and errors and records them in the backup results.
+// The hooks themselves log to the server log, so that their failures aren't counted twice.
+func logScopeHookFailures(backupLog logrus.FieldLogger, warnings []error, err error) {
+	for _, warning := range warnings {
+		backupLog.WithError(warning).Warn("Scope hook failed")
+	}
+	if err != nil {
+		backupLog.WithError(err).Error("Scope hook failed")
+	}
+}
+
+func scopeHookPhaseRan(statuses []velerov1api.ScopeHookStatus, phase string) bool {
+	for _, status := range statuses {
+		if status.Phase == phase {
+			return true
+		}
+	}
+	return false
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics, finalize bool) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -826,8 +1022,43 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 	}
 }
 
+// setBackupRetainUntil sets the time until which the objects of the backup are locked if object lock
+// is enabled for the location. It must be called before the first objects of the backup are uploaded,
+// the time is computed only once and all the objects uploaded later are locked until the same time.
+func setBackupRetainUntil(backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation, now time.Time) {
+	if backup.Status.RetainUntil != nil {
+		return
+	}
+
+	if retainUntil := objectLockRetainUntil(location, now); !retainUntil.IsZero() {
+		backup.Status.RetainUntil = &metav1.Time{Time: retainUntil}
+	}
+}
+
+// objectLockRetainUntil returns the time until which the objects written to the location now are
+// locked, which is the zero time if object lock isn't enabled for the location.
+func objectLockRetainUntil(location *velerov1api.BackupStorageLocation, now time.Time) time.Time {
+	if location.Spec.ObjectLock == nil {
+		return time.Time{}
+	}
+
+	return now.Add(location.Spec.ObjectLock.RetentionPeriod.Duration)
+}
+
+// backupRetainUntil returns the time until which the objects of the backup are locked, which is
+// the zero time if they aren't locked.
+func backupRetainUntil(backup *velerov1api.Backup) time.Time {
+	if backup.Status.RetainUntil == nil {
+		return time.Time{}
+	}
+
+	return backup.Status.RetainUntil.Time
+}
+
 func persistBackup(backup *pkgbackup.Request,
-	backupContents, backupLog *os.File,
+	backupContents *os.File,
+	contentsIndex *archive.Index,
+	backupLog *os.File,
 	backupStore persistence.BackupStore,
 	csiVolumeSnapshots []snapshotv1api.VolumeSnapshot,
 	csiVolumeSnapshotContents []snapshotv1api.VolumeSnapshotContent,
@@ -882,6 +1113,19 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	backupHookResults, errs := encode.ToJSONGzip(backup.GetHookTracker().Executions(), "backup hook results")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
+	var contentsIndexJSON *bytes.Buffer
+	if contentsIndex != nil {
+		contentsIndexJSON, errs = encode.ToJSONGzip(contentsIndex, "backup contents index")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		}
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -893,14 +1137,29 @@ func persistBackup(backup *pkgbackup.Request,
 		csiSnapshotContentsJSON = nil
 		csiSnapshotClassesJSON = nil
 		backupResult = nil
+		backupHookResults = nil
+		contentsIndexJSON = nil
+	}
+
+	// the contents were already uploaded if they're streamed or split per namespace
+	var contents io.Reader
+	if backupContents != nil {
+		contents = backupContents
+	}
+	var index io.Reader
+	if contentsIndexJSON != nil {
+		index = contentsIndexJSON
 	}
 
 	backupInfo := persistence.BackupInfo{
 		Name:                      backup.Name,
+		RetainUntil:               backupRetainUntil(backup.Backup),
 		Metadata:                  backupJSON,
-		Contents:                  backupContents,
+		Contents:                  contents,
+		ContentsIndex:             index,
 		Log:                       backupLog,
 		BackupResults:             backupResult,
+		BackupHookResults:         backupHookResults,
 		PodVolumeBackups:          podVolumeBackups,
 		VolumeSnapshots:           nativeVolumeSnapshots,
 		BackupItemOperations:      backupItemOperations,
@@ -916,6 +1175,55 @@ func persistBackup(backup *pkgbackup.Request,
 	return persistErrs
 }
 
+// putBackupContentsArchives splits the contents tarball of a backup per namespace, and uploads
+// the archives one at a time.
+func putBackupContentsArchives(backupStore persistence.BackupStore, backup *velerov1api.Backup, contents io.ReadSeeker, log logrus.FieldLogger) (*archive.Index, error) {
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return archive.NewExtractor(log, filesystem.NewFileSystem()).SplitByNamespace(contents, backup.Status.Compression, func(name string, r io.Reader) error {
+		return backupStore.PutBackupContentsArchive(backup.Name, name, r, backupRetainUntil(backup))
+	})
+}
+
+// streamingUpload uploads the contents of a backup to the backup store while they're written,
+// so that the tarball doesn't need to be held in a temp file.
+type streamingUpload struct {
+	writer *io.PipeWriter
+	done   chan error
+	size   int64
+}
+
+func startStreamingUpload(backupStore persistence.BackupStore, backupName string, retainUntil time.Time) *streamingUpload {
+	pr, pw := io.Pipe()
+	upload := &streamingUpload{
+		writer: pw,
+		done:   make(chan error, 1),
+	}
+
+	go func() {
+		err := backupStore.PutBackupContents(backupName, pr, retainUntil)
+		// unblock the writes if the upload stopped before reading everything
+		pr.CloseWithError(err)
+		upload.done <- err
+	}()
+
+	return upload
+}
+
+func (u *streamingUpload) Write(p []byte) (int, error) {
+	n, err := u.writer.Write(p)
+	u.size += int64(n)
+	return n, err
+}
+
+// finish ends the contents and waits for the upload to complete.
+func (u *streamingUpload) finish() error {
+	u.writer.Close()
+	return <-u.done
+}
+
 func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	if file == nil {
 		log.Debug("Skipping removal of file due to nil file pointer")
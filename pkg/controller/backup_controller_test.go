@@ -43,6 +43,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -238,6 +239,34 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 	}
 }
 
+func TestProcessBackupCanceledBeforeStart(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	backup := defaultBackup().Cancel(true).Result()
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, backup)
+
+	c := &backupReconciler{
+		logger:        logging.DefaultLogger(logrus.DebugLevel, logging.FormatText),
+		kbClient:      fakeClient,
+		clock:         testclocks.NewFakeClock(now),
+		metrics:       metrics.NewServerMetrics(),
+		backupTracker: NewBackupTracker(),
+	}
+
+	actualResult, err := c.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	assert.Equal(t, ctrl.Result{}, actualResult)
+	assert.NoError(t, err)
+
+	res := &velerov1api.Backup{}
+	require.NoError(t, c.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Name}, res))
+	assert.Equal(t, velerov1api.BackupPhaseCancelled, res.Status.Phase)
+	assert.Nil(t, res.Status.StartTimestamp)
+	require.NotNil(t, res.Status.CompletionTimestamp)
+	assert.Equal(t, now.Local(), res.Status.CompletionTimestamp.Time.Local())
+	assert.False(t, c.backupTracker.Contains(backup.Namespace, backup.Name))
+}
+
 func TestBackupLocationLabel(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -395,6 +424,78 @@ func Test_prepareBackupRequest_BackupStorageLocation(t *testing.T) {
 	}
 }
 
+func Test_prepareBackupRequest_StorageLocationFailover(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                   string
+		locations              []*velerov1api.BackupStorageLocation
+		expectedBackupLocation string
+		expectedFailoverFrom   string
+	}{
+		{
+			name: "available location isn't failed over",
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "primary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).FailoverLocations("secondary").Result(),
+				builder.ForBackupStorageLocation("velero", "secondary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			},
+			expectedBackupLocation: "primary",
+		},
+		{
+			name: "unavailable location is failed over to the first available failover location",
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "primary").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).FailoverLocations("missing", "secondary", "read-only", "tertiary", "quaternary").Result(),
+				builder.ForBackupStorageLocation("velero", "secondary").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+				builder.ForBackupStorageLocation("velero", "read-only").Phase(velerov1api.BackupStorageLocationPhaseAvailable).AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+				builder.ForBackupStorageLocation("velero", "tertiary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+				builder.ForBackupStorageLocation("velero", "quaternary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			},
+			expectedBackupLocation: "tertiary",
+			expectedFailoverFrom:   "primary",
+		},
+		{
+			name: "unavailable location without available failover location isn't failed over",
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "primary").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).FailoverLocations("secondary").Result(),
+				builder.ForBackupStorageLocation("velero", "secondary").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+			},
+			expectedBackupLocation: "primary",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger := logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+			apiServer := velerotest.NewAPIServer(t)
+
+			objects := make([]runtime.Object, 0, len(test.locations))
+			for _, location := range test.locations {
+				objects = append(objects, location)
+			}
+
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupReconciler{
+				discoveryHelper:  discoveryHelper,
+				kbClient:         velerotest.NewFakeControllerRuntimeClient(t, objects...),
+				defaultBackupTTL: 24 * time.Hour,
+				clock:            testclocks.NewFakeClock(now),
+				formatFlag:       logging.FormatText,
+			}
+
+			res := c.prepareBackupRequest(builder.ForBackup("velero", "backup-1").StorageLocation("primary").Result(), logger)
+
+			assert.Empty(t, res.Status.ValidationErrors)
+			assert.Equal(t, test.expectedBackupLocation, res.Spec.StorageLocation)
+			assert.Equal(t, test.expectedBackupLocation, res.StorageLocation.Name)
+			assert.Equal(t, test.expectedBackupLocation, res.Labels[velerov1api.StorageLocationLabel])
+			assert.Equal(t, test.expectedFailoverFrom, res.Status.FailoverFrom)
+		})
+	}
+}
+
 func TestDefaultBackupTTL(t *testing.T) {
 	var (
 		defaultBackupTTL = metav1.Duration{Duration: 24 * 30 * time.Hour}
@@ -1187,6 +1288,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				formatFlag:               formatFlag,
 				volumeSnapshotClient:     snapshotClient,
 				volumeSnapshotLister:     snapshotLister,
+				scopeHookHandler:         &hook.DefaultScopeHookHandler{},
 			}
 
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
@@ -1528,3 +1630,95 @@ func Test_getLastSuccessBySchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareBackupRequestCompression(t *testing.T) {
+	logger := velerotest.NewLogger()
+	compression := &velerov1api.BackupCompression{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 3}
+
+	apiServer := velerotest.NewAPIServer(t)
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupReconciler{
+		logger:          logger,
+		discoveryHelper: discoveryHelper,
+		kbClient:        velerotest.NewFakeControllerRuntimeClient(t),
+		clock:           testclocks.NewFakeClock(time.Now()),
+		compression:     compression,
+	}
+
+	res := c.prepareBackupRequest(defaultBackup().Result(), logger)
+	assert.Equal(t, compression, res.Status.Compression)
+	// the request doesn't share the compression of the server
+	assert.NotSame(t, compression, res.Status.Compression)
+}
+
+func TestStreamingUpload(t *testing.T) {
+	t.Run("the contents are uploaded while they're written", func(t *testing.T) {
+		uploaded := new(bytes.Buffer)
+		retainUntil := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		backupStore := new(persistencemocks.BackupStore)
+		backupStore.On("PutBackupContents", "backup-1", mock.Anything, retainUntil).Run(func(args mock.Arguments) {
+			_, err := io.Copy(uploaded, args.Get(1).(io.Reader))
+			require.NoError(t, err)
+		}).Return(nil)
+
+		upload := startStreamingUpload(backupStore, "backup-1", retainUntil)
+		for i := 0; i < 3; i++ {
+			_, err := upload.Write([]byte("contents"))
+			require.NoError(t, err)
+		}
+		require.NoError(t, upload.finish())
+
+		assert.Equal(t, "contentscontentscontents", uploaded.String())
+		assert.Equal(t, int64(24), upload.size)
+	})
+
+	t.Run("a failed upload fails the writes", func(t *testing.T) {
+		backupStore := new(persistencemocks.BackupStore)
+		backupStore.On("PutBackupContents", "backup-1", mock.Anything, time.Time{}).Return(errors.New("fake-error"))
+
+		upload := startStreamingUpload(backupStore, "backup-1", time.Time{})
+		_, err := upload.Write([]byte("contents"))
+		assert.EqualError(t, err, "fake-error")
+		assert.EqualError(t, upload.finish(), "fake-error")
+	})
+}
+
+func TestLogScopeHookFailures(t *testing.T) {
+	logCounter := logging.NewLogHook()
+	backupLog := logrus.New()
+	backupLog.Out = io.Discard
+	backupLog.Hooks.Add(logCounter)
+
+	logScopeHookFailures(backupLog, nil, nil)
+	assert.Equal(t, 0, logCounter.GetCount(logrus.WarnLevel))
+	assert.Equal(t, 0, logCounter.GetCount(logrus.ErrorLevel))
+
+	logScopeHookFailures(backupLog, []error{errors.New("pre hook freeze failed"), errors.New("pre hook flush failed")}, errors.New("pre hook lock failed"))
+	assert.Equal(t, 2, logCounter.GetCount(logrus.WarnLevel))
+	assert.Equal(t, 1, logCounter.GetCount(logrus.ErrorLevel))
+
+	warnings := logCounter.GetEntries(logrus.WarnLevel)
+	assert.Equal(t, []string{" error: /pre hook freeze failed", " error: /pre hook flush failed"}, warnings.Velero)
+	errs := logCounter.GetEntries(logrus.ErrorLevel)
+	assert.Equal(t, []string{" error: /pre hook lock failed"}, errs.Velero)
+}
+
+func TestSetBackupRetainUntil(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").ObjectLock(velerov1api.ObjectLockModeCompliance, 24*time.Hour).Result()
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	setBackupRetainUntil(backup, builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(), now)
+	assert.Nil(t, backup.Status.RetainUntil)
+
+	setBackupRetainUntil(backup, location, now)
+	require.NotNil(t, backup.Status.RetainUntil)
+	assert.Equal(t, now.Add(24*time.Hour), backup.Status.RetainUntil.Time)
+
+	// the objects uploaded later are locked until the same time
+	setBackupRetainUntil(backup, location, now.Add(time.Hour))
+	assert.Equal(t, now.Add(24*time.Hour), backup.Status.RetainUntil.Time)
+	assert.Equal(t, now.Add(24*time.Hour), backupRetainUntil(backup))
+}
@@ -205,6 +205,15 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	// Don't allow deleting backups whose data is still locked by object lock
+	if backup.Status.RetainUntil != nil && backup.Status.RetainUntil.After(r.clock.Now()) {
+		_, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, fmt.Sprintf("cannot delete backup because its data is locked by object lock until %s", backup.Status.RetainUntil.UTC().Format(time.RFC3339)))
+		})
+		return ctrl.Result{}, err
+	}
+
 	// if the request object has no labels defined, initialize an empty map since
 	// we will be updating labels
 	if dbr.Labels == nil {
@@ -255,7 +264,7 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	if len(actions) > 0 {
 		// Download the tarball
-		backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+		backupFile, err := downloadBackupContents(backup, nil, backupStore, log)
 
 		if err != nil {
 			log.WithError(err).Errorf("Unable to download tarball for backup %s, skipping associated DeleteItemAction plugins", backup.Name)
@@ -257,6 +257,26 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		assert.Equal(t, 1, len(res.Status.Errors))
 		assert.Equal(t, "cannot delete backup because backup storage location default is currently in read-only mode", res.Status.Errors[0])
 	})
+	t.Run("backup locked by object lock", func(t *testing.T) {
+		retainUntil := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").RetainUntil(retainUntil).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").ObjectLock(velerov1api.ObjectLockModeCompliance, 24*time.Hour).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		assert.Equal(t, 1, len(res.Status.Errors))
+		assert.Equal(t, "cannot delete backup because its data is locked by object lock until 2100-01-01T00:00:00Z", res.Status.Errors[0])
+
+		// the backup is kept
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{}))
+	})
 	t.Run("full delete, no errors", func(t *testing.T) {
 
 		input := defaultTestDbr()
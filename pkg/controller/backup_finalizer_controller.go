@@ -19,16 +19,19 @@ package controller
 import (
 	"bytes"
 	"context"
+	"io"
 	"os"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -48,6 +51,7 @@ type backupFinalizerReconciler struct {
 	metrics           *metrics.ServerMetrics
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	log               logrus.FieldLogger
+	scopeHookHandler  hook.ScopeHookHandler
 }
 
 // NewBackupFinalizerReconciler initializes and returns backupFinalizerReconciler struct.
@@ -60,6 +64,7 @@ func NewBackupFinalizerReconciler(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	log logrus.FieldLogger,
 	metrics *metrics.ServerMetrics,
+	scopeHookHandler hook.ScopeHookHandler,
 ) *backupFinalizerReconciler {
 	return &backupFinalizerReconciler{
 		client:            client,
@@ -70,6 +75,7 @@ func NewBackupFinalizerReconciler(
 		backupStoreGetter: backupStoreGetter,
 		log:               log,
 		metrics:           metrics,
+		scopeHookHandler:  scopeHookHandler,
 	}
 }
 
@@ -147,7 +153,7 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	if len(operations) > 0 {
 		// Call itemBackupper.BackupItem for the list of items updated by async operations
 		log.Info("Setting up finalized backup temp file")
-		inBackupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+		inBackupFile, err := downloadBackupContents(backup, nil, backupStore, log)
 		if err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "error downloading backup")
 		}
@@ -172,6 +178,21 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 	}
+
+	// the backup controller already ran the post-backup hooks unless the backup had to
+	// wait for async operations
+	if !scopeHookPhaseRan(backup.Status.ScopeHooks, string(hook.PhasePost)) {
+		tracker := hook.NewHookTracker()
+		statuses, warnings, err := r.scopeHookHandler.HandleHooks(ctx, log, backup.Spec.Hooks.PostBackup, hook.PhasePost, backupScopeHookLabels(backup), tracker)
+		backup.Status.ScopeHooks = append(backup.Status.ScopeHooks, statuses...)
+		backup.Status.HookStatus = tracker.AddToHookStatus(backup.Status.HookStatus)
+		backup.Status.Warnings += len(warnings)
+		if err != nil {
+			backup.Status.Errors++
+			backup.Status.Phase = velerov1api.BackupPhaseFinalizingPartiallyFailed
+		}
+	}
+
 	backupScheduleName := backupRequest.GetLabels()[velerov1api.ScheduleNameLabel]
 	switch backup.Status.Phase {
 	case velerov1api.BackupPhaseFinalizing:
@@ -186,17 +207,22 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	backup.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
 	recordBackupMetrics(log, backup, outBackupFile, r.metrics, true)
 
-	// update backup metadata in object store
+	// update backup metadata in object store, it's locked until the same time as the objects
+	// uploaded when the backup was processed
 	backupJSON := new(bytes.Buffer)
 	if err := encode.To(backup, "json", backupJSON); err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error encoding backup json")
 	}
-	err = backupStore.PutBackupMetadata(backup.Name, backupJSON)
+	err = backupStore.PutBackupMetadata(backup.Name, backupJSON, backupRetainUntil(backup))
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error uploading backup json")
 	}
 	if len(operations) > 0 {
-		err = backupStore.PutBackupContents(backup.Name, outBackupFile)
+		if backup.Status.ContentsLayout == velerov1api.BackupContentsLayoutPerNamespace {
+			err = putBackupContentsArchivesAndIndex(backupStore, backup, outBackupFile, log)
+		} else {
+			err = backupStore.PutBackupContents(backup.Name, outBackupFile, backupRetainUntil(backup))
+		}
 		if err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "error uploading backup final contents")
 		}
@@ -209,3 +235,19 @@ func (r *backupFinalizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&velerov1api.Backup{}).
 		Complete(r)
 }
+
+// putBackupContentsArchivesAndIndex splits the finalized contents of a backup per namespace, and
+// uploads the archives and their index.
+func putBackupContentsArchivesAndIndex(backupStore persistence.BackupStore, backup *velerov1api.Backup, contents io.ReadSeeker, log logrus.FieldLogger) error {
+	index, err := putBackupContentsArchives(backupStore, backup, contents, log)
+	if err != nil {
+		return err
+	}
+
+	indexJSON, errs := encode.ToJSONGzip(index, "backup contents index")
+	if errs != nil {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return backupStore.PutBackupContentsIndex(backup.Name, indexJSON, backupRetainUntil(backup))
+}
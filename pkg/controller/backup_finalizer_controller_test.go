@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"context"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
@@ -56,6 +59,7 @@ func mockBackupFinalizerReconciler(fakeClient kbclient.Client, fakeClock *testcl
 		NewFakeSingleObjectBackupStoreGetter(backupStore),
 		logrus.StandardLogger(),
 		metrics.NewServerMetrics(),
+		&hook.DefaultScopeHookHandler{},
 	), backupper
 }
 func TestBackupFinalizerReconcile(t *testing.T) {
@@ -64,6 +68,20 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 
 	defaultBackupLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
 
+	hookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer hookServer.Close()
+	failingPostHooks := velerov1api.BackupHooks{
+		PostBackup: []velerov1api.ScopeHook{
+			{
+				Name:    "thaw",
+				HTTP:    &velerov1api.HTTPHook{URL: hookServer.URL},
+				OnError: velerov1api.HookErrorModeFail,
+			},
+		},
+	}
+
 	tests := []struct {
 		name             string
 		backup           *velerov1api.Backup
@@ -71,6 +89,7 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 		backupLocation   *velerov1api.BackupStorageLocation
 		expectError      bool
 		expectPhase      velerov1api.BackupPhase
+		expectScopeHooks int
 	}{
 		{
 			name: "Finalizing backup is completed",
@@ -144,6 +163,31 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Finalizing backup with a failing post-backup hook is partially failed",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").
+				StorageLocation("default").
+				ObjectMeta(builder.WithUID("foo")).
+				StartTimestamp(fakeClock.Now()).
+				Hooks(failingPostHooks).
+				Phase(velerov1api.BackupPhaseFinalizing).Result(),
+			backupLocation:   defaultBackupLocation,
+			expectPhase:      velerov1api.BackupPhasePartiallyFailed,
+			expectScopeHooks: 1,
+		},
+		{
+			name: "post-backup hooks that already ran are not run again",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-4").
+				StorageLocation("default").
+				ObjectMeta(builder.WithUID("foo")).
+				StartTimestamp(fakeClock.Now()).
+				Hooks(failingPostHooks).
+				ScopeHookStatuses(velerov1api.ScopeHookStatus{Name: "thaw", Phase: "post", Result: velerov1api.ScopeHookResultSucceeded}).
+				Phase(velerov1api.BackupPhaseFinalizing).Result(),
+			backupLocation:   defaultBackupLocation,
+			expectPhase:      velerov1api.BackupPhaseCompleted,
+			expectScopeHooks: 1,
+		},
 	}
 
 	for _, test := range tests {
@@ -164,8 +208,8 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			pluginManager.On("CleanupClients").Return(nil)
 			backupStore.On("GetBackupItemOperations", test.backup.Name).Return(test.backupOperations, nil)
 			backupStore.On("GetBackupContents", mock.Anything).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
-			backupStore.On("PutBackupContents", mock.Anything, mock.Anything).Return(nil)
-			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("PutBackupContents", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
 			backupper.On("FinalizeBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, mock.Anything).Return(nil)
 			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
@@ -180,6 +224,7 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 
 			require.NoError(t, err)
 			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
+			assert.Len(t, backupAfter.Status.ScopeHooks, test.expectScopeHooks)
 		})
 	}
 }
@@ -30,9 +30,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -53,6 +56,8 @@ type backupOperationsReconciler struct {
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	metrics           *metrics.ServerMetrics
+	backupTracker     BackupTracker
+	scopeHookHandler  hook.ScopeHookHandler
 }
 
 func NewBackupOperationsReconciler(
@@ -63,6 +68,8 @@ func NewBackupOperationsReconciler(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	itemOperationsMap *itemoperationmap.BackupItemOperationsMap,
+	backupTracker BackupTracker,
+	scopeHookHandler hook.ScopeHookHandler,
 ) *backupOperationsReconciler {
 	abor := &backupOperationsReconciler{
 		Client:            client,
@@ -73,6 +80,8 @@ func NewBackupOperationsReconciler(
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
 		metrics:           metrics,
+		backupTracker:     backupTracker,
+		scopeHookHandler:  scopeHookHandler,
 	}
 	if abor.frequency <= 0 {
 		abor.frequency = defaultBackupOperationsFrequency
@@ -126,6 +135,11 @@ func (c *backupOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, nil
 	}
 
+	if backup.Spec.Paused && !backup.Spec.Cancel {
+		log.Debug("Backup is paused, skipping")
+		return ctrl.Result{}, nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.Get(ctx, client.ObjectKey{
 		Namespace: req.Namespace,
@@ -171,6 +185,35 @@ func (c *backupOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup operations")
 	}
+
+	if backup.Spec.Cancel {
+		log.Infof("Backup %s is canceled, canceling the ongoing plugin operations", backup.Name)
+		canceled := cancelBackupItemOperations(backup, pluginManager, operations.Operations)
+		if canceled > 0 {
+			operations.ChangesSinceUpdate = true
+		}
+		cancelBackupDataPath(ctx, c.Client, backup, log)
+
+		if !scopeHookPhaseRan(backup.Status.ScopeHooks, string(hook.PhasePost)) {
+			tracker := hook.NewHookTracker()
+			statuses, warnings, err := c.scopeHookHandler.HandleHooks(ctx, log, backup.Spec.Hooks.PostBackup, hook.PhasePost, backupScopeHookLabels(backup), tracker)
+			backup.Status.ScopeHooks = append(backup.Status.ScopeHooks, statuses...)
+			backup.Status.HookStatus = tracker.AddToHookStatus(backup.Status.HookStatus)
+			backup.Status.Warnings += len(warnings)
+			if err != nil {
+				backup.Status.Errors++
+			}
+		}
+
+		backup.Status.BackupItemOperationsFailed += canceled
+		backup.Status.Phase = velerov1api.BackupPhaseCancelled
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if err := c.updateBackupAndOperationsJSON(ctx, original, backup, backupStore, operations, canceled > 0, true); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error updating Backup")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	stillInProgress, changes, opsCompleted, opsFailed, errs := getBackupItemOperationProgress(backup, pluginManager, operations.Operations)
 	// if len(errs)>0, need to update backup errors and error log
 	operations.ErrsSinceUpdate = append(operations.ErrsSinceUpdate, errs...)
@@ -226,9 +269,13 @@ func (c *backupOperationsReconciler) updateBackupAndOperationsJSON(
 		// remove local operations list if complete
 		if removeIfComplete && (backup.Status.Phase == velerov1api.BackupPhaseCompleted ||
 			backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed ||
+			backup.Status.Phase == velerov1api.BackupPhaseCancelled ||
 			backup.Status.Phase == velerov1api.BackupPhaseFinalizing ||
 			backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed) {
 			c.itemOperationsMap.DeleteOperationsForBackup(backup.Name)
+			if backup.Status.Phase == velerov1api.BackupPhaseCancelled {
+				c.backupTracker.Delete(backup.Namespace, backup.Name)
+			}
 		} else if changes {
 			c.itemOperationsMap.PutOperationsForBackup(operations, backup.Name)
 		}
@@ -238,6 +285,7 @@ func (c *backupOperationsReconciler) updateBackupAndOperationsJSON(
 	if len(operations.ErrsSinceUpdate) > 0 ||
 		backup.Status.Phase == velerov1api.BackupPhaseCompleted ||
 		backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed ||
+		backup.Status.Phase == velerov1api.BackupPhaseCancelled ||
 		backup.Status.Phase == velerov1api.BackupPhaseFinalizing ||
 		backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed {
 		// update file store
@@ -247,12 +295,12 @@ func (c *backupOperationsReconciler) updateBackupAndOperationsJSON(
 				removeIfComplete = false
 				return errors.Wrap(err, "error encoding backup json")
 			}
-			err := backupStore.PutBackupMetadata(backup.Name, backupJSON)
+			err := backupStore.PutBackupMetadata(backup.Name, backupJSON, backupRetainUntil(backup))
 			if err != nil {
 				removeIfComplete = false
 				return errors.Wrap(err, "error uploading backup json")
 			}
-			if err := c.itemOperationsMap.UploadProgressAndPutOperationsForBackup(backupStore, operations, backup.Name); err != nil {
+			if err := c.itemOperationsMap.UploadProgressAndPutOperationsForBackup(backupStore, operations, backup.Name, backupRetainUntil(backup)); err != nil {
 				removeIfComplete = false
 				return err
 			}
@@ -372,3 +420,69 @@ func getBackupItemOperationProgress(
 	}
 	return inProgressOperations, changes, completedCount, failedCount, errs
 }
+
+// cancelBackupItemOperations cancels the backup's async operations that are still running and marks them
+// failed, it returns the number of the canceled operations
+func cancelBackupItemOperations(
+	backup *velerov1api.Backup,
+	pluginManager clientmgmt.Manager,
+	operationsList []*itemoperation.BackupOperation) int {
+	canceled := 0
+	for _, operation := range operationsList {
+		if operation.Status.Phase != itemoperation.OperationPhaseNew &&
+			operation.Status.Phase != itemoperation.OperationPhaseInProgress {
+			continue
+		}
+		if bia, err := pluginManager.GetBackupItemActionV2(operation.Spec.BackupItemAction); err == nil {
+			_ = bia.Cancel(operation.Spec.OperationID, backup)
+		}
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = "Asynchronous action canceled"
+		canceled++
+	}
+	return canceled
+}
+
+// cancelBackupDataPath requests to cancel the PodVolumeBackups and DataUploads of the backup that are not
+// finished yet, the node-agents stop the data path once they see the request
+func cancelBackupDataPath(ctx context.Context, cli client.Client, backup *velerov1api.Backup, log logrus.FieldLogger) {
+	selector := client.MatchingLabels{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)}
+
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := cli.List(ctx, pvbs, client.InNamespace(backup.Namespace), selector); err != nil {
+		log.WithError(err).Warn("Failed to list PodVolumeBackups of the canceled backup")
+	}
+	for i := range pvbs.Items {
+		pvb := &pvbs.Items[i]
+		if pvb.Spec.Cancel ||
+			pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted ||
+			pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed {
+			continue
+		}
+		original := pvb.DeepCopy()
+		pvb.Spec.Cancel = true
+		if err := cli.Patch(ctx, pvb, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Warnf("Failed to cancel PodVolumeBackup %s", pvb.Name)
+		}
+	}
+
+	dus := &velerov2alpha1api.DataUploadList{}
+	if err := cli.List(ctx, dus, client.InNamespace(backup.Namespace), selector); err != nil {
+		log.WithError(err).Warn("Failed to list DataUploads of the canceled backup")
+	}
+	for i := range dus.Items {
+		du := &dus.Items[i]
+		if du.Spec.Cancel ||
+			du.Status.Phase == velerov2alpha1api.DataUploadPhaseCompleted ||
+			du.Status.Phase == velerov2alpha1api.DataUploadPhaseFailed ||
+			du.Status.Phase == velerov2alpha1api.DataUploadPhaseCanceling ||
+			du.Status.Phase == velerov2alpha1api.DataUploadPhaseCanceled {
+			continue
+		}
+		original := du.DeepCopy()
+		du.Spec.Cancel = true
+		if err := cli.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Warnf("Failed to cancel DataUpload %s", du.Name)
+		}
+	}
+}
@@ -32,7 +32,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
@@ -61,6 +63,8 @@ func mockBackupOperationsReconciler(fakeClient kbclient.Client, fakeClock *testc
 		NewFakeSingleObjectBackupStoreGetter(backupStore),
 		metrics.NewServerMetrics(),
 		itemoperationmap.NewBackupItemOperationsMap(),
+		NewBackupTracker(),
+		&hook.DefaultScopeHookHandler{},
 	)
 	abor.clock = fakeClock
 	return abor
@@ -264,6 +268,68 @@ func TestBackupOperationsReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Canceled WaitingForPluginOperations backup with incomplete operations is Cancelled",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-17").
+				StorageLocation("default").
+				ItemOperationTimeout(60 * time.Minute).
+				ObjectMeta(builder.WithUID("foo-17")).
+				Cancel(true).
+				Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: false,
+			expectPhase:       velerov1api.BackupPhaseCancelled,
+			backupOperations: []*itemoperation.BackupOperation{
+				{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName:       "backup-17",
+						BackupUID:        "foo-17",
+						BackupItemAction: "foo-17",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-17",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseNew,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
+		{
+			name: "Paused WaitingForPluginOperations backup with completed operations is not progressed",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-18").
+				StorageLocation("default").
+				ItemOperationTimeout(60 * time.Minute).
+				ObjectMeta(builder.WithUID("foo-18")).
+				Paused(true).
+				Phase(velerov1api.BackupPhaseWaitingForPluginOperations).Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: true,
+			expectPhase:       velerov1api.BackupPhaseWaitingForPluginOperations,
+			backupOperations: []*itemoperation.BackupOperation{
+				{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName:       "backup-18",
+						BackupUID:        "foo-18",
+						BackupItemAction: "foo-18",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-18",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseNew,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -283,14 +349,15 @@ func TestBackupOperationsReconcile(t *testing.T) {
 			reconciler := mockBackupOperationsReconciler(fakeClient, fakeClock, defaultBackupOperationsFrequency)
 			pluginManager.On("CleanupClients").Return(nil)
 			backupStore.On("GetBackupItemOperations", test.backup.Name).Return(test.backupOperations, nil)
-			backupStore.On("PutBackupItemOperations", mock.Anything, mock.Anything).Return(nil)
-			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("PutBackupItemOperations", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			for _, operation := range test.backupOperations {
 				bia.On("Progress", operation.Spec.OperationID, mock.Anything).
 					Return(velero.OperationProgress{
 						Completed: test.operationComplete,
 						Err:       test.operationErr,
 					}, nil)
+				bia.On("Cancel", operation.Spec.OperationID, mock.Anything).Return(nil)
 				pluginManager.On("GetBackupItemActionV2", operation.Spec.BackupItemAction).Return(bia, nil)
 			}
 			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
@@ -308,3 +375,44 @@ func TestBackupOperationsReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelBackupDataPath(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backupLabel := builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-running").ObjectMeta(backupLabel).
+			Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-completed").ObjectMeta(backupLabel).
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-other").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2")).
+			Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-running").ObjectMeta(backupLabel).
+			Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result(),
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-canceled").ObjectMeta(backupLabel).
+			Phase(velerov2alpha1api.DataUploadPhaseCanceled).Result(),
+	)
+
+	cancelBackupDataPath(context.TODO(), fakeClient, backup, velerotest.NewLogger())
+
+	expected := map[string]bool{
+		"pvb-running":   true,
+		"pvb-completed": false,
+		"pvb-other":     false,
+	}
+	for name, cancel := range expected {
+		pvb := &velerov1api.PodVolumeBackup{}
+		require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: name}, pvb))
+		assert.Equal(t, cancel, pvb.Spec.Cancel, name)
+	}
+
+	expected = map[string]bool{
+		"du-running":  true,
+		"du-canceled": false,
+	}
+	for name, cancel := range expected {
+		du := &velerov2alpha1api.DataUpload{}
+		require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: name}, du))
+		assert.Equal(t, cancel, du.Spec.Cancel, name)
+	}
+}
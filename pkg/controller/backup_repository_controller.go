@@ -19,11 +19,14 @@ package controller
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -53,10 +56,15 @@ type BackupRepoReconciler struct {
 	clock                clocks.WithTickerAndDelayedExecution
 	maintenanceFrequency time.Duration
 	repositoryManager    repository.Manager
+	maintenanceConfig    repository.MaintenanceConfig
 }
 
 func NewBackupRepoReconciler(namespace string, logger logrus.FieldLogger, client client.Client,
-	maintenanceFrequency time.Duration, repositoryManager repository.Manager) *BackupRepoReconciler {
+	maintenanceFrequency time.Duration, repositoryManager repository.Manager, maintenanceConfig repository.MaintenanceConfig) *BackupRepoReconciler {
+	if maintenanceConfig.KeepLatestJobs <= 0 {
+		maintenanceConfig.KeepLatestJobs = repository.DefaultKeepLatestMaintenanceJobs
+	}
+
 	c := &BackupRepoReconciler{
 		client,
 		namespace,
@@ -64,6 +72,7 @@ func NewBackupRepoReconciler(namespace string, logger logrus.FieldLogger, client
 		clocks.RealClock{},
 		maintenanceFrequency,
 		repositoryManager,
+		maintenanceConfig,
 	}
 
 	return c
@@ -74,6 +83,7 @@ func (r *BackupRepoReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.BackupRepository{}).
+		Owns(&batchv1api.Job{}).
 		Watches(s, nil).
 		Watches(&source.Kind{Type: &velerov1api.BackupStorageLocation{}}, kube.EnqueueRequestsFromMapUpdateFunc(r.invalidateBackupReposForBSL),
 			builder.WithPredicates(kube.NewUpdateEventPredicate(r.needInvalidBackupRepo))).
@@ -275,30 +285,153 @@ func ensureRepo(repo *velerov1api.BackupRepository, repoManager repository.Manag
 func (r *BackupRepoReconciler) runMaintenanceIfDue(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
 	log.Debug("backupRepositoryController.runMaintenanceIfDue")
 
-	now := r.clock.Now()
+	jobs, err := repository.GetMaintenanceJobs(ctx, r.Client, req)
+	if err != nil {
+		return err
+	}
+
+	running, err := r.recordMaintenanceHistory(ctx, req, jobs, log)
+	if err != nil {
+		return err
+	}
 
-	if !dueForMaintenance(req, now) {
-		log.Debug("not due for maintenance")
+	if running {
+		log.Debug("maintenance job is running")
 		return nil
 	}
 
+	r.deleteOldMaintenanceJobs(ctx, jobs, log)
+
+	now := r.clock.Now()
+
+	_, requested := req.Annotations[velerov1api.RepositoryMaintenanceRequestAnnotation]
+	if !requested {
+		if !dueForMaintenance(req, now) {
+			log.Debug("not due for maintenance")
+			return nil
+		}
+
+		// don't retry a failed maintenance more often than the repos are synced
+		if last := latestMaintenance(req); last != nil && last.Result == velerov1api.BackupRepositoryMaintenanceFailed &&
+			last.CompleteTimestamp != nil && last.CompleteTimestamp.Add(repoSyncPeriod).After(now) {
+			log.Debug("last maintenance failed recently, wait for the next retry")
+			return nil
+		}
+	}
+
 	log.Info("Running maintenance on backup repository")
 
-	// prune failures should be displayed in the `.status.message` field but
+	// maintenance failures should be displayed in the `.status.message` field but
 	// should not cause the repo to move to `NotReady`.
-	log.Debug("Pruning repo")
-	if err := r.repositoryManager.PruneRepo(req); err != nil {
-		log.WithError(err).Warn("error pruning repository")
+	job, err := repository.BuildMaintenanceJob(ctx, r.Client, req, r.maintenanceConfig)
+	if err == nil {
+		err = r.Create(ctx, job)
+	}
+	if err != nil {
+		log.WithError(err).Warn("error creating maintenance job")
 		return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
-			rr.Status.Message = err.Error()
+			rr.Status.Message = errors.Wrap(err, "error creating maintenance job").Error()
 		})
 	}
 
-	return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
-		rr.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+	log.WithField("job", job.Name).Info("Maintenance job created")
+
+	if requested {
+		return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+			delete(rr.Annotations, velerov1api.RepositoryMaintenanceRequestAnnotation)
+		})
+	}
+
+	return nil
+}
+
+// recordMaintenanceHistory records the finished maintenance jobs that are not in the history yet
+// to the status of the repo, it returns whether there is a maintenance job still running
+func (r *BackupRepoReconciler) recordMaintenanceHistory(ctx context.Context, req *velerov1api.BackupRepository, jobs []batchv1api.Job, log logrus.FieldLogger) (bool, error) {
+	running := false
+	var newHistory []velerov1api.BackupRepositoryMaintenanceStatus
+	for i := range jobs {
+		if !repository.IsMaintenanceJobFinished(&jobs[i]) {
+			running = true
+			continue
+		}
+
+		status, err := repository.GetMaintenanceStatusFromJob(ctx, r.Client, &jobs[i])
+		if err != nil {
+			log.WithError(err).Warnf("error getting the result of maintenance job %s", jobs[i].Name)
+		}
+
+		if maintenanceRecorded(req.Status.RecentMaintenance, status) {
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+			"job":    jobs[i].Name,
+			"result": status.Result,
+		}).Info("Maintenance job finished")
+
+		newHistory = append(newHistory, status)
+	}
+
+	if len(newHistory) == 0 {
+		return running, nil
+	}
+
+	return running, r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+		history := append(rr.Status.RecentMaintenance, newHistory...)
+		sort.SliceStable(history, func(i, j int) bool {
+			return history[i].StartTimestamp.Before(history[j].StartTimestamp)
+		})
+		if len(history) > r.maintenanceConfig.KeepLatestJobs {
+			history = history[len(history)-r.maintenanceConfig.KeepLatestJobs:]
+		}
+		rr.Status.RecentMaintenance = history
+
+		for _, status := range newHistory {
+			switch status.Result {
+			case velerov1api.BackupRepositoryMaintenanceSucceeded:
+				if rr.Status.LastMaintenanceTime == nil || rr.Status.LastMaintenanceTime.Before(status.StartTimestamp) {
+					rr.Status.LastMaintenanceTime = status.StartTimestamp.DeepCopy()
+				}
+			case velerov1api.BackupRepositoryMaintenanceFailed:
+				rr.Status.Message = fmt.Sprintf("maintenance failed: %s", status.Message)
+			}
+		}
 	})
 }
 
+// deleteOldMaintenanceJobs deletes the finished maintenance jobs except the latest ones
+func (r *BackupRepoReconciler) deleteOldMaintenanceJobs(ctx context.Context, jobs []batchv1api.Job, log logrus.FieldLogger) {
+	if len(jobs) <= r.maintenanceConfig.KeepLatestJobs {
+		return
+	}
+
+	for i := range jobs[:len(jobs)-r.maintenanceConfig.KeepLatestJobs] {
+		log.WithField("job", jobs[i].Name).Debug("Deleting old maintenance job")
+		if err := r.Delete(ctx, &jobs[i], client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			log.WithError(err).Warnf("error deleting maintenance job %s", jobs[i].Name)
+		}
+	}
+}
+
+func maintenanceRecorded(history []velerov1api.BackupRepositoryMaintenanceStatus, status velerov1api.BackupRepositoryMaintenanceStatus) bool {
+	for i := range history {
+		if history[i].StartTimestamp.Equal(status.StartTimestamp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func latestMaintenance(req *velerov1api.BackupRepository) *velerov1api.BackupRepositoryMaintenanceStatus {
+	if len(req.Status.RecentMaintenance) == 0 {
+		return nil
+	}
+
+	return &req.Status.RecentMaintenance[len(req.Status.RecentMaintenance)-1]
+}
+
 func dueForMaintenance(req *velerov1api.BackupRepository, now time.Time) bool {
 	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
 }
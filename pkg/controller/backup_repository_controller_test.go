@@ -21,11 +21,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/install"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repomokes "github.com/vmware-tanzu/velero/pkg/repository/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -44,6 +47,7 @@ func mockBackupRepoReconciler(t *testing.T, rr *velerov1api.BackupRepository, mo
 		velerotest.NewFakeControllerRuntimeClient(t),
 		testMaintenanceFrequency,
 		mgr,
+		repository.MaintenanceConfig{},
 	)
 }
 
@@ -99,21 +103,164 @@ func TestCheckNotReadyRepo(t *testing.T) {
 	assert.Equal(t, "s3:test.amazonaws.com/bucket/restic/volume-ns-1", rr.Spec.ResticIdentifier)
 }
 
+func maintenanceJob(repo *velerov1api.BackupRepository, name string, created time.Time, succeeded, failed int32) *batchv1api.Job {
+	return &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         repo.Namespace,
+			Name:              name,
+			Labels:            map[string]string{velerov1api.RepositoryNameLabel: repo.Name},
+			CreationTimestamp: metav1.Time{Time: created},
+		},
+		Status: batchv1api.JobStatus{
+			StartTime: &metav1.Time{Time: created},
+			Succeeded: succeeded,
+			Failed:    failed,
+		},
+	}
+}
+
 func TestRunMaintenanceIfDue(t *testing.T) {
-	rr := mockBackupRepositoryCR()
-	reconciler := mockBackupRepoReconciler(t, rr, "PruneRepo", rr, nil)
-	err := reconciler.Client.Create(context.TODO(), rr)
-	assert.NoError(t, err)
-	lastTm := rr.Status.LastMaintenanceTime
-	err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
-	assert.NoError(t, err)
-	assert.NotEqual(t, rr.Status.LastMaintenanceTime, lastTm)
+	now := time.Now().Truncate(time.Second)
 
-	rr.Status.LastMaintenanceTime = &metav1.Time{Time: time.Now()}
-	lastTm = rr.Status.LastMaintenanceTime
-	err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
-	assert.NoError(t, err)
-	assert.Equal(t, rr.Status.LastMaintenanceTime, lastTm)
+	tests := []struct {
+		name                string
+		repo                *velerov1api.BackupRepository
+		jobs                []*batchv1api.Job
+		noDeployment        bool
+		expectNewJob        bool
+		expectJobs          int
+		expectHistory       []velerov1api.BackupRepositoryMaintenanceResult
+		expectLastMaintain  *metav1.Time
+		expectMessage       string
+		expectAnnotationSet bool
+	}{
+		{
+			name:         "due for maintenance, job is created",
+			repo:         mockBackupRepositoryCR(),
+			expectNewJob: true,
+			expectJobs:   1,
+		},
+		{
+			name: "not due for maintenance",
+			repo: func() *velerov1api.BackupRepository {
+				repo := mockBackupRepositoryCR()
+				repo.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+				return repo
+			}(),
+			expectLastMaintain: &metav1.Time{Time: now},
+		},
+		{
+			name: "maintenance requested, job is created and the request is removed",
+			repo: func() *velerov1api.BackupRepository {
+				repo := mockBackupRepositoryCR()
+				repo.Annotations = map[string]string{velerov1api.RepositoryMaintenanceRequestAnnotation: now.String()}
+				repo.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+				return repo
+			}(),
+			expectNewJob:       true,
+			expectJobs:         1,
+			expectLastMaintain: &metav1.Time{Time: now},
+		},
+		{
+			name: "maintenance job is running",
+			repo: mockBackupRepositoryCR(),
+			jobs: []*batchv1api.Job{
+				maintenanceJob(mockBackupRepositoryCR(), "job-1", now.Add(-time.Minute), 0, 0),
+			},
+			expectJobs: 1,
+		},
+		{
+			name:         "fail to build job without Velero deployment",
+			repo:         mockBackupRepositoryCR(),
+			noDeployment: true,
+			expectMessage: "error creating maintenance job: Velero deployment not found in namespace " +
+				velerov1api.DefaultNamespace,
+		},
+		{
+			name: "finished jobs are recorded and old jobs are deleted",
+			repo: func() *velerov1api.BackupRepository {
+				repo := mockBackupRepositoryCR()
+				repo.Spec.MaintenanceFrequency = metav1.Duration{Duration: 24 * time.Hour}
+				return repo
+			}(),
+			jobs: []*batchv1api.Job{
+				maintenanceJob(mockBackupRepositoryCR(), "job-1", now.Add(-4*time.Hour), 1, 0),
+				maintenanceJob(mockBackupRepositoryCR(), "job-2", now.Add(-3*time.Hour), 0, 1),
+				maintenanceJob(mockBackupRepositoryCR(), "job-3", now.Add(-2*time.Hour), 1, 0),
+				maintenanceJob(mockBackupRepositoryCR(), "job-4", now.Add(-time.Hour), 0, 1),
+			},
+			expectJobs: 3,
+			expectHistory: []velerov1api.BackupRepositoryMaintenanceResult{
+				velerov1api.BackupRepositoryMaintenanceFailed,
+				velerov1api.BackupRepositoryMaintenanceSucceeded,
+				velerov1api.BackupRepositoryMaintenanceFailed,
+			},
+			expectLastMaintain: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+			expectMessage:      "maintenance failed: ",
+		},
+		{
+			name: "last maintenance failed recently, not retried",
+			repo: func() *velerov1api.BackupRepository {
+				repo := mockBackupRepositoryCR()
+				repo.Status.RecentMaintenance = []velerov1api.BackupRepositoryMaintenanceStatus{
+					{
+						Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+						StartTimestamp:    &metav1.Time{Time: now.Add(-time.Minute)},
+						CompleteTimestamp: &metav1.Time{Time: now},
+					},
+				}
+				return repo
+			}(),
+			expectHistory: []velerov1api.BackupRepositoryMaintenanceResult{
+				velerov1api.BackupRepositoryMaintenanceFailed,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reconciler := mockBackupRepoReconciler(t, test.repo, "", nil, nil)
+			err := reconciler.Client.Create(context.TODO(), test.repo)
+			require.NoError(t, err)
+			if !test.noDeployment {
+				err = reconciler.Client.Create(context.TODO(), install.Deployment(velerov1api.DefaultNamespace))
+				require.NoError(t, err)
+			}
+			for _, job := range test.jobs {
+				err = reconciler.Client.Create(context.TODO(), job)
+				require.NoError(t, err)
+			}
+
+			err = reconciler.runMaintenanceIfDue(context.TODO(), test.repo, reconciler.logger)
+			require.NoError(t, err)
+
+			jobs, err := repository.GetMaintenanceJobs(context.TODO(), reconciler.Client, test.repo)
+			require.NoError(t, err)
+			assert.Len(t, jobs, test.expectJobs)
+			if test.expectNewJob {
+				job := jobs[len(jobs)-1]
+				assert.Equal(t, test.repo.Name, job.OwnerReferences[0].Name)
+				assert.Equal(t, []string{"repo-maintenance", "--repo-name=" + test.repo.Name}, job.Spec.Template.Spec.Containers[0].Args)
+			}
+
+			repo := &velerov1api.BackupRepository{}
+			err = reconciler.Client.Get(context.TODO(), types.NamespacedName{Namespace: test.repo.Namespace, Name: test.repo.Name}, repo)
+			require.NoError(t, err)
+
+			var history []velerov1api.BackupRepositoryMaintenanceResult
+			for _, status := range repo.Status.RecentMaintenance {
+				history = append(history, status.Result)
+			}
+			assert.Equal(t, test.expectHistory, history)
+			if test.expectLastMaintain == nil {
+				assert.Nil(t, repo.Status.LastMaintenanceTime)
+			} else {
+				assert.True(t, test.expectLastMaintain.Equal(repo.Status.LastMaintenanceTime))
+			}
+			assert.Equal(t, test.expectMessage, repo.Status.Message)
+			assert.NotContains(t, repo.Annotations, velerov1api.RepositoryMaintenanceRequestAnnotation)
+		})
+	}
 }
 
 func TestInitializeRepo(t *testing.T) {
@@ -245,6 +392,7 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 				velerotest.NewFakeControllerRuntimeClient(t),
 				test.userDefinedFreq,
 				&mgr,
+				repository.MaintenanceConfig{},
 			)
 
 			freq := reconciler.getRepositoryMaintenanceFrequency(test.repo)
@@ -371,7 +519,7 @@ func TestNeedInvalidBackupRepo(t *testing.T) {
 				velerov1api.DefaultNamespace,
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
-				time.Duration(0), nil)
+				time.Duration(0), nil, repository.MaintenanceConfig{})
 
 			need := reconciler.needInvalidBackupRepo(test.oldBSL, test.newBSL)
 			assert.Equal(t, test.expect, need)
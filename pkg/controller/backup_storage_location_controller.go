@@ -31,6 +31,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -53,6 +54,7 @@ type backupStorageLocationReconciler struct {
 	// replaced with fakes for testing.
 	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
+	metrics           *metrics.ServerMetrics
 
 	log logrus.FieldLogger
 }
@@ -65,6 +67,7 @@ func NewBackupStorageLocationReconciler(
 	defaultBackupLocationInfo storage.DefaultBackupLocationInfo,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
 	log logrus.FieldLogger) *backupStorageLocationReconciler {
 	return &backupStorageLocationReconciler{
 		ctx:                       ctx,
@@ -73,6 +76,7 @@ func NewBackupStorageLocationReconciler(
 		defaultBackupLocationInfo: defaultBackupLocationInfo,
 		newPluginManager:          newPluginManager,
 		backupStoreGetter:         backupStoreGetter,
+		metrics:                   metrics,
 		log:                       log,
 	}
 }
@@ -108,6 +112,7 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 
 	if location.Name == "" || location.Namespace == "" {
 		log.WithError(err).Error("BackupStorageLocation is not found")
+		r.metrics.RemoveBackupStorageLocation(req.Name)
 		return ctrl.Result{}, nil
 	}
 
@@ -126,18 +131,24 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		var err error
 		original := location.DeepCopy()
 		defer func() {
-			location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
+			now := &metav1.Time{Time: time.Now().UTC()}
+			location.Status.LastValidationTime = now
 			if err != nil {
 				log.Info("BackupStorageLocation is invalid, marking as unavailable")
 				err = errors.Wrapf(err, "BackupStorageLocation %q is unavailable", location.Name)
 				unavailableErrors = append(unavailableErrors, err.Error())
 				location.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
 				location.Status.Message = err.Error()
+				location.Status.LastFailedValidationTime = now
+				location.Status.ConsecutiveFailures++
 			} else {
 				log.Info("BackupStorageLocations is valid, marking as available")
 				location.Status.Phase = velerov1api.BackupStorageLocationPhaseAvailable
 				location.Status.Message = ""
+				location.Status.LastSuccessfulValidationTime = now
+				location.Status.ConsecutiveFailures = 0
 			}
+			r.recordMetrics(&location)
 			if err := r.client.Patch(r.ctx, &location, client.MergeFrom(original)); err != nil {
 				log.WithError(err).Error("Error updating BackupStorageLocation phase")
 			}
@@ -150,12 +161,36 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		}
 
 		log.Info("Validating BackupStorageLocation")
+		start := time.Now()
 		err = backupStore.IsValid()
+		location.Status.ListLatency = &metav1.Duration{Duration: time.Since(start)}
 		if err != nil {
 			log.WithError(err).Error("fail to validate backup store")
 			return
 		}
 
+		// writing to the locations with object lock would leave a locked version of the
+		// health check object behind on every validation
+		location.Status.PutLatency = nil
+		if location.Spec.AccessMode != velerov1api.BackupStorageLocationAccessModeReadOnly && location.Spec.ObjectLock == nil {
+			start = time.Now()
+			err = backupStore.PutHealthCheck()
+			location.Status.PutLatency = &metav1.Duration{Duration: time.Since(start)}
+			if err != nil {
+				err = errors.Wrap(err, "error writing to backup store")
+				log.WithError(err).Error("fail to validate backup store")
+				return
+			}
+		}
+
+		backups, err := backupStore.ListBackups()
+		if err != nil {
+			err = errors.Wrap(err, "error listing backups in backup store")
+			log.WithError(err).Error("fail to validate backup store")
+			return
+		}
+		location.Status.BackupCount = len(backups)
+
 		// updates the default backup location
 		location.Spec.Default = isDefault
 	}()
@@ -165,6 +200,21 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 	return ctrl.Result{}, nil
 }
 
+func (r *backupStorageLocationReconciler) recordMetrics(location *velerov1api.BackupStorageLocation) {
+	var lastSuccessful time.Time
+	if location.Status.LastSuccessfulValidationTime != nil {
+		lastSuccessful = location.Status.LastSuccessfulValidationTime.Time
+	}
+	r.metrics.RegisterBackupStorageLocationValidation(location.Name, location.Status.ConsecutiveFailures, lastSuccessful)
+	r.metrics.SetBackupStorageLocationBackups(location.Name, location.Status.BackupCount)
+	if location.Status.ListLatency != nil {
+		r.metrics.SetBackupStorageLocationListLatency(location.Name, location.Status.ListLatency.Duration)
+	}
+	if location.Status.PutLatency != nil {
+		r.metrics.SetBackupStorageLocationPutLatency(location.Name, location.Status.PutLatency.Duration)
+	}
+}
+
 func (r *backupStorageLocationReconciler) logReconciledPhase(defaultFound bool, locationList velerov1api.BackupStorageLocationList, errs []string) {
 	var availableBSLs []*velerov1api.BackupStorageLocation
 	var unAvailableBSLs []*velerov1api.BackupStorageLocation
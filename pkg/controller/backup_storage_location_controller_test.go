@@ -17,12 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -33,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -75,6 +80,8 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			backupStores[location.Name] = &persistencemocks.BackupStore{}
 			backupStore := backupStores[location.Name]
 			backupStore.On("IsValid").Return(tests[i].isValidError)
+			backupStore.On("PutHealthCheck").Return(nil)
+			backupStore.On("ListBackups").Return([]string{}, nil)
 		}
 
 		// Setup reconciler
@@ -88,6 +95,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			},
 			newPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			backupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			metrics:           metrics.NewServerMetrics(),
 			log:               velerotest.NewLogger(),
 		}
 
@@ -140,6 +148,8 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			backupStores[location.Name] = &persistencemocks.BackupStore{}
 			backupStore := backupStores[location.Name]
 			backupStore.On("IsValid").Return(tests[i].isValidError)
+			backupStore.On("PutHealthCheck").Return(nil)
+			backupStore.On("ListBackups").Return([]string{}, nil)
 		}
 
 		// Setup reconciler
@@ -153,6 +163,7 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			},
 			newPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			backupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			metrics:           metrics.NewServerMetrics(),
 			log:               velerotest.NewLogger(),
 		}
 
@@ -172,3 +183,114 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 		}
 	})
 })
+
+func TestBackupStorageLocationReconcileHealthStatus(t *testing.T) {
+	require.NoError(t, velerov1api.AddToScheme(scheme.Scheme))
+
+	tests := []struct {
+		name                        string
+		location                    *velerov1api.BackupStorageLocation
+		isValidErr                  error
+		putHealthCheckErr           error
+		expectedPhase               velerov1api.BackupStorageLocationPhase
+		expectedConsecutiveFailures int
+		expectedBackupCount         int
+		expectPutLatency            bool
+	}{
+		{
+			name:                "valid location",
+			location:            builder.ForBackupStorageLocation("velero", "default").Result(),
+			expectedPhase:       velerov1api.BackupStorageLocationPhaseAvailable,
+			expectedBackupCount: 2,
+			expectPutLatency:    true,
+		},
+		{
+			name:                "read only location isn't written to",
+			location:            builder.ForBackupStorageLocation("velero", "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			expectedPhase:       velerov1api.BackupStorageLocationPhaseAvailable,
+			expectedBackupCount: 2,
+		},
+		{
+			name:                "location with object lock isn't written to",
+			location:            builder.ForBackupStorageLocation("velero", "default").ObjectLock(velerov1api.ObjectLockModeCompliance, 24*time.Hour).Result(),
+			expectedPhase:       velerov1api.BackupStorageLocationPhaseAvailable,
+			expectedBackupCount: 2,
+		},
+		{
+			name:                "usage isn't calculated by the validation",
+			location:            builder.ForBackupStorageLocation("velero", "default").UsageReportFrequency(time.Hour).Result(),
+			expectedPhase:       velerov1api.BackupStorageLocationPhaseAvailable,
+			expectedBackupCount: 2,
+			expectPutLatency:    true,
+		},
+		{
+			name: "failed validation increments the consecutive failures",
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("velero", "default").Result()
+				location.Status.ConsecutiveFailures = 2
+				location.Status.BackupCount = 3
+				return location
+			}(),
+			isValidErr:                  errors.New("list error"),
+			expectedPhase:               velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedConsecutiveFailures: 3,
+			expectedBackupCount:         3,
+		},
+		{
+			name: "failed write makes the location unavailable",
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("velero", "default").Result()
+				location.Status.ConsecutiveFailures = 2
+				return location
+			}(),
+			putHealthCheckErr:           errors.New("put error"),
+			expectedPhase:               velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedConsecutiveFailures: 3,
+			expectPutLatency:            true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("IsValid").Return(tc.isValidErr)
+			backupStore.On("PutHealthCheck").Return(tc.putHealthCheckErr)
+			backupStore.On("ListBackups").Return([]string{"backup-1", "backup-2"}, nil)
+
+			r := NewBackupStorageLocationReconciler(
+				context.Background(),
+				fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(tc.location).Build(),
+				scheme.Scheme,
+				storage.DefaultBackupLocationInfo{StorageLocation: "default"},
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "default"}})
+			require.NoError(t, err)
+
+			location := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, r.client.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "default"}, location))
+
+			assert.Equal(t, tc.expectedPhase, location.Status.Phase)
+			assert.Equal(t, tc.expectedConsecutiveFailures, location.Status.ConsecutiveFailures)
+			assert.Equal(t, tc.expectedBackupCount, location.Status.BackupCount)
+			assert.NotNil(t, location.Status.ListLatency)
+			assert.Equal(t, tc.expectPutLatency, location.Status.PutLatency != nil)
+			if tc.expectedPhase == velerov1api.BackupStorageLocationPhaseAvailable {
+				assert.NotNil(t, location.Status.LastSuccessfulValidationTime)
+				assert.Nil(t, location.Status.LastFailedValidationTime)
+			} else {
+				assert.Nil(t, location.Status.LastSuccessfulValidationTime)
+				assert.NotNil(t, location.Status.LastFailedValidationTime)
+			}
+			assert.Nil(t, location.Status.Usage)
+			backupStore.AssertNotCalled(t, "GetUsage")
+		})
+	}
+}
@@ -0,0 +1,471 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+type backupStorageLocationMigrationReconciler struct {
+	client.Client
+	logger            logrus.FieldLogger
+	clock             clock.Clock
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+// NewBackupStorageLocationMigrationReconciler creates a new backup storage location migration reconciler.
+func NewBackupStorageLocationMigrationReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) *backupStorageLocationMigrationReconciler {
+	return &backupStorageLocationMigrationReconciler{
+		Client:            client,
+		logger:            logger,
+		clock:             clock.RealClock{},
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+func (r *backupStorageLocationMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.BackupStorageLocationMigration{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocationmigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocationmigrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=podvolumebackups,verbs=get;list;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositories,verbs=get;list;update;patch
+
+func (r *backupStorageLocationMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller":                     BackupStorageLocationMigration,
+		"backupstoragelocationmigration": req.String(),
+	})
+
+	migration := &velerov1api.BackupStorageLocationMigration{}
+	if err := r.Get(ctx, req.NamespacedName, migration); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find the backupstoragelocationmigration")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("Error getting backupstoragelocationmigration")
+		return ctrl.Result{}, err
+	}
+
+	log = log.WithFields(logrus.Fields{
+		"source": migration.Spec.SourceLocation,
+		"target": migration.Spec.TargetLocation,
+	})
+
+	switch migration.Status.Phase {
+	case "", velerov1api.BackupStorageLocationMigrationPhaseNew:
+		return r.start(ctx, migration, log)
+	case velerov1api.BackupStorageLocationMigrationPhaseInProgress:
+		return r.migrateNext(ctx, migration, log)
+	default:
+		log.Debug("The migration has been processed, skip.")
+		return ctrl.Result{}, nil
+	}
+}
+
+// start validates a new migration, and sets it in progress.
+func (r *backupStorageLocationMigrationReconciler) start(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, log logrus.FieldLogger) (ctrl.Result, error) {
+	if errs := r.validate(ctx, migration); len(errs) > 0 {
+		log.WithField("errors", errs).Info("Migration failed validation")
+		return ctrl.Result{}, r.fail(ctx, migration, errs)
+	}
+
+	log.Info("Migrating backup storage location")
+	return ctrl.Result{}, r.patchMigration(ctx, migration, func(m *velerov1api.BackupStorageLocationMigration) {
+		m.Status.Phase = velerov1api.BackupStorageLocationMigrationPhaseInProgress
+		m.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+}
+
+// migrateNext migrates the next backup of the source location which hasn't been processed yet,
+// or the next backup repository once all the backups are processed, and records it in the status
+// of the migration, whose update triggers the reconcile of the following one. Migrating a single
+// item per reconcile keeps the worker from being held for the whole migration, and a migration
+// interrupted by a restart of the server resumes from the first unprocessed item. The copy of an
+// interrupted item is started over, which overwrites the copied files with the same content.
+func (r *backupStorageLocationMigrationReconciler) migrateNext(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, log logrus.FieldLogger) (ctrl.Result, error) {
+	source := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: migration.Namespace, Name: migration.Spec.SourceLocation}, source); err != nil {
+		err = errors.Wrapf(err, "error getting source location %s", migration.Spec.SourceLocation)
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.fail(ctx, migration, []string{err.Error()})
+		}
+		return ctrl.Result{}, err
+	}
+	target := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: migration.Namespace, Name: migration.Spec.TargetLocation}, target); err != nil {
+		err = errors.Wrapf(err, "error getting target location %s", migration.Spec.TargetLocation)
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.fail(ctx, migration, []string{err.Error()})
+		}
+		return ctrl.Result{}, err
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	var errs []string
+	sourceStore, err := r.backupStoreGetter.Get(source, pluginManager, log)
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "error getting the backup store of the source location").Error())
+	}
+	targetStore, err := r.backupStoreGetter.Get(target, pluginManager, log)
+	if err != nil {
+		errs = append(errs, errors.Wrap(err, "error getting the backup store of the target location").Error())
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, r.fail(ctx, migration, errs)
+	}
+
+	names, err := sourceStore.ListBackups()
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error listing backups in the source location")
+	}
+	if name := nextItem(names, migration.Status.Backups); name != "" {
+		log := log.WithField("backup", name)
+		item := velerov1api.BackupStorageLocationMigrationItem{Name: name}
+		if err := r.migrateBackup(ctx, migration, name, target, sourceStore, targetStore, log); err != nil {
+			log.WithError(err).Error("Error migrating backup")
+			item.Error = errors.Wrapf(err, "error migrating backup %s", name).Error()
+		}
+
+		return ctrl.Result{}, r.patchMigration(ctx, migration, func(m *velerov1api.BackupStorageLocationMigration) {
+			m.Status.TotalBackups = len(names)
+			recordItem(&m.Status.Backups, &m.Status.MigratedBackups, m, item)
+		})
+	}
+
+	repos, err := r.listRepositories(ctx, migration, migration.Spec.SourceLocation)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if repo := nextRepository(repos, migration.Status.Repositories); repo != nil {
+		log := log.WithField("backupRepository", repo.Name)
+		item := velerov1api.BackupStorageLocationMigrationItem{Name: repo.Name}
+		if err := r.migrateRepository(ctx, migration, repo, sourceStore, targetStore, log); err != nil {
+			log.WithError(err).Error("Error migrating backup repository")
+			item.Error = errors.Wrapf(err, "error migrating backup repository %s", repo.Name).Error()
+		}
+
+		return ctrl.Result{}, r.patchMigration(ctx, migration, func(m *velerov1api.BackupStorageLocationMigration) {
+			// the migrated backup repositories aren't in the source location anymore
+			m.Status.TotalRepositories = m.Status.MigratedRepositories + len(repos)
+			recordItem(&m.Status.Repositories, &m.Status.MigratedRepositories, m, item)
+		})
+	}
+
+	// only mark the source location read-only once everything is in the target location
+	errs = append(errs, migration.Status.Errors...)
+	if len(errs) == 0 && migration.Spec.MarkSourceReadOnly {
+		log.Info("Marking the source location read-only")
+		original := source.DeepCopy()
+		source.Spec.AccessMode = velerov1api.BackupStorageLocationAccessModeReadOnly
+		if err := r.Patch(ctx, source, client.MergeFrom(original)); err != nil {
+			errs = append(errs, errors.Wrap(err, "error marking the source location read-only").Error())
+		}
+	}
+
+	phase := velerov1api.BackupStorageLocationMigrationPhaseCompleted
+	if len(errs) > 0 {
+		phase = velerov1api.BackupStorageLocationMigrationPhasePartiallyFailed
+	}
+	log.WithField("phase", phase).Info("Migration of backup storage location finished")
+
+	return ctrl.Result{}, r.patchMigration(ctx, migration, func(m *velerov1api.BackupStorageLocationMigration) {
+		m.Status.Phase = phase
+		m.Status.Errors = errs
+		m.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+}
+
+// fail marks the migration as failed with the errors.
+func (r *backupStorageLocationMigrationReconciler) fail(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, errs []string) error {
+	return r.patchMigration(ctx, migration, func(m *velerov1api.BackupStorageLocationMigration) {
+		m.Status.Phase = velerov1api.BackupStorageLocationMigrationPhaseFailed
+		m.Status.Errors = append(m.Status.Errors, errs...)
+		m.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+}
+
+// nextItem returns the first of the names which isn't in the processed items, or an empty
+// string if all of them are.
+func nextItem(names []string, processed []velerov1api.BackupStorageLocationMigrationItem) string {
+	done := make(map[string]bool, len(processed))
+	for _, item := range processed {
+		done[item.Name] = true
+	}
+	for _, name := range names {
+		if !done[name] {
+			return name
+		}
+	}
+	return ""
+}
+
+// nextRepository returns the first of the backup repositories which isn't in the processed
+// items, or nil if all of them are.
+func nextRepository(repos []*velerov1api.BackupRepository, processed []velerov1api.BackupStorageLocationMigrationItem) *velerov1api.BackupRepository {
+	names := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		names = append(names, repo.Name)
+	}
+	name := nextItem(names, processed)
+	for _, repo := range repos {
+		if repo.Name == name {
+			return repo
+		}
+	}
+	return nil
+}
+
+// recordItem adds a processed item to the items of the migration, and counts it as migrated or
+// adds its error to the errors of the migration.
+func recordItem(items *[]velerov1api.BackupStorageLocationMigrationItem, migrated *int, migration *velerov1api.BackupStorageLocationMigration, item velerov1api.BackupStorageLocationMigrationItem) {
+	*items = append(*items, item)
+	if item.Error != "" {
+		migration.Status.Errors = append(migration.Status.Errors, item.Error)
+	} else {
+		*migrated++
+	}
+}
+
+// validate checks that the locations of the migration can be migrated from and to.
+func (r *backupStorageLocationMigrationReconciler) validate(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration) []string {
+	var errs []string
+
+	if migration.Spec.SourceLocation == "" {
+		errs = append(errs, "spec.sourceLocation is required")
+	}
+	if migration.Spec.TargetLocation == "" {
+		errs = append(errs, "spec.targetLocation is required")
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	if migration.Spec.SourceLocation == migration.Spec.TargetLocation {
+		return []string{"the source and target locations must be different"}
+	}
+
+	source := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: migration.Namespace, Name: migration.Spec.SourceLocation}, source); err != nil {
+		errs = append(errs, errors.Wrapf(err, "error getting source location %s", migration.Spec.SourceLocation).Error())
+	}
+
+	target := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: migration.Namespace, Name: migration.Spec.TargetLocation}, target); err != nil {
+		errs = append(errs, errors.Wrapf(err, "error getting target location %s", migration.Spec.TargetLocation).Error())
+	} else if target.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		errs = append(errs, fmt.Sprintf("target location %s is in read-only mode", target.Name))
+	}
+
+	// the backups still writing to the source location might miss files in the target location
+	backups := &velerov1api.BackupList{}
+	if err := r.List(ctx, backups, client.InNamespace(migration.Namespace)); err != nil {
+		errs = append(errs, errors.Wrap(err, "error listing backups").Error())
+	}
+	for _, backup := range backups.Items {
+		if backup.Spec.StorageLocation != migration.Spec.SourceLocation {
+			continue
+		}
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseInProgress,
+			velerov1api.BackupPhaseWaitingForPluginOperations,
+			velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+			velerov1api.BackupPhaseFinalizing,
+			velerov1api.BackupPhaseFinalizingPartiallyFailed:
+			errs = append(errs, fmt.Sprintf("backup %s to the source location is in progress", backup.Name))
+		}
+	}
+
+	return errs
+}
+
+// migrateBackup copies a backup of the source location to the target location, and moves the
+// backup and its pod volume backups to the target location.
+func (r *backupStorageLocationMigrationReconciler) migrateBackup(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, name string, target *velerov1api.BackupStorageLocation,
+	sourceStore, targetStore persistence.BackupStore, log logrus.FieldLogger) error {
+	// all the copied files of the backup are locked until the same time, which is recorded in the backup
+	retainUntil := objectLockRetainUntil(target, r.clock.Now())
+
+	log.Info("Copying backup to the target location")
+	if err := persistence.CopyBackup(sourceStore, targetStore, name, retainUntil); err != nil {
+		return errors.Wrap(err, "error copying backup")
+	}
+
+	if err := r.moveBackup(ctx, migration, name, retainUntil); err != nil {
+		return errors.Wrap(err, "error moving backup to the target location")
+	}
+
+	return nil
+}
+
+// moveBackup updates the storage location of a backup and of its pod volume backups to the target location,
+// and extends the time until which the backup is locked to retainUntil if it's later.
+func (r *backupStorageLocationMigrationReconciler) moveBackup(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, name string, retainUntil time.Time) error {
+	backup := &velerov1api.Backup{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: migration.Namespace, Name: name}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the backup isn't synced yet, it will be synced from the target location
+			return nil
+		}
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	if backup.Spec.StorageLocation == migration.Spec.SourceLocation {
+		original := backup.DeepCopy()
+		backup.Spec.StorageLocation = migration.Spec.TargetLocation
+		if backup.Labels == nil {
+			backup.Labels = map[string]string{}
+		}
+		backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(migration.Spec.TargetLocation)
+		if !retainUntil.IsZero() && (backup.Status.RetainUntil == nil || backup.Status.RetainUntil.Time.Before(retainUntil)) {
+			backup.Status.RetainUntil = &metav1.Time{Time: retainUntil}
+		}
+		if err := r.Patch(ctx, backup, client.MergeFrom(original)); err != nil {
+			return errors.Wrap(err, "error patching backup")
+		}
+	}
+
+	podVolumeBackups := &velerov1api.PodVolumeBackupList{}
+	if err := r.List(ctx, podVolumeBackups, client.InNamespace(migration.Namespace), client.MatchingLabels{
+		velerov1api.BackupNameLabel: label.GetValidName(name),
+	}); err != nil {
+		return errors.Wrap(err, "error listing pod volume backups")
+	}
+
+	for i := range podVolumeBackups.Items {
+		pvb := &podVolumeBackups.Items[i]
+		if pvb.Spec.BackupStorageLocation != migration.Spec.SourceLocation {
+			continue
+		}
+		original := pvb.DeepCopy()
+		pvb.Spec.BackupStorageLocation = migration.Spec.TargetLocation
+		if err := r.Patch(ctx, pvb, client.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error patching pod volume backup %s", pvb.Name)
+		}
+	}
+
+	return nil
+}
+
+// listRepositories returns the backup repositories of the location.
+func (r *backupStorageLocationMigrationReconciler) listRepositories(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, location string) ([]*velerov1api.BackupRepository, error) {
+	list := &velerov1api.BackupRepositoryList{}
+	if err := r.List(ctx, list, client.InNamespace(migration.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backup repositories")
+	}
+
+	var repos []*velerov1api.BackupRepository
+	for i := range list.Items {
+		if list.Items[i].Spec.BackupStorageLocation == location {
+			repos = append(repos, &list.Items[i])
+		}
+	}
+	return repos, nil
+}
+
+// migrateRepository copies a backup repository of the source location to the target location,
+// and moves the backup repository to the target location.
+func (r *backupStorageLocationMigrationReconciler) migrateRepository(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, repo *velerov1api.BackupRepository,
+	sourceStore, targetStore persistence.BackupStore, log logrus.FieldLogger) error {
+	targetRepos, err := r.listRepositories(ctx, migration, migration.Spec.TargetLocation)
+	if err != nil {
+		return err
+	}
+	for _, targetRepo := range targetRepos {
+		if targetRepo.Spec.RepositoryType == repo.Spec.RepositoryType && targetRepo.Spec.VolumeNamespace == repo.Spec.VolumeNamespace {
+			return errors.Errorf("a %s repository for volume namespace %s already exists in the target location", repo.Spec.RepositoryType, repo.Spec.VolumeNamespace)
+		}
+	}
+
+	switch repo.Spec.RepositoryType {
+	case velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryTypeRestic:
+	default:
+		return errors.Errorf("unsupported repository type %q", repo.Spec.RepositoryType)
+	}
+
+	// the restic repositories under a custom prefix aren't in the backup store
+	if repo.Spec.RepositoryType == velerov1api.BackupRepositoryTypeRestic {
+		for _, name := range []string{migration.Spec.SourceLocation, migration.Spec.TargetLocation} {
+			location := &velerov1api.BackupStorageLocation{}
+			if err := r.Get(ctx, client.ObjectKey{Namespace: migration.Namespace, Name: name}, location); err != nil {
+				return errors.Wrapf(err, "error getting backup storage location %s", name)
+			}
+			if location.Spec.Config["resticRepoPrefix"] != "" {
+				return errors.Errorf("backup storage location %s has a custom restic repository prefix", name)
+			}
+		}
+	}
+
+	log.Info("Copying backup repository to the target location")
+	if err := persistence.CopyRepository(sourceStore, targetStore, repo.Spec.RepositoryType, repo.Spec.VolumeNamespace); err != nil {
+		return err
+	}
+
+	// resetting the phase makes the backup repository controller connect to the copy in the
+	// target location with a new identifier
+	original := repo.DeepCopy()
+	repo.Spec.BackupStorageLocation = migration.Spec.TargetLocation
+	repo.Spec.ResticIdentifier = ""
+	if repo.Labels == nil {
+		repo.Labels = map[string]string{}
+	}
+	repo.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(migration.Spec.TargetLocation)
+	repo.Status.Phase = velerov1api.BackupRepositoryPhaseNew
+	repo.Status.Message = ""
+	if err := r.Patch(ctx, repo, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error patching backup repository")
+	}
+
+	return nil
+}
+
+func (r *backupStorageLocationMigrationReconciler) patchMigration(ctx context.Context, migration *velerov1api.BackupStorageLocationMigration, mutate func(*velerov1api.BackupStorageLocationMigration)) error {
+	original := migration.DeepCopy()
+	mutate(migration)
+	if err := r.Patch(ctx, migration, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error patching the backupstoragelocationmigration")
+	}
+	return nil
+}
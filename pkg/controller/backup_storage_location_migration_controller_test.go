@@ -0,0 +1,345 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func backupRepositoryForMigration(name, location, volumeNamespace string) *velerov1api.BackupRepository {
+	return &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      name,
+			Labels: map[string]string{
+				velerov1api.StorageLocationLabel: location,
+			},
+		},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       volumeNamespace,
+			BackupStorageLocation: location,
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+			ResticIdentifier:      "s3:s3.amazonaws.com/" + location + "/restic/" + volumeNamespace,
+		},
+		Status: velerov1api.BackupRepositoryStatus{
+			Phase: velerov1api.BackupRepositoryPhaseReady,
+		},
+	}
+}
+
+// mockFileCopy sets up the source and target backup store mocks for copying the files with the keys.
+// The target backup store behaves like a v1 object store plugin, so the copies are read back.
+func mockFileCopy(sourceStore, targetStore *persistencemocks.BackupStore, keys ...string) {
+	data := func(string) io.ReadCloser { return io.NopCloser(strings.NewReader("data")) }
+	for _, key := range keys {
+		sourceStore.On("GetFile", key).Return(data, nil)
+		targetStore.On("PutFile", key, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			_, _ = io.ReadAll(args.Get(1).(io.Reader))
+		}).Return(osv2.Checksum{}, nil)
+		targetStore.On("GetFileInfo", key).Return(osv2.ObjectInfo{}, osv2.ErrNotSupported)
+		targetStore.On("GetFile", key).Return(data, nil)
+	}
+}
+
+// reconcileMigration reconciles the migration until it's processed, as the updates of its status
+// trigger its reconcile.
+func reconcileMigration(t *testing.T, r *backupStorageLocationMigrationReconciler, key types.NamespacedName) *velerov1api.BackupStorageLocationMigration {
+	t.Helper()
+
+	migration := &velerov1api.BackupStorageLocationMigration{}
+	for i := 0; i < 10; i++ {
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+
+		require.NoError(t, r.Get(context.Background(), key, migration))
+		switch migration.Status.Phase {
+		case velerov1api.BackupStorageLocationMigrationPhaseNew, velerov1api.BackupStorageLocationMigrationPhaseInProgress:
+		default:
+			return migration
+		}
+	}
+
+	require.FailNow(t, "migration isn't processed")
+	return nil
+}
+
+func TestBackupStorageLocationMigrationReconcile(t *testing.T) {
+	ns := velerov1api.DefaultNamespace
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		migration            *velerov1api.BackupStorageLocationMigration
+		objects              []runtime.Object
+		expectedPhase        velerov1api.BackupStorageLocationMigrationPhase
+		expectedErrors       int
+		expectedBackups      int
+		expectedRepositories int
+		expectSourceReadOnly bool
+		expectedRetainUntil  time.Time
+	}{
+		{
+			name: "same source and target location fails",
+			migration: &velerov1api.BackupStorageLocationMigration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+				Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "source"},
+			},
+			expectedPhase:  velerov1api.BackupStorageLocationMigrationPhaseFailed,
+			expectedErrors: 1,
+		},
+		{
+			name: "read-only target location fails",
+			migration: &velerov1api.BackupStorageLocationMigration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+				Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "target"},
+			},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "source").Result(),
+				builder.ForBackupStorageLocation(ns, "target").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			},
+			expectedPhase:  velerov1api.BackupStorageLocationMigrationPhaseFailed,
+			expectedErrors: 1,
+		},
+		{
+			name: "backup in progress to the source location fails",
+			migration: &velerov1api.BackupStorageLocationMigration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+				Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "target"},
+			},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "source").Result(),
+				builder.ForBackupStorageLocation(ns, "target").Result(),
+				builder.ForBackup(ns, "backup-2").StorageLocation("source").Phase(velerov1api.BackupPhaseInProgress).Result(),
+			},
+			expectedPhase:  velerov1api.BackupStorageLocationMigrationPhaseFailed,
+			expectedErrors: 1,
+		},
+		{
+			name: "backups and repositories are migrated and the source location is marked read-only",
+			migration: &velerov1api.BackupStorageLocationMigration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+				Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "target", MarkSourceReadOnly: true},
+			},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "source").Result(),
+				builder.ForBackupStorageLocation(ns, "target").Result(),
+				backupRepositoryForMigration("repo-1", "source", "ns-1"),
+			},
+			expectedPhase:        velerov1api.BackupStorageLocationMigrationPhaseCompleted,
+			expectedBackups:      1,
+			expectedRepositories: 1,
+			expectSourceReadOnly: true,
+		},
+		{
+			name: "backups copied to a location with object lock are locked until the same time",
+			migration: &velerov1api.BackupStorageLocationMigration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+				Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "target"},
+			},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "source").Result(),
+				builder.ForBackupStorageLocation(ns, "target").ObjectLock(velerov1api.ObjectLockModeCompliance, 48*time.Hour).Result(),
+				backupRepositoryForMigration("repo-1", "source", "ns-1"),
+			},
+			expectedPhase:        velerov1api.BackupStorageLocationMigrationPhaseCompleted,
+			expectedBackups:      1,
+			expectedRepositories: 1,
+			expectedRetainUntil:  now.Add(48 * time.Hour),
+		},
+		{
+			name: "repository conflicting with one in the target location is not migrated",
+			migration: &velerov1api.BackupStorageLocationMigration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+				Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "target", MarkSourceReadOnly: true},
+			},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "source").Result(),
+				builder.ForBackupStorageLocation(ns, "target").Result(),
+				backupRepositoryForMigration("repo-1", "source", "ns-1"),
+				backupRepositoryForMigration("repo-2", "target", "ns-1"),
+			},
+			expectedPhase:   velerov1api.BackupStorageLocationMigrationPhasePartiallyFailed,
+			expectedErrors:  1,
+			expectedBackups: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup(ns, "backup-1").
+				StorageLocation("source").
+				ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "source")).
+				Phase(velerov1api.BackupPhaseCompleted).Result()
+			pvb := builder.ForPodVolumeBackup(ns, "pvb-1").
+				BackupStorageLocation("source").
+				ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).Result()
+			objects := append([]runtime.Object{tc.migration, backup, pvb}, tc.objects...)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			sourceStore := &persistencemocks.BackupStore{}
+			targetStore := &persistencemocks.BackupStore{}
+			sourceStore.On("ListBackups").Return([]string{"backup-1"}, nil)
+			sourceStore.On("ListFiles", "backups/backup-1").Return([]string{"backups/backup-1/velero-backup.json", "backups/backup-1/backup-1.tar.gz"}, nil)
+			sourceStore.On("ListFiles", "kopia/ns-1").Return([]string{"kopia/ns-1/kopia.repository"}, nil)
+			mockFileCopy(sourceStore, targetStore, "backups/backup-1/velero-backup.json", "backups/backup-1/backup-1.tar.gz", "kopia/ns-1/kopia.repository")
+
+			r := NewBackupStorageLocationMigrationReconciler(
+				velerotest.NewLogger(),
+				velerotest.NewFakeControllerRuntimeClient(t, objects...),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"source": sourceStore, "target": targetStore}),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			migration := reconcileMigration(t, r, types.NamespacedName{Namespace: ns, Name: "migration"})
+			assert.Equal(t, tc.expectedPhase, migration.Status.Phase)
+			assert.Len(t, migration.Status.Errors, tc.expectedErrors)
+			assert.Equal(t, tc.expectedBackups, migration.Status.MigratedBackups)
+			assert.Equal(t, tc.expectedRepositories, migration.Status.MigratedRepositories)
+			assert.NotNil(t, migration.Status.CompletionTimestamp)
+
+			source := &velerov1api.BackupStorageLocation{}
+			if err := r.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: "source"}, source); err == nil {
+				assert.Equal(t, tc.expectSourceReadOnly, source.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly)
+			}
+
+			expectedLocation := "source"
+			if tc.expectedBackups > 0 {
+				expectedLocation = "target"
+			}
+			require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: "backup-1"}, backup))
+			assert.Equal(t, expectedLocation, backup.Spec.StorageLocation)
+			assert.Equal(t, expectedLocation, backup.Labels[velerov1api.StorageLocationLabel])
+			if tc.expectedRetainUntil.IsZero() {
+				assert.Nil(t, backup.Status.RetainUntil)
+			} else {
+				require.NotNil(t, backup.Status.RetainUntil)
+				assert.True(t, tc.expectedRetainUntil.Equal(backup.Status.RetainUntil.Time))
+			}
+			for _, call := range targetStore.Calls {
+				if call.Method == "PutFile" && strings.HasPrefix(call.Arguments.String(0), "backups/") {
+					assert.True(t, tc.expectedRetainUntil.Equal(call.Arguments.Get(2).(time.Time)))
+				}
+			}
+			require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: "pvb-1"}, pvb))
+			assert.Equal(t, expectedLocation, pvb.Spec.BackupStorageLocation)
+
+			if tc.expectedRepositories > 0 {
+				repo := &velerov1api.BackupRepository{}
+				require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: "repo-1"}, repo))
+				assert.Equal(t, "target", repo.Spec.BackupStorageLocation)
+				assert.Equal(t, "target", repo.Labels[velerov1api.StorageLocationLabel])
+				assert.Empty(t, repo.Spec.ResticIdentifier)
+				assert.Equal(t, velerov1api.BackupRepositoryPhaseNew, repo.Status.Phase)
+			}
+		})
+	}
+}
+
+func TestBackupStorageLocationMigrationReconcileSkipsProcessed(t *testing.T) {
+	migration := &velerov1api.BackupStorageLocationMigration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "migration"},
+		Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "source"},
+		Status:     velerov1api.BackupStorageLocationMigrationStatus{Phase: velerov1api.BackupStorageLocationMigrationPhaseCompleted},
+	}
+
+	r := NewBackupStorageLocationMigrationReconciler(
+		velerotest.NewLogger(),
+		velerotest.NewFakeControllerRuntimeClient(t, migration),
+		nil,
+		nil,
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: migration.Namespace, Name: migration.Name}})
+	require.NoError(t, err)
+
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: migration.Namespace, Name: migration.Name}, migration))
+	assert.Equal(t, velerov1api.BackupStorageLocationMigrationPhaseCompleted, migration.Status.Phase)
+	assert.Empty(t, migration.Status.Errors)
+}
+
+func TestBackupStorageLocationMigrationReconcileResumes(t *testing.T) {
+	ns := velerov1api.DefaultNamespace
+	migration := &velerov1api.BackupStorageLocationMigration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "migration"},
+		Spec:       velerov1api.BackupStorageLocationMigrationSpec{SourceLocation: "source", TargetLocation: "target"},
+		Status: velerov1api.BackupStorageLocationMigrationStatus{
+			Phase:           velerov1api.BackupStorageLocationMigrationPhaseInProgress,
+			TotalBackups:    2,
+			MigratedBackups: 1,
+			Backups:         []velerov1api.BackupStorageLocationMigrationItem{{Name: "backup-1"}},
+		},
+	}
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	// only the files of the backup which wasn't migrated yet are copied
+	sourceStore := &persistencemocks.BackupStore{}
+	targetStore := &persistencemocks.BackupStore{}
+	sourceStore.On("ListBackups").Return([]string{"backup-1", "backup-2"}, nil)
+	sourceStore.On("ListFiles", "backups/backup-2").Return([]string{"backups/backup-2/velero-backup.json"}, nil)
+	mockFileCopy(sourceStore, targetStore, "backups/backup-2/velero-backup.json")
+
+	r := NewBackupStorageLocationMigrationReconciler(
+		velerotest.NewLogger(),
+		velerotest.NewFakeControllerRuntimeClient(t,
+			migration,
+			builder.ForBackupStorageLocation(ns, "source").Result(),
+			builder.ForBackupStorageLocation(ns, "target").Result(),
+		),
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"source": sourceStore, "target": targetStore}),
+	)
+
+	// a single backup is migrated per reconcile
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: "migration"}})
+	require.NoError(t, err)
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: "migration"}, migration))
+	assert.Equal(t, velerov1api.BackupStorageLocationMigrationPhaseInProgress, migration.Status.Phase)
+	assert.Equal(t, []velerov1api.BackupStorageLocationMigrationItem{{Name: "backup-1"}, {Name: "backup-2"}}, migration.Status.Backups)
+	assert.Equal(t, 2, migration.Status.MigratedBackups)
+
+	migration = reconcileMigration(t, r, types.NamespacedName{Namespace: ns, Name: "migration"})
+	assert.Equal(t, velerov1api.BackupStorageLocationMigrationPhaseCompleted, migration.Status.Phase)
+	sourceStore.AssertNotCalled(t, "ListFiles", "backups/backup-1")
+}
@@ -0,0 +1,273 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib"
+)
+
+// defaultLargeObjectSize is the size of the object uploaded to check the multipart uploads
+// if the test doesn't specify one, it's large enough for all the object store plugins to
+// upload it in parts.
+const defaultLargeObjectSize = 100 << 20
+
+// signedURLTimeout is how long downloading the object with a signed URL may take.
+const signedURLTimeout = time.Minute
+
+type backupStorageLocationTestReconciler struct {
+	client.Client
+	logger                 logrus.FieldLogger
+	clock                  clock.Clock
+	newPluginManager       func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter      persistence.ObjectBackupStoreGetter
+	credentialFileStore    credentials.FileStore
+	checkRepositoryStorage func(context.Context, *velerov1api.BackupStorageLocation, credentials.FileStore, string) ([]kopialib.StorageCheck, error)
+}
+
+// NewBackupStorageLocationTestReconciler creates a new backup storage location test reconciler.
+func NewBackupStorageLocationTestReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	credentialFileStore credentials.FileStore,
+) *backupStorageLocationTestReconciler {
+	return &backupStorageLocationTestReconciler{
+		Client:                 client,
+		logger:                 logger,
+		clock:                  clock.RealClock{},
+		newPluginManager:       newPluginManager,
+		backupStoreGetter:      backupStoreGetter,
+		credentialFileStore:    credentialFileStore,
+		checkRepositoryStorage: provider.CheckUnifiedRepoStorage,
+	}
+}
+
+func (r *backupStorageLocationTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.BackupStorageLocationTest{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocationtests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocationtests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *backupStorageLocationTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller":                BackupStorageLocationTest,
+		"backupstoragelocationtest": req.String(),
+	})
+
+	test := &velerov1api.BackupStorageLocationTest{}
+	if err := r.Get(ctx, req.NamespacedName, test); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find the backupstoragelocationtest")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("Error getting backupstoragelocationtest")
+		return ctrl.Result{}, err
+	}
+
+	// A test found in progress was interrupted by a restart of the server, as the same
+	// object is never reconciled concurrently. The checks are idempotent, so start it over.
+	switch test.Status.Phase {
+	case "", velerov1api.BackupStorageLocationTestPhaseNew, velerov1api.BackupStorageLocationTestPhaseInProgress:
+	default:
+		log.Debug("The test has been processed, skip.")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithField("backupStorageLocation", test.Spec.BackupStorageLocation)
+
+	location, err := r.validate(ctx, test)
+	if err != nil {
+		log.WithError(err).Info("Test failed validation")
+		return ctrl.Result{}, r.patchTest(ctx, test, func(t *velerov1api.BackupStorageLocationTest) {
+			t.Status.Phase = velerov1api.BackupStorageLocationTestPhaseFailed
+			t.Status.Message = err.Error()
+			t.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		})
+	}
+
+	if err := r.patchTest(ctx, test, func(t *velerov1api.BackupStorageLocationTest) {
+		t.Status.Phase = velerov1api.BackupStorageLocationTestPhaseInProgress
+		t.Status.Message = ""
+		t.Status.Checks = nil
+		t.Status.PassedChecks = 0
+		t.Status.FailedChecks = 0
+		if t.Status.StartTimestamp == nil {
+			t.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		}
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Testing backup storage location")
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return ctrl.Result{}, r.patchTest(ctx, test, func(t *velerov1api.BackupStorageLocationTest) {
+			t.Status.Phase = velerov1api.BackupStorageLocationTestPhaseFailed
+			t.Status.Message = errors.Wrap(err, "error getting the backup store").Error()
+			t.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		})
+	}
+
+	largeObjectSize := int64(defaultLargeObjectSize)
+	if test.Spec.LargeObjectSize != nil {
+		largeObjectSize = test.Spec.LargeObjectSize.Value()
+	}
+
+	var checks []velerov1api.BackupStorageLocationTestCheck
+	for _, check := range backupStore.TestCompatibility(persistence.CompatibilityTestOptions{
+		ID:              test.Name,
+		LargeObjectSize: largeObjectSize,
+		HTTPClient:      signedURLClient(location),
+	}) {
+		checks = append(checks, newTestCheck(velerov1api.BackupStorageLocationTestComponentObjectStore, check.Name, check.Duration, check.Err))
+	}
+
+	if !test.Spec.SkipRepository {
+		repoChecks, err := r.checkRepositoryStorage(ctx, location, r.credentialFileStore, test.Name)
+		if err != nil {
+			checks = append(checks, newTestCheck(velerov1api.BackupStorageLocationTestComponentRepository, "Connect", 0, err))
+		}
+		for _, check := range repoChecks {
+			checks = append(checks, newTestCheck(velerov1api.BackupStorageLocationTestComponentRepository, check.Name, check.Duration, check.Err))
+		}
+	}
+
+	passed, failed := 0, 0
+	for _, check := range checks {
+		if check.Result == velerov1api.BackupStorageLocationTestCheckResultPassed {
+			passed++
+		} else {
+			failed++
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"passed": passed,
+		"failed": failed,
+	}).Info("Test of backup storage location finished")
+
+	return ctrl.Result{}, r.patchTest(ctx, test, func(t *velerov1api.BackupStorageLocationTest) {
+		t.Status.Phase = velerov1api.BackupStorageLocationTestPhaseCompleted
+		t.Status.Checks = checks
+		t.Status.PassedChecks = passed
+		t.Status.FailedChecks = failed
+		t.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+}
+
+// validate checks that the location of the test can be tested, and returns it.
+func (r *backupStorageLocationTestReconciler) validate(ctx context.Context, test *velerov1api.BackupStorageLocationTest) (*velerov1api.BackupStorageLocation, error) {
+	if test.Spec.BackupStorageLocation == "" {
+		return nil, errors.New("spec.backupStorageLocation is required")
+	}
+
+	if test.Spec.LargeObjectSize != nil && test.Spec.LargeObjectSize.Sign() <= 0 {
+		return nil, errors.New("spec.largeObjectSize must be greater than zero")
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: test.Namespace, Name: test.Spec.BackupStorageLocation}, location); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", test.Spec.BackupStorageLocation)
+	}
+
+	// the checks write to the location
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil, fmt.Errorf("backup storage location %s is in read-only mode", location.Name)
+	}
+
+	return location, nil
+}
+
+func (r *backupStorageLocationTestReconciler) patchTest(ctx context.Context, test *velerov1api.BackupStorageLocationTest, mutate func(*velerov1api.BackupStorageLocationTest)) error {
+	original := test.DeepCopy()
+	mutate(test)
+	if err := r.Patch(ctx, test, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error patching the backupstoragelocationtest")
+	}
+	return nil
+}
+
+func newTestCheck(component velerov1api.BackupStorageLocationTestComponent, name string, duration time.Duration, err error) velerov1api.BackupStorageLocationTestCheck {
+	check := velerov1api.BackupStorageLocationTestCheck{
+		Component: component,
+		Name:      name,
+		Result:    velerov1api.BackupStorageLocationTestCheckResultPassed,
+		Duration:  metav1.Duration{Duration: duration.Round(time.Millisecond)},
+	}
+	if err != nil {
+		check.Result = velerov1api.BackupStorageLocationTestCheckResultFailed
+		check.Message = err.Error()
+	}
+	return check
+}
+
+// signedURLClient returns an HTTP client trusting the CA certificate of the location, the
+// signed URLs point to the object storage the same way the object store plugin does.
+func signedURLClient(location *velerov1api.BackupStorageLocation) *http.Client {
+	var caPool *x509.CertPool
+	if location.Spec.ObjectStorage != nil && len(location.Spec.ObjectStorage.CACert) > 0 {
+		var err error
+		caPool, err = x509.SystemCertPool()
+		if err != nil {
+			caPool = x509.NewCertPool()
+		}
+		caPool.AppendCertsFromPEM(location.Spec.ObjectStorage.CACert)
+	}
+
+	insecureSkipTLSVerify, _ := strconv.ParseBool(location.Spec.Config["insecureSkipTLSVerify"])
+
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	transport := defaultTransport.Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecureSkipTLSVerify, //nolint:gosec
+		RootCAs:            caPool,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   signedURLTimeout,
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupStorageLocationTestReconcile(t *testing.T) {
+	ns := velerov1api.DefaultNamespace
+	largeObjectSize := resource.MustParse("1Mi")
+	zero := resource.MustParse("0")
+
+	tests := []struct {
+		name             string
+		spec             velerov1api.BackupStorageLocationTestSpec
+		objects          []runtime.Object
+		repoErr          error
+		expectedPhase    velerov1api.BackupStorageLocationTestPhase
+		expectedMessage  string
+		expectedChecks   []string
+		expectedPassed   int
+		expectedFailed   int
+		expectedLargeObj int64
+	}{
+		{
+			name:            "missing location fails",
+			spec:            velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default"},
+			expectedPhase:   velerov1api.BackupStorageLocationTestPhaseFailed,
+			expectedMessage: `error getting backup storage location default: backupstoragelocations.velero.io "default" not found`,
+		},
+		{
+			name: "read-only location fails",
+			spec: velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default"},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			},
+			expectedPhase:   velerov1api.BackupStorageLocationTestPhaseFailed,
+			expectedMessage: "backup storage location default is in read-only mode",
+		},
+		{
+			name: "invalid large object size fails",
+			spec: velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default", LargeObjectSize: &zero},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "default").Result(),
+			},
+			expectedPhase:   velerov1api.BackupStorageLocationTestPhaseFailed,
+			expectedMessage: "spec.largeObjectSize must be greater than zero",
+		},
+		{
+			name: "object store and repository checks are reported",
+			spec: velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default", LargeObjectSize: &largeObjectSize},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "default").Result(),
+			},
+			expectedPhase:    velerov1api.BackupStorageLocationTestPhaseCompleted,
+			expectedChecks:   []string{"ObjectStore/PutObject", "ObjectStore/SignedURL", "Repository/Connect", "Repository/ListBlobs"},
+			expectedPassed:   2,
+			expectedFailed:   2,
+			expectedLargeObj: 1 << 20,
+		},
+		{
+			name: "repository storage options error is reported as a failed check",
+			spec: velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default"},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "default").Result(),
+			},
+			repoErr:          errors.New("invalid storage provider"),
+			expectedPhase:    velerov1api.BackupStorageLocationTestPhaseCompleted,
+			expectedChecks:   []string{"ObjectStore/PutObject", "ObjectStore/SignedURL", "Repository/Connect"},
+			expectedPassed:   1,
+			expectedFailed:   2,
+			expectedLargeObj: defaultLargeObjectSize,
+		},
+		{
+			name: "repository checks are skipped",
+			spec: velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default", SkipRepository: true},
+			objects: []runtime.Object{
+				builder.ForBackupStorageLocation(ns, "default").Result(),
+			},
+			expectedPhase:    velerov1api.BackupStorageLocationTestPhaseCompleted,
+			expectedChecks:   []string{"ObjectStore/PutObject", "ObjectStore/SignedURL"},
+			expectedPassed:   1,
+			expectedFailed:   1,
+			expectedLargeObj: defaultLargeObjectSize,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			test := &velerov1api.BackupStorageLocationTest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "test"},
+				Spec:       tc.spec,
+			}
+			objects := append([]runtime.Object{test}, tc.objects...)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("TestCompatibility", mock.Anything).Return([]persistence.CompatibilityCheck{
+				{Name: "PutObject", Duration: time.Second},
+				{Name: "SignedURL", Err: errors.New("fake-error")},
+			})
+
+			r := NewBackupStorageLocationTestReconciler(
+				velerotest.NewLogger(),
+				velerotest.NewFakeControllerRuntimeClient(t, objects...),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+				nil,
+			)
+			var repoCheckID string
+			r.checkRepositoryStorage = func(_ context.Context, _ *velerov1api.BackupStorageLocation, _ credentials.FileStore, id string) ([]kopialib.StorageCheck, error) {
+				repoCheckID = id
+				if tc.repoErr != nil {
+					return nil, tc.repoErr
+				}
+				return []kopialib.StorageCheck{
+					{Name: "Connect"},
+					{Name: "ListBlobs", Err: errors.New("fake-error")},
+				}, nil
+			}
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: "test"}})
+			require.NoError(t, err)
+
+			require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: "test"}, test))
+			assert.Equal(t, tc.expectedPhase, test.Status.Phase)
+			assert.Equal(t, tc.expectedMessage, test.Status.Message)
+			assert.Equal(t, tc.expectedPassed, test.Status.PassedChecks)
+			assert.Equal(t, tc.expectedFailed, test.Status.FailedChecks)
+			assert.NotNil(t, test.Status.CompletionTimestamp)
+
+			var checks []string
+			for _, check := range test.Status.Checks {
+				checks = append(checks, string(check.Component)+"/"+check.Name)
+				if check.Result == velerov1api.BackupStorageLocationTestCheckResultFailed {
+					assert.NotEmpty(t, check.Message)
+				}
+			}
+			assert.Equal(t, tc.expectedChecks, checks)
+
+			if tc.expectedPhase == velerov1api.BackupStorageLocationTestPhaseCompleted {
+				options := backupStore.Calls[0].Arguments.Get(0).(persistence.CompatibilityTestOptions)
+				assert.Equal(t, "test", options.ID)
+				assert.Equal(t, tc.expectedLargeObj, options.LargeObjectSize)
+				assert.NotNil(t, options.HTTPClient)
+				if !tc.spec.SkipRepository {
+					assert.Equal(t, "test", repoCheckID)
+				}
+			}
+		})
+	}
+}
+
+func TestBackupStorageLocationTestReconcileSkipsProcessed(t *testing.T) {
+	test := &velerov1api.BackupStorageLocationTest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "test"},
+		Spec:       velerov1api.BackupStorageLocationTestSpec{BackupStorageLocation: "default"},
+		Status:     velerov1api.BackupStorageLocationTestStatus{Phase: velerov1api.BackupStorageLocationTestPhaseCompleted},
+	}
+
+	r := NewBackupStorageLocationTestReconciler(
+		velerotest.NewLogger(),
+		velerotest.NewFakeControllerRuntimeClient(t, test),
+		nil,
+		nil,
+		nil,
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.Namespace, Name: test.Name}})
+	require.NoError(t, err)
+
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: test.Namespace, Name: test.Name}, test))
+	assert.Equal(t, velerov1api.BackupStorageLocationTestPhaseCompleted, test.Status.Phase)
+	assert.Nil(t, test.Status.CompletionTimestamp)
+}
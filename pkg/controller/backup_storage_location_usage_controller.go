@@ -0,0 +1,171 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// the usage is calculated on the first enqueue after the report frequency of the location
+	// elapses, so the actual interval is at most the report frequency plus this period
+	bslUsageEnqueuePeriod = time.Minute
+)
+
+// backupStorageLocationUsageReconciler calculates the size of the data stored in the backup
+// storage locations whose usage reporting is enabled. It's separate from the validation of the
+// locations, since listing all the objects of a location may take long.
+type backupStorageLocationUsageReconciler struct {
+	client            client.Client
+	logger            logrus.FieldLogger
+	clock             clocks.WithTickerAndDelayedExecution
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	metrics           *metrics.ServerMetrics
+}
+
+// NewBackupStorageLocationUsageReconciler initializes and returns backupStorageLocationUsageReconciler struct.
+func NewBackupStorageLocationUsageReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
+) *backupStorageLocationUsageReconciler {
+	return &backupStorageLocationUsageReconciler{
+		client:            client,
+		logger:            logger,
+		clock:             clocks.RealClock{},
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		metrics:           metrics,
+	}
+}
+
+func (r *backupStorageLocationUsageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.BackupStorageLocationList{}, bslUsageEnqueuePeriod, kube.PeriodicalEnqueueSourceOption{})
+	gp := kube.NewGenericEventPredicate(func(object client.Object) bool {
+		return r.isUsageDue(object.(*velerov1api.BackupStorageLocation))
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(BackupStorageLocationUsage).
+		// the status is updated by the validation of the locations, only the changes of the spec
+		// can enable or disable the usage reporting
+		For(&velerov1api.BackupStorageLocation{}, builder.WithPredicates(kube.SpecChangePredicate{})).
+		Watches(s, nil, builder.WithPredicates(gp)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations/status,verbs=get;update;patch
+
+func (r *backupStorageLocationUsageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("controller", BackupStorageLocationUsage).WithField(BackupStorageLocation, req.NamespacedName.String())
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, req.NamespacedName, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find the backup storage location")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup storage location %s", req.String())
+	}
+
+	original := location.DeepCopy()
+	if !usageReportEnabled(location) {
+		if location.Status.Usage == nil {
+			return ctrl.Result{}, nil
+		}
+		location.Status.Usage = nil
+		return ctrl.Result{}, r.client.Patch(ctx, location, client.MergeFrom(original))
+	}
+
+	if !r.isUsageDue(location) {
+		return ctrl.Result{}, nil
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Warn("Error getting a backup store")
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Calculating the usage of backup storage location")
+	usage, err := backupStore.GetUsage()
+	switch {
+	case errors.Is(err, osv2.ErrNotSupported):
+		log.WithError(err).Info("The usage of backup storage location is unavailable")
+		location.Status.Usage = &velerov1api.BackupStorageLocationUsage{
+			LastUpdateTime: &metav1.Time{Time: r.clock.Now().UTC()},
+			Message:        err.Error(),
+		}
+	case err != nil:
+		// failing to calculate the usage doesn't make the location unavailable, it's retried
+		// on the next enqueue
+		log.WithError(err).Warn("Error calculating the usage of backup storage location")
+		return ctrl.Result{}, nil
+	default:
+		location.Status.Usage = &velerov1api.BackupStorageLocationUsage{
+			BackupBytes:     usage.BackupBytes,
+			RepositoryBytes: usage.RepositoryBytes,
+			LastUpdateTime:  &metav1.Time{Time: r.clock.Now().UTC()},
+		}
+		r.metrics.SetBackupStorageLocationUsedBytes(location.Name, usage.BackupBytes, usage.RepositoryBytes)
+	}
+
+	if err := r.client.Patch(ctx, location, client.MergeFrom(original)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating the usage of backup storage location")
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// isUsageDue returns whether the usage reporting of the location is enabled and its last report
+// is older than the report frequency.
+func (r *backupStorageLocationUsageReconciler) isUsageDue(location *velerov1api.BackupStorageLocation) bool {
+	if !usageReportEnabled(location) {
+		return false
+	}
+
+	usage := location.Status.Usage
+	return usage == nil || usage.LastUpdateTime == nil ||
+		r.clock.Since(usage.LastUpdateTime.Time) >= location.Spec.UsageReportFrequency.Duration
+}
+
+func usageReportEnabled(location *velerov1api.BackupStorageLocation) bool {
+	return location.Spec.UsageReportFrequency != nil && location.Spec.UsageReportFrequency.Duration > 0
+}
@@ -0,0 +1,135 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupStorageLocationUsageReconcile(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		location      *velerov1api.BackupStorageLocation
+		usage         *persistence.Usage
+		usageErr      error
+		expectedUsage *velerov1api.BackupStorageLocationUsage
+	}{
+		{
+			name:     "usage isn't calculated when the reporting is disabled",
+			location: builder.ForBackupStorageLocation("velero", "default").Result(),
+		},
+		{
+			name: "usage is removed when the reporting is disabled",
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("velero", "default").Result()
+				location.Status.Usage = &velerov1api.BackupStorageLocationUsage{BackupBytes: 10}
+				return location
+			}(),
+		},
+		{
+			name:     "usage is calculated when the reporting is enabled",
+			location: builder.ForBackupStorageLocation("velero", "default").UsageReportFrequency(time.Hour).Result(),
+			usage:    &persistence.Usage{BackupBytes: 10, RepositoryBytes: 20},
+			expectedUsage: &velerov1api.BackupStorageLocationUsage{
+				BackupBytes:     10,
+				RepositoryBytes: 20,
+				LastUpdateTime:  &metav1.Time{Time: now},
+			},
+		},
+		{
+			name: "usage isn't calculated before the report frequency elapses",
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("velero", "default").UsageReportFrequency(time.Hour).Result()
+				location.Status.Usage = &velerov1api.BackupStorageLocationUsage{BackupBytes: 10, LastUpdateTime: &metav1.Time{Time: now.Add(-time.Minute)}}
+				return location
+			}(),
+			expectedUsage: &velerov1api.BackupStorageLocationUsage{BackupBytes: 10, LastUpdateTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+		},
+		{
+			name:     "usage is unavailable when the object store can't list the sizes",
+			location: builder.ForBackupStorageLocation("velero", "default").UsageReportFrequency(time.Hour).Result(),
+			usageErr: errors.Wrap(osv2.ErrNotSupported, "v1 object store plugins can't list the sizes of the objects"),
+			expectedUsage: &velerov1api.BackupStorageLocationUsage{
+				LastUpdateTime: &metav1.Time{Time: now},
+				Message:        "v1 object store plugins can't list the sizes of the objects: operation not supported by the object store",
+			},
+		},
+		{
+			name:     "usage is kept when it fails to be calculated",
+			location: builder.ForBackupStorageLocation("velero", "default").UsageReportFrequency(time.Hour).Result(),
+			usageErr: errors.New("list error"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetUsage").Return(tc.usage, tc.usageErr)
+
+			r := NewBackupStorageLocationUsageReconciler(
+				velerotest.NewLogger(),
+				velerotest.NewFakeControllerRuntimeClient(t, tc.location),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+				metrics.NewServerMetrics(),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "default"}})
+			require.NoError(t, err)
+
+			location := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, r.client.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "default"}, location))
+			if tc.expectedUsage == nil {
+				assert.Nil(t, location.Status.Usage)
+				return
+			}
+			require.NotNil(t, location.Status.Usage)
+			assert.Equal(t, tc.expectedUsage.BackupBytes, location.Status.Usage.BackupBytes)
+			assert.Equal(t, tc.expectedUsage.RepositoryBytes, location.Status.Usage.RepositoryBytes)
+			assert.Equal(t, tc.expectedUsage.Message, location.Status.Usage.Message)
+			assert.True(t, tc.expectedUsage.LastUpdateTime.Equal(location.Status.Usage.LastUpdateTime))
+		})
+	}
+}
@@ -17,21 +17,25 @@ limitations under the License.
 package controller
 
 const (
-	Backup                = "backup"
-	BackupOperations      = "backup-operations"
-	BackupDeletion        = "backup-deletion"
-	BackupFinalizer       = "backup-finalizer"
-	BackupRepo            = "backup-repo"
-	BackupStorageLocation = "backup-storage-location"
-	BackupSync            = "backup-sync"
-	DownloadRequest       = "download-request"
-	GarbageCollection     = "gc"
-	PodVolumeBackup       = "pod-volume-backup"
-	PodVolumeRestore      = "pod-volume-restore"
-	Restore               = "restore"
-	RestoreOperations     = "restore-operations"
-	Schedule              = "schedule"
-	ServerStatusRequest   = "server-status-request"
+	Backup                         = "backup"
+	BackupOperations               = "backup-operations"
+	BackupDeletion                 = "backup-deletion"
+	BackupFinalizer                = "backup-finalizer"
+	BackupRepo                     = "backup-repo"
+	BackupStorageLocation          = "backup-storage-location"
+	BackupStorageLocationMigration = "backup-storage-location-migration"
+	BackupStorageLocationTest      = "backup-storage-location-test"
+	BackupStorageLocationUsage     = "backup-storage-location-usage"
+	BackupSync                     = "backup-sync"
+	DownloadRequest                = "download-request"
+	GarbageCollection              = "gc"
+	OrphanedSnapshot               = "orphaned-snapshot"
+	PodVolumeBackup                = "pod-volume-backup"
+	PodVolumeRestore               = "pod-volume-restore"
+	Restore                        = "restore"
+	RestoreOperations              = "restore-operations"
+	Schedule                       = "schedule"
+	ServerStatusRequest            = "server-status-request"
 )
 
 // DisableableControllers is a list of controllers that can be disabled
@@ -40,10 +44,14 @@ var DisableableControllers = []string{
 	BackupOperations,
 	BackupDeletion,
 	BackupFinalizer,
+	BackupStorageLocationMigration,
+	BackupStorageLocationTest,
+	BackupStorageLocationUsage,
 	BackupSync,
 	DownloadRequest,
 	GarbageCollection,
 	BackupRepo,
+	OrphanedSnapshot,
 	Restore,
 	RestoreOperations,
 	Schedule,
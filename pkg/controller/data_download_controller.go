@@ -44,6 +44,7 @@ import (
 	datamover "github.com/vmware-tanzu/velero/pkg/datamover"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	repository "github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -62,10 +63,13 @@ type DataDownloadReconciler struct {
 	nodeName          string
 	repositoryEnsurer *repository.Ensurer
 	dataPathMgr       *datapath.Manager
+	loadAffinity      []*nodeagent.LoadAffinity
+	podConfig         *nodeagent.PodConfig
 }
 
 func NewDataDownloadReconciler(client client.Client, kubeClient kubernetes.Interface,
-	repoEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter, nodeName string, logger logrus.FieldLogger) *DataDownloadReconciler {
+	repoEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter, nodeName string, dataPathMgr *datapath.Manager,
+	loadAffinity []*nodeagent.LoadAffinity, podConfig *nodeagent.PodConfig, logger logrus.FieldLogger) *DataDownloadReconciler {
 	return &DataDownloadReconciler{
 		client:            client,
 		kubeClient:        kubeClient,
@@ -76,7 +80,9 @@ func NewDataDownloadReconciler(client client.Client, kubeClient kubernetes.Inter
 		nodeName:          nodeName,
 		repositoryEnsurer: repoEnsurer,
 		restoreExposer:    exposer.NewGenericRestoreExposer(kubeClient, logger),
-		dataPathMgr:       datapath.NewManager(1),
+		dataPathMgr:       dataPathMgr,
+		loadAffinity:      loadAffinity,
+		podConfig:         podConfig,
 	}
 }
 
@@ -104,7 +110,7 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
-	if dd.Spec.DataMover != "" && dd.Spec.DataMover != dataMoverType {
+	if !datamover.IsBuiltInDataMover(dd.Spec.DataMover) {
 		log.WithField("data mover", dd.Spec.DataMover).Info("it is not one built-in data mover which is not supported by Velero")
 		return ctrl.Result{}, nil
 	}
@@ -114,6 +120,11 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	if dd.Status.Phase == "" || dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseNew {
+		if dd.Spec.Cancel {
+			log.Info("Data download is canceled before it is started")
+			return ctrl.Result{}, r.cancelNewDataDownload(ctx, dd)
+		}
+
 		log.Info("Data download starting")
 
 		if _, err := r.getTargetPVC(ctx, dd); err != nil {
@@ -134,10 +145,15 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 		hostingPodLabels := map[string]string{velerov1api.DataDownloadLabel: dd.Name}
 
+		podConfig, err := nodeagent.GetPodConfig(r.podConfig, dd.Spec.DataMoverConfig)
+		if err != nil {
+			return r.errorOut(ctx, dd, err, "error to get the pod config of the data mover", log)
+		}
+
 		// ep.Expose() will trigger to create one pod whose volume is restored by a given volume snapshot,
 		// but the pod maybe is not in the same node of the current controller, so we need to return it here.
 		// And then only the controller who is in the same node could do the rest work.
-		err = r.restoreExposer.Expose(ctx, getDataDownloadOwnerObject(dd), dd.Spec.TargetVolume.PVC, dd.Spec.TargetVolume.Namespace, hostingPodLabels, dd.Spec.OperationTimeout.Duration)
+		err = r.restoreExposer.Expose(ctx, getDataDownloadOwnerObject(dd), dd.Spec.TargetVolume.PVC, dd.Spec.TargetVolume.Namespace, hostingPodLabels, r.loadAffinity, podConfig, dd.Spec.OperationTimeout.Duration)
 		if err != nil {
 			return r.errorOut(ctx, dd, err, "error to start restore expose", log)
 		}
@@ -153,6 +169,12 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			return ctrl.Result{}, nil
 		}
 
+		if dd.Spec.Cancel && dd.Status.Node == r.nodeName {
+			log.Info("Data download is canceled before the data path is started")
+			r.OnDataDownloadCancelled(ctx, dd.Namespace, dd.Name)
+			return ctrl.Result{}, nil
+		}
+
 		result, err := r.restoreExposer.GetExposed(ctx, getDataDownloadOwnerObject(dd), r.client, r.nodeName, dd.Spec.OperationTimeout.Duration)
 		if err != nil {
 			return r.errorOut(ctx, dd, err, "restore exposer is not ready", log)
@@ -452,6 +474,25 @@ func (r *DataDownloadReconciler) updateStatusToFailed(ctx context.Context, dd *v
 	return err
 }
 
+// cancelNewDataDownload moves a DataDownload that is not accepted by any node yet to the canceled phase, the update
+// fails with conflict if the DataDownload is accepted by another node at the same time
+func (r *DataDownloadReconciler) cancelNewDataDownload(ctx context.Context, dd *velerov2alpha1api.DataDownload) error {
+	updated := dd.DeepCopy()
+	updated.Status.Phase = velerov2alpha1api.DataDownloadPhaseCanceled
+	updated.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	updated.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	err := r.client.Update(ctx, updated)
+	if err == nil {
+		return nil
+	} else if apierrors.IsConflict(err) {
+		r.logger.WithField("DataDownload", dd.Name).Info("This data download has been handled by others")
+		return nil
+	} else {
+		return errors.Wrap(err, "error canceling DataDownload")
+	}
+}
+
 func (r *DataDownloadReconciler) acceptDataDownload(ctx context.Context, dd *velerov2alpha1api.DataDownload) (bool, error) {
 	updated := dd.DeepCopy()
 	updated.Status.Phase = velerov2alpha1api.DataDownloadPhaseAccepted
@@ -112,7 +112,7 @@ func initDataDownloadReconciler(objects []runtime.Object, needError ...bool) (*D
 	if err != nil {
 		return nil, err
 	}
-	return NewDataDownloadReconciler(fakeClient, fakeKubeClient, nil, &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", velerotest.NewLogger()), nil
+	return NewDataDownloadReconciler(fakeClient, fakeKubeClient, nil, &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", datapath.NewManager(1), nil, nil, velerotest.NewLogger()), nil
 }
 
 func TestDataDownloadReconcile(t *testing.T) {
@@ -134,6 +134,7 @@ func TestDataDownloadReconcile(t *testing.T) {
 		mockClose         bool
 		expectedStatusMsg string
 		expectedResult    *ctrl.Result
+		expectedPhase     velerov2alpha1api.DataDownloadPhase
 	}{
 		{
 			name:      "Unknown data download status",
@@ -161,6 +162,12 @@ func TestDataDownloadReconcile(t *testing.T) {
 			needCreateFSBR: true,
 			mockCancel:     true,
 		},
+		{
+			name:          "Cancel data download before accepted",
+			dd:            dataDownloadBuilder().Cancel(true).Result(),
+			targetPVC:     builder.ForPersistentVolumeClaim("test-ns", "test-pvc").Result(),
+			expectedPhase: velerov2alpha1api.DataDownloadPhaseCanceled,
+		},
 		{
 			name:           "Error in data path is concurrent limited",
 			dd:             dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePrepared).Result(),
@@ -275,7 +282,7 @@ func TestDataDownloadReconcile(t *testing.T) {
 				r.dataPathMgr = datapath.NewManager(1)
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, time.Duration, logrus.FieldLogger) datapath.AsyncBR {
 				fsBR := datapathmockes.NewAsyncBR(t)
 				if test.mockCancel {
 					fsBR.On("Cancel").Return()
@@ -295,7 +302,7 @@ func TestDataDownloadReconcile(t *testing.T) {
 					r.restoreExposer = func() exposer.GenericRestoreExposer {
 						ep := exposermockes.NewGenericRestoreExposer(t)
 						if test.isExposeErr {
-							ep.On("Expose", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("Error to expose restore exposer"))
+							ep.On("Expose", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("Error to expose restore exposer"))
 						} else if test.notNilExpose {
 							hostingPod := builder.ForPod("test-ns", "test-name").Volumes(&corev1.Volume{Name: "test-pvc"}).Result()
 							hostingPod.ObjectMeta.SetUID("test-uid")
@@ -348,6 +355,9 @@ func TestDataDownloadReconcile(t *testing.T) {
 			if test.isGetExposeErr {
 				assert.Contains(t, dd.Status.Message, test.expectedStatusMsg)
 			}
+			if test.expectedPhase != "" {
+				assert.Equal(t, test.expectedPhase, dd.Status.Phase)
+			}
 			if test.dd.Namespace == velerov1api.DefaultNamespace {
 				require.Nil(t, err)
 			} else {
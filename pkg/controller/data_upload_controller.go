@@ -46,13 +46,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/datamover"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-const dataMoverType string = "velero"
 const dataUploadDownloadRequestor string = "snapshot-data-upload-download"
 
 // DataUploadReconciler reconciles a DataUpload object
@@ -68,23 +68,31 @@ type DataUploadReconciler struct {
 	logger              logrus.FieldLogger
 	snapshotExposerList map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer
 	dataPathMgr         *datapath.Manager
+	loadAffinity        []*nodeagent.LoadAffinity
+	podConfig           *nodeagent.PodConfig
 }
 
 func NewDataUploadReconciler(client client.Client, kubeClient kubernetes.Interface,
 	csiSnapshotClient snapshotter.SnapshotV1Interface, repoEnsurer *repository.Ensurer, clock clocks.WithTickerAndDelayedExecution,
-	cred *credentials.CredentialGetter, nodeName string, fs filesystem.Interface, log logrus.FieldLogger) *DataUploadReconciler {
+	cred *credentials.CredentialGetter, nodeName string, fs filesystem.Interface, dataPathMgr *datapath.Manager, loadAffinity []*nodeagent.LoadAffinity,
+	podConfig *nodeagent.PodConfig, log logrus.FieldLogger) *DataUploadReconciler {
 	return &DataUploadReconciler{
-		client:              client,
-		kubeClient:          kubeClient,
-		csiSnapshotClient:   csiSnapshotClient,
-		clock:               clock,
-		credentialGetter:    cred,
-		nodeName:            nodeName,
-		fileSystem:          fs,
-		logger:              log,
-		repoEnsurer:         repoEnsurer,
-		snapshotExposerList: map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{velerov2alpha1api.SnapshotTypeCSI: exposer.NewCSISnapshotExposer(kubeClient, csiSnapshotClient, log)},
-		dataPathMgr:         datapath.NewManager(1),
+		client:            client,
+		kubeClient:        kubeClient,
+		csiSnapshotClient: csiSnapshotClient,
+		clock:             clock,
+		credentialGetter:  cred,
+		nodeName:          nodeName,
+		fileSystem:        fs,
+		logger:            log,
+		repoEnsurer:       repoEnsurer,
+		snapshotExposerList: map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{
+			velerov2alpha1api.SnapshotTypeCSI:         exposer.NewCSISnapshotExposer(kubeClient, csiSnapshotClient, log),
+			velerov2alpha1api.SnapshotTypeVolumeClone: exposer.NewVolumeCloneExposer(kubeClient, log),
+		},
+		dataPathMgr:  dataPathMgr,
+		loadAffinity: loadAffinity,
+		podConfig:    podConfig,
 	}
 }
 
@@ -109,7 +117,7 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
 	}
 
-	if du.Spec.DataMover != "" && du.Spec.DataMover != dataMoverType {
+	if !datamover.IsBuiltInDataMover(du.Spec.DataMover) {
 		log.WithField("Data mover", du.Spec.DataMover).Debug("it is not one built-in data mover which is not supported by Velero")
 		return ctrl.Result{}, nil
 	}
@@ -120,6 +128,11 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	if du.Status.Phase == "" || du.Status.Phase == velerov2alpha1api.DataUploadPhaseNew {
+		if du.Spec.Cancel {
+			log.Info("Data upload is canceled before it is started")
+			return ctrl.Result{}, r.cancelNewDataUpload(ctx, &du)
+		}
+
 		log.Info("Data upload starting")
 
 		accepted, err := r.acceptDataUpload(ctx, &du)
@@ -134,7 +147,10 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 		log.Info("Data upload is accepted")
 
-		exposeParam := r.setupExposeParam(&du)
+		exposeParam, err := r.setupExposeParam(ctx, &du)
+		if err != nil {
+			return r.errorOut(ctx, &du, err, "failed to set exposer parameters", log)
+		}
 
 		if err := ep.Expose(ctx, getOwnerObject(&du), exposeParam); err != nil {
 			return r.errorOut(ctx, &du, err, "error to expose snapshot", log)
@@ -151,6 +167,12 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			log.Info("Cancellable data path is already started")
 			return ctrl.Result{}, nil
 		}
+
+		if du.Spec.Cancel && du.Status.Node == r.nodeName {
+			log.Info("Data upload is canceled before the data path is started")
+			r.OnDataUploadCancelled(ctx, du.Namespace, du.Name)
+			return ctrl.Result{}, nil
+		}
 		waitExposePara := r.setupWaitExposePara(&du)
 		res, err := ep.GetExposed(ctx, getOwnerObject(&du), du.Spec.OperationTimeout.Duration, waitExposePara)
 		if err != nil {
@@ -183,7 +205,10 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		// Update status to InProgress
 		original := du.DeepCopy()
 		du.Status.Phase = velerov2alpha1api.DataUploadPhaseInProgress
-		du.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		if du.Status.StartTimestamp == nil {
+			// the start timestamp is kept if the data upload is resumed after the restart of the node-agent
+			du.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		}
 		if err := r.client.Patch(ctx, &du, client.MergeFrom(original)); err != nil {
 			return r.errorOut(ctx, &du, err, "error updating dataupload status", log)
 		}
@@ -228,7 +253,7 @@ func (r *DataUploadReconciler) runCancelableDataUpload(ctx context.Context, fsBa
 		return r.errorOut(ctx, du, err, "error exposing host path for pod volume", log)
 	}
 
-	log.WithField("path", path.ByPath).Debug("Found host path")
+	log.WithField("path", path.ByPath).WithField("device", path.ByBlock).Debug("Found host path")
 	if err := fsBackup.Init(ctx, du.Spec.BackupStorageLocation, du.Spec.SourceNamespace, datamover.GetUploaderType(du.Spec.DataMover),
 		velerov1api.BackupRepositoryTypeKopia, "", r.repoEnsurer, r.credentialGetter); err != nil {
 		return r.errorOut(ctx, du, err, "error to initialize data path", log)
@@ -237,6 +262,14 @@ func (r *DataUploadReconciler) runCancelableDataUpload(ctx context.Context, fsBa
 
 	tags := map[string]string{
 		velerov1api.AsyncOperationIDLabel: du.Labels[velerov1api.AsyncOperationIDLabel],
+		uploader.SnapshotRequestTag:       string(du.UID),
+		"ns":                              du.Spec.SourceNamespace,
+		"pvc":                             du.Spec.SourcePVC,
+	}
+	// the backup name is kept so that the snapshot could be mapped back to its backup,
+	// e.g., when looking for the orphaned snapshots in the repository
+	if backupName := du.Labels[velerov1api.BackupNameLabel]; backupName != "" {
+		tags["backup"] = backupName
 	}
 	if err := fsBackup.StartBackup(path, fmt.Sprintf("%s/%s", du.Spec.SourceNamespace, du.Spec.SourcePVC), "", false, tags); err != nil {
 		return r.errorOut(ctx, du, err, "error starting data path backup", log)
@@ -445,6 +478,21 @@ func (r *DataUploadReconciler) prepareDataUpload(du *velerov2alpha1api.DataUploa
 	du.Status.Node = r.nodeName
 }
 
+// ResetDataUploadForResume moves a DataUpload that is interrupted by the restart of the node-agent back to
+// the prepared phase, so that the data path is started again on the exposed snapshot and resumes from the checkpoint
+func ResetDataUploadForResume(ctx context.Context, c client.Client, du *velerov2alpha1api.DataUpload, msg string, log logrus.FieldLogger) error {
+	original := du.DeepCopy()
+	du.Status.Phase = velerov2alpha1api.DataUploadPhasePrepared
+	du.Status.Message = msg
+
+	err := c.Patch(ctx, du, client.MergeFrom(original))
+	if err != nil {
+		log.WithError(err).Error("error updating DataUpload status")
+	}
+
+	return err
+}
+
 func (r *DataUploadReconciler) errorOut(ctx context.Context, du *velerov2alpha1api.DataUpload, err error, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
 	if se, ok := r.snapshotExposerList[du.Spec.SnapshotType]; ok {
 		var volumeSnapshotName string
@@ -474,6 +522,25 @@ func (r *DataUploadReconciler) updateStatusToFailed(ctx context.Context, du *vel
 	return err
 }
 
+// cancelNewDataUpload moves a DataUpload that is not accepted by any node yet to the canceled phase, the update
+// fails with conflict if the DataUpload is accepted by another node at the same time
+func (r *DataUploadReconciler) cancelNewDataUpload(ctx context.Context, du *velerov2alpha1api.DataUpload) error {
+	updated := du.DeepCopy()
+	updated.Status.Phase = velerov2alpha1api.DataUploadPhaseCanceled
+	updated.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	updated.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	err := r.client.Update(ctx, updated)
+	if err == nil {
+		return nil
+	} else if apierrors.IsConflict(err) {
+		r.logger.WithField("Dataupload", du.Name).Info("This datauplod backup has been handled by others")
+		return nil
+	} else {
+		return errors.Wrap(err, "error canceling DataUpload")
+	}
+}
+
 func (r *DataUploadReconciler) acceptDataUpload(ctx context.Context, du *velerov2alpha1api.DataUpload) (bool, error) {
 	updated := du.DeepCopy()
 	updated.Status.Phase = velerov2alpha1api.DataUploadPhaseAccepted
@@ -503,18 +570,60 @@ func (r *DataUploadReconciler) closeDataPath(ctx context.Context, duName string)
 	r.dataPathMgr.RemoveAsyncBR(duName)
 }
 
-func (r *DataUploadReconciler) setupExposeParam(du *velerov2alpha1api.DataUpload) interface{} {
-	if du.Spec.SnapshotType == velerov2alpha1api.SnapshotTypeCSI {
-		return &exposer.CSISnapshotExposeParam{
-			SnapshotName:     du.Spec.CSISnapshot.VolumeSnapshot,
+func (r *DataUploadReconciler) setupExposeParam(ctx context.Context, du *velerov2alpha1api.DataUpload) (interface{}, error) {
+	if du.Spec.SnapshotType != velerov2alpha1api.SnapshotTypeCSI && du.Spec.SnapshotType != velerov2alpha1api.SnapshotTypeVolumeClone {
+		return nil, nil
+	}
+
+	pvc, err := r.kubeClient.CoreV1().PersistentVolumeClaims(du.Spec.SourceNamespace).Get(ctx, du.Spec.SourcePVC, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get source PVC %s/%s", du.Spec.SourceNamespace, du.Spec.SourcePVC)
+	}
+
+	var dataMoverConfig map[string]string
+	if du.Spec.DataMoverConfig != nil {
+		dataMoverConfig = *du.Spec.DataMoverConfig
+	}
+
+	podConfig, err := nodeagent.GetPodConfig(r.podConfig, dataMoverConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get the pod config of the data mover")
+	}
+
+	accessMode := exposer.AccessModeFileSystem
+	if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+		accessMode = exposer.AccessModeBlock
+	}
+
+	if du.Spec.SnapshotType == velerov2alpha1api.SnapshotTypeVolumeClone {
+		param := &exposer.VolumeCloneExposeParam{
 			SourceNamespace:  du.Spec.SourceNamespace,
-			StorageClass:     du.Spec.CSISnapshot.StorageClass,
+			SourcePVC:        du.Spec.SourcePVC,
 			HostingPodLabels: map[string]string{velerov1api.DataUploadLabel: du.Name},
-			AccessMode:       exposer.AccessModeFileSystem,
+			AccessMode:       accessMode,
+			Affinity:         r.loadAffinity,
+			PodConfig:        podConfig,
 			Timeout:          du.Spec.OperationTimeout.Duration,
 		}
+
+		if du.Spec.VolumeClone != nil {
+			param.PersistentVolume = du.Spec.VolumeClone.PersistentVolume
+			param.StorageClass = du.Spec.VolumeClone.StorageClass
+		}
+
+		return param, nil
 	}
-	return nil
+
+	return &exposer.CSISnapshotExposeParam{
+		SnapshotName:     du.Spec.CSISnapshot.VolumeSnapshot,
+		SourceNamespace:  du.Spec.SourceNamespace,
+		StorageClass:     du.Spec.CSISnapshot.StorageClass,
+		HostingPodLabels: map[string]string{velerov1api.DataUploadLabel: du.Name},
+		AccessMode:       accessMode,
+		Affinity:         r.loadAffinity,
+		PodConfig:        podConfig,
+		Timeout:          du.Spec.OperationTimeout.Duration,
+	}, nil
 }
 
 func (r *DataUploadReconciler) setupWaitExposePara(du *velerov2alpha1api.DataUpload) interface{} {
@@ -523,6 +632,11 @@ func (r *DataUploadReconciler) setupWaitExposePara(du *velerov2alpha1api.DataUpl
 			NodeClient: r.client,
 			NodeName:   r.nodeName,
 		}
+	} else if du.Spec.SnapshotType == velerov2alpha1api.SnapshotTypeVolumeClone {
+		return &exposer.VolumeCloneExposeWaitParam{
+			NodeClient: r.client,
+			NodeName:   r.nodeName,
+		}
 	}
 	return nil
 }
@@ -28,11 +28,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
 	"k8s.io/utils/clock"
 	testclocks "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -43,9 +46,11 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
@@ -152,7 +157,7 @@ func initDataUploaderReconciler(needError ...bool) (*DataUploadReconciler, error
 	}
 
 	fakeSnapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj)
-	fakeKubeClient := clientgofake.NewSimpleClientset()
+	fakeKubeClient := clientgofake.NewSimpleClientset(builder.ForPersistentVolumeClaim("fake-ns", "test-pvc").Result())
 	fakeFS := velerotest.NewFakeFileSystem()
 	pathGlob := fmt.Sprintf("/host_pods/%s/volumes/*/%s", "", dataUploadName)
 	_, err = fakeFS.Create(pathGlob)
@@ -170,7 +175,7 @@ func initDataUploaderReconciler(needError ...bool) (*DataUploadReconciler, error
 		return nil, err
 	}
 	return NewDataUploadReconciler(fakeClient, fakeKubeClient, fakeSnapshotClient.SnapshotV1(), nil,
-		testclocks.NewFakeClock(now), &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", fakeFS, velerotest.NewLogger()), nil
+		testclocks.NewFakeClock(now), &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", fakeFS, datapath.NewManager(1), nil, nil, velerotest.NewLogger()), nil
 }
 
 func dataUploadBuilder() *builder.DataUploadBuilder {
@@ -300,6 +305,13 @@ func TestReconcile(t *testing.T) {
 			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result(),
 			expectedRequeue:   ctrl.Result{},
 		},
+		{
+			name:              "Dataupload canceled before accepted",
+			du:                dataUploadBuilder().Cancel(true).Result(),
+			expectedProcessed: true,
+			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseCanceled).Result(),
+			expectedRequeue:   ctrl.Result{},
+		},
 		{
 			name:              "Dataupload should be prepared",
 			du:                dataUploadBuilder().SnapshotType(fakeSnapshotType).Result(),
@@ -374,7 +386,7 @@ func TestReconcile(t *testing.T) {
 				r.snapshotExposerList = map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{velerov2alpha1api.SnapshotTypeCSI: exposer.NewCSISnapshotExposer(r.kubeClient, r.csiSnapshotClient, velerotest.NewLogger())}
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, time.Duration, logrus.FieldLogger) datapath.AsyncBR {
 				return &fakeDataUploadFSBR{
 					du:         test.du,
 					kubeClient: r.client,
@@ -599,3 +611,125 @@ func TestFindDataUploadForPod(t *testing.T) {
 		}
 	}
 }
+
+func TestSetupExposeParam(t *testing.T) {
+	r, err := initDataUploaderReconciler()
+	require.NoError(t, err)
+
+	du := dataUploadBuilder().Result()
+	param, err := r.setupExposeParam(context.TODO(), du)
+	require.NoError(t, err)
+	assert.Equal(t, &exposer.CSISnapshotExposeParam{
+		SnapshotName:     "fake-volume-snapshot",
+		SourceNamespace:  "fake-ns",
+		StorageClass:     "default",
+		HostingPodLabels: map[string]string{velerov1api.DataUploadLabel: du.Name},
+		AccessMode:       exposer.AccessModeFileSystem,
+		PodConfig:        &nodeagent.PodConfig{},
+	}, param)
+	assert.Equal(t, &exposer.CSISnapshotExposeWaitParam{NodeClient: r.client, NodeName: r.nodeName}, r.setupWaitExposePara(du))
+
+	du = dataUploadBuilder().SnapshotType(velerov2alpha1api.SnapshotTypeVolumeClone).CSISnapshot(nil).
+		VolumeClone(&velerov2alpha1api.VolumeCloneSpec{PersistentVolume: "fake-pv"}).Result()
+	param, err = r.setupExposeParam(context.TODO(), du)
+	require.NoError(t, err)
+	assert.Equal(t, &exposer.VolumeCloneExposeParam{
+		SourceNamespace:  "fake-ns",
+		SourcePVC:        "test-pvc",
+		PersistentVolume: "fake-pv",
+		HostingPodLabels: map[string]string{velerov1api.DataUploadLabel: du.Name},
+		AccessMode:       exposer.AccessModeFileSystem,
+		PodConfig:        &nodeagent.PodConfig{},
+	}, param)
+	assert.Equal(t, &exposer.VolumeCloneExposeWaitParam{NodeClient: r.client, NodeName: r.nodeName}, r.setupWaitExposePara(du))
+
+	r.podConfig = &nodeagent.PodConfig{CPURequest: "100m", PriorityClassName: "low"}
+	du = dataUploadBuilder().DataMoverConfig(&map[string]string{nodeagent.DataMoverConfigPodPriorityClassName: "high"}).Result()
+	param, err = r.setupExposeParam(context.TODO(), du)
+	require.NoError(t, err)
+	assert.Equal(t, &nodeagent.PodConfig{CPURequest: "100m", PriorityClassName: "high"}, param.(*exposer.CSISnapshotExposeParam).PodConfig)
+
+	du = dataUploadBuilder().DataMoverConfig(&map[string]string{nodeagent.DataMoverConfigPodTolerations: "invalid"}).Result()
+	_, err = r.setupExposeParam(context.TODO(), du)
+	assert.EqualError(t, err, "error to get the pod config of the data mover: error to unmarshal podTolerations of the data mover config: invalid character 'i' looking for beginning of value")
+	r.podConfig = nil
+
+	du = dataUploadBuilder().SourcePVC("not-exist").Result()
+	_, err = r.setupExposeParam(context.TODO(), du)
+	assert.EqualError(t, err, "failed to get source PVC fake-ns/not-exist: persistentvolumeclaims \"not-exist\" not found")
+}
+
+func TestVolumeCloneBackupPath(t *testing.T) {
+	ctx := context.Background()
+
+	sourcePV := builder.ForPersistentVolume("source-pv").CSI("csi.example.com", "handle").Result()
+	sourcePVC := builder.ForPersistentVolumeClaim("fake-ns", "source-pvc").VolumeName("source-pv").Phase(corev1.ClaimBound).Result()
+	sourcePVC.UID = "pvc-uid"
+	clonePV := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "clone-pv",
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		},
+	}
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").SnapshotMoveData(true).StorageLocation("default").
+		CSISnapshotTimeout(time.Minute).Result()
+	backup.UID = "backup-uid"
+
+	client := velerotest.NewFakeControllerRuntimeClient(t, sourcePV)
+
+	// the clone PVC is bound to the clone PV as soon as it is created
+	kubeClient := clientgofake.NewSimpleClientset(sourcePVC, clonePV)
+	kubeClient.PrependReactor("create", "persistentvolumeclaims", func(action clientTesting.Action) (bool, runtime.Object, error) {
+		pvc := action.(clientTesting.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+		if pvc.Spec.DataSource != nil && pvc.Spec.DataSource.Name == sourcePVC.Name {
+			pvc.Spec.VolumeName = clonePV.Name
+		}
+		return false, nil, nil
+	})
+
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(sourcePVC)
+	require.NoError(t, err)
+
+	action := pkgbackup.NewVolumeCloneAction(velerotest.NewLogger(), client, velerov1api.DefaultNamespace)
+	_, _, operationID, itemsToUpdate, err := action.Execute(&unstructured.Unstructured{Object: item}, backup)
+	require.NoError(t, err)
+	require.Len(t, itemsToUpdate, 1)
+
+	r := NewDataUploadReconciler(client, kubeClient, nil, nil, testclocks.NewFakeClock(time.Now()), nil, "test_node",
+		velerotest.NewFakeFileSystem(), datapath.NewManager(1), nil, nil, velerotest.NewLogger())
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: itemsToUpdate[0].Name}})
+	require.NoError(t, err)
+
+	du := &velerov2alpha1api.DataUpload{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: itemsToUpdate[0].Name}, du))
+	assert.Equal(t, velerov2alpha1api.DataUploadPhaseAccepted, du.Status.Phase)
+
+	// the clone PVC is gone from the source namespace, and the clone PV is moved to the backup PVC
+	_, err = kubeClient.CoreV1().PersistentVolumeClaims("fake-ns").Get(ctx, du.Name, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	backupPVC, err := kubeClient.CoreV1().PersistentVolumeClaims(velerov1api.DefaultNamespace).Get(ctx, du.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, clonePV.Name, backupPVC.Spec.VolumeName)
+
+	backupPod, err := kubeClient.CoreV1().Pods(velerov1api.DefaultNamespace).Get(ctx, du.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, du.Name, backupPod.Labels[velerov1api.DataUploadLabel])
+
+	progress, err := action.Progress(operationID, backup)
+	require.NoError(t, err)
+	assert.False(t, progress.Completed)
+
+	du.Status.Phase = velerov2alpha1api.DataUploadPhaseCompleted
+	require.NoError(t, client.Update(ctx, du))
+
+	progress, err = action.Progress(operationID, backup)
+	require.NoError(t, err)
+	assert.True(t, progress.Completed)
+	assert.Empty(t, progress.Err)
+}
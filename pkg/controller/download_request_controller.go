@@ -30,6 +30,7 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -134,7 +135,9 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreLog ||
 			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults ||
 			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResourceList ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreItemOperations {
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreItemOperations ||
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreItemStatus ||
+			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreHookResults {
 			restore := &velerov1api.Restore{}
 			if err := r.client.Get(ctx, kbclient.ObjectKey{
 				Namespace: downloadRequest.Namespace,
@@ -163,6 +166,27 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 
+		// the contents of a backup split per namespace aren't stored as a single tarball, they're
+		// downloaded archive by archive. These requests can't succeed, so they aren't retried.
+		switch downloadRequest.Spec.Target.Kind {
+		case velerov1api.DownloadTargetKindBackupContents:
+			if backup.Status.ContentsLayout == velerov1api.BackupContentsLayoutPerNamespace {
+				log.Errorf("The contents of backup %s are split per namespace, download its %s and %s targets instead",
+					backup.Name, velerov1api.DownloadTargetKindBackupContentsIndex, velerov1api.DownloadTargetKindBackupContentsArchive)
+				return ctrl.Result{}, nil
+			}
+		case velerov1api.DownloadTargetKindBackupContentsIndex, velerov1api.DownloadTargetKindBackupContentsArchive:
+			if backup.Status.ContentsLayout != velerov1api.BackupContentsLayoutPerNamespace {
+				log.Errorf("The contents of backup %s aren't split per namespace, download its %s target instead",
+					backup.Name, velerov1api.DownloadTargetKindBackupContents)
+				return ctrl.Result{}, nil
+			}
+			if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindBackupContentsArchive && !archive.IsValidArchive(downloadRequest.Spec.Target.Archive) {
+				log.Errorf("Invalid backup contents archive %q", downloadRequest.Spec.Target.Archive)
+				return ctrl.Result{}, nil
+			}
+		}
+
 		location := &velerov1api.BackupStorageLocation{}
 		if err := r.client.Get(ctx, kbclient.ObjectKey{
 			Namespace: backup.Namespace,
@@ -192,7 +216,7 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindBackupItemOperations &&
 			r.backupItemOperationsMap != nil {
 			// ignore errors here. If we can't upload anything here, process the download as usual
-			_ = r.backupItemOperationsMap.UpdateForBackup(backupStore, backupName)
+			_ = r.backupItemOperationsMap.UpdateForBackup(backupStore, backupName, backupRetainUntil(backup))
 		}
 		// If this is a request for restore item operations, force upload of in-memory operations that
 		// are not yet uploaded (if there are any)
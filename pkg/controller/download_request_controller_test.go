@@ -57,6 +57,12 @@ var _ = Describe("Download Request Reconciler", func() {
 		return builder.ForBackup(velerov1api.DefaultNamespace, "a-backup").StorageLocation("a-location").Result()
 	}
 
+	perNamespaceBackup := func() *velerov1api.Backup {
+		backup := defaultBackup()
+		backup.Status.ContentsLayout = velerov1api.BackupContentsLayoutPerNamespace
+		return backup
+	}
+
 	DescribeTable("a Download request",
 		func(test request) {
 			// now will be used to set the fake clock's time; capture
@@ -192,6 +198,38 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectGetsURL:   true,
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("backup contents request for backup split per namespace doesn't get a url", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContents, "a-backup").Result(),
+			backup:          perNamespaceBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
+		Entry("backup contents index request for backup split per namespace gets a url", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContentsIndex, "a-backup").Result(),
+			backup:          perNamespaceBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectGetsURL:   true,
+			expectedRequeue: ctrl.Result{},
+		}),
+		Entry("backup contents archive request for backup split per namespace gets a url", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContentsArchive, "a-backup").TargetArchive("namespaces/ns-1").Result(),
+			backup:          perNamespaceBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectGetsURL:   true,
+			expectedRequeue: ctrl.Result{},
+		}),
+		Entry("backup contents archive request with invalid archive doesn't get a url", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContentsArchive, "a-backup").TargetArchive("../other-backup/cluster").Result(),
+			backup:          perNamespaceBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
+		Entry("backup contents archive request for backup which isn't split doesn't get a url", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContentsArchive, "a-backup").TargetArchive("cluster").Result(),
+			backup:          defaultBackup(),
+			backupLocation:  builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
 		Entry("backup log request with phase '' gets a url", request{
 			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupLog, "a-backup").Result(),
 			backup:          defaultBackup(),
@@ -42,6 +42,7 @@ const (
 	gcFailureBSLNotFound     = "BSLNotFound"
 	gcFailureBSLCannotGet    = "BSLCannotGet"
 	gcFailureBSLReadOnly     = "BSLReadOnly"
+	gcFailureObjectLocked    = "ObjectLocked"
 )
 
 // gcReconciler creates DeleteBackupRequests for expired backups.
@@ -130,6 +131,17 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		backup.Labels = make(map[string]string)
 	}
 
+	// the deletion is deferred until the backup's data in the backup storage location is unlocked,
+	// the backup is checked again at the next garbage collection
+	if backup.Status.RetainUntil != nil && backup.Status.RetainUntil.After(now) {
+		log.Infof("Backup cannot be garbage-collected until its object lock retention expires at %s", backup.Status.RetainUntil)
+		backup.Labels[garbageCollectionFailure] = gcFailureObjectLocked
+		if err := c.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup labels")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.Get(ctx, client.ObjectKey{
 		Namespace: req.Namespace,
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -145,3 +146,45 @@ func TestGCReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestGCReconcileObjectLocked(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+
+	tests := []struct {
+		name          string
+		retainUntil   time.Time
+		expectDeleted bool
+		expectLabel   string
+	}{
+		{
+			name:        "expired backup locked by object lock is not deleted",
+			retainUntil: fakeClock.Now().Add(time.Hour),
+			expectLabel: gcFailureObjectLocked,
+		},
+		{
+			name:          "expired backup whose object lock retention expired is deleted",
+			retainUntil:   fakeClock.Now().Add(-time.Hour),
+			expectDeleted: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).RetainUntil(test.retainUntil).StorageLocation("default").Result()
+
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, backup, location)
+			reconciler := mockGCReconciler(fakeClient, fakeClock, defaultGCFrequency)
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+			require.NoError(t, err)
+
+			dbrs := &velerov1api.DeleteBackupRequestList{}
+			require.NoError(t, fakeClient.List(context.TODO(), dbrs))
+			assert.Equal(t, test.expectDeleted, len(dbrs.Items) == 1)
+
+			updated := &velerov1api.Backup{}
+			require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, updated))
+			assert.Equal(t, test.expectLabel, updated.Labels[garbageCollectionFailure])
+		})
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const defaultOrphanedSnapshotCheckFrequency = 24 * time.Hour
+
+// orphanedSnapshotReconciler periodically finds the snapshots in the backup repositories that are not
+// referenced by any backup, either in the cluster or in the backup storage, and forgets them. This
+// happens if a backup is deleted while the Velero server is down or the deletion of the snapshots fails.
+type orphanedSnapshotReconciler struct {
+	client.Client
+	namespace         string
+	logger            logrus.FieldLogger
+	clock             clocks.WithTickerAndDelayedExecution
+	frequency         time.Duration
+	dryRun            bool
+	repoManager       repository.Manager
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	metrics           *metrics.ServerMetrics
+}
+
+// NewOrphanedSnapshotReconciler constructs a new orphanedSnapshotReconciler, the orphaned snapshots are
+// only reported but not forgotten if dryRun is true.
+func NewOrphanedSnapshotReconciler(
+	namespace string,
+	logger logrus.FieldLogger,
+	client client.Client,
+	frequency time.Duration,
+	dryRun bool,
+	repoManager repository.Manager,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
+) *orphanedSnapshotReconciler {
+	r := &orphanedSnapshotReconciler{
+		Client:            client,
+		namespace:         namespace,
+		logger:            logger,
+		clock:             clocks.RealClock{},
+		frequency:         frequency,
+		dryRun:            dryRun,
+		repoManager:       repoManager,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		metrics:           metrics,
+	}
+	if r.frequency <= 0 {
+		r.frequency = defaultOrphanedSnapshotCheckFrequency
+	}
+	return r
+}
+
+// SetupWithManager only reconciles the backup repositories periodically, the events of them are filtered
+func (r *orphanedSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.BackupRepositoryList{}, r.frequency, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(OrphanedSnapshot).
+		For(&velerov1api.BackupRepository{}, builder.WithPredicates(kube.FalsePredicate{})).
+		Watches(s, nil).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositories,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *orphanedSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backupRepo", req.String())
+
+	repo := &velerov1api.BackupRepository{}
+	if err := r.Get(ctx, req.NamespacedName, repo); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("backup repository not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup repository %s", req.String())
+	}
+
+	// snapshots of restic repositories can't be listed
+	if repo.Spec.RepositoryType != velerov1api.BackupRepositoryTypeKopia {
+		log.Debugf("Skip checking orphaned snapshots for %s repository", repo.Spec.RepositoryType)
+		return ctrl.Result{}, nil
+	}
+
+	if repo.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+		log.Debugf("Skip checking orphaned snapshots for backup repository in %s phase", repo.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: repo.Spec.BackupStorageLocation}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("Skip checking orphaned snapshots because backup storage location %s does not exist", repo.Spec.BackupStorageLocation)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup storage location %s", repo.Spec.BackupStorageLocation)
+	}
+
+	owners, err := r.getSnapshotOwners(ctx, location, log)
+	if err != nil {
+		// without the full list of the backups, any snapshot could be treated as orphaned by mistake
+		log.WithError(err).Error("Skip checking orphaned snapshots because the backups can't be listed")
+		return ctrl.Result{}, nil
+	}
+
+	snapshots, err := r.repoManager.ListSnapshots(repo)
+	if err != nil {
+		log.WithError(err).Error("Failed to list snapshots in backup repository")
+		return ctrl.Result{}, nil
+	}
+
+	orphaned := findOrphanedSnapshots(snapshots, owners, r.clock.Now())
+	r.metrics.SetRepoOrphanedSnapshots(repo.Name, len(orphaned))
+	if len(orphaned) == 0 {
+		log.Debugf("No orphaned snapshot found in %d snapshots", len(snapshots))
+		return ctrl.Result{}, nil
+	}
+
+	readOnly := location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+	for _, snapshot := range orphaned {
+		snapshotLog := log.WithFields(logrus.Fields{
+			"snapshotID": snapshot.ID,
+			"backup":     snapshot.Labels[repository.SnapshotTagBackup],
+			"backupUID":  snapshot.Labels[repository.SnapshotTagBackupUID],
+		})
+
+		if r.dryRun || readOnly {
+			snapshotLog.Info("Found orphaned snapshot not referenced by any backup")
+			continue
+		}
+
+		snapshotLog.Info("Forgetting orphaned snapshot not referenced by any backup")
+		if err := r.repoManager.Forget(ctx, repository.SnapshotIdentifier{
+			VolumeNamespace:       repo.Spec.VolumeNamespace,
+			BackupStorageLocation: repo.Spec.BackupStorageLocation,
+			SnapshotID:            string(snapshot.ID),
+			RepositoryType:        repo.Spec.RepositoryType,
+		}); err != nil {
+			snapshotLog.WithError(err).Error("Failed to forget orphaned snapshot")
+			r.metrics.RegisterRepoOrphanedSnapshotForgetFailed(repo.Name)
+			continue
+		}
+		r.metrics.RegisterRepoOrphanedSnapshotForgotten(repo.Name)
+	}
+
+	if readOnly && !r.dryRun {
+		log.Warnf("%d orphaned snapshots are not forgotten because backup storage location %s is read-only", len(orphaned), location.Name)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getSnapshotOwners returns the backups in the cluster and in the backup storage location
+func (r *orphanedSnapshotReconciler) getSnapshotOwners(ctx context.Context, location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (*repository.SnapshotOwners, error) {
+	backupList := &velerov1api.BackupList{}
+	if err := r.List(ctx, backupList, client.InNamespace(r.namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backups in the cluster")
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup store")
+	}
+
+	storeBackups, err := backupStore.ListBackups()
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing backups in backup store")
+	}
+
+	return repository.NewSnapshotOwners(backupList.Items, storeBackups), nil
+}
+
+// findOrphanedSnapshots returns the snapshots that are orphaned at the given time
+func findOrphanedSnapshots(snapshots []*udmrepo.ManifestEntryMetadata, owners *repository.SnapshotOwners, now time.Time) []*udmrepo.ManifestEntryMetadata {
+	var orphaned []*udmrepo.ManifestEntryMetadata
+	for _, snapshot := range snapshots {
+		if owners.IsOrphaned(snapshot.Labels, snapshot.ModTime, now) {
+			orphaned = append(orphaned, snapshot)
+		}
+	}
+
+	return orphaned
+}
@@ -0,0 +1,190 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repomokes "github.com/vmware-tanzu/velero/pkg/repository/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestFindOrphanedSnapshots(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-2 * repository.OrphanedSnapshotGracePeriod)
+
+	owners := repository.NewSnapshotOwners(
+		[]velerov1api.Backup{*builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").ObjectMeta(builder.WithUID("uid-1")).Result()},
+		[]string{"backup-1", "backup-2"},
+	)
+
+	snapshots := []*udmrepo.ManifestEntryMetadata{
+		{ID: "untagged", ModTime: old, Labels: map[string]string{}},
+		{ID: "in-cluster", ModTime: old, Labels: map[string]string{"backup": "backup-1", "backup-uid": "uid-1"}},
+		{ID: "in-store", ModTime: old, Labels: map[string]string{"backup": "backup-2", "backup-uid": "uid-2"}},
+		{ID: "orphaned", ModTime: old, Labels: map[string]string{"backup": "backup-3"}},
+		{ID: "orphaned-recently", ModTime: now, Labels: map[string]string{"backup": "backup-3"}},
+	}
+
+	orphaned := findOrphanedSnapshots(snapshots, owners, now)
+
+	var ids []udmrepo.ID
+	for _, snapshot := range orphaned {
+		ids = append(ids, snapshot.ID)
+	}
+	assert.Equal(t, []udmrepo.ID{"orphaned"}, ids)
+}
+
+func TestOrphanedSnapshotReconcile(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-2 * repository.OrphanedSnapshotGracePeriod)
+
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo-1"},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+		},
+		Status: velerov1api.BackupRepositoryStatus{Phase: velerov1api.BackupRepositoryPhaseReady},
+	}
+	resticRepo := repo.DeepCopy()
+	resticRepo.Spec.RepositoryType = velerov1api.BackupRepositoryTypeRestic
+
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	readOnlyLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result()
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").ObjectMeta(builder.WithUID("uid-1")).Result()
+
+	snapshots := []*udmrepo.ManifestEntryMetadata{
+		{ID: "snapshot-1", ModTime: old, Labels: map[string]string{"backup": "backup-1", "backup-uid": "uid-1"}},
+		{ID: "snapshot-2", ModTime: old, Labels: map[string]string{"backup": "backup-2", "backup-uid": "uid-2"}},
+		{ID: "snapshot-3", ModTime: old, Labels: map[string]string{"backup": "backup-3", "backup-uid": "uid-3"}},
+	}
+
+	tests := []struct {
+		name          string
+		repo          *velerov1api.BackupRepository
+		location      *velerov1api.BackupStorageLocation
+		dryRun        bool
+		storeBackups  []string
+		listStoreErr  error
+		expectList    bool
+		expectForgets []string
+	}{
+		{
+			name:     "restic repository is skipped",
+			repo:     resticRepo,
+			location: location,
+		},
+		{
+			name:         "snapshots are kept if backups in the backup store can't be listed",
+			repo:         repo,
+			location:     location,
+			listStoreErr: errors.New("fake-error"),
+		},
+		{
+			name:          "orphaned snapshots are forgotten",
+			repo:          repo,
+			location:      location,
+			storeBackups:  []string{"backup-2"},
+			expectList:    true,
+			expectForgets: []string{"snapshot-3"},
+		},
+		{
+			name:       "orphaned snapshots are only reported in dry run",
+			repo:       repo,
+			location:   location,
+			dryRun:     true,
+			expectList: true,
+		},
+		{
+			name:       "orphaned snapshots are only reported for read-only location",
+			repo:       repo,
+			location:   readOnlyLocation,
+			expectList: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t, test.repo, test.location, backup)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("ListBackups").Return(test.storeBackups, test.listStoreErr)
+
+			repoManager := &repomokes.Manager{}
+			repoManager.On("ListSnapshots", mock.Anything).Return(snapshots, nil)
+			repoManager.On("Forget", mock.Anything, mock.Anything).Return(nil)
+
+			r := NewOrphanedSnapshotReconciler(
+				velerov1api.DefaultNamespace,
+				velerotest.NewLogger(),
+				cli,
+				0,
+				test.dryRun,
+				repoManager,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+				metrics.NewServerMetrics(),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.repo.Namespace, Name: test.repo.Name}})
+			require.NoError(t, err)
+
+			if test.expectList {
+				repoManager.AssertCalled(t, "ListSnapshots", mock.Anything)
+			} else {
+				repoManager.AssertNotCalled(t, "ListSnapshots", mock.Anything)
+			}
+
+			var forgotten []string
+			for _, call := range repoManager.Calls {
+				if call.Method == "Forget" {
+					snapshot := call.Arguments.Get(1).(repository.SnapshotIdentifier)
+					assert.Equal(t, "ns-1", snapshot.VolumeNamespace)
+					assert.Equal(t, "default", snapshot.BackupStorageLocation)
+					assert.Equal(t, velerov1api.BackupRepositoryTypeKopia, snapshot.RepositoryType)
+					forgotten = append(forgotten, snapshot.SnapshotID)
+				}
+			}
+			assert.Equal(t, test.expectForgets, forgotten)
+		})
+	}
+}
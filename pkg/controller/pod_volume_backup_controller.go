@@ -48,7 +48,7 @@ const pVBRRequestor string = "pod-volume-backup-restore"
 
 // NewPodVolumeBackupReconciler creates the PodVolumeBackupReconciler instance
 func NewPodVolumeBackupReconciler(client client.Client, ensurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter,
-	nodeName string, scheme *runtime.Scheme, metrics *metrics.ServerMetrics, logger logrus.FieldLogger) *PodVolumeBackupReconciler {
+	nodeName string, scheme *runtime.Scheme, metrics *metrics.ServerMetrics, dataPathMgr *datapath.Manager, logger logrus.FieldLogger) *PodVolumeBackupReconciler {
 	return &PodVolumeBackupReconciler{
 		Client:            client,
 		logger:            logger.WithField("controller", "PodVolumeBackup"),
@@ -59,7 +59,7 @@ func NewPodVolumeBackupReconciler(client client.Client, ensurer *repository.Ensu
 		clock:             &clocks.RealClock{},
 		scheme:            scheme,
 		metrics:           metrics,
-		dataPathMgr:       datapath.NewManager(1),
+		dataPathMgr:       dataPathMgr,
 	}
 }
 
@@ -111,6 +111,19 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	switch pvb.Status.Phase {
 	case "", velerov1api.PodVolumeBackupPhaseNew:
 		// Only process new items.
+		if pvb.Spec.Cancel {
+			log.Info("PodVolumeBackup is canceled before it is started")
+			_ = UpdatePVBStatusToFailed(ctx, r.Client, &pvb, velerov1api.PodVolumeBackupCanceledMessage, r.clock.Now(), log)
+			return ctrl.Result{}, nil
+		}
+	case velerov1api.PodVolumeBackupPhaseInProgress:
+		if pvb.Spec.Cancel {
+			if fsBackup := r.dataPathMgr.GetAsyncBR(pvb.Name); fsBackup != nil {
+				log.Info("PodVolumeBackup is being canceled")
+				fsBackup.Cancel()
+			}
+		}
+		return ctrl.Result{}, nil
 	default:
 		log.Debug("PodVolumeBackup is not new, not processing")
 		return ctrl.Result{}, nil
@@ -137,7 +150,10 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	// Update status to InProgress.
 	original := pvb.DeepCopy()
 	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
-	pvb.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if pvb.Status.StartTimestamp == nil {
+		// the start timestamp is kept if the backup is resumed after the restart of the node-agent
+		pvb.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}
 	if err := r.Client.Patch(ctx, &pvb, client.MergeFrom(original)); err != nil {
 		return r.errorOut(ctx, &pvb, err, "error updating PodVolumeBackup status", log)
 	}
@@ -178,7 +194,13 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
-	if err := fsBackup.StartBackup(path, "", parentSnapshotID, false, pvb.Spec.Tags); err != nil {
+	tags := map[string]string{}
+	for k, v := range pvb.Spec.Tags {
+		tags[k] = v
+	}
+	tags[uploader.SnapshotRequestTag] = string(pvb.UID)
+
+	if err := fsBackup.StartBackup(path, "", parentSnapshotID, false, tags); err != nil {
 		return r.errorOut(ctx, &pvb, err, "error starting data path backup", log)
 	}
 
@@ -251,7 +273,8 @@ func (r *PodVolumeBackupReconciler) OnDataPathCancelled(ctx context.Context, nam
 	if getErr := r.Client.Get(ctx, types.NamespacedName{Name: pvbName, Namespace: namespace}, &pvb); getErr != nil {
 		log.WithError(getErr).Warn("Failed to get PVB on cancel")
 	} else {
-		_, _ = r.errorOut(ctx, &pvb, errors.New("PVB is canceled"), "data path backup canceled", log)
+		// the message tells the backup that the PVB is canceled rather than failed
+		_ = UpdatePVBStatusToFailed(ctx, r.Client, &pvb, velerov1api.PodVolumeBackupCanceledMessage, r.clock.Now(), log)
 	}
 }
 
@@ -353,6 +376,21 @@ func (r *PodVolumeBackupReconciler) errorOut(ctx context.Context, pvb *velerov1a
 	return ctrl.Result{}, err
 }
 
+// ResetPVBForResume moves a PodVolumeBackup that is interrupted by the restart of the node-agent back to
+// the new phase, so that the backup is started again and resumes from the checkpoint
+func ResetPVBForResume(ctx context.Context, c client.Client, pvb *velerov1api.PodVolumeBackup, msg string, log logrus.FieldLogger) error {
+	original := pvb.DeepCopy()
+	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseNew
+	pvb.Status.Message = msg
+
+	err := c.Patch(ctx, pvb, client.MergeFrom(original))
+	if err != nil {
+		log.WithError(err).Error("error updating PodVolumeBackup status")
+	}
+
+	return err
+}
+
 func UpdatePVBStatusToFailed(ctx context.Context, c client.Client, pvb *velerov1api.PodVolumeBackup, errString string, time time.Time, log logrus.FieldLogger) error {
 	original := pvb.DeepCopy()
 	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseFailed
@@ -179,7 +179,7 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 				test.dataMgr = datapath.NewManager(1)
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, time.Duration, logrus.FieldLogger) datapath.AsyncBR {
 				return &fakeFSBR{
 					pvb:    test.pvb,
 					client: fakeClient,
@@ -261,6 +261,21 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 				Result(),
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("canceled new phase pvb on same node should be failed", request{
+			pvb: pvbBuilder().
+				Phase(velerov1api.PodVolumeBackupPhaseNew).
+				Node("test_node").
+				Cancel(true).
+				Result(),
+			pod:               podBuilder().Result(),
+			bsl:               bslBuilder().Result(),
+			backupRepo:        buildBackupRepo(),
+			expectedProcessed: true,
+			expected: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+				Phase(velerov1api.PodVolumeBackupPhaseFailed).
+				Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
 		Entry("in progress phase pvb on same node should not be processed", request{
 			pvb: pvbBuilder().
 				Phase(velerov1api.PodVolumeBackupPhaseInProgress).
@@ -51,7 +51,7 @@ import (
 )
 
 func NewPodVolumeRestoreReconciler(client client.Client, ensurer *repository.Ensurer,
-	credentialGetter *credentials.CredentialGetter, logger logrus.FieldLogger) *PodVolumeRestoreReconciler {
+	credentialGetter *credentials.CredentialGetter, dataPathMgr *datapath.Manager, logger logrus.FieldLogger) *PodVolumeRestoreReconciler {
 	return &PodVolumeRestoreReconciler{
 		Client:            client,
 		logger:            logger.WithField("controller", "PodVolumeRestore"),
@@ -59,7 +59,7 @@ func NewPodVolumeRestoreReconciler(client client.Client, ensurer *repository.Ens
 		credentialGetter:  credentialGetter,
 		fileSystem:        filesystem.NewFileSystem(),
 		clock:             &clocks.RealClock{},
-		dataPathMgr:       datapath.NewManager(1),
+		dataPathMgr:       dataPathMgr,
 	}
 }
 
@@ -40,6 +40,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -53,6 +54,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
+// restoreCancelCheckInterval is how often a running restore checks whether it's requested to be canceled
+var restoreCancelCheckInterval = 5 * time.Second
+
 // nonRestorableResources is an exclusion list  for the restoration process. Any resources
 // included here are explicitly excluded from the restoration process.
 var nonRestorableResources = []string{
@@ -95,6 +99,7 @@ type restoreReconciler struct {
 	logFormat                   logging.Format
 	clock                       clock.WithTickerAndDelayedExecution
 	defaultItemOperationTimeout time.Duration
+	scopeHookHandler            hook.ScopeHookHandler
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
@@ -117,6 +122,7 @@ func NewRestoreReconciler(
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
 	defaultItemOperationTimeout time.Duration,
+	scopeHookHandler hook.ScopeHookHandler,
 ) *restoreReconciler {
 	r := &restoreReconciler{
 		ctx:                         ctx,
@@ -129,6 +135,7 @@ func NewRestoreReconciler(
 		logFormat:                   logFormat,
 		clock:                       &clock.RealClock{},
 		defaultItemOperationTimeout: defaultItemOperationTimeout,
+		scopeHookHandler:            scopeHookHandler,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
@@ -162,6 +169,16 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// store a copy of the original restore for creating patch
 	original := restore.DeepCopy()
 
+	if restore.Spec.Cancel {
+		log.Info("Restore is canceled before it is started")
+		restore.Status.Phase = api.RestorePhaseCancelled
+		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		if err := kubeutil.PatchResource(original, restore, r.kbClient); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating Restore phase to %s", restore.Status.Phase)
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Validate the restore and fetch the backup
 	info := r.validateAndComplete(restore)
 
@@ -207,7 +224,8 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// mark completion if in terminal phase
 	if restore.Status.Phase == api.RestorePhaseFailed ||
 		restore.Status.Phase == api.RestorePhasePartiallyFailed ||
-		restore.Status.Phase == api.RestorePhaseCompleted {
+		restore.Status.Phase == api.RestorePhaseCompleted ||
+		restore.Status.Phase == api.RestorePhaseCancelled {
 		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
 	}
 	log.Debug("Updating restore's final status")
@@ -297,6 +315,14 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) backupInfo
 		}
 	}
 
+	// validate Restore scope hooks
+	for _, err := range hook.ValidateScopeHooks(restore.Spec.Hooks.PreRestore) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+	for _, err := range hook.ValidateScopeHooks(restore.Spec.Hooks.PostRestore) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
 	// if ScheduleName is specified, fill in BackupName with the most recent successful backup from
 	// the schedule
 	if restore.Spec.ScheduleName != "" {
@@ -428,7 +454,10 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	}
 	actionsResolver := framework.NewRestoreItemActionResolverV2(actions)
 
-	backupFile, err := downloadToTempFile(restore.Spec.BackupName, backupStore, restoreLog)
+	// only the resources of the included namespaces are downloaded if the contents of the
+	// backup are split per namespace
+	namespaces := collections.NewIncludesExcludes().Includes(restore.Spec.IncludedNamespaces...).Excludes(restore.Spec.ExcludedNamespaces...)
+	backupFile, err := downloadBackupContents(info.backup, namespaces.ShouldInclude, backupStore, restoreLog)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
@@ -452,8 +481,6 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
-	restoreLog.Info("starting restore")
-
 	var podVolumeBackups []*api.PodVolumeBackup
 	for i := range podVolumeBackupList.Items {
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
@@ -466,7 +493,30 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		VolumeSnapshots:  volumeSnapshots,
 		BackupReader:     backupFile,
 	}
+
+	preHookStatuses, preHookWarnings, err := r.scopeHookHandler.HandleHooks(r.ctx, restoreLog, restore.Spec.Hooks.PreRestore, hook.PhasePre, restoreScopeHookLabels(restore), restoreReq.GetHookTracker())
+	restore.Status.ScopeHooks = append(restore.Status.ScopeHooks, preHookStatuses...)
+	if err != nil {
+		return errors.Wrap(err, "error running pre-restore hooks")
+	}
+
+	restoreLog.Info("starting restore")
+
+	stopCancelWatch := make(chan struct{})
+	go r.watchRestoreCancel(restoreReq, stopCancelWatch, restoreLog)
 	restoreWarnings, restoreErrors := r.restorer.RestoreWithResolvers(restoreReq, actionsResolver, pluginManager)
+	close(stopCancelWatch)
+	for _, w := range preHookWarnings {
+		restoreWarnings.Velero = append(restoreWarnings.Velero, w.Error())
+	}
+
+	// the async operations and the data mover restores started before the cancellation are
+	// not waited for
+	if restoreReq.IsCancelled() {
+		restoreLog.Info("Restore is canceled, canceling the ongoing asynchronous operations and data path")
+		cancelRestoreItemOperations(restore, pluginManager, *restoreReq.GetItemOperationsList())
+		cancelRestoreDataPath(context.Background(), r.kbClient, restore, restoreLog)
+	}
 
 	// Iterate over restore item operations and update progress.
 	// Any errors on operations at this point should be added to restore errors.
@@ -483,6 +533,20 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	restore.Status.RestoreItemOperationsCompleted = opsCompleted
 	restore.Status.RestoreItemOperationsFailed = opsFailed
 
+	// post-restore hooks run once all items are restored; if async operations are still
+	// running, the restore operations controller runs them when the operations finish.
+	if !inProgressOperations {
+		postHookStatuses, postHookWarnings, err := r.scopeHookHandler.HandleHooks(r.ctx, restoreLog, restore.Spec.Hooks.PostRestore, hook.PhasePost, restoreScopeHookLabels(restore), restoreReq.GetHookTracker())
+		restore.Status.ScopeHooks = append(restore.Status.ScopeHooks, postHookStatuses...)
+		for _, w := range postHookWarnings {
+			restoreWarnings.Velero = append(restoreWarnings.Velero, w.Error())
+		}
+		if err != nil {
+			restoreErrors.Velero = append(restoreErrors.Velero, err.Error())
+		}
+	}
+	restore.Status.HookStatus = restoreReq.GetHookTracker().HookStatus()
+
 	// log errors and warnings to the restore log
 	for _, msg := range restoreErrors.Velero {
 		restoreLog.Errorf("Velero restore error: %v", msg)
@@ -551,11 +615,22 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		r.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
 	}
 
+	if err := putRestoreItemStatus(restore, restoreReq.RestoredItemStatusList(), backupStore); err != nil {
+		r.logger.WithError(err).Error("Error uploading restore item status list to backup storage")
+	}
+
+	if err := putRestoreHookResults(restore, restoreReq.GetHookTracker().Executions(), backupStore); err != nil {
+		r.logger.WithError(err).Error("Error uploading restore hook results to backup storage")
+	}
+
 	if err := putOperationsForRestore(restore, *restoreReq.GetItemOperationsList(), backupStore); err != nil {
 		r.logger.WithError(err).Error("Error uploading restore item action operation resource list to backup storage")
 	}
 
-	if restore.Status.Errors > 0 {
+	if restoreReq.IsCancelled() {
+		r.logger.Debug("Restore canceled")
+		restore.Status.Phase = api.RestorePhaseCancelled
+	} else if restore.Status.Errors > 0 {
 		if inProgressOperations {
 			r.logger.Debug("Restore WaitingForPluginOperationsPartiallyFailed")
 			restore.Status.Phase = api.RestorePhaseWaitingForPluginOperationsPartiallyFailed
@@ -577,6 +652,35 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	return nil
 }
 
+// watchRestoreCancel checks the restore periodically until stop is closed, and cancels the running
+// restore request once the restore is requested to be canceled.
+func (r *restoreReconciler) watchRestoreCancel(restore *pkgrestore.Request, stop <-chan struct{}, log logrus.FieldLogger) {
+	wait.Until(func() {
+		if restore.IsCancelled() {
+			return
+		}
+
+		current := &api.Restore{}
+		if err := r.kbClient.Get(context.Background(), client.ObjectKeyFromObject(restore.Restore), current); err != nil {
+			log.WithError(err).Warn("Failed to check whether the restore is canceled")
+			return
+		}
+
+		if current.Spec.Cancel {
+			log.Info("Restore is requested to be canceled")
+			restore.Cancel()
+		}
+	}, restoreCancelCheckInterval, stop)
+}
+
+// restoreScopeHookLabels returns the labels added to the objects created by the restore's scope hooks.
+func restoreScopeHookLabels(restore *api.Restore) map[string]string {
+	return map[string]string{
+		api.RestoreNameLabel: label.GetValidName(restore.Name),
+		api.RestoreUIDLabel:  string(restore.UID),
+	}
+}
+
 // updateTotalRestoreMetric update the velero_restore_total metric every minute.
 func (r *restoreReconciler) updateTotalRestoreMetric() {
 	go func() {
@@ -620,6 +724,29 @@ func putResults(restore *api.Restore, results map[string]results.Result, backupS
 	return nil
 }
 
+// addRestoreResults adds warnings and errors to the Velero messages of the results of a
+// restore that already finished restoring its items.
+func addRestoreResults(restore *api.Restore, warnings, errs []string, backupStore persistence.BackupStore) error {
+	if len(warnings) == 0 && len(errs) == 0 {
+		return nil
+	}
+
+	m, err := backupStore.GetRestoreResults(restore.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting restore results")
+	}
+	if m == nil {
+		m = make(map[string]results.Result)
+	}
+
+	restoreWarnings, restoreErrors := m["warnings"], m["errors"]
+	restoreWarnings.Velero = append(restoreWarnings.Velero, warnings...)
+	restoreErrors.Velero = append(restoreErrors.Velero, errs...)
+	m["warnings"], m["errors"] = restoreWarnings, restoreErrors
+
+	return putResults(restore, m, backupStore)
+}
+
 func putRestoredResourceList(restore *api.Restore, list map[string][]string, backupStore persistence.BackupStore) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -640,6 +767,46 @@ func putRestoredResourceList(restore *api.Restore, list map[string][]string, bac
 	return nil
 }
 
+func putRestoreItemStatus(restore *api.Restore, list []results.ItemStatus, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(list); err != nil {
+		return errors.Wrap(err, "error encoding restore item status list to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	if err := backupStore.PutRestoreItemStatus(restore.Name, buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func putRestoreHookResults(restore *api.Restore, executions []results.HookExecution, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(executions); err != nil {
+		return errors.Wrap(err, "error encoding restore hook results to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	if err := backupStore.PutRestoreHookResults(restore.Name, buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func putOperationsForRestore(restore *api.Restore, operations []*itemoperation.RestoreOperation, backupStore persistence.BackupStore) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -694,3 +861,43 @@ func downloadToTempFile(backupName string, backupStore persistence.BackupStore,
 
 	return file, nil
 }
+
+// downloadBackupContents downloads the contents of a backup to a temp file. If the contents are
+// split per namespace, only the archives of the namespaces includeNamespace returns true for are
+// downloaded, along with the cluster archive, and merged into an uncompressed tarball.
+func downloadBackupContents(backup *api.Backup, includeNamespace func(string) bool, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
+	if backup.Status.ContentsLayout != api.BackupContentsLayoutPerNamespace {
+		return downloadToTempFile(backup.Name, backupStore, logger)
+	}
+
+	index, err := backupStore.GetBackupContentsIndex(backup.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the index of the backup archives")
+	}
+
+	file, err := os.CreateTemp("", backup.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating Backup temp file")
+	}
+
+	archives := index.Archives(includeNamespace)
+	if err := archive.MergeArchives(file, archives, func(name string) (io.ReadCloser, error) {
+		return backupStore.GetBackupContentsArchive(backup.Name, name)
+	}); err != nil {
+		closeAndRemoveFile(file, logger)
+		return nil, errors.Wrap(err, "error downloading the Backup archives")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"backup":   backup.Name,
+		"fileName": file.Name(),
+		"archives": len(archives),
+	}).Debugf("Merged %d of the %d Backup archives to file", len(archives), len(index.Namespaces)+1)
+
+	if _, err := file.Seek(0, 0); err != nil {
+		closeAndRemoveFile(file, logger)
+		return nil, errors.Wrap(err, "error resetting Backup file offset")
+	}
+
+	return file, nil
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package controller
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"io"
 	"testing"
@@ -34,7 +36,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
@@ -111,6 +115,7 @@ func TestFetchBackupInfo(t *testing.T) {
 				metrics.NewServerMetrics(),
 				formatFlag,
 				60*time.Minute,
+				&hook.DefaultScopeHookHandler{},
 			)
 
 			if test.backupStoreError == nil {
@@ -194,6 +199,7 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				metrics.NewServerMetrics(),
 				formatFlag,
 				60*time.Minute,
+				&hook.DefaultScopeHookHandler{},
 			)
 
 			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{
@@ -276,6 +282,15 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedValidationErrors: []string{"encountered labelSelector as well as orLabelSelectors in restore spec, only one can be specified"},
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 		},
+		{
+			name:                     "restore with a scope hook without an action fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PreRestoreHooks(velerov1api.ScopeHook{Name: "scale-down"}).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedValidationErrors: []string{"scope hook scale-down must specify exactly one of job or http"},
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+		},
 		{
 			name:                  "valid restore with schedule name gets executed",
 			location:              defaultStorageLocation,
@@ -424,6 +439,7 @@ func TestRestoreReconcile(t *testing.T) {
 				metrics.NewServerMetrics(),
 				formatFlag,
 				60*time.Minute,
+				&hook.DefaultScopeHookHandler{},
 			)
 
 			r.clock = clocktesting.NewFakeClock(now)
@@ -455,6 +471,8 @@ func TestRestoreReconcile(t *testing.T) {
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 				backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreItemStatus", test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreHookResults", test.restore.Name, mock.Anything).Return(nil)
 				backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
 
 				volumeSnapshots := []*volume.Snapshot{
@@ -589,6 +607,7 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		metrics.NewServerMetrics(),
 		formatFlag,
 		60*time.Minute,
+		&hook.DefaultScopeHookHandler{},
 	)
 
 	restore := &velerov1api.Restore{
@@ -764,6 +783,76 @@ func NewRestore(ns, name, backup, includeNS, includeResource string, phase veler
 	return restore
 }
 
+func TestDownloadBackupContents(t *testing.T) {
+	tarball := func(names ...string) io.ReadCloser {
+		buf := new(bytes.Buffer)
+		gzw := gzip.NewWriter(buf)
+		tw := tar.NewWriter(gzw)
+		for _, name := range names {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(name)), Typeflag: tar.TypeReg, Mode: 0755}))
+			_, err := tw.Write([]byte(name))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gzw.Close())
+		return io.NopCloser(buf)
+	}
+
+	t.Run("single layout downloads the tarball", func(t *testing.T) {
+		backupStore := &persistencemocks.BackupStore{}
+		backupStore.On("GetBackupContents", "backup-1").Return(tarball("metadata/version"), nil)
+
+		file, err := downloadBackupContents(defaultBackup().ObjectMeta(builder.WithName("backup-1")).Result(), nil, backupStore, velerotest.NewLogger())
+		require.NoError(t, err)
+		defer closeAndRemoveFile(file, velerotest.NewLogger())
+
+		backupStore.AssertExpectations(t)
+	})
+
+	t.Run("per namespace layout only downloads the included namespaces", func(t *testing.T) {
+		backup := defaultBackup().ObjectMeta(builder.WithName("backup-1")).Result()
+		backup.Status.ContentsLayout = velerov1api.BackupContentsLayoutPerNamespace
+
+		backupStore := &persistencemocks.BackupStore{}
+		backupStore.On("GetBackupContentsIndex", "backup-1").Return(&archive.Index{Namespaces: []string{"ns-1", "ns-2"}}, nil)
+		backupStore.On("GetBackupContentsArchive", "backup-1", archive.ClusterArchive).Return(tarball("metadata/version", "resources/persistentvolumes/cluster/pv-1.json"), nil)
+		backupStore.On("GetBackupContentsArchive", "backup-1", archive.NamespaceArchive("ns-2")).Return(tarball("resources/pods/namespaces/ns-2/pod-1.json"), nil)
+
+		file, err := downloadBackupContents(backup, func(namespace string) bool { return namespace == "ns-2" }, backupStore, velerotest.NewLogger())
+		require.NoError(t, err)
+		defer closeAndRemoveFile(file, velerotest.NewLogger())
+
+		backupStore.AssertExpectations(t)
+
+		var names []string
+		tr := tar.NewReader(file)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			names = append(names, header.Name)
+		}
+		assert.Equal(t, []string{
+			"metadata/version",
+			"resources/persistentvolumes/cluster/pv-1.json",
+			"resources/pods/namespaces/ns-2/pod-1.json",
+		}, names)
+	})
+
+	t.Run("per namespace layout without an index", func(t *testing.T) {
+		backup := defaultBackup().ObjectMeta(builder.WithName("backup-1")).Result()
+		backup.Status.ContentsLayout = velerov1api.BackupContentsLayoutPerNamespace
+
+		backupStore := &persistencemocks.BackupStore{}
+		backupStore.On("GetBackupContentsIndex", "backup-1").Return(nil, errors.New("key not found"))
+
+		_, err := downloadBackupContents(backup, nil, backupStore, velerotest.NewLogger())
+		assert.EqualError(t, err, "error getting the index of the backup archives: key not found")
+	})
+}
+
 type fakeRestorer struct {
 	mock.Mock
 	calledWithArg velerov1api.Restore
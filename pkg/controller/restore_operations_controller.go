@@ -29,9 +29,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -52,6 +55,7 @@ type restoreOperationsReconciler struct {
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	metrics           *metrics.ServerMetrics
+	scopeHookHandler  hook.ScopeHookHandler
 }
 
 func NewRestoreOperationsReconciler(
@@ -63,6 +67,7 @@ func NewRestoreOperationsReconciler(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	itemOperationsMap *itemoperationmap.RestoreItemOperationsMap,
+	scopeHookHandler hook.ScopeHookHandler,
 ) *restoreOperationsReconciler {
 	abor := &restoreOperationsReconciler{
 		Client:            client,
@@ -74,6 +79,7 @@ func NewRestoreOperationsReconciler(
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
 		metrics:           metrics,
+		scopeHookHandler:  scopeHookHandler,
 	}
 	if abor.frequency <= 0 {
 		abor.frequency = defaultRestoreOperationsFrequency
@@ -126,6 +132,11 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, nil
 	}
 
+	if restore.Spec.Paused && !restore.Spec.Cancel {
+		log.Debug("Restore is paused, skipping")
+		return ctrl.Result{}, nil
+	}
+
 	info, err := r.fetchBackupInfo(restore.Spec.BackupName)
 	if err != nil {
 		log.Warnf("Cannot check progress on Restore operations because backup info is unavailable %s; marking restore PartiallyFailed", err.Error())
@@ -163,7 +174,41 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 		return ctrl.Result{}, errors.Wrap(err, "error getting restore operations")
 	}
+
+	if restore.Spec.Cancel {
+		log.Infof("Restore %s is canceled, canceling the ongoing plugin operations", restore.Name)
+		canceled := cancelRestoreItemOperations(restore, pluginManager, operations.Operations)
+		if canceled > 0 {
+			operations.ChangesSinceUpdate = true
+		}
+		cancelRestoreDataPath(ctx, r.Client, restore, log)
+
+		hookWarnings, hookErrs, err := r.runPostRestoreHooks(ctx, log, restore, backupStore)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		restore.Status.Warnings += len(hookWarnings)
+		restore.Status.Errors += len(hookErrs)
+
+		restore.Status.RestoreItemOperationsFailed += canceled
+		restore.Status.Phase = velerov1api.RestorePhaseCancelled
+		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		if err := r.updateRestoreAndOperationsJSON(ctx, original, restore, backupStore, operations, canceled > 0, true); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error updating Restore")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	stillInProgress, changes, opsCompleted, opsFailed, errs := getRestoreItemOperationProgress(restore, pluginManager, operations.Operations)
+	if !stillInProgress {
+		// all async operations are done, so the post-restore hooks can run now
+		hookWarnings, hookErrs, err := r.runPostRestoreHooks(ctx, log, restore, backupStore)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		restore.Status.Warnings += len(hookWarnings)
+		errs = append(errs, hookErrs...)
+	}
 	// if len(errs)>0, need to update restore errors and error log
 	operations.ErrsSinceUpdate = append(operations.ErrsSinceUpdate, errs...)
 	restore.Status.Errors += len(operations.ErrsSinceUpdate)
@@ -208,6 +253,50 @@ func (r *restoreOperationsReconciler) fetchBackupInfo(backupName string) (backup
 	return fetchBackupInfoInternal(r.Client, r.namespace, backupName)
 }
 
+// runPostRestoreHooks runs the post-restore hooks of a restore, unless they already ran, and
+// adds their warnings and errors to the restore results. The hooks are recorded as started in
+// the status of the restore before they run, so that they aren't run again if the reconcile
+// is retried or the server restarts.
+func (r *restoreOperationsReconciler) runPostRestoreHooks(ctx context.Context, log logrus.FieldLogger, restore *velerov1api.Restore, backupStore persistence.BackupStore) (warnings, errs []string, err error) {
+	hooks := restore.Spec.Hooks.PostRestore
+	if len(hooks) == 0 || scopeHookPhaseRan(restore.Status.ScopeHooks, string(hook.PhasePost)) {
+		return nil, nil, nil
+	}
+
+	original := restore.DeepCopy()
+	started := metav1.Time{Time: r.clock.Now()}
+	for _, h := range hooks {
+		restore.Status.ScopeHooks = append(restore.Status.ScopeHooks, velerov1api.ScopeHookStatus{
+			Name:           h.Name,
+			Phase:          string(hook.PhasePost),
+			Result:         velerov1api.ScopeHookResultStarted,
+			StartTimestamp: &started,
+		})
+	}
+	if err := r.Client.Patch(ctx, restore, client.MergeFrom(original)); err != nil {
+		return nil, nil, errors.Wrap(err, "error recording the post-restore hooks as started")
+	}
+
+	tracker := hook.NewHookTracker()
+	statuses, hookWarnings, hookErr := r.scopeHookHandler.HandleHooks(ctx, log, hooks, hook.PhasePost, restoreScopeHookLabels(restore), tracker)
+	// the hooks after a failed hook aren't run, so they don't keep a status
+	restore.Status.ScopeHooks = append(restore.Status.ScopeHooks[:len(restore.Status.ScopeHooks)-len(hooks)], statuses...)
+	restore.Status.HookStatus = tracker.AddToHookStatus(restore.Status.HookStatus)
+
+	for _, w := range hookWarnings {
+		warnings = append(warnings, w.Error())
+	}
+	if hookErr != nil {
+		errs = append(errs, hookErr.Error())
+	}
+
+	if err := addRestoreResults(restore, warnings, errs, backupStore); err != nil {
+		log.WithError(err).Error("Error adding the post-restore hook results to the restore results")
+	}
+
+	return warnings, errs, nil
+}
+
 func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	ctx context.Context,
 	original, restore *velerov1api.Restore,
@@ -223,7 +312,8 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	defer func() {
 		// remove local operations list if complete
 		if removeIfComplete && (restore.Status.Phase == velerov1api.RestorePhaseCompleted ||
-			restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed) {
+			restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed ||
+			restore.Status.Phase == velerov1api.RestorePhaseCancelled) {
 			r.itemOperationsMap.DeleteOperationsForRestore(restore.Name)
 		} else if changes {
 			r.itemOperationsMap.PutOperationsForRestore(operations, restore.Name)
@@ -233,7 +323,8 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	// update restore and upload progress if errs or complete
 	if len(operations.ErrsSinceUpdate) > 0 ||
 		restore.Status.Phase == velerov1api.RestorePhaseCompleted ||
-		restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed {
+		restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed ||
+		restore.Status.Phase == velerov1api.RestorePhaseCancelled {
 		// update file store
 		if backupStore != nil {
 			if err := r.itemOperationsMap.UploadProgressAndPutOperationsForRestore(backupStore, operations, restore.Name); err != nil {
@@ -356,3 +447,51 @@ func getRestoreItemOperationProgress(
 	}
 	return inProgressOperations, changes, completedCount, failedCount, errs
 }
+
+// cancelRestoreItemOperations cancels the restore's async operations that are still running and marks them
+// failed, it returns the number of the canceled operations
+func cancelRestoreItemOperations(
+	restore *velerov1api.Restore,
+	pluginManager clientmgmt.Manager,
+	operationsList []*itemoperation.RestoreOperation) int {
+	canceled := 0
+	for _, operation := range operationsList {
+		if operation.Status.Phase != itemoperation.OperationPhaseNew &&
+			operation.Status.Phase != itemoperation.OperationPhaseInProgress {
+			continue
+		}
+		if ria, err := pluginManager.GetRestoreItemActionV2(operation.Spec.RestoreItemAction); err == nil {
+			_ = ria.Cancel(operation.Spec.OperationID, restore)
+		}
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = "Asynchronous action canceled"
+		canceled++
+	}
+	return canceled
+}
+
+// cancelRestoreDataPath requests to cancel the DataDownloads of the restore that are not finished yet.
+// PodVolumeRestores can't be canceled, they run to the end.
+func cancelRestoreDataPath(ctx context.Context, cli client.Client, restore *velerov1api.Restore, log logrus.FieldLogger) {
+	dds := &velerov2alpha1api.DataDownloadList{}
+	if err := cli.List(ctx, dds, client.InNamespace(restore.Namespace),
+		client.MatchingLabels{velerov1api.RestoreNameLabel: label.GetValidName(restore.Name)}); err != nil {
+		log.WithError(err).Warn("Failed to list DataDownloads of the canceled restore")
+		return
+	}
+	for i := range dds.Items {
+		dd := &dds.Items[i]
+		if dd.Spec.Cancel ||
+			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseCompleted ||
+			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseFailed ||
+			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseCanceling ||
+			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseCanceled {
+			continue
+		}
+		original := dd.DeepCopy()
+		dd.Spec.Cancel = true
+		if err := cli.Patch(ctx, dd, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Warnf("Failed to cancel DataDownload %s", dd.Name)
+		}
+	}
+}
@@ -17,7 +17,12 @@ limitations under the License.
 package controller
 
 import (
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -32,6 +37,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
@@ -44,6 +50,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	riav2mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/restoreitemaction/v2"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 var (
@@ -62,6 +69,7 @@ func mockRestoreOperationsReconciler(fakeClient kbclient.Client, fakeClock *test
 		NewFakeSingleObjectBackupStoreGetter(restoreBackupStore),
 		metrics.NewServerMetrics(),
 		itemoperationmap.NewRestoreItemOperationsMap(),
+		&hook.DefaultScopeHookHandler{},
 	)
 	abor.clock = fakeClock
 	return abor
@@ -272,6 +280,70 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Canceled WaitingForPluginOperations restore with incomplete operations is Cancelled",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-17").
+				Backup("backup-1").
+				ItemOperationTimeout(60 * time.Minute).
+				ObjectMeta(builder.WithUID("foo-17")).
+				Cancel(true).
+				Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result(),
+			backup:            defaultBackup().StorageLocation("default").Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: false,
+			expectPhase:       velerov1api.RestorePhaseCancelled,
+			restoreOperations: []*itemoperation.RestoreOperation{
+				{
+					Spec: itemoperation.RestoreOperationSpec{
+						RestoreName:       "restore-17",
+						RestoreUID:        "foo-17",
+						RestoreItemAction: "foo-17",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-17",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseInProgress,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
+		{
+			name: "Paused WaitingForPluginOperations restore with completed operations is not progressed",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-18").
+				Backup("backup-1").
+				ItemOperationTimeout(60 * time.Minute).
+				ObjectMeta(builder.WithUID("foo-18")).
+				Paused(true).
+				Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result(),
+			backup:            defaultBackup().StorageLocation("default").Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: true,
+			expectPhase:       velerov1api.RestorePhaseWaitingForPluginOperations,
+			restoreOperations: []*itemoperation.RestoreOperation{
+				{
+					Spec: itemoperation.RestoreOperationSpec{
+						RestoreName:       "restore-18",
+						RestoreUID:        "foo-18",
+						RestoreItemAction: "foo-18",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-18",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseInProgress,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -300,6 +372,7 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 						Completed: test.operationComplete,
 						Err:       test.operationErr,
 					}, nil)
+				ria.On("Cancel", operation.Spec.OperationID, mock.Anything).Return(nil)
 				restorePluginManager.On("GetRestoreItemActionV2", operation.Spec.RestoreItemAction).Return(ria, nil)
 			}
 
@@ -318,3 +391,71 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestRunPostRestoreHooks(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+		Backup("backup-1").
+		Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result()
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, restore)
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	r := mockRestoreOperationsReconciler(fakeClient, fakeClock, defaultRestoreOperationsFrequency)
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		// the hooks are recorded as started before they run
+		current := &velerov1api.Restore{}
+		require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKeyFromObject(restore), current))
+		require.Len(t, current.Status.ScopeHooks, 2)
+		for _, status := range current.Status.ScopeHooks {
+			assert.Equal(t, velerov1api.ScopeHookResultStarted, status.Result)
+		}
+
+		if req.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	current := &velerov1api.Restore{}
+	require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKeyFromObject(restore), current))
+	current.Spec.Hooks.PostRestore = []velerov1api.ScopeHook{
+		{Name: "warm-up", HTTP: &velerov1api.HTTPHook{URL: server.URL + "/fail"}},
+		{Name: "notify", HTTP: &velerov1api.HTTPHook{URL: server.URL + "/ok"}},
+	}
+	require.NoError(t, fakeClient.Update(context.Background(), current))
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetRestoreResults", "restore-1").Return(map[string]results.Result{
+		"warnings": {Namespaces: map[string][]string{"ns-1": {"restore warning"}}},
+	}, nil)
+	backupStore.On("PutRestoreResults", "backup-1", "restore-1", mock.Anything).Return(nil)
+
+	warnings, errs, err := r.runPostRestoreHooks(context.Background(), velerotest.NewLogger(), current, backupStore)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "post hook warm-up failed")
+	assert.Empty(t, errs)
+	assert.Equal(t, 2, calls)
+	require.Len(t, current.Status.ScopeHooks, 2)
+	assert.Equal(t, velerov1api.ScopeHookResultFailed, current.Status.ScopeHooks[0].Result)
+	assert.Equal(t, velerov1api.ScopeHookResultSucceeded, current.Status.ScopeHooks[1].Result)
+	backupStore.AssertExpectations(t)
+
+	// the hook warnings are added to the results, the restore warnings are kept
+	gzr, err := gzip.NewReader(backupStore.Calls[1].Arguments.Get(2).(io.Reader))
+	require.NoError(t, err)
+	restoreResults := map[string]results.Result{}
+	require.NoError(t, json.NewDecoder(gzr).Decode(&restoreResults))
+	assert.Equal(t, warnings, restoreResults["warnings"].Velero)
+	assert.Equal(t, []string{"restore warning"}, restoreResults["warnings"].Namespaces["ns-1"])
+
+	// a retried reconcile doesn't run the hooks again
+	require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKeyFromObject(restore), current))
+	warnings, errs, err = r.runPostRestoreHooks(context.Background(), velerotest.NewLogger(), current, backupStore)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Empty(t, errs)
+	assert.Equal(t, 2, calls)
+}
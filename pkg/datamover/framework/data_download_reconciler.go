@@ -0,0 +1,215 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// DataDownloadReconciler drives the DataDownloads of a third-party data mover through the same
+// phases as DataDownloadReconciler does for DataDownloads.
+type DataDownloadReconciler struct {
+	client     kbclient.Client
+	mover      Mover
+	name       string
+	clock      clocks.WithTickerAndDelayedExecution
+	logger     logrus.FieldLogger
+	operations *operations
+}
+
+// NewDataDownloadReconciler creates a reconciler for the DataDownloads of the mover, name identifies the
+// current instance of the mover, e.g. the name of the pod or the node it runs in
+func NewDataDownloadReconciler(client kbclient.Client, mover Mover, name string, logger logrus.FieldLogger) *DataDownloadReconciler {
+	return &DataDownloadReconciler{
+		client:     client,
+		mover:      mover,
+		name:       name,
+		clock:      clocks.RealClock{},
+		logger:     logger,
+		operations: newOperations(),
+	}
+}
+
+func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"data mover":   r.mover.Name(),
+		"datadownload": req.NamespacedName,
+	})
+
+	dd := &velerov2alpha1api.DataDownload{}
+	if err := r.client.Get(ctx, req.NamespacedName, dd); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataDownload")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "getting DataDownload")
+	}
+
+	if dd.Spec.DataMover != r.mover.Name() {
+		return ctrl.Result{}, nil
+	}
+
+	switch dd.Status.Phase {
+	case "", velerov2alpha1api.DataDownloadPhaseNew:
+		if dd.Spec.Cancel {
+			log.Info("Data download is canceled before it is accepted")
+			return ctrl.Result{}, r.finish(ctx, dd, velerov2alpha1api.DataDownloadPhaseCanceled, nil, log)
+		}
+
+		accepted, err := r.accept(ctx, dd)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error to accept the data download")
+		}
+
+		if !accepted {
+			log.Debug("Data download is accepted by others")
+			return ctrl.Result{}, nil
+		}
+
+		log.Info("Data download is accepted")
+
+		go r.run(r.operations.start(req.NamespacedName), dd.DeepCopy(), log)
+	case velerov2alpha1api.DataDownloadPhaseAccepted, velerov2alpha1api.DataDownloadPhaseInProgress, velerov2alpha1api.DataDownloadPhaseCanceling:
+		if dd.Status.Node != r.name {
+			return ctrl.Result{}, nil
+		}
+
+		if !r.operations.running(req.NamespacedName) {
+			// the data movement is lost as the mover restarted
+			err := errors.Errorf("found a datadownload with status %q during the data mover starting, mark it as failed", dd.Status.Phase)
+			return ctrl.Result{}, r.finish(ctx, dd, velerov2alpha1api.DataDownloadPhaseFailed, err, log)
+		}
+
+		if dd.Spec.Cancel && dd.Status.Phase != velerov2alpha1api.DataDownloadPhaseCanceling {
+			log.Info("Data download is being canceled")
+
+			original := dd.DeepCopy()
+			dd.Status.Phase = velerov2alpha1api.DataDownloadPhaseCanceling
+			if err := r.client.Patch(ctx, dd, kbclient.MergeFrom(original)); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "error updating data download into canceling status")
+			}
+
+			r.operations.cancel(req.NamespacedName)
+		}
+	default:
+		log.Debugf("Data download now is in %s phase and do nothing", dd.Status.Phase)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the DataDownload reconciler for the DataDownloads of the mover
+func (r *DataDownloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("datadownload-" + r.mover.Name()).
+		For(&velerov2alpha1api.DataDownload{}).
+		WithEventFilter(predicate.NewPredicateFuncs(func(obj kbclient.Object) bool {
+			dd, ok := obj.(*velerov2alpha1api.DataDownload)
+			return ok && dd.Spec.DataMover == r.mover.Name()
+		})).
+		Complete(r)
+}
+
+func (r *DataDownloadReconciler) accept(ctx context.Context, dd *velerov2alpha1api.DataDownload) (bool, error) {
+	updated := dd.DeepCopy()
+	updated.Status.Phase = velerov2alpha1api.DataDownloadPhaseAccepted
+	updated.Status.Node = r.name
+	updated.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	// all the instances of the mover try to update the DataDownload and only one of them succeeds
+	err := r.client.Update(ctx, updated)
+	if err == nil {
+		*dd = *updated
+		return true, nil
+	} else if apierrors.IsConflict(err) {
+		return false, nil
+	} else {
+		return false, err
+	}
+}
+
+// run moves the data of the DataDownload and updates the result to the DataDownload
+func (r *DataDownloadReconciler) run(ctx context.Context, dd *velerov2alpha1api.DataDownload, log logrus.FieldLogger) {
+	key := types.NamespacedName{Namespace: dd.Namespace, Name: dd.Name}
+	defer r.operations.finish(key)
+
+	// the status is updated with a fresh context as ctx is canceled when the DataDownload is canceled
+	statusCtx := context.Background()
+
+	original := dd.DeepCopy()
+	dd.Status.Phase = velerov2alpha1api.DataDownloadPhaseInProgress
+	if err := r.client.Patch(statusCtx, dd, kbclient.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Error updating data download into in progress status")
+		r.finish(statusCtx, dd, velerov2alpha1api.DataDownloadPhaseFailed, errors.Wrap(err, "error updating data download status"), log)
+		return
+	}
+
+	log.Info("Data download is in progress")
+
+	err := r.mover.Restore(ctx, dd.DeepCopy(), func(progress shared.DataMoveOperationProgress) {
+		obj := &velerov2alpha1api.DataDownload{ObjectMeta: metav1.ObjectMeta{Namespace: dd.Namespace, Name: dd.Name}}
+		if err := patchProgress(statusCtx, r.client, obj, progress); err != nil {
+			log.WithError(err).Warn("Failed to update progress")
+		}
+	})
+
+	if ctx.Err() != nil {
+		log.Info("Data download is canceled")
+		r.finish(statusCtx, dd, velerov2alpha1api.DataDownloadPhaseCanceled, nil, log)
+	} else if err != nil {
+		log.WithError(err).Error("Data download failed")
+		r.finish(statusCtx, dd, velerov2alpha1api.DataDownloadPhaseFailed, errors.Wrap(err, "data mover restore failed"), log)
+	} else {
+		log.Info("Data download is completed")
+		r.finish(statusCtx, dd, velerov2alpha1api.DataDownloadPhaseCompleted, nil, log)
+	}
+}
+
+// finish updates the DataDownload into the final phase
+func (r *DataDownloadReconciler) finish(ctx context.Context, dd *velerov2alpha1api.DataDownload, phase velerov2alpha1api.DataDownloadPhase,
+	err error, log logrus.FieldLogger) error {
+	original := dd.DeepCopy()
+	dd.Status.Phase = phase
+	if err != nil {
+		dd.Status.Message = err.Error()
+	}
+
+	if dd.Status.StartTimestamp.IsZero() {
+		dd.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}
+	dd.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	if patchErr := r.client.Patch(ctx, dd, kbclient.MergeFrom(original)); patchErr != nil {
+		log.WithError(patchErr).Error("Error updating DataDownload status")
+		return errors.Wrap(patchErr, "error updating DataDownload status")
+	}
+
+	return nil
+}
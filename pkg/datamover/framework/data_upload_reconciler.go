@@ -0,0 +1,229 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// DataUploadReconciler drives the DataUploads of a third-party data mover:
+// New -> Accepted -> InProgress -> Completed/Failed, or Canceling -> Canceled if the DataUpload is canceled.
+// The DataUpload is accepted by one instance of the mover only, the name of the instance is recorded
+// as the node of the DataUpload.
+type DataUploadReconciler struct {
+	client     kbclient.Client
+	mover      Mover
+	name       string
+	clock      clocks.WithTickerAndDelayedExecution
+	logger     logrus.FieldLogger
+	operations *operations
+}
+
+// NewDataUploadReconciler creates a reconciler for the DataUploads of the mover, name identifies the
+// current instance of the mover, e.g. the name of the pod or the node it runs in
+func NewDataUploadReconciler(client kbclient.Client, mover Mover, name string, logger logrus.FieldLogger) *DataUploadReconciler {
+	return &DataUploadReconciler{
+		client:     client,
+		mover:      mover,
+		name:       name,
+		clock:      clocks.RealClock{},
+		logger:     logger,
+		operations: newOperations(),
+	}
+}
+
+func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"data mover": r.mover.Name(),
+		"dataupload": req.NamespacedName,
+	})
+
+	du := &velerov2alpha1api.DataUpload{}
+	if err := r.client.Get(ctx, req.NamespacedName, du); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataUpload")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
+	}
+
+	if du.Spec.DataMover != r.mover.Name() {
+		return ctrl.Result{}, nil
+	}
+
+	switch du.Status.Phase {
+	case "", velerov2alpha1api.DataUploadPhaseNew:
+		if du.Spec.Cancel {
+			log.Info("Data upload is canceled before it is accepted")
+			return ctrl.Result{}, r.finish(ctx, du, velerov2alpha1api.DataUploadPhaseCanceled, nil, nil, log)
+		}
+
+		accepted, err := r.accept(ctx, du)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error to accept the data upload")
+		}
+
+		if !accepted {
+			log.Debug("Data upload is accepted by others")
+			return ctrl.Result{}, nil
+		}
+
+		log.Info("Data upload is accepted")
+
+		go r.run(r.operations.start(req.NamespacedName), du.DeepCopy(), log)
+	case velerov2alpha1api.DataUploadPhaseAccepted, velerov2alpha1api.DataUploadPhaseInProgress, velerov2alpha1api.DataUploadPhaseCanceling:
+		if du.Status.Node != r.name {
+			return ctrl.Result{}, nil
+		}
+
+		if !r.operations.running(req.NamespacedName) {
+			// the data movement is lost as the mover restarted
+			err := errors.Errorf("found a dataupload with status %q during the data mover starting, mark it as failed", du.Status.Phase)
+			return ctrl.Result{}, r.finish(ctx, du, velerov2alpha1api.DataUploadPhaseFailed, nil, err, log)
+		}
+
+		if du.Spec.Cancel && du.Status.Phase != velerov2alpha1api.DataUploadPhaseCanceling {
+			log.Info("Data upload is being canceled")
+
+			original := du.DeepCopy()
+			du.Status.Phase = velerov2alpha1api.DataUploadPhaseCanceling
+			if err := r.client.Patch(ctx, du, kbclient.MergeFrom(original)); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "error updating data upload into canceling status")
+			}
+
+			r.operations.cancel(req.NamespacedName)
+		}
+	default:
+		log.Debugf("Data upload now is in %s phase and do nothing", du.Status.Phase)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the DataUpload reconciler for the DataUploads of the mover
+func (r *DataUploadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("dataupload-" + r.mover.Name()).
+		For(&velerov2alpha1api.DataUpload{}).
+		WithEventFilter(predicate.NewPredicateFuncs(func(obj kbclient.Object) bool {
+			du, ok := obj.(*velerov2alpha1api.DataUpload)
+			return ok && du.Spec.DataMover == r.mover.Name()
+		})).
+		Complete(r)
+}
+
+func (r *DataUploadReconciler) accept(ctx context.Context, du *velerov2alpha1api.DataUpload) (bool, error) {
+	updated := du.DeepCopy()
+	updated.Status.Phase = velerov2alpha1api.DataUploadPhaseAccepted
+	updated.Status.Node = r.name
+	updated.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	// all the instances of the mover try to update the DataUpload and only one of them succeeds
+	err := r.client.Update(ctx, updated)
+	if err == nil {
+		*du = *updated
+		return true, nil
+	} else if apierrors.IsConflict(err) {
+		return false, nil
+	} else {
+		return false, err
+	}
+}
+
+// run moves the data of the DataUpload and updates the result to the DataUpload
+func (r *DataUploadReconciler) run(ctx context.Context, du *velerov2alpha1api.DataUpload, log logrus.FieldLogger) {
+	key := types.NamespacedName{Namespace: du.Namespace, Name: du.Name}
+	defer r.operations.finish(key)
+
+	// the status is updated with a fresh context as ctx is canceled when the DataUpload is canceled
+	statusCtx := context.Background()
+
+	original := du.DeepCopy()
+	du.Status.Phase = velerov2alpha1api.DataUploadPhaseInProgress
+	if err := r.client.Patch(statusCtx, du, kbclient.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Error updating data upload into in progress status")
+		r.finish(statusCtx, du, velerov2alpha1api.DataUploadPhaseFailed, nil, errors.Wrap(err, "error updating data upload status"), log)
+		return
+	}
+
+	log.Info("Data upload is in progress")
+
+	result, err := r.mover.Backup(ctx, du.DeepCopy(), func(progress shared.DataMoveOperationProgress) {
+		obj := &velerov2alpha1api.DataUpload{ObjectMeta: metav1.ObjectMeta{Namespace: du.Namespace, Name: du.Name}}
+		if err := patchProgress(statusCtx, r.client, obj, progress); err != nil {
+			log.WithError(err).Warn("Failed to update progress")
+		}
+	})
+
+	if ctx.Err() != nil {
+		log.Info("Data upload is canceled")
+		r.finish(statusCtx, du, velerov2alpha1api.DataUploadPhaseCanceled, nil, nil, log)
+	} else if err != nil {
+		log.WithError(err).Error("Data upload failed")
+		r.finish(statusCtx, du, velerov2alpha1api.DataUploadPhaseFailed, nil, errors.Wrap(err, "data mover backup failed"), log)
+	} else {
+		log.Info("Data upload is completed")
+		r.finish(statusCtx, du, velerov2alpha1api.DataUploadPhaseCompleted, result, nil, log)
+	}
+}
+
+// finish releases the snapshot of the DataUpload and updates the DataUpload into the final phase
+func (r *DataUploadReconciler) finish(ctx context.Context, du *velerov2alpha1api.DataUpload, phase velerov2alpha1api.DataUploadPhase,
+	result *BackupResult, err error, log logrus.FieldLogger) error {
+	if releaseErr := releaseSnapshot(ctx, r.client, du); releaseErr != nil {
+		log.WithError(releaseErr).Warn("Failed to release the snapshot of the data upload")
+	}
+
+	original := du.DeepCopy()
+	du.Status.Phase = phase
+	if err != nil {
+		du.Status.Message = err.Error()
+	}
+
+	if result != nil {
+		du.Status.SnapshotID = result.SnapshotID
+		if result.DataMoverResult != nil {
+			dataMoverResult := result.DataMoverResult
+			du.Status.DataMoverResult = &dataMoverResult
+		}
+	}
+
+	if du.Status.StartTimestamp.IsZero() {
+		du.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}
+	du.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+	if patchErr := r.client.Patch(ctx, du, kbclient.MergeFrom(original)); patchErr != nil {
+		log.WithError(patchErr).Error("Error updating DataUpload status")
+		return errors.Wrap(patchErr, "error updating DataUpload status")
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeMover struct {
+	backup  func(ctx context.Context, du *velerov2alpha1api.DataUpload, progress ProgressFunc) (*BackupResult, error)
+	restore func(ctx context.Context, dd *velerov2alpha1api.DataDownload, progress ProgressFunc) error
+}
+
+func (m *fakeMover) Name() string {
+	return "fake-mover"
+}
+
+func (m *fakeMover) Backup(ctx context.Context, du *velerov2alpha1api.DataUpload, progress ProgressFunc) (*BackupResult, error) {
+	return m.backup(ctx, du, progress)
+}
+
+func (m *fakeMover) Restore(ctx context.Context, dd *velerov2alpha1api.DataDownload, progress ProgressFunc) error {
+	return m.restore(ctx, dd, progress)
+}
+
+func waitDataUploadPhase(t *testing.T, client kbclient.Client, phase velerov2alpha1api.DataUploadPhase) *velerov2alpha1api.DataUpload {
+	du := &velerov2alpha1api.DataUpload{}
+	require.Eventually(t, func() bool {
+		err := client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "fake-du"}, du)
+		return err == nil && du.Status.Phase == phase
+	}, 10*time.Second, 10*time.Millisecond)
+
+	return du
+}
+
+func TestDataUploadReconcile(t *testing.T) {
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "fake-du"}}
+	vs := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-ns",
+			Name:      "fake-vs",
+		},
+	}
+
+	newDataUpload := func(dataMover string) *builder.DataUploadBuilder {
+		return builder.ForDataUpload(velerov1api.DefaultNamespace, "fake-du").
+			DataMover(dataMover).
+			SnapshotType(velerov2alpha1api.SnapshotTypeCSI).
+			SourceNamespace("fake-ns").
+			SourcePVC("fake-pvc").
+			CSISnapshot(&velerov2alpha1api.CSISnapshotSpec{VolumeSnapshot: "fake-vs"})
+	}
+
+	t.Run("other data mover", func(t *testing.T) {
+		client := velerotest.NewFakeControllerRuntimeClient(t, newDataUpload("velero").Result())
+		r := NewDataUploadReconciler(client, &fakeMover{}, "fake-node", velerotest.NewLogger())
+
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		du := &velerov2alpha1api.DataUpload{}
+		require.NoError(t, client.Get(context.Background(), req.NamespacedName, du))
+		assert.Equal(t, velerov2alpha1api.DataUploadPhase(""), du.Status.Phase)
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		client := velerotest.NewFakeControllerRuntimeClient(t, newDataUpload("fake-mover").Result(), vs.DeepCopy())
+		mover := &fakeMover{
+			backup: func(ctx context.Context, du *velerov2alpha1api.DataUpload, progress ProgressFunc) (*BackupResult, error) {
+				progress(shared.DataMoveOperationProgress{TotalBytes: 10, BytesDone: 10})
+				return &BackupResult{SnapshotID: "fake-snapshot", DataMoverResult: map[string]string{"fake-key": "fake-value"}}, nil
+			},
+		}
+		r := NewDataUploadReconciler(client, mover, "fake-node", velerotest.NewLogger())
+
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		du := waitDataUploadPhase(t, client, velerov2alpha1api.DataUploadPhaseCompleted)
+		assert.Equal(t, "fake-node", du.Status.Node)
+		assert.Equal(t, "fake-snapshot", du.Status.SnapshotID)
+		assert.Equal(t, &map[string]string{"fake-key": "fake-value"}, du.Status.DataMoverResult)
+		assert.Equal(t, int64(10), du.Status.Progress.BytesDone)
+		assert.NotNil(t, du.Status.StartTimestamp)
+		assert.NotNil(t, du.Status.CompletionTimestamp)
+
+		err = client.Get(context.Background(), types.NamespacedName{Namespace: "fake-ns", Name: "fake-vs"}, &snapshotv1api.VolumeSnapshot{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		client := velerotest.NewFakeControllerRuntimeClient(t, newDataUpload("fake-mover").Result())
+		mover := &fakeMover{
+			backup: func(ctx context.Context, du *velerov2alpha1api.DataUpload, progress ProgressFunc) (*BackupResult, error) {
+				return nil, errors.New("fake-error")
+			},
+		}
+		r := NewDataUploadReconciler(client, mover, "fake-node", velerotest.NewLogger())
+
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		du := waitDataUploadPhase(t, client, velerov2alpha1api.DataUploadPhaseFailed)
+		assert.Equal(t, "data mover backup failed: fake-error", du.Status.Message)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		client := velerotest.NewFakeControllerRuntimeClient(t, newDataUpload("fake-mover").Result())
+		mover := &fakeMover{
+			backup: func(ctx context.Context, du *velerov2alpha1api.DataUpload, progress ProgressFunc) (*BackupResult, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		}
+		r := NewDataUploadReconciler(client, mover, "fake-node", velerotest.NewLogger())
+
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		du := waitDataUploadPhase(t, client, velerov2alpha1api.DataUploadPhaseInProgress)
+		original := du.DeepCopy()
+		du.Spec.Cancel = true
+		require.NoError(t, client.Patch(context.Background(), du, kbclient.MergeFrom(original)))
+
+		_, err = r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		waitDataUploadPhase(t, client, velerov2alpha1api.DataUploadPhaseCanceled)
+	})
+
+	t.Run("canceled before accepted", func(t *testing.T) {
+		du := newDataUpload("fake-mover").Result()
+		du.Spec.Cancel = true
+		client := velerotest.NewFakeControllerRuntimeClient(t, du)
+		r := NewDataUploadReconciler(client, &fakeMover{}, "fake-node", velerotest.NewLogger())
+
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		waitDataUploadPhase(t, client, velerov2alpha1api.DataUploadPhaseCanceled)
+	})
+
+	t.Run("lost after restart", func(t *testing.T) {
+		du := newDataUpload("fake-mover").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Result()
+		du.Status.Node = "fake-node"
+		client := velerotest.NewFakeControllerRuntimeClient(t, du)
+		r := NewDataUploadReconciler(client, &fakeMover{}, "fake-node", velerotest.NewLogger())
+
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		du = waitDataUploadPhase(t, client, velerov2alpha1api.DataUploadPhaseFailed)
+		assert.Equal(t, "found a dataupload with status \"InProgress\" during the data mover starting, mark it as failed", du.Status.Message)
+	})
+}
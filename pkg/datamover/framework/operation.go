@@ -0,0 +1,94 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+)
+
+// operations tracks the data movements running in the current mover instance
+type operations struct {
+	lock    sync.Mutex
+	cancels map[types.NamespacedName]context.CancelFunc
+}
+
+func newOperations() *operations {
+	return &operations{
+		cancels: make(map[types.NamespacedName]context.CancelFunc),
+	}
+}
+
+// start returns the context of a new data movement, the context is canceled by cancel
+func (o *operations) start(key types.NamespacedName) context.Context {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancels[key] = cancel
+
+	return ctx
+}
+
+func (o *operations) running(key types.NamespacedName) bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	_, found := o.cancels[key]
+	return found
+}
+
+func (o *operations) cancel(key types.NamespacedName) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if cancel, found := o.cancels[key]; found {
+		cancel()
+	}
+}
+
+func (o *operations) finish(key types.NamespacedName) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if cancel, found := o.cancels[key]; found {
+		cancel()
+		delete(o.cancels, key)
+	}
+}
+
+// patchProgress patches the progress in the status of a DataUpload or DataDownload, a merge patch
+// that only has the progress is used so that the progress could be reported from any goroutine
+func patchProgress(ctx context.Context, client kbclient.Client, obj kbclient.Object, progress shared.DataMoveOperationProgress) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"progress": progress,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "error marshaling progress patch")
+	}
+
+	return client.Patch(ctx, obj, kbclient.RawPatch(types.MergePatchType, patch))
+}
@@ -0,0 +1,78 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// releaseSnapshot deletes the snapshot that the DataUpload is created for once the data is moved,
+// the same as the built-in data mover does, so that the mover doesn't need to handle the snapshot
+func releaseSnapshot(ctx context.Context, client kbclient.Client, du *velerov2alpha1api.DataUpload) error {
+	switch du.Spec.SnapshotType {
+	case velerov2alpha1api.SnapshotTypeCSI:
+		if du.Spec.CSISnapshot == nil || du.Spec.CSISnapshot.VolumeSnapshot == "" {
+			return nil
+		}
+
+		vs := &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: du.Spec.SourceNamespace,
+				Name:      du.Spec.CSISnapshot.VolumeSnapshot,
+			},
+		}
+
+		if err := client.Delete(ctx, vs); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error to delete volume snapshot %s/%s", vs.Namespace, vs.Name)
+		}
+	case velerov2alpha1api.SnapshotTypeVolumeClone:
+		if du.Spec.VolumeClone == nil || du.Spec.VolumeClone.PersistentVolume == "" {
+			return nil
+		}
+
+		pv := &corev1.PersistentVolume{}
+		if err := client.Get(ctx, types.NamespacedName{Name: du.Spec.VolumeClone.PersistentVolume}, pv); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return errors.Wrapf(err, "error to get PV %s", du.Spec.VolumeClone.PersistentVolume)
+		}
+
+		// the volume behind the PV is a copy of the source volume, so it is deleted together with the PV
+		original := pv.DeepCopy()
+		pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimDelete
+		if err := client.Patch(ctx, pv, kbclient.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error to set reclaim policy of PV %s", pv.Name)
+		}
+
+		if err := client.Delete(ctx, pv); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error to delete PV %s", pv.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package framework is a reference implementation of the contract between Velero and a
+// third-party data mover. A data mover running out of the Velero process implements the
+// Mover interface, and the reconcilers of this package drive the DataUploads and
+// DataDownloads whose datamover field matches the name of the mover through their phases,
+// so the mover only needs to move the data.
+package framework
+
+import (
+	"context"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// ProgressFunc reports the progress of a data movement, the progress is written to the
+// status of the DataUpload or DataDownload
+type ProgressFunc func(progress shared.DataMoveOperationProgress)
+
+// BackupResult is the result of a data movement for a DataUpload
+type BackupResult struct {
+	// SnapshotID identifies the data in the backup storage, it is passed to the
+	// DataDownload when the volume is restored
+	SnapshotID string
+
+	// DataMoverResult is the mover-specific information that is needed to restore the data,
+	// it is kept in the backup together with the DataUpload result
+	DataMoverResult map[string]string
+}
+
+// Mover is implemented by a third-party data mover
+type Mover interface {
+	// Name returns the value of the datamover field of the DataUploads and DataDownloads
+	// that are handled by the mover, it must not be "" or "velero"
+	Name() string
+
+	// Backup moves the data of the snapshot described by the DataUpload to the backup storage
+	// location of the DataUpload. The snapshot is deleted by the framework once Backup returns.
+	// The context is canceled if the DataUpload is canceled, Backup should return as soon as
+	// possible in that case.
+	Backup(ctx context.Context, du *velerov2alpha1api.DataUpload, progress ProgressFunc) (*BackupResult, error)
+
+	// Restore moves the data identified by the snapshot ID of the DataDownload from the backup
+	// storage location to the target volume of the DataDownload. The context is canceled if
+	// the DataDownload is canceled, Restore should return as soon as possible in that case.
+	Restore(ctx context.Context, dd *velerov2alpha1api.DataDownload, progress ProgressFunc) error
+}
+
+// AddToScheme adds the types that the reconcilers of the framework work with to the scheme
+func AddToScheme(scheme *runtime.Scheme) error {
+	if err := velerov2alpha1api.AddToScheme(scheme); err != nil {
+		return err
+	}
+
+	if err := snapshotv1api.AddToScheme(scheme); err != nil {
+		return err
+	}
+
+	return corev1.AddToScheme(scheme)
+}
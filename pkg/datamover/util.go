@@ -16,10 +16,19 @@ limitations under the License.
 
 package datamover
 
+// BuiltInDataMover is the name of the data mover shipped with Velero
+const BuiltInDataMover = "velero"
+
 func GetUploaderType(dataMover string) string {
-	if dataMover == "" || dataMover == "velero" {
+	if IsBuiltInDataMover(dataMover) {
 		return "kopia"
 	} else {
 		return dataMover
 	}
 }
+
+// IsBuiltInDataMover returns true if the DataUploads and DataDownloads with the given
+// data mover are handled by Velero, otherwise they are handled by a third-party data mover
+func IsBuiltInDataMover(dataMover string) bool {
+	return dataMover == "" || dataMover == BuiltInDataMover
+}
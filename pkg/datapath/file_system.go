@@ -18,6 +18,7 @@ package datapath
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -34,28 +35,30 @@ import (
 )
 
 type fileSystemBR struct {
-	ctx            context.Context
-	cancel         context.CancelFunc
-	backupRepo     *velerov1api.BackupRepository
-	uploaderProv   provider.Provider
-	log            logrus.FieldLogger
-	client         client.Client
-	backupLocation *velerov1api.BackupStorageLocation
-	namespace      string
-	initialized    bool
-	callbacks      Callbacks
-	jobName        string
-	requestorType  string
+	ctx                context.Context
+	cancel             context.CancelFunc
+	backupRepo         *velerov1api.BackupRepository
+	uploaderProv       provider.Provider
+	log                logrus.FieldLogger
+	client             client.Client
+	backupLocation     *velerov1api.BackupStorageLocation
+	namespace          string
+	initialized        bool
+	callbacks          Callbacks
+	jobName            string
+	requestorType      string
+	checkpointInterval time.Duration
 }
 
-func newFileSystemBR(jobName string, requestorType string, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) AsyncBR {
+func newFileSystemBR(jobName string, requestorType string, client client.Client, namespace string, callbacks Callbacks, checkpointInterval time.Duration, log logrus.FieldLogger) AsyncBR {
 	fs := &fileSystemBR{
-		jobName:       jobName,
-		requestorType: requestorType,
-		client:        client,
-		namespace:     namespace,
-		callbacks:     callbacks,
-		log:           log,
+		jobName:            jobName,
+		requestorType:      requestorType,
+		client:             client,
+		namespace:          namespace,
+		callbacks:          callbacks,
+		checkpointInterval: checkpointInterval,
+		log:                log,
 	}
 
 	return fs
@@ -93,7 +96,7 @@ func (fs *fileSystemBR) Init(ctx context.Context, bslName string, sourceNamespac
 	}
 
 	fs.uploaderProv, err = provider.NewUploaderProvider(ctx, fs.client, uploaderType, fs.requestorType, repoIdentifier,
-		fs.backupLocation, fs.backupRepo, credentialGetter, repokey.RepoKeySelector(), fs.log)
+		fs.backupLocation, fs.backupRepo, credentialGetter, repokey.RepoKeySelector(), fs.checkpointInterval, fs.log)
 	if err != nil {
 		return errors.Wrapf(err, "error creating uploader %s", uploaderType)
 	}
@@ -135,7 +138,8 @@ func (fs *fileSystemBR) StartBackup(source AccessPoint, realSource string, paren
 	}
 
 	go func() {
-		snapshotID, emptySnapshot, err := fs.uploaderProv.RunBackup(fs.ctx, source.ByPath, realSource, tags, forceFull, parentSnapshot, fs)
+		path, volMode := source.accessPath()
+		snapshotID, emptySnapshot, err := fs.uploaderProv.RunBackup(fs.ctx, path, realSource, tags, forceFull, parentSnapshot, volMode, fs)
 
 		if err == provider.ErrorCanceled {
 			fs.callbacks.OnCancelled(context.Background(), fs.namespace, fs.jobName)
@@ -155,7 +159,8 @@ func (fs *fileSystemBR) StartRestore(snapshotID string, target AccessPoint) erro
 	}
 
 	go func() {
-		err := fs.uploaderProv.RunRestore(fs.ctx, snapshotID, target.ByPath, fs)
+		path, volMode := target.accessPath()
+		err := fs.uploaderProv.RunRestore(fs.ctx, snapshotID, path, volMode, fs)
 
 		if err == provider.ErrorCanceled {
 			fs.callbacks.OnCancelled(context.Background(), fs.namespace, fs.jobName)
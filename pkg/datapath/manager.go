@@ -19,6 +19,7 @@ package datapath
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -29,9 +30,10 @@ var ConcurrentLimitExceed error = errors.New("Concurrent number exceeds")
 var FSBRCreator = newFileSystemBR
 
 type Manager struct {
-	cocurrentNum int
-	trackerLock  sync.Mutex
-	tracker      map[string]AsyncBR
+	cocurrentNum       int
+	checkpointInterval time.Duration
+	trackerLock        sync.Mutex
+	tracker            map[string]AsyncBR
 }
 
 // NewManager creates the data path manager to manage concurrent data path instances
@@ -42,6 +44,15 @@ func NewManager(cocurrentNum int) *Manager {
 	}
 }
 
+// NewManagerWithCheckpointInterval creates the data path manager whose data path instances save the checkpoints
+// of their backups at the given interval
+func NewManagerWithCheckpointInterval(cocurrentNum int, checkpointInterval time.Duration) *Manager {
+	m := NewManager(cocurrentNum)
+	m.checkpointInterval = checkpointInterval
+
+	return m
+}
+
 // CreateFileSystemBR creates a new file system backup/restore data path instance
 func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, ctx context.Context, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) (AsyncBR, error) {
 	m.trackerLock.Lock()
@@ -51,7 +62,7 @@ func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, ctx c
 		return nil, ConcurrentLimitExceed
 	}
 
-	m.tracker[jobName] = FSBRCreator(jobName, requestorType, client, namespace, callbacks, log)
+	m.tracker[jobName] = FSBRCreator(jobName, requestorType, client, namespace, callbacks, m.checkpointInterval, log)
 
 	return m.tracker[jobName], nil
 }
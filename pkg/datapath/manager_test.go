@@ -19,6 +19,7 @@ package datapath
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -50,3 +51,11 @@ func TestManager(t *testing.T) {
 	ret = m.GetAsyncBR("job-1")
 	assert.Equal(t, nil, ret)
 }
+
+func TestManagerWithCheckpointInterval(t *testing.T) {
+	m := NewManagerWithCheckpointInterval(1, time.Minute)
+
+	async, err := m.CreateFileSystemBR("job-1", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, async.(*fileSystemBR).checkpointInterval)
+}
@@ -51,8 +51,21 @@ type Callbacks struct {
 }
 
 // AccessPoint represents an access point that has been exposed to a data path instance
+// Only one of ByPath and ByBlock is set
 type AccessPoint struct {
+	// ByPath is the path of the directory where the volume is mounted
 	ByPath string
+	// ByBlock is the path of the block device of the volume
+	ByBlock string
+}
+
+// accessPath returns the path of the access point and the mode the uploader accesses it with
+func (a AccessPoint) accessPath() (string, uploader.PersistentVolumeMode) {
+	if a.ByBlock != "" {
+		return a.ByBlock, uploader.PersistentVolumeBlock
+	}
+
+	return a.ByPath, uploader.PersistentVolumeFilesystem
 }
 
 // AsyncBR is the interface for asynchronous data path methods
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 
 	corev1 "k8s.io/api/core/v1"
@@ -59,6 +60,12 @@ type CSISnapshotExposeParam struct {
 	// HostingPodLabels is the labels that are going to apply to the hosting pod
 	HostingPodLabels map[string]string
 
+	// Affinity specifies the nodes that the hosting pod could be scheduled to
+	Affinity []*nodeagent.LoadAffinity
+
+	// PodConfig specifies the resources, priority class, tolerations and image of the hosting pod
+	PodConfig *nodeagent.PodConfig
+
 	// Timeout specifies the time wait for resources operations in Expose
 	Timeout time.Duration
 }
@@ -169,7 +176,7 @@ func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1.Obje
 		}
 	}()
 
-	backupPod, err := e.createBackupPod(ctx, ownerObject, backupPVC, csiExposeParam.HostingPodLabels)
+	backupPod, err := createBackupPod(ctx, e.kubeClient, ownerObject, backupPVC, csiExposeParam.HostingPodLabels, csiExposeParam.Affinity, csiExposeParam.PodConfig)
 	if err != nil {
 		return errors.Wrap(err, "error to create backup pod")
 	}
@@ -233,13 +240,32 @@ func (e *csiSnapshotExposer) CleanUp(ctx context.Context, ownerObject corev1.Obj
 }
 
 func getVolumeModeByAccessMode(accessMode string) (corev1.PersistentVolumeMode, error) {
-	if accessMode == AccessModeFileSystem {
+	switch accessMode {
+	case AccessModeFileSystem:
 		return corev1.PersistentVolumeFilesystem, nil
-	} else {
+	case AccessModeBlock:
+		return corev1.PersistentVolumeBlock, nil
+	default:
 		return "", errors.Errorf("unsupported access mode %s", accessMode)
 	}
 }
 
+// getPodVolumeAccess returns how a hosting pod accesses the volume of a PVC with the given volume mode,
+// block volumes are attached as raw devices and other volumes are mounted
+func getPodVolumeAccess(volumeName string, volumeMode *corev1.PersistentVolumeMode) ([]corev1.VolumeMount, []corev1.VolumeDevice) {
+	if volumeMode != nil && *volumeMode == corev1.PersistentVolumeBlock {
+		return nil, []corev1.VolumeDevice{{
+			Name:       volumeName,
+			DevicePath: "/" + volumeName,
+		}}
+	}
+
+	return []corev1.VolumeMount{{
+		Name:      volumeName,
+		MountPath: "/" + volumeName,
+	}}, nil
+}
+
 func (e *csiSnapshotExposer) createBackupVS(ctx context.Context, ownerObject corev1.ObjectReference, snapshotVS *snapshotv1api.VolumeSnapshot) (*snapshotv1api.VolumeSnapshot, error) {
 	backupVSName := ownerObject.Name
 	backupVSCName := ownerObject.Name
@@ -342,11 +368,16 @@ func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject co
 	return created, err
 }
 
-func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject corev1.ObjectReference, backupPVC *corev1.PersistentVolumeClaim, label map[string]string) (*corev1.Pod, error) {
+// createBackupPod creates the pod that hosts the backup PVC, so that the data path on the node
+// where the pod is scheduled can access the volume
+func createBackupPod(ctx context.Context, kubeClient kubernetes.Interface, ownerObject corev1.ObjectReference, backupPVC *corev1.PersistentVolumeClaim,
+	label map[string]string, affinity []*nodeagent.LoadAffinity, podConfig *nodeagent.PodConfig) (*corev1.Pod, error) {
 	podName := ownerObject.Name
 
 	var gracePeriod int64 = 0
 
+	volumeMounts, volumeDevices := getPodVolumeAccess(backupPVC.Name, backupPVC.Spec.VolumeMode)
+
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podName,
@@ -366,16 +397,16 @@ func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject co
 			Containers: []corev1.Container{
 				{
 					Name:            podName,
-					Image:           "alpine:latest",
+					Image:           defaultHostingPodImage,
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Command:         []string{"sleep", "infinity"},
-					VolumeMounts: []corev1.VolumeMount{{
-						Name:      backupPVC.Name,
-						MountPath: "/" + backupPVC.Name,
-					}},
+					VolumeMounts:    volumeMounts,
+					VolumeDevices:   volumeDevices,
 				},
 			},
 			TerminationGracePeriodSeconds: &gracePeriod,
+			Affinity:                      toSystemAffinity(affinity),
+			TopologySpreadConstraints:     getHostingPodSpread(label),
 			Volumes: []corev1.Volume{{
 				Name: backupPVC.Name,
 				VolumeSource: corev1.VolumeSource{
@@ -387,5 +418,9 @@ func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject co
 		},
 	}
 
-	return e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err := applyPodConfig(pod, podConfig); err != nil {
+		return nil, err
+	}
+
+	return kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
 }
@@ -305,3 +305,33 @@ func TestExpose(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVolumeModeByAccessMode(t *testing.T) {
+	mode, err := getVolumeModeByAccessMode(AccessModeFileSystem)
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.PersistentVolumeFilesystem, mode)
+
+	mode, err = getVolumeModeByAccessMode(AccessModeBlock)
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.PersistentVolumeBlock, mode)
+
+	_, err = getVolumeModeByAccessMode("fake-mode")
+	assert.EqualError(t, err, "unsupported access mode fake-mode")
+}
+
+func TestGetPodVolumeAccess(t *testing.T) {
+	filesystem := corev1.PersistentVolumeFilesystem
+	block := corev1.PersistentVolumeBlock
+
+	mounts, devices := getPodVolumeAccess("fake-pvc", nil)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "fake-pvc", MountPath: "/fake-pvc"}}, mounts)
+	assert.Nil(t, devices)
+
+	mounts, devices = getPodVolumeAccess("fake-pvc", &filesystem)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "fake-pvc", MountPath: "/fake-pvc"}}, mounts)
+	assert.Nil(t, devices)
+
+	mounts, devices = getPodVolumeAccess("fake-pvc", &block)
+	assert.Nil(t, mounts)
+	assert.Equal(t, []corev1.VolumeDevice{{Name: "fake-pvc", DevicePath: "/fake-pvc"}}, devices)
+}
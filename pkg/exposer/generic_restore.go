@@ -30,6 +30,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -37,7 +38,7 @@ import (
 // GenericRestoreExposer is the interfaces for a generic restore exposer
 type GenericRestoreExposer interface {
 	// Expose starts the process to a restore expose, the expose process may take long time
-	Expose(context.Context, corev1.ObjectReference, string, string, map[string]string, time.Duration) error
+	Expose(context.Context, corev1.ObjectReference, string, string, map[string]string, []*nodeagent.LoadAffinity, *nodeagent.PodConfig, time.Duration) error
 
 	// GetExposed polls the status of the expose.
 	// If the expose is accessible by the current caller, it waits the expose ready and returns the expose result.
@@ -64,7 +65,8 @@ type genericRestoreExposer struct {
 	log        logrus.FieldLogger
 }
 
-func (e *genericRestoreExposer) Expose(ctx context.Context, ownerObject corev1.ObjectReference, targetPVCName string, sourceNamespace string, hostingPodLabels map[string]string, timeout time.Duration) error {
+func (e *genericRestoreExposer) Expose(ctx context.Context, ownerObject corev1.ObjectReference, targetPVCName string, sourceNamespace string, hostingPodLabels map[string]string,
+	affinity []*nodeagent.LoadAffinity, podConfig *nodeagent.PodConfig, timeout time.Duration) error {
 	curLog := e.log.WithFields(logrus.Fields{
 		"owner":            ownerObject.Name,
 		"target PVC":       targetPVCName,
@@ -78,7 +80,7 @@ func (e *genericRestoreExposer) Expose(ctx context.Context, ownerObject corev1.O
 
 	curLog.WithField("target PVC", targetPVCName).WithField("selected node", selectedNode).Info("Target PVC is consumed")
 
-	restorePod, err := e.createRestorePod(ctx, ownerObject, hostingPodLabels, selectedNode)
+	restorePod, err := e.createRestorePod(ctx, ownerObject, hostingPodLabels, selectedNode, targetPVC.Spec.VolumeMode, affinity, podConfig)
 	if err != nil {
 		return errors.Wrapf(err, "error to create restore pod")
 	}
@@ -243,12 +245,15 @@ func (e *genericRestoreExposer) RebindVolume(ctx context.Context, ownerObject co
 	return nil
 }
 
-func (e *genericRestoreExposer) createRestorePod(ctx context.Context, ownerObject corev1.ObjectReference, label map[string]string, selectedNode string) (*corev1.Pod, error) {
+func (e *genericRestoreExposer) createRestorePod(ctx context.Context, ownerObject corev1.ObjectReference, label map[string]string, selectedNode string,
+	volumeMode *corev1.PersistentVolumeMode, affinity []*nodeagent.LoadAffinity, podConfig *nodeagent.PodConfig) (*corev1.Pod, error) {
 	restorePodName := ownerObject.Name
 	restorePVCName := ownerObject.Name
 
 	var gracePeriod int64 = 0
 
+	volumeMounts, volumeDevices := getPodVolumeAccess(restorePVCName, volumeMode)
+
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      restorePodName,
@@ -268,13 +273,11 @@ func (e *genericRestoreExposer) createRestorePod(ctx context.Context, ownerObjec
 			Containers: []corev1.Container{
 				{
 					Name:            restorePodName,
-					Image:           "alpine:latest",
+					Image:           defaultHostingPodImage,
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Command:         []string{"sleep", "infinity"},
-					VolumeMounts: []corev1.VolumeMount{{
-						Name:      restorePVCName,
-						MountPath: "/" + restorePVCName,
-					}},
+					VolumeMounts:    volumeMounts,
+					VolumeDevices:   volumeDevices,
 				},
 			},
 			TerminationGracePeriodSeconds: &gracePeriod,
@@ -290,6 +293,17 @@ func (e *genericRestoreExposer) createRestorePod(ctx context.Context, ownerObjec
 		},
 	}
 
+	// the node is selected by the scheduler for the target volume if it is bound at the first consumer,
+	// otherwise the restore pod is scheduled according to the load affinity
+	if selectedNode == "" {
+		pod.Spec.Affinity = toSystemAffinity(affinity)
+		pod.Spec.TopologySpreadConstraints = getHostingPodSpread(label)
+	}
+
+	if err := applyPodConfig(pod, podConfig); err != nil {
+		return nil, err
+	}
+
 	return e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
 }
 
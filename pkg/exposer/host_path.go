@@ -31,6 +31,7 @@ import (
 )
 
 var getVolumeDirectory = kube.GetVolumeDirectory
+var getVolumeMode = kube.GetVolumeMode
 var singlePathMatch = kube.SinglePathMatch
 
 // GetPodVolumeHostPath returns a path that can be accessed from the host for a given volume of a pod
@@ -45,7 +46,18 @@ func GetPodVolumeHostPath(ctx context.Context, pod *corev1.Pod, pvcName string,
 
 	logger.WithField("volDir", volDir).Info("Got volume for backup PVC")
 
-	pathGlob := fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(pod.GetUID()), volDir)
+	volMode, err := getVolumeMode(ctx, logger, pod, pvcName, cli)
+	if err != nil {
+		return datapath.AccessPoint{}, errors.Wrapf(err, "error getting volume mode for pvc %s in pod %s", pvcName, pod.Name)
+	}
+
+	// block volumes are mapped to the pod as devices under volumeDevices rather than mounted under volumes
+	volSubDir := "volumes"
+	if volMode == corev1.PersistentVolumeBlock {
+		volSubDir = "volumeDevices"
+	}
+
+	pathGlob := fmt.Sprintf("/host_pods/%s/%s/*/%s", string(pod.GetUID()), volSubDir, volDir)
 	logger.WithField("pathGlob", pathGlob).Debug("Looking for path matching glob")
 
 	path, err := singlePathMatch(pathGlob, fs, logger)
@@ -55,6 +67,12 @@ func GetPodVolumeHostPath(ctx context.Context, pod *corev1.Pod, pvcName string,
 
 	logger.WithField("path", path).Info("Found path matching glob")
 
+	if volMode == corev1.PersistentVolumeBlock {
+		return datapath.AccessPoint{
+			ByBlock: path,
+		}, nil
+	}
+
 	return datapath.AccessPoint{
 		ByPath: path,
 	}, nil
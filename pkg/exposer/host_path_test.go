@@ -28,18 +28,22 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
 func TestGetPodVolumeHostPath(t *testing.T) {
 	tests := []struct {
-		name             string
-		getVolumeDirFunc func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (string, error)
-		pathMatchFunc    func(string, filesystem.Interface, logrus.FieldLogger) (string, error)
-		pod              *corev1.Pod
-		pvc              string
-		err              string
+		name              string
+		getVolumeDirFunc  func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (string, error)
+		getVolumeModeFunc func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (corev1.PersistentVolumeMode, error)
+		pathMatchFunc     func(string, filesystem.Interface, logrus.FieldLogger) (string, error)
+		pod               *corev1.Pod
+		pvc               string
+		expectedGlob      string
+		expected          datapath.AccessPoint
+		err               string
 	}{
 		{
 			name: "get volume dir fail",
@@ -50,11 +54,26 @@ func TestGetPodVolumeHostPath(t *testing.T) {
 			pvc: "fake-pvc-1",
 			err: "error getting volume directory name for pvc fake-pvc-1 in pod fake-pod-1: fake-error-1",
 		},
+		{
+			name: "get volume mode fail",
+			getVolumeDirFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (string, error) {
+				return "", nil
+			},
+			getVolumeModeFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (corev1.PersistentVolumeMode, error) {
+				return "", errors.New("fake-error-3")
+			},
+			pod: builder.ForPod(velerov1api.DefaultNamespace, "fake-pod-3").Result(),
+			pvc: "fake-pvc-1",
+			err: "error getting volume mode for pvc fake-pvc-1 in pod fake-pod-3: fake-error-3",
+		},
 		{
 			name: "single path match fail",
 			getVolumeDirFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (string, error) {
 				return "", nil
 			},
+			getVolumeModeFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (corev1.PersistentVolumeMode, error) {
+				return corev1.PersistentVolumeFilesystem, nil
+			},
 			pathMatchFunc: func(string, filesystem.Interface, logrus.FieldLogger) (string, error) {
 				return "", errors.New("fake-error-2")
 			},
@@ -62,6 +81,38 @@ func TestGetPodVolumeHostPath(t *testing.T) {
 			pvc: "fake-pvc-1",
 			err: "error identifying unique volume path on host for pvc fake-pvc-1 in pod fake-pod-2: fake-error-2",
 		},
+		{
+			name: "filesystem mode",
+			getVolumeDirFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (string, error) {
+				return "fake-pv/mount", nil
+			},
+			getVolumeModeFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (corev1.PersistentVolumeMode, error) {
+				return corev1.PersistentVolumeFilesystem, nil
+			},
+			pathMatchFunc: func(glob string, _ filesystem.Interface, _ logrus.FieldLogger) (string, error) {
+				return "/host_pods/fake-uid/volumes/kubernetes.io~csi/fake-pv/mount", nil
+			},
+			pod:          builder.ForPod(velerov1api.DefaultNamespace, "fake-pod-4").ObjectMeta(builder.WithUID("fake-uid")).Result(),
+			pvc:          "fake-pvc-1",
+			expectedGlob: "/host_pods/fake-uid/volumes/*/fake-pv/mount",
+			expected:     datapath.AccessPoint{ByPath: "/host_pods/fake-uid/volumes/kubernetes.io~csi/fake-pv/mount"},
+		},
+		{
+			name: "block mode",
+			getVolumeDirFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (string, error) {
+				return "fake-pv", nil
+			},
+			getVolumeModeFunc: func(context.Context, logrus.FieldLogger, *corev1.Pod, string, ctrlclient.Client) (corev1.PersistentVolumeMode, error) {
+				return corev1.PersistentVolumeBlock, nil
+			},
+			pathMatchFunc: func(glob string, _ filesystem.Interface, _ logrus.FieldLogger) (string, error) {
+				return "/host_pods/fake-uid/volumeDevices/kubernetes.io~csi/fake-pv", nil
+			},
+			pod:          builder.ForPod(velerov1api.DefaultNamespace, "fake-pod-5").ObjectMeta(builder.WithUID("fake-uid")).Result(),
+			pvc:          "fake-pvc-1",
+			expectedGlob: "/host_pods/fake-uid/volumeDevices/*/fake-pv",
+			expected:     datapath.AccessPoint{ByBlock: "/host_pods/fake-uid/volumeDevices/kubernetes.io~csi/fake-pv"},
+		},
 	}
 
 	for _, test := range tests {
@@ -70,12 +121,27 @@ func TestGetPodVolumeHostPath(t *testing.T) {
 				getVolumeDirectory = test.getVolumeDirFunc
 			}
 
+			if test.getVolumeModeFunc != nil {
+				getVolumeMode = test.getVolumeModeFunc
+			}
+
+			var glob string
 			if test.pathMatchFunc != nil {
-				singlePathMatch = test.pathMatchFunc
+				singlePathMatch = func(pathGlob string, fs filesystem.Interface, log logrus.FieldLogger) (string, error) {
+					glob = pathGlob
+					return test.pathMatchFunc(pathGlob, fs, log)
+				}
+			}
+
+			accessPoint, err := GetPodVolumeHostPath(context.Background(), test.pod, test.pvc, nil, nil, velerotest.NewLogger())
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
 			}
 
-			_, err := GetPodVolumeHostPath(context.Background(), test.pod, test.pvc, nil, nil, velerotest.NewLogger())
-			assert.EqualError(t, err, test.err)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedGlob, glob)
+			assert.Equal(t, test.expected, accessPoint)
 		})
 	}
 }
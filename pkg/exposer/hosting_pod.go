@@ -0,0 +1,134 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+// defaultHostingPodImage is the image of the hosting pods if it is not specified by the pod config
+const defaultHostingPodImage = "alpine:latest"
+
+// applyPodConfig applies the pod config of the node-agent and the data mover to a hosting pod
+func applyPodConfig(pod *corev1.Pod, podConfig *nodeagent.PodConfig) error {
+	if podConfig == nil {
+		return nil
+	}
+
+	resources, err := podConfig.GetResources()
+	if err != nil {
+		return errors.Wrap(err, "error to parse the resources of the hosting pod")
+	}
+
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Resources = resources
+		if podConfig.Image != "" {
+			pod.Spec.Containers[i].Image = podConfig.Image
+		}
+	}
+
+	pod.Spec.PriorityClassName = podConfig.PriorityClassName
+	pod.Spec.Tolerations = podConfig.Tolerations
+
+	return nil
+}
+
+// toSystemAffinity converts the load affinities of the node-agent configs to the node affinity of a hosting pod,
+// the hosting pod is scheduled to a node that matches any of the load affinities
+func toSystemAffinity(loadAffinities []*nodeagent.LoadAffinity) *corev1.Affinity {
+	terms := []corev1.NodeSelectorTerm{}
+	for _, loadAffinity := range loadAffinities {
+		if loadAffinity == nil {
+			continue
+		}
+
+		term := corev1.NodeSelectorTerm{}
+
+		keys := make([]string, 0, len(loadAffinity.NodeSelector.MatchLabels))
+		for k := range loadAffinity.NodeSelector.MatchLabels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      k,
+				Values:   []string{loadAffinity.NodeSelector.MatchLabels[k]},
+				Operator: corev1.NodeSelectorOpIn,
+			})
+		}
+
+		for _, exp := range loadAffinity.NodeSelector.MatchExpressions {
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      exp.Key,
+				Values:   exp.Values,
+				Operator: corev1.NodeSelectorOperator(exp.Operator),
+			})
+		}
+
+		if len(term.MatchExpressions) > 0 {
+			terms = append(terms, term)
+		}
+	}
+
+	if len(terms) == 0 {
+		return nil
+	}
+
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: terms,
+			},
+		},
+	}
+}
+
+// getHostingPodSpread spreads the hosting pods that have the same label keys across the nodes as much as possible,
+// so that the data path loads are balanced among the nodes instead of piling up on a single node
+func getHostingPodSpread(label map[string]string) []corev1.TopologySpreadConstraint {
+	if len(label) == 0 {
+		return nil
+	}
+
+	selector := &metav1.LabelSelector{}
+	for k := range label {
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      k,
+			Operator: metav1.LabelSelectorOpExists,
+		})
+	}
+
+	sort.Slice(selector.MatchExpressions, func(i, j int) bool {
+		return selector.MatchExpressions[i].Key < selector.MatchExpressions[j].Key
+	})
+
+	return []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelHostname,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     selector,
+		},
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+func TestToSystemAffinity(t *testing.T) {
+	assert.Nil(t, toSystemAffinity(nil))
+	assert.Nil(t, toSystemAffinity([]*nodeagent.LoadAffinity{nil, {}}))
+
+	affinity := toSystemAffinity([]*nodeagent.LoadAffinity{
+		{
+			NodeSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"role": "backup", "disk": "fast"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "zone", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"zone-a"}},
+				},
+			},
+		},
+		{
+			NodeSelector: metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "backup-only", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "disk", Operator: corev1.NodeSelectorOpIn, Values: []string{"fast"}},
+							{Key: "role", Operator: corev1.NodeSelectorOpIn, Values: []string{"backup"}},
+							{Key: "zone", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"zone-a"}},
+						},
+					},
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "backup-only", Operator: corev1.NodeSelectorOpExists},
+						},
+					},
+				},
+			},
+		},
+	}, affinity)
+}
+
+func TestGetHostingPodSpread(t *testing.T) {
+	assert.Nil(t, getHostingPodSpread(nil))
+
+	assert.Equal(t, []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelHostname,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "velero.io/data-upload", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+		},
+	}, getHostingPodSpread(map[string]string{"velero.io/data-upload": "fake-du"}))
+}
+
+func TestApplyPodConfig(t *testing.T) {
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "fake-container", Image: defaultHostingPodImage}},
+			},
+		}
+	}
+
+	pod := newPod()
+	assert.NoError(t, applyPodConfig(pod, nil))
+	assert.Equal(t, newPod(), pod)
+
+	pod = newPod()
+	assert.NoError(t, applyPodConfig(pod, &nodeagent.PodConfig{}))
+	assert.Equal(t, defaultHostingPodImage, pod.Spec.Containers[0].Image)
+
+	toleration := corev1.Toleration{Key: "backup", Operator: corev1.TolerationOpExists}
+	pod = newPod()
+	assert.NoError(t, applyPodConfig(pod, &nodeagent.PodConfig{
+		CPURequest:        "100m",
+		MemoryLimit:       "1Gi",
+		PriorityClassName: "fake-priority",
+		Tolerations:       []corev1.Toleration{toleration},
+		Image:             "fake-image",
+	}))
+	assert.Equal(t, resource.MustParse("100m"), pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("1Gi"), pod.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory])
+	assert.Equal(t, "fake-priority", pod.Spec.PriorityClassName)
+	assert.Equal(t, []corev1.Toleration{toleration}, pod.Spec.Tolerations)
+	assert.Equal(t, "fake-image", pod.Spec.Containers[0].Image)
+
+	pod = newPod()
+	assert.EqualError(t, applyPodConfig(pod, &nodeagent.PodConfig{CPURequest: "invalid"}),
+		"error to parse the resources of the hosting pod: couldn't parse CPU request \"invalid\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'")
+}
@@ -11,6 +11,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	nodeagent "github.com/vmware-tanzu/velero/pkg/nodeagent"
+
 	time "time"
 
 	v1 "k8s.io/api/core/v1"
@@ -26,13 +28,13 @@ func (_m *GenericRestoreExposer) CleanUp(_a0 context.Context, _a1 v1.ObjectRefer
 	_m.Called(_a0, _a1)
 }
 
-// Expose provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
-func (_m *GenericRestoreExposer) Expose(_a0 context.Context, _a1 v1.ObjectReference, _a2 string, _a3 string, _a4 map[string]string, _a5 time.Duration) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+// Expose provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7
+func (_m *GenericRestoreExposer) Expose(_a0 context.Context, _a1 v1.ObjectReference, _a2 string, _a3 string, _a4 map[string]string, _a5 []*nodeagent.LoadAffinity, _a6 *nodeagent.PodConfig, _a7 time.Duration) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, v1.ObjectReference, string, string, map[string]string, time.Duration) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ObjectReference, string, string, map[string]string, []*nodeagent.LoadAffinity, *nodeagent.PodConfig, time.Duration) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7)
 	} else {
 		r0 = ret.Error(0)
 	}
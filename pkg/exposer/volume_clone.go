@@ -0,0 +1,356 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// VolumeCloneExposeParam define the input param for Expose of volume clones
+type VolumeCloneExposeParam struct {
+	// SourceNamespace is the original namespace of the volume
+	SourceNamespace string
+
+	// SourcePVC is the original PVC, it is cloned if PersistentVolume is empty
+	SourcePVC string
+
+	// PersistentVolume is an unbound PV that holds a copy of the volume, e.g. a PV created from a native snapshot
+	PersistentVolume string
+
+	// StorageClass is the storage class that the source PVC is cloned with, the storage class of the source PVC is used if it is empty
+	StorageClass string
+
+	// AccessMode defines the mode to access the volume clone
+	AccessMode string
+
+	// HostingPodLabels is the labels that are going to apply to the hosting pod
+	HostingPodLabels map[string]string
+
+	// Affinity specifies the nodes that the hosting pod could be scheduled to
+	Affinity []*nodeagent.LoadAffinity
+
+	// PodConfig specifies the resources, priority class, tolerations and image of the hosting pod
+	PodConfig *nodeagent.PodConfig
+
+	// Timeout specifies the time wait for resources operations in Expose
+	Timeout time.Duration
+}
+
+// VolumeCloneExposeWaitParam define the input param for WaitExposed of volume clones
+type VolumeCloneExposeWaitParam struct {
+	// NodeClient is the client that is used to find the hosting pod
+	NodeClient client.Client
+	NodeName   string
+}
+
+// NewVolumeCloneExposer create a new instance of volume clone exposer
+func NewVolumeCloneExposer(kubeClient kubernetes.Interface, log logrus.FieldLogger) SnapshotExposer {
+	return &volumeCloneExposer{
+		kubeClient: kubeClient,
+		log:        log,
+	}
+}
+
+// volumeCloneExposer exposes a copy of a volume that is not taken by a CSI snapshot, either a PV
+// that has been created from the snapshot of a VolumeSnapshotter plugin, or a clone of the source PVC.
+// The PV is moved to a backup PVC in the owner's namespace the same way as the CSI snapshot exposer
+// moves the VolumeSnapshot, and the PV is deleted together with the backup PVC.
+type volumeCloneExposer struct {
+	kubeClient kubernetes.Interface
+	log        logrus.FieldLogger
+}
+
+func (e *volumeCloneExposer) Expose(ctx context.Context, ownerObject corev1.ObjectReference, param interface{}) error {
+	cloneExposeParam := param.(*VolumeCloneExposeParam)
+
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	curLog.Info("Exposing volume clone")
+
+	volumeMode, err := getVolumeModeByAccessMode(cloneExposeParam.AccessMode)
+	if err != nil {
+		return err
+	}
+
+	var clonePV *corev1.PersistentVolume
+	if cloneExposeParam.PersistentVolume != "" {
+		clonePV, err = e.kubeClient.CoreV1().PersistentVolumes().Get(ctx, cloneExposeParam.PersistentVolume, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "error to get PV %s", cloneExposeParam.PersistentVolume)
+		}
+
+		if clonePV.Status.Phase == corev1.VolumeBound {
+			return errors.Errorf("PV %s is in use", clonePV.Name)
+		}
+
+		curLog.WithField("pv name", clonePV.Name).Info("Got PV of the volume clone")
+	} else {
+		clonePV, err = e.cloneSourcePVC(ctx, ownerObject, cloneExposeParam, volumeMode, curLog)
+		if err != nil {
+			return errors.Wrap(err, "error to clone source PVC")
+		}
+
+		curLog.WithField("pv name", clonePV.Name).Infof("Source PVC %s/%s is cloned", cloneExposeParam.SourceNamespace, cloneExposeParam.SourcePVC)
+	}
+
+	defer func() {
+		if err != nil {
+			e.deletePV(ctx, clonePV.Name, curLog)
+		}
+	}()
+
+	backupPVC, err := e.createBackupPVC(ctx, ownerObject, clonePV, volumeMode)
+	if err != nil {
+		return errors.Wrap(err, "error to create backup pvc")
+	}
+
+	curLog.WithField("pvc name", backupPVC.Name).Info("Backup PVC is created")
+
+	defer func() {
+		if err != nil {
+			kube.DeletePVCIfAny(ctx, e.kubeClient.CoreV1(), backupPVC.Name, backupPVC.Namespace, curLog)
+		}
+	}()
+
+	backupPod, err := createBackupPod(ctx, e.kubeClient, ownerObject, backupPVC, cloneExposeParam.HostingPodLabels, cloneExposeParam.Affinity, cloneExposeParam.PodConfig)
+	if err != nil {
+		return errors.Wrap(err, "error to create backup pod")
+	}
+
+	curLog.WithField("pod name", backupPod.Name).Info("Backup pod is created")
+
+	return nil
+}
+
+func (e *volumeCloneExposer) GetExposed(ctx context.Context, ownerObject corev1.ObjectReference, timeout time.Duration, param interface{}) (*ExposeResult, error) {
+	exposeWaitParam := param.(*VolumeCloneExposeWaitParam)
+
+	backupPodName := ownerObject.Name
+	backupPVCName := ownerObject.Name
+
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	pod := &corev1.Pod{}
+	err := exposeWaitParam.NodeClient.Get(ctx, types.NamespacedName{
+		Namespace: ownerObject.Namespace,
+		Name:      backupPodName,
+	}, pod)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			curLog.WithField("backup pod", backupPodName).Debugf("Backup pod is not running in the current node %s", exposeWaitParam.NodeName)
+			return nil, nil
+		} else {
+			return nil, errors.Wrapf(err, "error to get backup pod %s", backupPodName)
+		}
+	}
+
+	curLog.WithField("pod", pod.Name).Infof("Backup pod is in running state in node %s", pod.Spec.NodeName)
+
+	_, err = kube.WaitPVCBound(ctx, e.kubeClient.CoreV1(), e.kubeClient.CoreV1(), backupPVCName, ownerObject.Namespace, timeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to wait backup PVC bound, %s", backupPVCName)
+	}
+
+	curLog.WithField("backup pvc", backupPVCName).Info("Backup PVC is bound")
+
+	return &ExposeResult{ByPod: ExposeByPod{HostingPod: pod, PVC: backupPVCName}}, nil
+}
+
+// CleanUp deletes the backup pod and the backup PVC together with the PV of the volume clone,
+// and the clone PVC in the source namespace if the expose failed before it was deleted
+func (e *volumeCloneExposer) CleanUp(ctx context.Context, ownerObject corev1.ObjectReference, _ string, sourceNamespace string) {
+	backupPodName := ownerObject.Name
+	backupPVCName := ownerObject.Name
+	clonePVCName := ownerObject.Name
+
+	kube.DeletePodIfAny(ctx, e.kubeClient.CoreV1(), backupPodName, ownerObject.Namespace, e.log)
+
+	backupPVC, err := e.kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(ctx, backupPVCName, metav1.GetOptions{})
+	if err == nil && backupPVC.Spec.VolumeName != "" {
+		e.deletePV(ctx, backupPVC.Spec.VolumeName, e.log)
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		e.log.WithError(err).Warnf("Failed to get backup PVC %s/%s", ownerObject.Namespace, backupPVCName)
+	}
+
+	kube.DeletePVCIfAny(ctx, e.kubeClient.CoreV1(), backupPVCName, ownerObject.Namespace, e.log)
+
+	if sourceNamespace != "" {
+		kube.DeletePVCIfAny(ctx, e.kubeClient.CoreV1(), clonePVCName, sourceNamespace, e.log)
+	}
+}
+
+// cloneSourcePVC creates a clone of the source PVC, waits for it to be bound and then releases the PV from the
+// clone PVC and returns it. Kubernetes only clones a PVC into its own namespace, so the clone PVC is the one
+// object created in the source namespace; it is excluded from backups and deleted as soon as it's bound, and
+// the PV is moved to the backup PVC in the owner's namespace like the other exposers do.
+func (e *volumeCloneExposer) cloneSourcePVC(ctx context.Context, ownerObject corev1.ObjectReference, param *VolumeCloneExposeParam,
+	volumeMode corev1.PersistentVolumeMode, log logrus.FieldLogger) (*corev1.PersistentVolume, error) {
+	sourcePVC, err := e.kubeClient.CoreV1().PersistentVolumeClaims(param.SourceNamespace).Get(ctx, param.SourcePVC, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to get source PVC %s/%s", param.SourceNamespace, param.SourcePVC)
+	}
+
+	storageClass := sourcePVC.Spec.StorageClassName
+	if param.StorageClass != "" {
+		storageClass = &param.StorageClass
+	}
+
+	clonePVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: param.SourceNamespace,
+			Name:      ownerObject.Name,
+			Labels: map[string]string{
+				velerov1api.ExcludeFromBackupLabel: "true",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			StorageClassName: storageClass,
+			VolumeMode:       &volumeMode,
+			DataSource: &corev1.TypedLocalObjectReference{
+				Kind: "PersistentVolumeClaim",
+				Name: sourcePVC.Name,
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: sourcePVC.Spec.Resources.Requests,
+			},
+		},
+	}
+
+	created, err := e.kubeClient.CoreV1().PersistentVolumeClaims(clonePVC.Namespace).Create(ctx, clonePVC, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to create clone pvc")
+	}
+
+	log.WithField("pvc name", created.Name).Infof("Clone PVC is created in namespace %s", created.Namespace)
+
+	defer kube.DeletePVCIfAny(ctx, e.kubeClient.CoreV1(), created.Name, created.Namespace, log)
+
+	clonePV, err := kube.WaitPVCBound(ctx, e.kubeClient.CoreV1(), e.kubeClient.CoreV1(), created.Name, created.Namespace, param.Timeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to wait clone PVC bound, %s/%s", created.Namespace, created.Name)
+	}
+
+	// the PV is retained so that it survives the deletion of the clone PVC, its reclaim policy is
+	// changed to delete once it is bound to the backup PVC
+	retained, err := kube.SetPVReclaimPolicy(ctx, e.kubeClient.CoreV1(), clonePV, corev1.PersistentVolumeReclaimRetain)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to retain PV %s", clonePV.Name)
+	}
+
+	if retained != nil {
+		clonePV = retained
+	}
+
+	err = kube.EnsureDeletePVC(ctx, e.kubeClient.CoreV1(), created.Name, created.Namespace, param.Timeout)
+	if err != nil {
+		e.deletePV(ctx, clonePV.Name, log)
+		return nil, errors.Wrapf(err, "error to delete clone PVC %s/%s", created.Namespace, created.Name)
+	}
+
+	log.WithField("pvc name", created.Name).Info("Clone PVC is deleted")
+
+	return clonePV, nil
+}
+
+// createBackupPVC creates the backup PVC in the owner's namespace and binds it to the PV of the volume clone
+func (e *volumeCloneExposer) createBackupPVC(ctx context.Context, ownerObject corev1.ObjectReference, clonePV *corev1.PersistentVolume,
+	volumeMode corev1.PersistentVolumeMode) (*corev1.PersistentVolumeClaim, error) {
+	backupPVCName := ownerObject.Name
+
+	pv, err := kube.ResetPVBinding(ctx, e.kubeClient.CoreV1(), clonePV, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to reset binding info for PV %s", clonePV.Name)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      backupPVCName,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: ownerObject.APIVersion,
+					Kind:       ownerObject.Kind,
+					Name:       ownerObject.Name,
+					UID:        ownerObject.UID,
+					Controller: boolptr.True(),
+				},
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      pv.Spec.AccessModes,
+			StorageClassName: &pv.Spec.StorageClassName,
+			VolumeMode:       &volumeMode,
+			VolumeName:       pv.Name,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: pv.Spec.Capacity[corev1.ResourceStorage],
+				},
+			},
+		},
+	}
+
+	created, err := e.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to create pvc")
+	}
+
+	if _, err := kube.SetPVReclaimPolicy(ctx, e.kubeClient.CoreV1(), pv, corev1.PersistentVolumeReclaimDelete); err != nil {
+		kube.DeletePVCIfAny(ctx, e.kubeClient.CoreV1(), created.Name, created.Namespace, e.log)
+		return nil, errors.Wrapf(err, "error to set reclaim policy of PV %s", pv.Name)
+	}
+
+	return created, nil
+}
+
+// deletePV deletes the PV of a volume clone together with the volume behind it
+func (e *volumeCloneExposer) deletePV(ctx context.Context, pvName string, log logrus.FieldLogger) {
+	pv, err := e.kubeClient.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.WithError(err).Warnf("Failed to get PV %s", pvName)
+		}
+		return
+	}
+
+	if _, err := kube.SetPVReclaimPolicy(ctx, e.kubeClient.CoreV1(), pv, corev1.PersistentVolumeReclaimDelete); err != nil {
+		log.WithError(err).Warnf("Failed to set reclaim policy of PV %s", pvName)
+	}
+
+	kube.DeletePVIfAny(ctx, e.kubeClient.CoreV1(), pvName, log)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestVolumeCloneExpose(t *testing.T) {
+	ownerObject := corev1.ObjectReference{
+		Kind:       "DataUpload",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-du",
+		UID:        "fake-uid",
+		APIVersion: "velero.io/v2alpha1",
+	}
+
+	clonePV := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			StorageClassName:              "fake-sc",
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			ClaimRef:                      &corev1.ObjectReference{Namespace: "fake-ns", Name: "fake-released-pvc"},
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeReleased,
+		},
+	}
+
+	boundPV := clonePV.DeepCopy()
+	boundPV.Status.Phase = corev1.VolumeBound
+
+	sourcePVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-ns",
+			Name:      "fake-pvc",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		exposeParam   VolumeCloneExposeParam
+		kubeReactors  []reactor
+		err           string
+	}{
+		{
+			name: "invalid access mode",
+			exposeParam: VolumeCloneExposeParam{
+				PersistentVolume: "fake-pv",
+				AccessMode:       "fake-mode",
+			},
+			err: "unsupported access mode fake-mode",
+		},
+		{
+			name: "get pv fail",
+			exposeParam: VolumeCloneExposeParam{
+				PersistentVolume: "fake-pv",
+				AccessMode:       AccessModeFileSystem,
+			},
+			err: "error to get PV fake-pv: persistentvolumes \"fake-pv\" not found",
+		},
+		{
+			name: "pv in use",
+			exposeParam: VolumeCloneExposeParam{
+				PersistentVolume: "fake-pv",
+				AccessMode:       AccessModeFileSystem,
+			},
+			kubeClientObj: []runtime.Object{boundPV},
+			err:           "PV fake-pv is in use",
+		},
+		{
+			name: "get source pvc fail",
+			exposeParam: VolumeCloneExposeParam{
+				SourceNamespace: "fake-ns",
+				SourcePVC:       "fake-pvc",
+				AccessMode:      AccessModeFileSystem,
+			},
+			err: "error to clone source PVC: error to get source PVC fake-ns/fake-pvc: persistentvolumeclaims \"fake-pvc\" not found",
+		},
+		{
+			name: "wait clone pvc bound fail",
+			exposeParam: VolumeCloneExposeParam{
+				SourceNamespace: "fake-ns",
+				SourcePVC:       "fake-pvc",
+				AccessMode:      AccessModeFileSystem,
+				Timeout:         time.Millisecond,
+			},
+			kubeClientObj: []runtime.Object{sourcePVC},
+			err:           "error to clone source PVC: error to wait clone PVC bound, fake-ns/fake-du: error to wait for rediness of PVC: timed out waiting for the condition",
+		},
+		{
+			name: "create backup pvc fail",
+			exposeParam: VolumeCloneExposeParam{
+				PersistentVolume: "fake-pv",
+				AccessMode:       AccessModeFileSystem,
+			},
+			kubeClientObj: []runtime.Object{clonePV},
+			kubeReactors: []reactor{
+				{
+					verb:     "create",
+					resource: "persistentvolumeclaims",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-create-error")
+					},
+				},
+			},
+			err: "error to create backup pvc: error to create pvc: fake-create-error",
+		},
+		{
+			name: "create backup pod fail",
+			exposeParam: VolumeCloneExposeParam{
+				PersistentVolume: "fake-pv",
+				AccessMode:       AccessModeFileSystem,
+			},
+			kubeClientObj: []runtime.Object{clonePV},
+			kubeReactors: []reactor{
+				{
+					verb:     "create",
+					resource: "pods",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-create-error")
+					},
+				},
+			},
+			err: "error to create backup pod: fake-create-error",
+		},
+		{
+			name: "succeed",
+			exposeParam: VolumeCloneExposeParam{
+				PersistentVolume: "fake-pv",
+				AccessMode:       AccessModeBlock,
+			},
+			kubeClientObj: []runtime.Object{clonePV},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+
+			for _, reactor := range test.kubeReactors {
+				fakeKubeClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			exposer := volumeCloneExposer{
+				kubeClient: fakeKubeClient,
+				log:        velerotest.NewLogger(),
+			}
+
+			err := exposer.Expose(context.Background(), ownerObject, &test.exposeParam)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			pv, err := fakeKubeClient.CoreV1().PersistentVolumes().Get(context.Background(), "fake-pv", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Nil(t, pv.Spec.ClaimRef)
+			assert.Equal(t, corev1.PersistentVolumeReclaimDelete, pv.Spec.PersistentVolumeReclaimPolicy)
+
+			pvc, err := fakeKubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, "fake-pv", pvc.Spec.VolumeName)
+			assert.Equal(t, corev1.PersistentVolumeBlock, *pvc.Spec.VolumeMode)
+
+			pod, err := fakeKubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Len(t, pod.Spec.Containers[0].VolumeDevices, 1)
+		})
+	}
+}
@@ -40,6 +40,14 @@ func (c *FakeVeleroV1) BackupStorageLocations(namespace string) v1.BackupStorage
 	return &FakeBackupStorageLocations{c, namespace}
 }
 
+func (c *FakeVeleroV1) BackupStorageLocationMigrations(namespace string) v1.BackupStorageLocationMigrationInterface {
+	return &FakeBackupStorageLocationMigrations{c, namespace}
+}
+
+func (c *FakeVeleroV1) BackupStorageLocationTests(namespace string) v1.BackupStorageLocationTestInterface {
+	return &FakeBackupStorageLocationTests{c, namespace}
+}
+
 func (c *FakeVeleroV1) DeleteBackupRequests(namespace string) v1.DeleteBackupRequestInterface {
 	return &FakeDeleteBackupRequests{c, namespace}
 }
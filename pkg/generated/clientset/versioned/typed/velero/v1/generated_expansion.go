@@ -24,6 +24,10 @@ type BackupRepositoryExpansion interface{}
 
 type BackupStorageLocationExpansion interface{}
 
+type BackupStorageLocationMigrationExpansion interface{}
+
+type BackupStorageLocationTestExpansion interface{}
+
 type DeleteBackupRequestExpansion interface{}
 
 type DownloadRequestExpansion interface{}
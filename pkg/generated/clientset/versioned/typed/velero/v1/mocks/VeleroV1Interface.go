@@ -46,6 +46,38 @@ func (_m *VeleroV1Interface) BackupStorageLocations(namespace string) v1.BackupS
 	return r0
 }
 
+// BackupStorageLocationMigrations provides a mock function with given fields: namespace
+func (_m *VeleroV1Interface) BackupStorageLocationMigrations(namespace string) v1.BackupStorageLocationMigrationInterface {
+	ret := _m.Called(namespace)
+
+	var r0 v1.BackupStorageLocationMigrationInterface
+	if rf, ok := ret.Get(0).(func(string) v1.BackupStorageLocationMigrationInterface); ok {
+		r0 = rf(namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.BackupStorageLocationMigrationInterface)
+		}
+	}
+
+	return r0
+}
+
+// BackupStorageLocationTests provides a mock function with given fields: namespace
+func (_m *VeleroV1Interface) BackupStorageLocationTests(namespace string) v1.BackupStorageLocationTestInterface {
+	ret := _m.Called(namespace)
+
+	var r0 v1.BackupStorageLocationTestInterface
+	if rf, ok := ret.Get(0).(func(string) v1.BackupStorageLocationTestInterface); ok {
+		r0 = rf(namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.BackupStorageLocationTestInterface)
+		}
+	}
+
+	return r0
+}
+
 // Backups provides a mock function with given fields: namespace
 func (_m *VeleroV1Interface) Backups(namespace string) v1.BackupInterface {
 	ret := _m.Called(namespace)
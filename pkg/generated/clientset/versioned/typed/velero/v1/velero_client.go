@@ -29,6 +29,8 @@ type VeleroV1Interface interface {
 	BackupsGetter
 	BackupRepositoriesGetter
 	BackupStorageLocationsGetter
+	BackupStorageLocationMigrationsGetter
+	BackupStorageLocationTestsGetter
 	DeleteBackupRequestsGetter
 	DownloadRequestsGetter
 	PodVolumeBackupsGetter
@@ -56,6 +58,14 @@ func (c *VeleroV1Client) BackupStorageLocations(namespace string) BackupStorageL
 	return newBackupStorageLocations(c, namespace)
 }
 
+func (c *VeleroV1Client) BackupStorageLocationMigrations(namespace string) BackupStorageLocationMigrationInterface {
+	return newBackupStorageLocationMigrations(c, namespace)
+}
+
+func (c *VeleroV1Client) BackupStorageLocationTests(namespace string) BackupStorageLocationTestInterface {
+	return newBackupStorageLocationTests(c, namespace)
+}
+
 func (c *VeleroV1Client) DeleteBackupRequests(namespace string) DeleteBackupRequestInterface {
 	return newDeleteBackupRequests(c, namespace)
 }
@@ -60,6 +60,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Velero().V1().BackupRepositories().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("backupstoragelocations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Velero().V1().BackupStorageLocations().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("backupstoragelocationmigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Velero().V1().BackupStorageLocationMigrations().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("backupstoragelocationtests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Velero().V1().BackupStorageLocationTests().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("deletebackuprequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Velero().V1().DeleteBackupRequests().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("downloadrequests"):
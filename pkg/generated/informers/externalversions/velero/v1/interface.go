@@ -30,6 +30,10 @@ type Interface interface {
 	BackupRepositories() BackupRepositoryInformer
 	// BackupStorageLocations returns a BackupStorageLocationInformer.
 	BackupStorageLocations() BackupStorageLocationInformer
+	// BackupStorageLocationMigrations returns a BackupStorageLocationMigrationInformer.
+	BackupStorageLocationMigrations() BackupStorageLocationMigrationInformer
+	// BackupStorageLocationTests returns a BackupStorageLocationTestInformer.
+	BackupStorageLocationTests() BackupStorageLocationTestInformer
 	// DeleteBackupRequests returns a DeleteBackupRequestInformer.
 	DeleteBackupRequests() DeleteBackupRequestInformer
 	// DownloadRequests returns a DownloadRequestInformer.
@@ -74,6 +78,16 @@ func (v *version) BackupStorageLocations() BackupStorageLocationInformer {
 	return &backupStorageLocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackupStorageLocationMigrations returns a BackupStorageLocationMigrationInformer.
+func (v *version) BackupStorageLocationMigrations() BackupStorageLocationMigrationInformer {
+	return &backupStorageLocationMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// BackupStorageLocationTests returns a BackupStorageLocationTestInformer.
+func (v *version) BackupStorageLocationTests() BackupStorageLocationTestInformer {
+	return &backupStorageLocationTestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DeleteBackupRequests returns a DeleteBackupRequestInformer.
 func (v *version) DeleteBackupRequests() DeleteBackupRequestInformer {
 	return &deleteBackupRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
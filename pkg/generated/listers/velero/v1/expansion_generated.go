@@ -42,6 +42,22 @@ type BackupStorageLocationListerExpansion interface{}
 // BackupStorageLocationNamespaceLister.
 type BackupStorageLocationNamespaceListerExpansion interface{}
 
+// BackupStorageLocationMigrationListerExpansion allows custom methods to be added to
+// BackupStorageLocationMigrationLister.
+type BackupStorageLocationMigrationListerExpansion interface{}
+
+// BackupStorageLocationMigrationNamespaceListerExpansion allows custom methods to be added to
+// BackupStorageLocationMigrationNamespaceLister.
+type BackupStorageLocationMigrationNamespaceListerExpansion interface{}
+
+// BackupStorageLocationTestListerExpansion allows custom methods to be added to
+// BackupStorageLocationTestLister.
+type BackupStorageLocationTestListerExpansion interface{}
+
+// BackupStorageLocationTestNamespaceListerExpansion allows custom methods to be added to
+// BackupStorageLocationTestNamespaceLister.
+type BackupStorageLocationTestNamespaceListerExpansion interface{}
+
 // DeleteBackupRequestListerExpansion allows custom methods to be added to
 // DeleteBackupRequestLister.
 type DeleteBackupRequestListerExpansion interface{}
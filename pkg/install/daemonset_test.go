@@ -35,12 +35,28 @@ func TestDaemonSet(t *testing.T) {
 
 	ds = DaemonSet("velero", WithSecret(true))
 	assert.Equal(t, 7, len(ds.Spec.Template.Spec.Containers[0].Env))
-	assert.Equal(t, 3, len(ds.Spec.Template.Spec.Volumes))
+	assert.Equal(t, 4, len(ds.Spec.Template.Spec.Volumes))
 
 	ds = DaemonSet("velero", WithFeatures([]string{"foo,bar,baz"}))
 	assert.Len(t, ds.Spec.Template.Spec.Containers[0].Args, 3)
 	assert.Equal(t, "--features=foo,bar,baz", ds.Spec.Template.Spec.Containers[0].Args[2])
 
+	ds = DaemonSet("velero", WithPrivilegedNodeAgent())
+	assert.True(t, *ds.Spec.Template.Spec.Containers[0].SecurityContext.Privileged)
+
+	ds = DaemonSet("velero", WithNodeAgentConfigMap("node-agent-config"))
+	assert.Equal(t, "--node-agent-configmap=node-agent-config", ds.Spec.Template.Spec.Containers[0].Args[2])
+
 	ds = DaemonSet("velero", WithServiceAccountName("test-sa"))
 	assert.Equal(t, "test-sa", ds.Spec.Template.Spec.ServiceAccountName)
+
+	ds = DaemonSet("velero", WithFilesystemVolume(corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "backups"}}))
+	assert.Equal(t, 4, len(ds.Spec.Template.Spec.Volumes))
+	assert.Equal(t, "backups", ds.Spec.Template.Spec.Volumes[3].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "/velero-filesystem", ds.Spec.Template.Spec.Containers[0].VolumeMounts[3].MountPath)
+
+	ds = DaemonSet("velero", WithServiceAccountTokenAudience("sts.amazonaws.com"))
+	assert.Equal(t, 4, len(ds.Spec.Template.Spec.Volumes))
+	assert.Equal(t, "sts.amazonaws.com", ds.Spec.Template.Spec.Volumes[3].Projected.Sources[0].ServiceAccountToken.Audience)
+	assert.Equal(t, "/var/run/secrets/velero/serviceaccount", ds.Spec.Template.Spec.Containers[0].VolumeMounts[3].MountPath)
 }
@@ -46,6 +46,10 @@ type podTemplateConfig struct {
 	defaultVolumesToFsBackup        bool
 	serviceAccountName              string
 	uploaderType                    string
+	privilegedNodeAgent             bool
+	nodeAgentConfigMap              string
+	filesystemVolume                *corev1.VolumeSource
+	serviceAccountTokenAudience     string
 }
 
 func WithImage(image string) podTemplateOption {
@@ -136,6 +140,34 @@ func WithDefaultVolumesToFsBackup() podTemplateOption {
 	}
 }
 
+func WithPrivilegedNodeAgent() podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.privilegedNodeAgent = true
+	}
+}
+
+func WithNodeAgentConfigMap(configMap string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.nodeAgentConfigMap = configMap
+	}
+}
+
+// WithFilesystemVolume mounts the volume storing the buckets of the filesystem object store
+// into the pods at the default root of the object store.
+func WithFilesystemVolume(source corev1.VolumeSource) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.filesystemVolume = &source
+	}
+}
+
+// WithServiceAccountTokenAudience mounts a projected service account token with the given
+// audience, which is exchanged for the short-lived credentials of backup storage locations.
+func WithServiceAccountTokenAudience(audience string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.serviceAccountTokenAudience = audience
+	}
+}
+
 func WithServiceAccountName(sa string) podTemplateOption {
 	return func(c *podTemplateConfig) {
 		c.serviceAccountName = sa
@@ -300,6 +332,16 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 		}...)
 	}
 
+	if c.filesystemVolume != nil {
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, filesystemVolume(*c.filesystemVolume))
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, filesystemVolumeMount())
+	}
+
+	if c.serviceAccountTokenAudience != "" {
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, serviceAccountTokenVolume(c.serviceAccountTokenAudience))
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, serviceAccountTokenVolumeMount())
+	}
+
 	deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
 	if len(c.plugins) > 0 {
@@ -64,4 +64,15 @@ func TestDeployment(t *testing.T) {
 
 	deploy = Deployment("velero", WithServiceAccountName("test-sa"))
 	assert.Equal(t, "test-sa", deploy.Spec.Template.Spec.ServiceAccountName)
+
+	deploy = Deployment("velero", WithFilesystemVolume(corev1.VolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/exports/velero"}}))
+	assert.Equal(t, 3, len(deploy.Spec.Template.Spec.Volumes))
+	assert.Equal(t, "nfs", deploy.Spec.Template.Spec.Volumes[2].NFS.Server)
+	assert.Equal(t, "/velero-filesystem", deploy.Spec.Template.Spec.Containers[0].VolumeMounts[2].MountPath)
+
+	deploy = Deployment("velero", WithServiceAccountTokenAudience("sts.amazonaws.com"))
+	assert.Equal(t, 3, len(deploy.Spec.Template.Spec.Volumes))
+	assert.Equal(t, "sts.amazonaws.com", deploy.Spec.Template.Spec.Volumes[2].Projected.Sources[0].ServiceAccountToken.Audience)
+	assert.Equal(t, "token", deploy.Spec.Template.Spec.Volumes[2].Projected.Sources[0].ServiceAccountToken.Path)
+	assert.Equal(t, "/var/run/secrets/velero/serviceaccount", deploy.Spec.Template.Spec.Containers[0].VolumeMounts[2].MountPath)
 }
@@ -51,6 +51,7 @@ var kindToResource = map[string]string{
 	"DaemonSet":                "daemonsets",
 	"Secret":                   "secrets",
 	"ConfigMap":                "configmaps",
+	"Service":                  "services",
 	"BackupStorageLocation":    "backupstoragelocations",
 	"VolumeSnapshotLocation":   "volumesnapshotlocations",
 }
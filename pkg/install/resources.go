@@ -18,6 +18,7 @@ package install
 
 import (
 	"fmt"
+	"path"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -26,13 +27,22 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1crds "github.com/vmware-tanzu/velero/config/crd/v1/crds"
 	v2alpha1crds "github.com/vmware-tanzu/velero/config/crd/v2alpha1/crds"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/filesystem"
 )
 
-const defaultServiceAccountName = "velero"
+const (
+	defaultServiceAccountName = "velero"
+	filesystemVolumeName      = "filesystem-storage"
+
+	serviceAccountTokenVolumeName = "service-account-token"
+	// kubelet refreshes the projected token when it's older than 80% of its expiration
+	serviceAccountTokenExpirationSeconds = 86400
+)
 
 var (
 	DefaultVeleroPodCPURequest    = "500m"
@@ -91,6 +101,48 @@ func containerPorts() []corev1.ContainerPort {
 	}
 }
 
+func filesystemVolume(source corev1.VolumeSource) corev1.Volume {
+	return corev1.Volume{
+		Name:         filesystemVolumeName,
+		VolumeSource: source,
+	}
+}
+
+func filesystemVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      filesystemVolumeName,
+		MountPath: filesystem.DefaultRoot,
+	}
+}
+
+func serviceAccountTokenVolume(audience string) corev1.Volume {
+	expirationSeconds := int64(serviceAccountTokenExpirationSeconds)
+	return corev1.Volume{
+		Name: serviceAccountTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          audience,
+							ExpirationSeconds: &expirationSeconds,
+							Path:              path.Base(velerov1api.DefaultWebIdentityTokenFile),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func serviceAccountTokenVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      serviceAccountTokenVolumeName,
+		MountPath: path.Dir(velerov1api.DefaultWebIdentityTokenFile),
+		ReadOnly:  true,
+	}
+}
+
 func objectMeta(namespace, name string) metav1.ObjectMeta {
 	return metav1.ObjectMeta{
 		Name:      name,
@@ -139,6 +191,28 @@ func ClusterRoleBinding(namespace string) *rbacv1.ClusterRoleBinding {
 	return crb
 }
 
+// Service returns the Service of the Velero server, which exposes the download endpoint of
+// the filesystem object store to the signed URLs.
+func Service(namespace string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: objectMeta(namespace, "velero"),
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"deploy": "velero"},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       filesystem.DefaultDownloadPort,
+					TargetPort: intstr.FromString("metrics"),
+				},
+			},
+		},
+	}
+}
+
 func Namespace(namespace string) *corev1.Namespace {
 	ns := &corev1.Namespace{
 		ObjectMeta: objectMeta("", namespace),
@@ -243,6 +317,10 @@ type VeleroOptions struct {
 	Features                        []string
 	DefaultVolumesToFsBackup        bool
 	UploaderType                    string
+	PrivilegedNodeAgent             bool
+	NodeAgentConfigMap              string
+	FilesystemVolume                *corev1.VolumeSource
+	ServiceAccountTokenAudience     string
 }
 
 func AllCRDs() *unstructured.UnstructuredList {
@@ -343,6 +421,19 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 		deployOpts = append(deployOpts, WithDefaultVolumesToFsBackup())
 	}
 
+	if o.FilesystemVolume != nil {
+		deployOpts = append(deployOpts, WithFilesystemVolume(*o.FilesystemVolume))
+
+		svc := Service(o.Namespace)
+		if err := appendUnstructured(resources, svc); err != nil {
+			fmt.Printf("error appending Service %s: %s\n", svc.GetName(), err.Error())
+		}
+	}
+
+	if o.ServiceAccountTokenAudience != "" {
+		deployOpts = append(deployOpts, WithServiceAccountTokenAudience(o.ServiceAccountTokenAudience))
+	}
+
 	deploy := Deployment(o.Namespace, deployOpts...)
 
 	if err := appendUnstructured(resources, deploy); err != nil {
@@ -361,6 +452,18 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 		if len(o.Features) > 0 {
 			dsOpts = append(dsOpts, WithFeatures(o.Features))
 		}
+		if o.PrivilegedNodeAgent {
+			dsOpts = append(dsOpts, WithPrivilegedNodeAgent())
+		}
+		if o.NodeAgentConfigMap != "" {
+			dsOpts = append(dsOpts, WithNodeAgentConfigMap(o.NodeAgentConfigMap))
+		}
+		if o.FilesystemVolume != nil {
+			dsOpts = append(dsOpts, WithFilesystemVolume(*o.FilesystemVolume))
+		}
+		if o.ServiceAccountTokenAudience != "" {
+			dsOpts = append(dsOpts, WithServiceAccountTokenAudience(o.ServiceAccountTokenAudience))
+		}
 		ds := DaemonSet(o.Namespace, dsOpts...)
 		if err := appendUnstructured(resources, ds); err != nil {
 			fmt.Printf("error appending DaemonSet %s: %s\n", ds.GetName(), err.Error())
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -67,7 +68,7 @@ func TestResources(t *testing.T) {
 
 func TestAllCRDs(t *testing.T) {
 	list := AllCRDs()
-	assert.Len(t, list.Items, 13)
+	assert.Len(t, list.Items, 15)
 	assert.Equal(t, Labels(), list.Items[0].GetLabels())
 }
 
@@ -109,4 +110,17 @@ func TestAllResources(t *testing.T) {
 
 	_, exist = objects["DaemonSet"]
 	assert.True(t, exist)
+
+	_, exist = objects["Service"]
+	assert.False(t, exist)
+
+	option.FilesystemVolume = &corev1.VolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/exports/velero"}}
+	objects = map[string]unstructured.Unstructured{}
+	for _, item := range AllResources(option).Items {
+		objects[item.GetKind()] = item
+	}
+
+	svc, exist := objects["Service"]
+	require.True(t, exist)
+	assert.Equal(t, "velero", svc.GetName())
 }
@@ -19,6 +19,7 @@ package itemoperationmap
 import (
 	"bytes"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -76,18 +77,20 @@ func (m *BackupItemOperationsMap) DeleteOperationsForBackup(backupName string) {
 }
 
 // UploadProgressAndPutOperationsForBackup will upload the item operations for this backup to
-// the object store and update the map for this backup with the modified operations
+// the object store and update the map for this backup with the modified operations, the
+// uploaded item operations are locked until retainUntil if object lock is enabled
 func (m *BackupItemOperationsMap) UploadProgressAndPutOperationsForBackup(
 	backupStore persistence.BackupStore,
 	operations *OperationsForBackup,
-	backupName string) error {
+	backupName string,
+	retainUntil time.Time) error {
 	m.opsLock.Lock()
 	defer m.opsLock.Unlock()
 
 	if operations == nil {
 		return errors.New("nil operations passed in")
 	}
-	if err := operations.uploadProgress(backupStore, backupName); err != nil {
+	if err := operations.uploadProgress(backupStore, backupName, retainUntil); err != nil {
 		return err
 	}
 	m.opsMap[backupName] = operations
@@ -95,8 +98,9 @@ func (m *BackupItemOperationsMap) UploadProgressAndPutOperationsForBackup(
 }
 
 // UpdateForBackup will upload the item operations for this backup to
-// the object store, if it has changes not yet uploaded
-func (m *BackupItemOperationsMap) UpdateForBackup(backupStore persistence.BackupStore, backupName string) error {
+// the object store, if it has changes not yet uploaded, the uploaded item
+// operations are locked until retainUntil if object lock is enabled
+func (m *BackupItemOperationsMap) UpdateForBackup(backupStore persistence.BackupStore, backupName string, retainUntil time.Time) error {
 	// lock operations map
 	m.opsLock.Lock()
 	defer m.opsLock.Unlock()
@@ -107,7 +111,7 @@ func (m *BackupItemOperationsMap) UpdateForBackup(backupStore persistence.Backup
 	if !ok || (!operations.ChangesSinceUpdate && len(operations.ErrsSinceUpdate) == 0) {
 		return nil
 	}
-	if err := operations.uploadProgress(backupStore, backupName); err != nil {
+	if err := operations.uploadProgress(backupStore, backupName, retainUntil); err != nil {
 		return err
 	}
 	return nil
@@ -148,14 +152,14 @@ func (m *OperationsForBackup) DeepCopyInto(out *OperationsForBackup) {
 	}
 }
 
-func (m *OperationsForBackup) uploadProgress(backupStore persistence.BackupStore, backupName string) error {
+func (m *OperationsForBackup) uploadProgress(backupStore persistence.BackupStore, backupName string, retainUntil time.Time) error {
 	if len(m.Operations) > 0 {
 		var backupItemOperations *bytes.Buffer
 		backupItemOperations, errs := encode.ToJSONGzip(m.Operations, "backup item operations list")
 		if errs != nil {
 			return errors.Wrap(errs[0], "error encoding item operations json")
 		}
-		err := backupStore.PutBackupItemOperations(backupName, backupItemOperations)
+		err := backupStore.PutBackupItemOperations(backupName, backupItemOperations, retainUntil)
 		if err != nil {
 			return errors.Wrap(err, "error uploading item operations json")
 		}
@@ -59,6 +59,15 @@ const (
 	csiSnapshotAttemptTotal       = "csi_snapshot_attempt_total"
 	csiSnapshotSuccessTotal       = "csi_snapshot_success_total"
 	csiSnapshotFailureTotal       = "csi_snapshot_failure_total"
+	repoOrphanedSnapshotsGauge    = "repo_orphaned_snapshots"
+	repoOrphanedSnapshotForgotten = "repo_orphaned_snapshot_forget_success_total"
+	repoOrphanedSnapshotFailure   = "repo_orphaned_snapshot_forget_failure_total"
+	bslConsecutiveFailuresGauge   = "backup_storage_location_consecutive_failures"
+	bslLastSuccessfulValidation   = "backup_storage_location_last_successful_validation_timestamp"
+	bslListLatencySeconds         = "backup_storage_location_list_latency_seconds"
+	bslPutLatencySeconds          = "backup_storage_location_put_latency_seconds"
+	bslUsedBytesGauge             = "backup_storage_location_used_bytes"
+	bslBackupsGauge               = "backup_storage_location_backups"
 
 	// pod volume metrics
 	podVolumeBackupEnqueueTotal           = "pod_volume_backup_enqueue_count"
@@ -72,6 +81,9 @@ const (
 	pvbNameLabel            = "pod_volume_backup"
 	scheduleLabel           = "schedule"
 	backupNameLabel         = "backupName"
+	repositoryLabel         = "repository"
+	bslNameLabel            = "backupStorageLocation"
+	bslUsageTypeLabel       = "type"
 
 	// metrics values
 	BackupLastStatusSucc    int64 = 1
@@ -315,6 +327,78 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel, backupNameLabel},
 			),
+			repoOrphanedSnapshotsGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      repoOrphanedSnapshotsGauge,
+					Help:      "Number of the snapshots in a backup repository not referenced by any backup, found by the latest check",
+				},
+				[]string{repositoryLabel},
+			),
+			repoOrphanedSnapshotForgotten: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      repoOrphanedSnapshotForgotten,
+					Help:      "Total number of orphaned snapshots forgotten from backup repositories",
+				},
+				[]string{repositoryLabel},
+			),
+			repoOrphanedSnapshotFailure: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      repoOrphanedSnapshotFailure,
+					Help:      "Total number of orphaned snapshots failed to be forgotten from backup repositories",
+				},
+				[]string{repositoryLabel},
+			),
+			bslConsecutiveFailuresGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslConsecutiveFailuresGauge,
+					Help:      "Number of consecutive failed validations of a backup storage location",
+				},
+				[]string{bslNameLabel},
+			),
+			bslLastSuccessfulValidation: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslLastSuccessfulValidation,
+					Help:      "Last time a backup storage location was validated successfully, Unix timestamp in seconds",
+				},
+				[]string{bslNameLabel},
+			),
+			bslListLatencySeconds: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslListLatencySeconds,
+					Help:      "Time taken, in seconds, to list the objects of a backup storage location during its latest validation",
+				},
+				[]string{bslNameLabel},
+			),
+			bslPutLatencySeconds: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslPutLatencySeconds,
+					Help:      "Time taken, in seconds, to put an object in a backup storage location during its latest validation",
+				},
+				[]string{bslNameLabel},
+			),
+			bslUsedBytesGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslUsedBytesGauge,
+					Help:      "Size, in bytes, of the data stored in a backup storage location",
+				},
+				[]string{bslNameLabel, bslUsageTypeLabel},
+			),
+			bslBackupsGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslBackupsGauge,
+					Help:      "Number of the backups stored in a backup storage location",
+				},
+				[]string{bslNameLabel},
+			),
 		},
 	}
 }
@@ -690,3 +774,76 @@ func (m *ServerMetrics) RegisterCSISnapshotFailures(backupSchedule, backupName s
 		c.WithLabelValues(backupSchedule, backupName).Add(float64(csiSnapshotsFailed))
 	}
 }
+
+// SetRepoOrphanedSnapshots records the number of the orphaned snapshots found in a backup repository.
+func (m *ServerMetrics) SetRepoOrphanedSnapshots(repo string, count int) {
+	if g, ok := m.metrics[repoOrphanedSnapshotsGauge].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(repo).Set(float64(count))
+	}
+}
+
+// RegisterRepoOrphanedSnapshotForgotten records an orphaned snapshot forgotten from a backup repository.
+func (m *ServerMetrics) RegisterRepoOrphanedSnapshotForgotten(repo string) {
+	if c, ok := m.metrics[repoOrphanedSnapshotForgotten].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(repo).Inc()
+	}
+}
+
+// RegisterRepoOrphanedSnapshotForgetFailed records an orphaned snapshot failed to be forgotten from a backup repository.
+func (m *ServerMetrics) RegisterRepoOrphanedSnapshotForgetFailed(repo string) {
+	if c, ok := m.metrics[repoOrphanedSnapshotFailure].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(repo).Inc()
+	}
+}
+
+// RegisterBackupStorageLocationValidation records the result of a validation of a backup storage location.
+func (m *ServerMetrics) RegisterBackupStorageLocationValidation(location string, consecutiveFailures int, lastSuccessful time.Time) {
+	if g, ok := m.metrics[bslConsecutiveFailuresGauge].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location).Set(float64(consecutiveFailures))
+	}
+	if g, ok := m.metrics[bslLastSuccessfulValidation].(*prometheus.GaugeVec); ok && !lastSuccessful.IsZero() {
+		g.WithLabelValues(location).Set(float64(lastSuccessful.Unix()))
+	}
+}
+
+// SetBackupStorageLocationListLatency records the time taken to list the objects of a backup storage location.
+func (m *ServerMetrics) SetBackupStorageLocationListLatency(location string, latency time.Duration) {
+	if g, ok := m.metrics[bslListLatencySeconds].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location).Set(latency.Seconds())
+	}
+}
+
+// SetBackupStorageLocationPutLatency records the time taken to put an object in a backup storage location.
+func (m *ServerMetrics) SetBackupStorageLocationPutLatency(location string, latency time.Duration) {
+	if g, ok := m.metrics[bslPutLatencySeconds].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location).Set(latency.Seconds())
+	}
+}
+
+// SetBackupStorageLocationUsedBytes records the size of the backups and of the backup
+// repositories stored in a backup storage location.
+func (m *ServerMetrics) SetBackupStorageLocationUsedBytes(location string, backupBytes, repositoryBytes int64) {
+	if g, ok := m.metrics[bslUsedBytesGauge].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location, "backup").Set(float64(backupBytes))
+		g.WithLabelValues(location, "repository").Set(float64(repositoryBytes))
+	}
+}
+
+// SetBackupStorageLocationBackups records the number of the backups stored in a backup storage location.
+func (m *ServerMetrics) SetBackupStorageLocationBackups(location string, count int) {
+	if g, ok := m.metrics[bslBackupsGauge].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(location).Set(float64(count))
+	}
+}
+
+// RemoveBackupStorageLocation removes the metrics of a deleted backup storage location.
+func (m *ServerMetrics) RemoveBackupStorageLocation(location string) {
+	for _, name := range []string{bslConsecutiveFailuresGauge, bslLastSuccessfulValidation, bslListLatencySeconds, bslPutLatencySeconds, bslBackupsGauge} {
+		if g, ok := m.metrics[name].(*prometheus.GaugeVec); ok {
+			g.DeleteLabelValues(location)
+		}
+	}
+	if g, ok := m.metrics[bslUsedBytesGauge].(*prometheus.GaugeVec); ok {
+		g.DeletePartialMatch(prometheus.Labels{bslNameLabel: location})
+	}
+}
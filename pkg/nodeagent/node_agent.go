@@ -18,13 +18,17 @@ package nodeagent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -39,6 +43,85 @@ var (
 	ErrDaemonSetNotFound = errors.New("daemonset not found")
 )
 
+// Configs are the configurations of the node-agent, they are kept as JSON in a ConfigMap in the Velero namespace
+type Configs struct {
+	// LoadConcurrency is the config for the number of the data path loads that run concurrently in each node
+	LoadConcurrency *LoadConcurrency `json:"loadConcurrency,omitempty"`
+
+	// LoadAffinity is the config for the nodes that the hosting pods of the data path loads are scheduled to,
+	// a hosting pod is scheduled to a node that matches any of them
+	LoadAffinity []*LoadAffinity `json:"loadAffinity,omitempty"`
+
+	// PodConfig is the config of the hosting pods of the data path loads
+	PodConfig *PodConfig `json:"podConfig,omitempty"`
+
+	// CheckpointInterval is how often the kopia uploader saves a checkpoint of a backup in progress, which
+	// the backup is resumed from if the node-agent restarts
+	CheckpointInterval *metav1.Duration `json:"checkpointInterval,omitempty"`
+}
+
+// LoadConcurrency is the config for the number of the data path loads that run concurrently in each node
+type LoadConcurrency struct {
+	// GlobalConfig is the number that applies to all the nodes
+	GlobalConfig int `json:"globalConfig,omitempty"`
+
+	// PerNodeConfig is the number that applies to the nodes matching the node selector, it overrides GlobalConfig.
+	// If a node matches more than one of them, the smallest number applies.
+	PerNodeConfig []RuledConfigs `json:"perNodeConfig,omitempty"`
+}
+
+// RuledConfigs is a number that applies to the nodes matching the node selector
+type RuledConfigs struct {
+	// NodeSelector specifies the label of the nodes that the number applies to
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// Number is the number of the data path loads that run concurrently
+	Number int `json:"number"`
+}
+
+// LoadAffinity is the config for the nodes that the hosting pods of the data path loads are scheduled to
+type LoadAffinity struct {
+	// NodeSelector specifies the label of the nodes
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
+// PodConfig is the config of the hosting pods of the data path loads, each field could be
+// overridden by a DataUpload or DataDownload through the DataMoverConfig keys below
+type PodConfig struct {
+	// CPURequest is the CPU request of the hosting pod, it is unbounded if empty
+	CPURequest string `json:"cpuRequest,omitempty"`
+
+	// MemoryRequest is the memory request of the hosting pod, it is unbounded if empty
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+
+	// CPULimit is the CPU limit of the hosting pod, it is unbounded if empty
+	CPULimit string `json:"cpuLimit,omitempty"`
+
+	// MemoryLimit is the memory limit of the hosting pod, it is unbounded if empty
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+
+	// PriorityClassName is the priority class of the hosting pod
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// Tolerations are the tolerations of the hosting pod, e.g. to run it in the nodes tainted for backups
+	Tolerations []corev1api.Toleration `json:"tolerations,omitempty"`
+
+	// Image is the image of the hosting pod, e.g. to use a mirror of the default image
+	Image string `json:"image,omitempty"`
+}
+
+// The DataMoverConfig keys of a DataUpload or DataDownload that override the PodConfig of the node-agent
+const (
+	DataMoverConfigPodCPURequest        = "podCPURequest"
+	DataMoverConfigPodMemoryRequest     = "podMemoryRequest"
+	DataMoverConfigPodCPULimit          = "podCPULimit"
+	DataMoverConfigPodMemoryLimit       = "podMemoryLimit"
+	DataMoverConfigPodPriorityClassName = "podPriorityClassName"
+	// DataMoverConfigPodTolerations is a JSON array of tolerations
+	DataMoverConfigPodTolerations = "podTolerations"
+	DataMoverConfigPodImage       = "podImage"
+)
+
 // IsRunning checks if the node agent daemonset is running properly. If not, return the error found
 func IsRunning(ctx context.Context, kubeClient kubernetes.Interface, namespace string) error {
 	if _, err := kubeClient.AppsV1().DaemonSets(namespace).Get(ctx, daemonSet, metav1.GetOptions{}); apierrors.IsNotFound(err) {
@@ -73,3 +156,128 @@ func IsRunningInNode(ctx context.Context, namespace string, nodeName string, pod
 
 	return errors.Errorf("daemonset pod not found in running state in node %s", nodeName)
 }
+
+// GetConfigs reads the node-agent configurations from the ConfigMap with the name of configName
+func GetConfigs(ctx context.Context, namespace string, configMapGetter corev1client.ConfigMapsGetter, configName string) (*Configs, error) {
+	cm, err := configMapGetter.ConfigMaps(namespace).Get(ctx, configName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to get node-agent configs %s", configName)
+	}
+
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("node-agent configs %s should have exactly one data item, but it has %d", configName, len(cm.Data))
+	}
+
+	configs := &Configs{}
+	for _, v := range cm.Data {
+		if err := json.Unmarshal([]byte(v), configs); err != nil {
+			return nil, errors.Wrapf(err, "error to unmarshal node-agent configs %s", configName)
+		}
+	}
+
+	return configs, nil
+}
+
+// GetConcurrentNum returns the number of the data path loads that run concurrently in the node with the nodeLabels,
+// defaultNum is returned if the number is not configured for the node
+func GetConcurrentNum(concurrency *LoadConcurrency, nodeLabels map[string]string, defaultNum int, log logrus.FieldLogger) int {
+	if concurrency == nil {
+		return defaultNum
+	}
+
+	num := defaultNum
+	if concurrency.GlobalConfig > 0 {
+		num = concurrency.GlobalConfig
+	} else if concurrency.GlobalConfig < 0 {
+		log.Warnf("Global number %v is invalid, use the default value %v", concurrency.GlobalConfig, defaultNum)
+	}
+
+	perNodeNum := 0
+	for _, rule := range concurrency.PerNodeConfig {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NodeSelector)
+		if err != nil {
+			log.WithError(err).Warnf("Failed to parse node selector %v, skip it", rule.NodeSelector)
+			continue
+		}
+
+		if rule.Number <= 0 {
+			log.Warnf("Rule number %v for node selector %v is invalid, skip it", rule.Number, rule.NodeSelector)
+			continue
+		}
+
+		if !selector.Matches(labels.Set(nodeLabels)) {
+			continue
+		}
+
+		if perNodeNum == 0 || rule.Number < perNodeNum {
+			perNodeNum = rule.Number
+		}
+	}
+
+	if perNodeNum > 0 {
+		num = perNodeNum
+	}
+
+	return num
+}
+
+// GetPodConfig returns the config of a hosting pod, which is the PodConfig of the node-agent overridden by
+// the DataMoverConfig of the DataUpload or DataDownload
+func GetPodConfig(podConfig *PodConfig, dataMoverConfig map[string]string) (*PodConfig, error) {
+	result := &PodConfig{}
+	if podConfig != nil {
+		result = podConfig.DeepCopy()
+	}
+
+	for key, field := range map[string]*string{
+		DataMoverConfigPodCPURequest:        &result.CPURequest,
+		DataMoverConfigPodMemoryRequest:     &result.MemoryRequest,
+		DataMoverConfigPodCPULimit:          &result.CPULimit,
+		DataMoverConfigPodMemoryLimit:       &result.MemoryLimit,
+		DataMoverConfigPodPriorityClassName: &result.PriorityClassName,
+		DataMoverConfigPodImage:             &result.Image,
+	} {
+		if value, found := dataMoverConfig[key]; found {
+			*field = value
+		}
+	}
+
+	if value, found := dataMoverConfig[DataMoverConfigPodTolerations]; found {
+		var tolerations []corev1api.Toleration
+		if err := json.Unmarshal([]byte(value), &tolerations); err != nil {
+			return nil, errors.Wrapf(err, "error to unmarshal %s of the data mover config", DataMoverConfigPodTolerations)
+		}
+		result.Tolerations = tolerations
+	}
+
+	return result, nil
+}
+
+// GetResources returns the resource requirements of the hosting pod
+func (c *PodConfig) GetResources() (corev1api.ResourceRequirements, error) {
+	quantity := func(value string) string {
+		if value == "" {
+			return "0"
+		}
+		return value
+	}
+
+	return kube.ParseResourceRequirements(quantity(c.CPURequest), quantity(c.MemoryRequest), quantity(c.CPULimit), quantity(c.MemoryLimit))
+}
+
+// DeepCopy returns a deep copy of the PodConfig
+func (c *PodConfig) DeepCopy() *PodConfig {
+	if c == nil {
+		return nil
+	}
+
+	out := *c
+	if c.Tolerations != nil {
+		out.Tolerations = make([]corev1api.Toleration, len(c.Tolerations))
+		for i := range c.Tolerations {
+			c.Tolerations[i].DeepCopyInto(&out.Tolerations[i])
+		}
+	}
+
+	return &out
+}
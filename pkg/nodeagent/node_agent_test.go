@@ -0,0 +1,230 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetConfigs(t *testing.T) {
+	newConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "node-agent-config"},
+			Data:       data,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		expected      *Configs
+		err           string
+	}{
+		{
+			name: "configmap not found",
+			err:  "error to get node-agent configs node-agent-config: configmaps \"node-agent-config\" not found",
+		},
+		{
+			name:          "no data",
+			kubeClientObj: []runtime.Object{newConfigMap(nil)},
+			err:           "node-agent configs node-agent-config should have exactly one data item, but it has 0",
+		},
+		{
+			name:          "invalid json",
+			kubeClientObj: []runtime.Object{newConfigMap(map[string]string{"config": "invalid"})},
+			err:           "error to unmarshal node-agent configs node-agent-config: invalid character 'i' looking for beginning of value",
+		},
+		{
+			name: "succeed",
+			kubeClientObj: []runtime.Object{newConfigMap(map[string]string{
+				"config": `{"loadConcurrency":{"globalConfig":2,"perNodeConfig":[{"nodeSelector":{"matchLabels":{"size":"large"}},"number":4}]},"loadAffinity":[{"nodeSelector":{"matchLabels":{"role":"backup"}}}]}`,
+			})},
+			expected: &Configs{
+				LoadConcurrency: &LoadConcurrency{
+					GlobalConfig: 2,
+					PerNodeConfig: []RuledConfigs{
+						{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"size": "large"}}, Number: 4},
+					},
+				},
+				LoadAffinity: []*LoadAffinity{
+					{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "backup"}}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+
+			configs, err := GetConfigs(context.Background(), "velero", fakeKubeClient.CoreV1(), "node-agent-config")
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, configs)
+		})
+	}
+}
+
+func TestGetConcurrentNum(t *testing.T) {
+	large := RuledConfigs{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"size": "large"}}, Number: 4}
+	fast := RuledConfigs{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"disk": "fast"}}, Number: 3}
+	invalid := RuledConfigs{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"size": "large"}}, Number: 0}
+
+	tests := []struct {
+		name        string
+		concurrency *LoadConcurrency
+		nodeLabels  map[string]string
+		expected    int
+	}{
+		{
+			name:     "not configured",
+			expected: 1,
+		},
+		{
+			name:        "global config",
+			concurrency: &LoadConcurrency{GlobalConfig: 2},
+			expected:    2,
+		},
+		{
+			name:        "invalid global config",
+			concurrency: &LoadConcurrency{GlobalConfig: -1},
+			expected:    1,
+		},
+		{
+			name:        "per node config doesn't match",
+			concurrency: &LoadConcurrency{GlobalConfig: 2, PerNodeConfig: []RuledConfigs{large}},
+			nodeLabels:  map[string]string{"size": "small"},
+			expected:    2,
+		},
+		{
+			name:        "per node config matches",
+			concurrency: &LoadConcurrency{GlobalConfig: 2, PerNodeConfig: []RuledConfigs{large}},
+			nodeLabels:  map[string]string{"size": "large"},
+			expected:    4,
+		},
+		{
+			name:        "smallest matched per node config",
+			concurrency: &LoadConcurrency{GlobalConfig: 2, PerNodeConfig: []RuledConfigs{large, fast}},
+			nodeLabels:  map[string]string{"size": "large", "disk": "fast"},
+			expected:    3,
+		},
+		{
+			name:        "invalid per node config",
+			concurrency: &LoadConcurrency{GlobalConfig: 2, PerNodeConfig: []RuledConfigs{invalid}},
+			nodeLabels:  map[string]string{"size": "large"},
+			expected:    2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, GetConcurrentNum(test.concurrency, test.nodeLabels, 1, velerotest.NewLogger()))
+		})
+	}
+}
+
+func TestGetPodConfig(t *testing.T) {
+	toleration := corev1.Toleration{Key: "backup", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+
+	tests := []struct {
+		name            string
+		podConfig       *PodConfig
+		dataMoverConfig map[string]string
+		expected        *PodConfig
+		err             string
+	}{
+		{
+			name:     "not configured",
+			expected: &PodConfig{},
+		},
+		{
+			name:      "node-agent config only",
+			podConfig: &PodConfig{CPURequest: "100m", MemoryLimit: "1Gi", PriorityClassName: "low", Tolerations: []corev1.Toleration{toleration}},
+			expected:  &PodConfig{CPURequest: "100m", MemoryLimit: "1Gi", PriorityClassName: "low", Tolerations: []corev1.Toleration{toleration}},
+		},
+		{
+			name:      "overridden by data mover config",
+			podConfig: &PodConfig{CPURequest: "100m", MemoryLimit: "1Gi", PriorityClassName: "low"},
+			dataMoverConfig: map[string]string{
+				DataMoverConfigPodMemoryLimit:       "2Gi",
+				DataMoverConfigPodPriorityClassName: "high",
+				DataMoverConfigPodTolerations:       `[{"key":"backup","operator":"Exists","effect":"NoSchedule"}]`,
+				DataMoverConfigPodImage:             "registry.example.com/alpine:latest",
+				"otherKey":                          "other-value",
+			},
+			expected: &PodConfig{
+				CPURequest:        "100m",
+				MemoryLimit:       "2Gi",
+				PriorityClassName: "high",
+				Tolerations:       []corev1.Toleration{toleration},
+				Image:             "registry.example.com/alpine:latest",
+			},
+		},
+		{
+			name:            "invalid tolerations",
+			dataMoverConfig: map[string]string{DataMoverConfigPodTolerations: "invalid"},
+			err:             "error to unmarshal podTolerations of the data mover config: invalid character 'i' looking for beginning of value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := test.podConfig.DeepCopy()
+
+			podConfig, err := GetPodConfig(test.podConfig, test.dataMoverConfig)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, podConfig)
+			assert.Equal(t, original, test.podConfig)
+		})
+	}
+}
+
+func TestPodConfigGetResources(t *testing.T) {
+	resources, err := (&PodConfig{}).GetResources()
+	assert.NoError(t, err)
+	assert.Empty(t, resources.Requests)
+	assert.Empty(t, resources.Limits)
+
+	resources, err = (&PodConfig{CPURequest: "100m", MemoryLimit: "1Gi"}).GetResources()
+	assert.NoError(t, err)
+	assert.Equal(t, resource.MustParse("100m"), resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("1Gi"), resources.Limits[corev1.ResourceMemory])
+	assert.NotContains(t, resources.Requests, corev1.ResourceMemory)
+	assert.NotContains(t, resources.Limits, corev1.ResourceCPU)
+
+	_, err = (&PodConfig{CPURequest: "invalid"}).GetResources()
+	assert.Error(t, err)
+}
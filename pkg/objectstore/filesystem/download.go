@@ -0,0 +1,211 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// DownloadPath is the path of the endpoint of the Velero server serving the
+	// signed URLs of the filesystem object store.
+	DownloadPath = "/filesystem/download"
+
+	// DefaultDownloadPort is the port of the Velero server the download endpoint
+	// is served on by default, which is the port of the metrics endpoint.
+	DefaultDownloadPort = 8085
+
+	// SigningKeyConfigKey is the config key the Velero server passes the key which
+	// signs the download URLs in, hex encoded. It's only passed to the built-in
+	// filesystem object store.
+	SigningKeyConfigKey = "signingKey"
+
+	signingKeySize = 32
+)
+
+// NewSigningKey returns a random key to sign the download URLs with.
+func NewSigningKey() ([]byte, error) {
+	key := make([]byte, signingKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "error generating the signing key of the filesystem object store")
+	}
+
+	return key, nil
+}
+
+// downloadRequest is the object a signed URL gives access to, until it expires. The root
+// directory of the object store is signed but isn't part of the URL, the Velero server
+// gets it from the backup storage locations.
+type downloadRequest struct {
+	Root    string
+	Bucket  string
+	Key     string
+	Expires int64
+}
+
+func (r downloadRequest) signature(signingKey []byte) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(strings.Join([]string{r.Root, r.Bucket, r.Key, strconv.FormatInt(r.Expires, 10)}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (r downloadRequest) query(signingKey []byte) url.Values {
+	return url.Values{
+		"bucket":    []string{r.Bucket},
+		"key":       []string{r.Key},
+		"expires":   []string{strconv.FormatInt(r.Expires, 10)},
+		"signature": []string{r.signature(signingKey)},
+	}
+}
+
+// parseDownloadRequest returns the download request of query if it's signed by signingKey
+// for one of the roots and hasn't expired.
+func parseDownloadRequest(query url.Values, signingKey []byte, roots []string) (downloadRequest, error) {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return downloadRequest{}, errors.Wrap(err, "invalid expires parameter")
+	}
+
+	req := downloadRequest{
+		Bucket:  query.Get("bucket"),
+		Key:     query.Get("key"),
+		Expires: expires,
+	}
+
+	var signed bool
+	for _, root := range roots {
+		req.Root = root
+		if hmac.Equal([]byte(req.signature(signingKey)), []byte(query.Get("signature"))) {
+			signed = true
+			break
+		}
+	}
+	if !signed {
+		return downloadRequest{}, errors.New("invalid signature")
+	}
+
+	if time.Now().Unix() > req.Expires {
+		return downloadRequest{}, errors.New("the URL has expired")
+	}
+
+	return req, nil
+}
+
+type downloadHandler struct {
+	signingKey []byte
+	client     kbclient.Reader
+	namespace  string
+	log        logrus.FieldLogger
+}
+
+// NewDownloadHandler returns the handler of the download endpoint, which serves the objects
+// of the signed URLs created by the filesystem object store. The objects are only served from
+// the root directories of the filesystem backup storage locations in namespace.
+func NewDownloadHandler(signingKey []byte, client kbclient.Reader, namespace string, log logrus.FieldLogger) http.Handler {
+	return &downloadHandler{signingKey: signingKey, client: client, namespace: namespace, log: log}
+}
+
+func (h *downloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	roots, err := h.roots(r.Context(), r.URL.Query().Get("bucket"))
+	if err != nil {
+		h.log.WithError(err).Error("Error getting the root directories of the filesystem backup storage locations")
+		http.Error(w, "error getting the backup storage locations", http.StatusInternalServerError)
+		return
+	}
+
+	req, err := parseDownloadRequest(r.URL.Query(), h.signingKey, roots)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	log := h.log.WithFields(logrus.Fields{"bucket": req.Bucket, "key": req.Key})
+
+	file, err := objectPath(req.Root, req.Bucket, req.Key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Error opening object to download")
+		http.Error(w, "error opening object", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		log.WithError(err).Error("Error getting the attributes of object to download")
+		http.Error(w, "error getting the attributes of object", http.StatusInternalServerError)
+		return
+	}
+
+	log.Debug("Serving object download")
+	http.ServeContent(w, r, path.Base(req.Key), info.ModTime(), f)
+}
+
+// roots returns the root directories of the filesystem backup storage locations of bucket.
+func (h *downloadHandler) roots(ctx context.Context, bucket string) ([]string, error) {
+	locations := new(velerov1api.BackupStorageLocationList)
+	if err := h.client.List(ctx, locations, kbclient.InNamespace(h.namespace)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var roots []string
+	for _, location := range locations.Items {
+		if !IsProvider(location.Spec.Provider) || location.Spec.ObjectStorage == nil || location.Spec.ObjectStorage.Bucket != bucket {
+			continue
+		}
+
+		root := location.Spec.Config[rootConfigKey]
+		if root == "" {
+			root = DefaultRoot
+		}
+		roots = append(roots, filepath.Clean(root))
+	}
+
+	return roots, nil
+}
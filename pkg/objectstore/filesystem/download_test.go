@@ -0,0 +1,142 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewSigningKey(t *testing.T) {
+	key, err := NewSigningKey()
+	require.NoError(t, err)
+	assert.Len(t, key, signingKeySize)
+
+	other, err := NewSigningKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestCreateSignedURLAndDownload(t *testing.T) {
+	signingKey, err := NewSigningKey()
+	require.NoError(t, err)
+
+	root := t.TempDir()
+	location := builder.ForBackupStorageLocation("velero", "nfs").Provider("filesystem").Bucket("bucket").Result()
+	location.Spec.Config = map[string]string{"root": root}
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackupStorageLocation("velero", "default").Provider("velero.io/filesystem").Bucket("bucket").Result(),
+		location,
+	)
+	server := httptest.NewServer(NewDownloadHandler(signingKey, client, "velero", velerotest.NewLogger()))
+	defer server.Close()
+
+	o := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, o.Init(map[string]string{"root": root, "downloadURL": server.URL, "signingKey": hex.EncodeToString(signingKey)}))
+	_, err = o.PutObject("bucket", "backups/backup-1/backup-1-logs.gz", strings.NewReader("logs"), osv2.PutObjectOptions{})
+	require.NoError(t, err)
+
+	get := func(rawURL string) (int, string) {
+		res, err := http.Get(rawURL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+
+	signedURL, err := o.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signedURL, server.URL+DownloadPath+"?"))
+
+	status, body := get(signedURL)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "logs", body)
+
+	// the root directory isn't part of the URL
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	assert.Empty(t, u.Query().Get("root"))
+
+	// objects of other root directories than the ones of the locations aren't served
+	other := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, other.Init(map[string]string{"root": t.TempDir(), "downloadURL": server.URL, "signingKey": hex.EncodeToString(signingKey)}))
+	_, err = other.PutObject("bucket", "backups/backup-1/backup-1-logs.gz", strings.NewReader("other logs"), osv2.PutObjectOptions{})
+	require.NoError(t, err)
+	otherURL, err := other.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", time.Minute)
+	require.NoError(t, err)
+	status, _ = get(otherURL)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// tampering with the URL invalidates the signature
+	query := u.Query()
+	query.Set("key", "backups/backup-2/backup-2-logs.gz")
+	u.RawQuery = query.Encode()
+	status, _ = get(u.String())
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// expired URLs are refused
+	expiredURL, err := o.CreateSignedURL("bucket", "backups/backup-1/backup-1-logs.gz", -time.Minute)
+	require.NoError(t, err)
+	status, body = get(expiredURL)
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.Contains(t, body, "the URL has expired")
+
+	// objects which don't exist aren't found
+	missingURL, err := o.CreateSignedURL("bucket", "backups/backup-1/missing", time.Minute)
+	require.NoError(t, err)
+	status, _ = get(missingURL)
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestCreateSignedURLWithoutDownloadURL(t *testing.T) {
+	t.Setenv("VELERO_NAMESPACE", "")
+
+	o := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, o.Init(map[string]string{"root": t.TempDir(), "signingKey": "00"}))
+
+	_, err := o.CreateSignedURL("bucket", "key", time.Minute)
+	assert.EqualError(t, err, "downloadURL config is required to create signed URLs")
+
+	t.Setenv("VELERO_NAMESPACE", "velero")
+	require.NoError(t, o.Init(map[string]string{"root": t.TempDir()}))
+	assert.Equal(t, "http://velero.velero.svc:8085", o.downloadURL)
+}
+
+func TestCreateSignedURLWithoutSigningKey(t *testing.T) {
+	o := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, o.Init(map[string]string{"root": t.TempDir(), "downloadURL": "http://velero.velero.svc:8085"}))
+
+	_, err := o.CreateSignedURL("bucket", "key", time.Minute)
+	assert.EqualError(t, err, "signingKey config is required to create signed URLs, it's only passed by the Velero server")
+
+	assert.Error(t, o.Init(map[string]string{"root": t.TempDir(), "signingKey": "not-hex"}))
+}
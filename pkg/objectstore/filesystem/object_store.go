@@ -0,0 +1,558 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	veleroplugin "github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+const (
+	// ProviderName is the name of the built-in filesystem object store plugin.
+	ProviderName = "velero.io/filesystem"
+
+	// DefaultRoot is the directory the buckets are stored in if the location
+	// doesn't configure one. The installer mounts the filesystem volume there.
+	DefaultRoot = "/velero-filesystem"
+
+	rootConfigKey            = "root"
+	downloadURLConfigKey     = "downloadURL"
+	credentialsFileConfigKey = "credentialsFile"
+
+	// metadataDir is the directory under the root where the metadata of the
+	// objects is stored, it can't clash with a bucket since bucket names can't
+	// start with a dot.
+	metadataDir = ".velero-metadata"
+
+	tempFilePrefix = ".velero-upload-"
+
+	dirMode  = 0o755
+	fileMode = 0o644
+)
+
+// IsProvider returns true if provider is the name of the built-in filesystem object store,
+// with or without the velero.io prefix.
+func IsProvider(provider string) bool {
+	if !strings.Contains(provider, "/") {
+		provider = "velero.io/" + provider
+	}
+	return provider == ProviderName
+}
+
+// objectMetadata is the content of the file storing the attributes of an object
+// which can't be stored in the filesystem.
+type objectMetadata struct {
+	Checksum osv2.Checksum     `json:"checksum"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty"`
+}
+
+// ObjectStore is an object store which stores the objects as files in a directory,
+// each bucket is a sub directory of the root directory and each key is the path of
+// the file in the bucket directory. The directory is usually a shared volume, e.g.
+// an NFS share, mounted into the Velero server and the node-agent pods.
+type ObjectStore struct {
+	log         logrus.FieldLogger
+	root        string
+	downloadURL string
+	signingKey  []byte
+}
+
+// NewObjectStore returns a filesystem ObjectStore.
+func NewObjectStore(log logrus.FieldLogger) *ObjectStore {
+	return &ObjectStore{log: log}
+}
+
+// Init sets the root directory and the download URL of the object store from config.
+func (o *ObjectStore) Init(config map[string]string) error {
+	if err := veleroplugin.ValidateObjectStoreConfigKeys(config, rootConfigKey, downloadURLConfigKey, credentialsFileConfigKey, SigningKeyConfigKey); err != nil {
+		return err
+	}
+
+	o.root = config[rootConfigKey]
+	if o.root == "" {
+		o.root = DefaultRoot
+	}
+	if !filepath.IsAbs(o.root) {
+		return errors.Errorf("root %s of the filesystem object store is not an absolute path", o.root)
+	}
+	o.root = filepath.Clean(o.root)
+
+	info, err := os.Stat(o.root)
+	if err != nil {
+		return errors.Wrapf(err, "error checking root %s of the filesystem object store", o.root)
+	}
+	if !info.IsDir() {
+		return errors.Errorf("root %s of the filesystem object store is not a directory", o.root)
+	}
+
+	o.downloadURL = config[downloadURLConfigKey]
+	if o.downloadURL == "" {
+		o.downloadURL = defaultDownloadURL()
+	}
+
+	o.signingKey, err = hex.DecodeString(config[SigningKeyConfigKey])
+	if err != nil {
+		return errors.Wrapf(err, "error decoding %s config", SigningKeyConfigKey)
+	}
+
+	return nil
+}
+
+// PutObject writes body to a temporary file in the directory of the object and renames it
+// once it's complete, so that readers never see a partial object.
+func (o *ObjectStore) PutObject(bucket, key string, body io.Reader, options osv2.PutObjectOptions) (osv2.Checksum, error) {
+	if options.RetentionMode != "" {
+		return osv2.Checksum{}, errors.New("object lock is not supported by the filesystem object store")
+	}
+
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return osv2.Checksum{}, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), dirMode); err != nil {
+		return osv2.Checksum{}, errors.Wrapf(err, "error creating directory for object %s", key)
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(file), tempFilePrefix)
+	if err != nil {
+		return osv2.Checksum{}, errors.Wrapf(err, "error creating temporary file for object %s", key)
+	}
+	defer os.Remove(temp.Name())
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(temp, hash), body); err != nil {
+		temp.Close()
+		return osv2.Checksum{}, errors.Wrapf(err, "error writing object %s", key)
+	}
+	if err := temp.Sync(); err != nil {
+		temp.Close()
+		return osv2.Checksum{}, errors.Wrapf(err, "error syncing object %s", key)
+	}
+	if err := temp.Close(); err != nil {
+		return osv2.Checksum{}, errors.Wrapf(err, "error closing object %s", key)
+	}
+	if err := os.Chmod(temp.Name(), fileMode); err != nil {
+		return osv2.Checksum{}, errors.Wrapf(err, "error setting the mode of object %s", key)
+	}
+
+	checksum := osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: hex.EncodeToString(hash.Sum(nil))}
+	if err := o.putMetadata(bucket, key, objectMetadata{Checksum: checksum, Metadata: options.Metadata, Tags: options.Tags}); err != nil {
+		return osv2.Checksum{}, err
+	}
+
+	if err := os.Rename(temp.Name(), file); err != nil {
+		return osv2.Checksum{}, errors.Wrapf(err, "error renaming the temporary file of object %s", key)
+	}
+
+	return checksum, nil
+}
+
+// ObjectExists checks if there is a file for the given key in the bucket directory.
+func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(file)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error checking object %s", key)
+	}
+
+	return info.Mode().IsRegular(), nil
+}
+
+// GetObject opens the file of the object with the given key.
+func (o *ObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening object %s", key)
+	}
+
+	return f, nil
+}
+
+// GetObjectRange opens the file of the object with the given key and returns a reader
+// of length bytes starting at offset, or of the rest of the file if length <= 0.
+func (o *ObjectStore) GetObjectRange(bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening object %s", key)
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "error seeking to offset %d of object %s", offset, key)
+	}
+
+	if length <= 0 {
+		return f, nil
+	}
+
+	return &readCloser{Reader: io.LimitReader(f, length), Closer: f}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// HeadObject returns the size and modification time of the file of the object, along
+// with the checksum, metadata and tags recorded when it was put.
+func (o *ObjectStore) HeadObject(bucket, key string) (osv2.ObjectInfo, error) {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return osv2.ObjectInfo{}, err
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return osv2.ObjectInfo{}, errors.Wrapf(err, "error getting the attributes of object %s", key)
+	}
+	if !info.Mode().IsRegular() {
+		return osv2.ObjectInfo{}, errors.Errorf("object %s not found", key)
+	}
+
+	metadata, err := o.getMetadata(bucket, key)
+	if err != nil {
+		return osv2.ObjectInfo{}, err
+	}
+
+	return osv2.ObjectInfo{
+		Size:         info.Size(),
+		LastModified: info.ModTime(),
+		Checksum:     metadata.Checksum,
+		Metadata:     metadata.Metadata,
+		Tags:         metadata.Tags,
+	}, nil
+}
+
+// ListCommonPrefixes gets a list of all object key prefixes that start with
+// the specified prefix and stop at the next instance of the provided delimiter.
+func (o *ObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	keys, err := o.ListObjects(bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefixes []string
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		afterPrefix := key[len(prefix):]
+
+		delimiterStart := strings.Index(afterPrefix, delimiter)
+		if delimiterStart == -1 {
+			continue
+		}
+
+		commonPrefix := prefix + afterPrefix[0:delimiterStart] + delimiter
+		if !seen[commonPrefix] {
+			seen[commonPrefix] = true
+			prefixes = append(prefixes, commonPrefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+// ListObjects walks the bucket directory from the deepest directory included in prefix,
+// and returns the keys of the files starting with prefix.
+func (o *ObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	var keys []string
+	if err := o.walkObjects(bucket, prefix, func(key string, _ fs.DirEntry) error {
+		keys = append(keys, key)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// ListObjectSizes walks the bucket directory like ListObjects, and returns the sizes of
+// the files starting with prefix.
+func (o *ObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	sizes := map[string]int64{}
+	if err := o.walkObjects(bucket, prefix, func(key string, entry fs.DirEntry) error {
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		sizes[key] = info.Size()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return sizes, nil
+}
+
+// walkObjects walks the bucket directory from the deepest directory included in prefix,
+// and calls fn with the key of each file starting with prefix.
+func (o *ObjectStore) walkObjects(bucket, prefix string, fn func(key string, entry fs.DirEntry) error) error {
+	bucketDir, err := o.bucketPath(bucket)
+	if err != nil {
+		return err
+	}
+
+	// the directory part of the prefix is the deepest directory which can
+	// contain files with keys matching the prefix
+	start := bucketDir
+	if i := strings.LastIndex(prefix, "/"); i > 0 {
+		start, err = o.objectPath(bucket, prefix[:i])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = filepath.WalkDir(start, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), tempFilePrefix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bucketDir, file)
+		if err != nil {
+			return err
+		}
+
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			return fn(key, entry)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error listing objects with prefix %s", prefix)
+	}
+
+	return nil
+}
+
+// DeleteObject removes the file of the object with the given key and the directories
+// left empty. Deleting an object which doesn't exist isn't an error.
+func (o *ObjectStore) DeleteObject(bucket, key string) error {
+	file, err := o.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "error deleting object %s", key)
+	}
+
+	bucketDir, _ := o.bucketPath(bucket)
+	removeEmptyDirs(filepath.Dir(file), bucketDir)
+
+	metadataFile, _ := o.metadataPath(bucket, key)
+	if err := os.Remove(metadataFile); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "error deleting the metadata of object %s", key)
+	}
+	removeEmptyDirs(filepath.Dir(metadataFile), filepath.Join(o.root, metadataDir))
+
+	return nil
+}
+
+// DeleteObjects deletes the objects with the given keys one by one.
+func (o *ObjectStore) DeleteObjects(bucket string, keys []string) error {
+	var errs []error
+	for _, key := range keys {
+		if err := o.DeleteObject(bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// CreateSignedURL returns a URL of the download endpoint of the Velero server for the
+// object, signed with the key the server passes in the config.
+func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	if _, err := o.objectPath(bucket, key); err != nil {
+		return "", err
+	}
+
+	if o.downloadURL == "" {
+		return "", errors.Errorf("%s config is required to create signed URLs", downloadURLConfigKey)
+	}
+	if len(o.signingKey) == 0 {
+		return "", errors.Errorf("%s config is required to create signed URLs, it's only passed by the Velero server", SigningKeyConfigKey)
+	}
+
+	base, err := url.Parse(o.downloadURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "error parsing %s config", downloadURLConfigKey)
+	}
+
+	req := downloadRequest{
+		Root:    o.root,
+		Bucket:  bucket,
+		Key:     key,
+		Expires: time.Now().Add(ttl).Unix(),
+	}
+
+	base.Path = path.Join(base.Path, DownloadPath)
+	base.RawQuery = req.query(o.signingKey).Encode()
+
+	return base.String(), nil
+}
+
+// bucketPath returns the directory of bucket.
+func (o *ObjectStore) bucketPath(bucket string) (string, error) {
+	return bucketPath(o.root, bucket)
+}
+
+// objectPath returns the file of the object with the given key, it returns an error
+// if the key points outside of the bucket directory.
+func (o *ObjectStore) objectPath(bucket, key string) (string, error) {
+	return objectPath(o.root, bucket, key)
+}
+
+// metadataPath returns the file storing the metadata of the object with the given key.
+func (o *ObjectStore) metadataPath(bucket, key string) (string, error) {
+	return objectPath(filepath.Join(o.root, metadataDir), bucket, key)
+}
+
+func (o *ObjectStore) putMetadata(bucket, key string, metadata objectMetadata) error {
+	file, err := o.metadataPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling the metadata of object %s", key)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), dirMode); err != nil {
+		return errors.Wrapf(err, "error creating the metadata directory of object %s", key)
+	}
+
+	if err := os.WriteFile(file, data, fileMode); err != nil {
+		return errors.Wrapf(err, "error writing the metadata of object %s", key)
+	}
+
+	return nil
+}
+
+// getMetadata returns the metadata of the object with the given key, or empty metadata
+// if the object was written without it, e.g. copied into the directory.
+func (o *ObjectStore) getMetadata(bucket, key string) (objectMetadata, error) {
+	var metadata objectMetadata
+
+	file, err := o.metadataPath(bucket, key)
+	if err != nil {
+		return metadata, err
+	}
+
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return metadata, nil
+	}
+	if err != nil {
+		return metadata, errors.Wrapf(err, "error reading the metadata of object %s", key)
+	}
+
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return metadata, errors.Wrapf(err, "error unmarshalling the metadata of object %s", key)
+	}
+
+	return metadata, nil
+}
+
+func bucketPath(root, bucket string) (string, error) {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || strings.HasPrefix(bucket, ".") {
+		return "", errors.Errorf("invalid bucket name %q for the filesystem object store", bucket)
+	}
+
+	return filepath.Join(root, bucket), nil
+}
+
+func objectPath(root, bucket, key string) (string, error) {
+	bucketDir, err := bucketPath(root, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	file := filepath.Join(bucketDir, filepath.FromSlash(key))
+	if !strings.HasPrefix(file, bucketDir+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid key %q for the filesystem object store", key)
+	}
+
+	return file, nil
+}
+
+// removeEmptyDirs removes dir and its parents until one of them isn't empty or is stop.
+func removeEmptyDirs(dir, stop string) {
+	for dir != stop && strings.HasPrefix(dir, stop) {
+		// os.Remove fails if the directory isn't empty
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func defaultDownloadURL() string {
+	namespace := os.Getenv("VELERO_NAMESPACE")
+	if namespace == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("http://velero.%s.svc:%d", namespace, DefaultDownloadPort)
+}
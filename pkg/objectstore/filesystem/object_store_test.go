@@ -0,0 +1,285 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newTestObjectStore(t *testing.T) *ObjectStore {
+	t.Helper()
+
+	o := NewObjectStore(velerotest.NewLogger())
+	require.NoError(t, o.Init(map[string]string{"root": t.TempDir(), "bucket": "bucket"}))
+	return o
+}
+
+func putObject(t *testing.T, o *ObjectStore, key, body string) {
+	t.Helper()
+
+	_, err := o.PutObject("bucket", key, strings.NewReader(body), osv2.PutObjectOptions{})
+	require.NoError(t, err)
+}
+
+func readAll(t *testing.T, rc io.ReadCloser) string {
+	t.Helper()
+
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestInit(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	tests := []struct {
+		name        string
+		config      map[string]string
+		expectedErr string
+	}{
+		{
+			name:   "valid root",
+			config: map[string]string{"root": root, "bucket": "bucket", "prefix": "prefix"},
+		},
+		{
+			name:        "invalid key",
+			config:      map[string]string{"root": root, "region": "us-east-1"},
+			expectedErr: "config has invalid keys [region]",
+		},
+		{
+			name:        "relative root",
+			config:      map[string]string{"root": "backups"},
+			expectedErr: "root backups of the filesystem object store is not an absolute path",
+		},
+		{
+			name:        "root doesn't exist",
+			config:      map[string]string{"root": filepath.Join(root, "missing")},
+			expectedErr: "error checking root",
+		},
+		{
+			name:        "root isn't a directory",
+			config:      map[string]string{"root": file},
+			expectedErr: "is not a directory",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewObjectStore(velerotest.NewLogger()).Init(tc.config)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPutAndGetObject(t *testing.T) {
+	o := newTestObjectStore(t)
+
+	options := osv2.PutObjectOptions{
+		Metadata: map[string]string{"velero.io/backup": "backup-1"},
+		Tags:     map[string]string{"team": "a"},
+	}
+	checksum, err := o.PutObject("bucket", "backups/backup-1/backup-1.tar.gz", strings.NewReader("body"), options)
+	require.NoError(t, err)
+	assert.Equal(t, osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: "230d8358dc8e8890b4c58deeb62912ee2f20357ae92a5cc861b98e68fe31acb5"}, checksum)
+
+	exists, err := o.ObjectExists("bucket", "backups/backup-1/backup-1.tar.gz")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// directories aren't objects
+	exists, err = o.ObjectExists("bucket", "backups/backup-1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	rc, err := o.GetObject("bucket", "backups/backup-1/backup-1.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "body", readAll(t, rc))
+
+	info, err := o.HeadObject("bucket", "backups/backup-1/backup-1.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), info.Size)
+	assert.False(t, info.LastModified.IsZero())
+	assert.Equal(t, checksum, info.Checksum)
+	assert.Equal(t, options.Metadata, info.Metadata)
+	assert.Equal(t, options.Tags, info.Tags)
+
+	// overwriting an object replaces its content
+	putObject(t, o, "backups/backup-1/backup-1.tar.gz", "new body")
+	rc, err = o.GetObject("bucket", "backups/backup-1/backup-1.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "new body", readAll(t, rc))
+
+	_, err = o.PutObject("bucket", "key", strings.NewReader("body"), osv2.PutObjectOptions{RetentionMode: "COMPLIANCE"})
+	assert.EqualError(t, err, "object lock is not supported by the filesystem object store")
+}
+
+func TestGetObjectRange(t *testing.T) {
+	o := newTestObjectStore(t)
+	putObject(t, o, "key", "0123456789")
+
+	tests := []struct {
+		name     string
+		offset   int64
+		length   int64
+		expected string
+	}{
+		{
+			name:     "range in the middle",
+			offset:   2,
+			length:   3,
+			expected: "234",
+		},
+		{
+			name:     "zero length reads to the end",
+			offset:   7,
+			expected: "789",
+		},
+		{
+			name:     "range past the end",
+			offset:   8,
+			length:   10,
+			expected: "89",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rc, err := o.GetObjectRange("bucket", "key", tc.offset, tc.length)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, readAll(t, rc))
+		})
+	}
+}
+
+func TestListObjectsAndCommonPrefixes(t *testing.T) {
+	o := newTestObjectStore(t)
+	putObject(t, o, "backups/backup-1/velero-backup.json", "")
+	putObject(t, o, "backups/backup-1/backup-1.tar.gz", "data")
+	putObject(t, o, "backups/backup-2/velero-backup.json", "")
+	putObject(t, o, "restores/restore-1/restore-1-logs.gz", "")
+	putObject(t, o, "metadata/revision", "")
+
+	keys, err := o.ListObjects("bucket", "backups/backup-1/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"backups/backup-1/velero-backup.json", "backups/backup-1/backup-1.tar.gz"}, keys)
+
+	keys, err = o.ListObjects("bucket", "backups/backup")
+	require.NoError(t, err)
+	assert.Len(t, keys, 3)
+
+	keys, err = o.ListObjects("bucket", "missing/")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	sizes, err := o.ListObjectSizes("bucket", "backups/backup-1/")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"backups/backup-1/velero-backup.json": 0, "backups/backup-1/backup-1.tar.gz": 4}, sizes)
+
+	prefixes, err := o.ListCommonPrefixes("bucket", "", "/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"backups/", "restores/", "metadata/"}, prefixes)
+
+	prefixes, err = o.ListCommonPrefixes("bucket", "backups/", "/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"backups/backup-1/", "backups/backup-2/"}, prefixes)
+
+	// an empty bucket has no objects
+	keys, err = o.ListObjects("other-bucket", "")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestDeleteObjects(t *testing.T) {
+	o := newTestObjectStore(t)
+	putObject(t, o, "backups/backup-1/velero-backup.json", "")
+	putObject(t, o, "backups/backup-1/backup-1.tar.gz", "")
+	putObject(t, o, "backups/backup-2/velero-backup.json", "")
+
+	require.NoError(t, o.DeleteObjects("bucket", []string{"backups/backup-1/velero-backup.json", "backups/backup-1/backup-1.tar.gz", "backups/backup-1/missing"}))
+
+	keys, err := o.ListObjects("bucket", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-2/velero-backup.json"}, keys)
+
+	// the directory of the deleted backup is removed with its last object
+	assert.NoDirExists(t, filepath.Join(o.root, "bucket", "backups", "backup-1"))
+	assert.NoDirExists(t, filepath.Join(o.root, metadataDir, "bucket", "backups", "backup-1"))
+	assert.DirExists(t, filepath.Join(o.root, "bucket", "backups"))
+}
+
+func TestInvalidBucketsAndKeys(t *testing.T) {
+	o := newTestObjectStore(t)
+
+	tests := []struct {
+		name        string
+		bucket      string
+		key         string
+		expectedErr string
+	}{
+		{
+			name:        "empty bucket",
+			key:         "key",
+			expectedErr: `invalid bucket name "" for the filesystem object store`,
+		},
+		{
+			name:        "bucket with a slash",
+			bucket:      "a/b",
+			key:         "key",
+			expectedErr: `invalid bucket name "a/b" for the filesystem object store`,
+		},
+		{
+			name:        "hidden bucket",
+			bucket:      metadataDir,
+			key:         "key",
+			expectedErr: `invalid bucket name ".velero-metadata" for the filesystem object store`,
+		},
+		{
+			name:        "key outside of the bucket",
+			bucket:      "bucket",
+			key:         "../other-bucket/key",
+			expectedErr: `invalid key "../other-bucket/key" for the filesystem object store`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := o.PutObject(tc.bucket, tc.key, strings.NewReader("body"), osv2.PutObjectOptions{})
+			assert.EqualError(t, err, tc.expectedErr)
+
+			_, err = o.GetObject(tc.bucket, tc.key)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
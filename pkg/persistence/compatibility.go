@@ -0,0 +1,415 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+// CompatibilityTestOptions are the options of a compatibility test of a backup store.
+type CompatibilityTestOptions struct {
+	// ID identifies the test. The objects of the test are written under
+	// metadata/compatibility-test/<ID>/ and deleted once the test completes.
+	ID string
+
+	// LargeObjectSize is the size of the object uploaded to check the multipart uploads.
+	LargeObjectSize int64
+
+	// HTTPClient downloads the object with a signed URL.
+	HTTPClient *http.Client
+}
+
+// CompatibilityCheck is the result of a check run by a compatibility test.
+type CompatibilityCheck struct {
+	// Name is the name of the check.
+	Name string
+	// Duration is how long the check took.
+	Duration time.Duration
+	// Err is why the check failed, it's nil if the check passed.
+	Err error
+}
+
+// compatibilityTest runs the checks of a compatibility test against the object store
+// of a backup store, each check relying on the objects written by the previous ones.
+type compatibilityTest struct {
+	store   *objectBackupStore
+	options CompatibilityTestOptions
+	prefix  string
+	content []byte
+	checks  []CompatibilityCheck
+}
+
+func (s *objectBackupStore) TestCompatibility(options CompatibilityTestOptions) []CompatibilityCheck {
+	t := &compatibilityTest{
+		store:   s,
+		options: options,
+		prefix:  s.layout.subdirs["metadata"] + "compatibility-test/" + options.ID + "/",
+		content: []byte(fmt.Sprintf("velero compatibility test %s", options.ID)),
+	}
+	defer t.cleanup()
+
+	t.run("PutObject", t.checkPutObject)
+	t.run("HeadObject", t.checkHeadObject)
+	t.run("GetObject", t.checkGetObject)
+	t.run("GetObjectRange", t.checkGetObjectRange)
+	t.run("ObjectExists", t.checkObjectExists)
+	t.run("ConsistencyAfterWrite", t.checkConsistencyAfterWrite)
+	t.run("ListObjects", t.checkListObjects)
+	t.run("ListCommonPrefixes", t.checkListCommonPrefixes)
+	t.run("MultipartUpload", t.checkMultipartUpload)
+	t.run("SignedURL", t.checkSignedURL)
+	t.run("DeleteObjects", t.checkDeleteObjects)
+	t.run("DeleteObject", t.checkDeleteObject)
+	t.run("ConsistencyAfterDelete", t.checkConsistencyAfterDelete)
+
+	return t.checks
+}
+
+func (t *compatibilityTest) run(name string, check func() error) {
+	start := time.Now()
+	err := check()
+	t.checks = append(t.checks, CompatibilityCheck{
+		Name:     name,
+		Duration: time.Since(start),
+		Err:      err,
+	})
+}
+
+// cleanup deletes the objects left behind by the failed checks.
+func (t *compatibilityTest) cleanup() {
+	keys, err := t.store.objectStore.ListObjects(t.store.bucket, t.prefix)
+	if err != nil {
+		t.store.logger.WithError(err).Warn("Error listing the objects of the compatibility test")
+		return
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if err := t.store.objectStore.DeleteObjects(t.store.bucket, keys); err != nil {
+		t.store.logger.WithError(err).Warn("Error deleting the objects of the compatibility test")
+	}
+}
+
+func (t *compatibilityTest) key(name string) string {
+	return t.prefix + name
+}
+
+func (t *compatibilityTest) put(name string, content []byte) error {
+	_, err := t.store.objectStore.PutObject(t.store.bucket, t.key(name), bytes.NewReader(content), osv2.PutObjectOptions{})
+	return errors.Wrapf(err, "error putting object %s", t.key(name))
+}
+
+func (t *compatibilityTest) get(name string) ([]byte, error) {
+	rc, err := t.store.objectStore.GetObject(t.store.bucket, t.key(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting object %s", t.key(name))
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(rc)
+	return content, errors.Wrapf(err, "error reading object %s", t.key(name))
+}
+
+func (t *compatibilityTest) list(prefix string) ([]string, error) {
+	keys, err := t.store.objectStore.ListObjects(t.store.bucket, t.key(prefix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing objects under %s", t.key(prefix))
+	}
+
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, strings.TrimPrefix(key, t.prefix))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (t *compatibilityTest) checkPutObject() error {
+	return t.put("object", t.content)
+}
+
+func (t *compatibilityTest) checkHeadObject() error {
+	info, err := t.store.objectStore.HeadObject(t.store.bucket, t.key("object"))
+	if errors.Is(err, osv2.ErrNotSupported) {
+		// the v1 object store plugins only check the object exists, Velero reads the objects
+		// whose attributes it needs instead
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error getting the attributes of object %s", t.key("object"))
+	}
+	if info.Size != int64(len(t.content)) {
+		return errors.Errorf("got size %d, expected %d", info.Size, len(t.content))
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkGetObject() error {
+	content, err := t.get("object")
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(content, t.content) {
+		return errors.Errorf("got content %q, expected %q", content, t.content)
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkGetObjectRange() error {
+	var offset, length int64 = 7, 13
+	rc, err := t.store.objectStore.GetObjectRange(t.store.bucket, t.key("object"), offset, length)
+	if err != nil {
+		return errors.Wrapf(err, "error getting a range of object %s", t.key("object"))
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return errors.Wrapf(err, "error reading a range of object %s", t.key("object"))
+	}
+	if expected := t.content[offset : offset+length]; !bytes.Equal(content, expected) {
+		return errors.Errorf("got content %q, expected %q", content, expected)
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkObjectExists() error {
+	exists, err := t.store.objectStore.ObjectExists(t.store.bucket, t.key("object"))
+	if err != nil {
+		return errors.Wrapf(err, "error checking if object %s exists", t.key("object"))
+	}
+	if !exists {
+		return errors.Errorf("object %s doesn't exist", t.key("object"))
+	}
+
+	exists, err = t.store.objectStore.ObjectExists(t.store.bucket, t.key("missing"))
+	if err != nil {
+		return errors.Wrapf(err, "error checking if object %s exists", t.key("missing"))
+	}
+	if exists {
+		return errors.Errorf("object %s exists, but it was never created", t.key("missing"))
+	}
+	return nil
+}
+
+// checkConsistencyAfterWrite overwrites an object and checks the new content is read
+// and listed right away, as the backup sync relies on it.
+func (t *compatibilityTest) checkConsistencyAfterWrite() error {
+	t.content = append(t.content, " overwritten"...)
+	if err := t.put("object", t.content); err != nil {
+		return err
+	}
+
+	content, err := t.get("object")
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(content, t.content) {
+		return errors.Errorf("got stale content %q after overwriting the object with %q", content, t.content)
+	}
+
+	names, err := t.list("object")
+	if err != nil {
+		return err
+	}
+	if len(names) != 1 || names[0] != "object" {
+		return errors.Errorf("listed %v after writing the object, expected [object]", names)
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkListObjects() error {
+	for _, name := range []string{"list/a", "list/b", "list-other"} {
+		if err := t.put(name, []byte(name)); err != nil {
+			return err
+		}
+	}
+
+	names, err := t.list("list/")
+	if err != nil {
+		return err
+	}
+	if expected := []string{"list/a", "list/b"}; !equalStrings(names, expected) {
+		return errors.Errorf("listed %v, expected %v", names, expected)
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkListCommonPrefixes() error {
+	for _, name := range []string{"dirs/one/a", "dirs/one/b", "dirs/two/a", "dirs/file"} {
+		if err := t.put(name, []byte(name)); err != nil {
+			return err
+		}
+	}
+
+	prefixes, err := t.store.objectStore.ListCommonPrefixes(t.store.bucket, t.key("dirs/"), "/")
+	if err != nil {
+		return errors.Wrapf(err, "error listing common prefixes under %s", t.key("dirs/"))
+	}
+
+	var names []string
+	for _, prefix := range prefixes {
+		names = append(names, strings.TrimPrefix(prefix, t.prefix))
+	}
+	sort.Strings(names)
+
+	if expected := []string{"dirs/one/", "dirs/two/"}; !equalStrings(names, expected) {
+		return errors.Errorf("listed common prefixes %v, expected %v", names, expected)
+	}
+	return nil
+}
+
+// checkMultipartUpload streams an object large enough for the object store plugin to
+// upload it in parts, and checks its size and content are read back intact.
+func (t *compatibilityTest) checkMultipartUpload() error {
+	key := t.key("large-object")
+	size := t.options.LargeObjectSize
+
+	written := sha256.New()
+	body := io.TeeReader(io.LimitReader(rand.New(rand.NewSource(size)), size), written) //nolint:gosec // the content doesn't need to be secure
+	if _, err := t.store.objectStore.PutObject(t.store.bucket, key, body, osv2.PutObjectOptions{}); err != nil {
+		return errors.Wrapf(err, "error putting object %s", key)
+	}
+
+	rc, err := t.store.objectStore.GetObject(t.store.bucket, key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting object %s", key)
+	}
+	defer rc.Close()
+
+	return verifyContent(rc, size, written)
+}
+
+func (t *compatibilityTest) checkSignedURL() error {
+	url, err := t.store.objectStore.CreateSignedURL(t.store.bucket, t.key("object"), DownloadURLTTL)
+	if err != nil {
+		return errors.Wrapf(err, "error creating a signed URL for object %s", t.key("object"))
+	}
+
+	client := t.options.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return errors.Wrap(err, "error downloading the object with the signed URL")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("downloading the object with the signed URL returned status %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "error downloading the object with the signed URL")
+	}
+	if !bytes.Equal(content, t.content) {
+		return errors.Errorf("downloaded content %q, expected %q", content, t.content)
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkDeleteObjects() error {
+	keys := []string{t.key("list/a"), t.key("list/b"), t.key("list-other")}
+	if err := t.store.objectStore.DeleteObjects(t.store.bucket, keys); err != nil {
+		return errors.Wrap(err, "error deleting objects")
+	}
+
+	names, err := t.list("list")
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		return errors.Errorf("listed %v after deleting them", names)
+	}
+	return nil
+}
+
+func (t *compatibilityTest) checkDeleteObject() error {
+	return errors.Wrapf(t.store.objectStore.DeleteObject(t.store.bucket, t.key("object")), "error deleting object %s", t.key("object"))
+}
+
+// checkConsistencyAfterDelete checks a deleted object is gone right away, as the backup
+// deletion relies on it.
+func (t *compatibilityTest) checkConsistencyAfterDelete() error {
+	exists, err := t.store.objectStore.ObjectExists(t.store.bucket, t.key("object"))
+	if err != nil {
+		return errors.Wrapf(err, "error checking if object %s exists", t.key("object"))
+	}
+	if exists {
+		return errors.Errorf("object %s still exists after deleting it", t.key("object"))
+	}
+
+	names, err := t.list("object")
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		return errors.Errorf("listed %v after deleting the object", names)
+	}
+
+	if rc, err := t.store.objectStore.GetObject(t.store.bucket, t.key("object")); err == nil {
+		rc.Close()
+		return errors.Errorf("got object %s after deleting it", t.key("object"))
+	}
+	return nil
+}
+
+// verifyContent reads r to its end and checks it has the size and the SHA256 checksum
+// of the content written.
+func verifyContent(r io.Reader, size int64, written hash.Hash) error {
+	read := sha256.New()
+	n, err := io.Copy(read, r)
+	if err != nil {
+		return errors.Wrap(err, "error reading the object")
+	}
+	if n != size {
+		return errors.Errorf("read %d bytes, expected %d", n, size)
+	}
+	if got, expected := hex.EncodeToString(read.Sum(nil)), hex.EncodeToString(written.Sum(nil)); got != expected {
+		return errors.Errorf("read content with checksum %s, expected %s", got, expected)
+	}
+	return nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
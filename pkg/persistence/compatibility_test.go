@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// compatibilityTestObjectStore serves the signed URLs of an in-memory object store, and
+// can be made to misbehave like some S3-compatible object storages do, or to behave like a
+// v1 object store plugin which can't get the attributes of the objects.
+type compatibilityTestObjectStore struct {
+	*inMemoryObjectStore
+
+	server        *httptest.Server
+	ignoreRanges  bool
+	truncateLarge bool
+	noHead        bool
+}
+
+func (o *compatibilityTestObjectStore) HeadObject(bucket, key string) (osv2.ObjectInfo, error) {
+	if o.noHead {
+		return osv2.ObjectInfo{}, errors.Wrap(osv2.ErrNotSupported, "v1 plugin")
+	}
+	return o.inMemoryObjectStore.HeadObject(bucket, key)
+}
+
+func (o *compatibilityTestObjectStore) GetObjectRange(bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	if o.ignoreRanges {
+		return o.GetObject(bucket, key)
+	}
+	return o.inMemoryObjectStore.GetObjectRange(bucket, key, offset, length)
+}
+
+func (o *compatibilityTestObjectStore) PutObject(bucket, key string, body io.Reader, options osv2.PutObjectOptions) (osv2.Checksum, error) {
+	if o.truncateLarge && strings.HasSuffix(key, "large-object") {
+		body = io.LimitReader(body, 1024)
+	}
+	return o.inMemoryObjectStore.PutObject(bucket, key, body, options)
+}
+
+func (o *compatibilityTestObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	return o.server.URL + "/" + key, nil
+}
+
+func TestTestCompatibility(t *testing.T) {
+	tests := []struct {
+		name          string
+		ignoreRanges  bool
+		truncateLarge bool
+		noHead        bool
+		expectedErrs  map[string]string
+	}{
+		{
+			name: "all checks pass",
+		},
+		{
+			name:   "all checks pass when the object store can't get the attributes of the objects",
+			noHead: true,
+		},
+		{
+			name:          "misbehaving object store",
+			ignoreRanges:  true,
+			truncateLarge: true,
+			expectedErrs: map[string]string{
+				"GetObjectRange":  `got content "velero compatibility test fake-id", expected "compatibility"`,
+				"MultipartUpload": "read 1024 bytes, expected 4096",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectStore := &compatibilityTestObjectStore{
+				inMemoryObjectStore: newInMemoryObjectStore("bucket"),
+				ignoreRanges:        test.ignoreRanges,
+				truncateLarge:       test.truncateLarge,
+				noHead:              test.noHead,
+			}
+			objectStore.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				obj, ok := objectStore.Data["bucket"][strings.TrimPrefix(r.URL.Path, "/")]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write(obj)
+			}))
+			defer objectStore.server.Close()
+
+			store := &objectBackupStore{
+				objectStore: objectStore,
+				bucket:      "bucket",
+				layout:      NewObjectStoreLayout("prefix"),
+				logger:      velerotest.NewLogger(),
+			}
+
+			checks := store.TestCompatibility(CompatibilityTestOptions{
+				ID:              "fake-id",
+				LargeObjectSize: 4096,
+				HTTPClient:      objectStore.server.Client(),
+			})
+
+			var names []string
+			for _, check := range checks {
+				names = append(names, check.Name)
+				if expected, ok := test.expectedErrs[check.Name]; ok {
+					assert.EqualError(t, check.Err, expected, check.Name)
+				} else {
+					assert.NoError(t, check.Err, check.Name)
+				}
+			}
+			assert.Equal(t, []string{
+				"PutObject",
+				"HeadObject",
+				"GetObject",
+				"GetObjectRange",
+				"ObjectExists",
+				"ConsistencyAfterWrite",
+				"ListObjects",
+				"ListCommonPrefixes",
+				"MultipartUpload",
+				"SignedURL",
+				"DeleteObjects",
+				"DeleteObject",
+				"ConsistencyAfterDelete",
+			}, names)
+
+			// the objects of the test are deleted
+			keys, err := objectStore.ListObjects("bucket", "")
+			require.NoError(t, err)
+			assert.Empty(t, keys)
+		})
+	}
+}
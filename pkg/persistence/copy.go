@@ -0,0 +1,145 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+// CopyBackup copies the files of a backup from a backup store to another and verifies the
+// copies, the copies are locked until retainUntil if object lock is enabled for the target
+// backup store. The metadata file is copied last so that the backup isn't synced from the
+// target backup store before all its files are there.
+func CopyBackup(source, target BackupStore, name string, retainUntil time.Time) error {
+	dir := path.Join("backups", name)
+	files, err := source.ListFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	metadataKey := path.Join(dir, "velero-backup.json")
+	var hasMetadata bool
+	for _, file := range files {
+		if file == metadataKey {
+			hasMetadata = true
+			continue
+		}
+		if err := copyFile(source, target, file, retainUntil); err != nil {
+			return err
+		}
+	}
+
+	if !hasMetadata {
+		return errors.Errorf("metadata file of backup %s not found", name)
+	}
+
+	return copyFile(source, target, metadataKey, retainUntil)
+}
+
+// CopyRepository copies the files of the backup repository of a type for a volume namespace
+// from a backup store to another and verifies the copies.
+func CopyRepository(source, target BackupStore, repositoryType, volumeNamespace string) error {
+	files, err := source.ListFiles(path.Join(repositoryType, volumeNamespace))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := copyFile(source, target, file, time.Time{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyFile copies a file from a backup store to another and verifies the copy. The file is read
+// from the source backup store once, and its SHA256 checksum is calculated while it's copied. The
+// copy is verified with its attributes if the target object store reports its SHA256 checksum
+// without reading it, otherwise the copy is read back from the target backup store. The checksum
+// returned by the put isn't used, since for the v1 object store plugins it's calculated from the
+// data sent rather than from what was stored.
+func copyFile(source, target BackupStore, key string, retainUntil time.Time) error {
+	body, err := source.GetFile(key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting file %s", key)
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	counter := &countingReader{reader: io.TeeReader(body, hash)}
+	if _, err := target.PutFile(key, counter, retainUntil); err != nil {
+		return errors.Wrapf(err, "error putting file %s", key)
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	copied, err := target.GetFileInfo(key)
+	if errors.Is(err, osv2.ErrNotSupported) || (err == nil && copied.Checksum.Algorithm != osv2.ChecksumAlgorithmSHA256) {
+		copied, err = readFileInfo(target, key)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error getting the attributes of the copy of file %s", key)
+	}
+
+	if copied.Size != counter.count {
+		return errors.Errorf("error verifying the copy of file %s: size is %d bytes, expected %d", key, copied.Size, counter.count)
+	}
+	if copied.Checksum.Value != checksum {
+		return errors.Errorf("error verifying the copy of file %s: %s checksum is %s, expected %s", key, osv2.ChecksumAlgorithmSHA256, copied.Checksum.Value, checksum)
+	}
+
+	return nil
+}
+
+// readFileInfo reads a file from a backup store to get its size and SHA256 checksum.
+func readFileInfo(store BackupStore, key string) (osv2.ObjectInfo, error) {
+	body, err := store.GetFile(key)
+	if err != nil {
+		return osv2.ObjectInfo{}, err
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, body)
+	if err != nil {
+		return osv2.ObjectInfo{}, errors.Wrapf(err, "error reading file %s", key)
+	}
+
+	return osv2.ObjectInfo{
+		Size:     size,
+		Checksum: osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: hex.EncodeToString(hash.Sum(nil))},
+	}, nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
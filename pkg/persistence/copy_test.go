@@ -0,0 +1,246 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+func TestCopyBackup(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       string
+		sourceData   BucketData
+		expectedData BucketData
+		expectErr    bool
+	}{
+		{
+			name:   "all the files of the backup are copied",
+			backup: "backup-1",
+			sourceData: map[string][]byte{
+				"source/backups/backup-1/velero-backup.json": []byte("metadata"),
+				"source/backups/backup-1/backup-1.tar.gz":    []byte("contents"),
+				"source/backups/backup-1/backup-1-logs.gz":   []byte("logs"),
+				"source/backups/backup-2/velero-backup.json": []byte("metadata"),
+				"source/kopia/ns-1/pack-1":                   []byte("pack"),
+			},
+			expectedData: map[string][]byte{
+				"target/backups/backup-1/velero-backup.json": []byte("metadata"),
+				"target/backups/backup-1/backup-1.tar.gz":    []byte("contents"),
+				"target/backups/backup-1/backup-1-logs.gz":   []byte("logs"),
+			},
+		},
+		{
+			name:   "backup without metadata file fails after copying its other files",
+			backup: "backup-1",
+			sourceData: map[string][]byte{
+				"source/backups/backup-1/backup-1.tar.gz": []byte("contents"),
+			},
+			expectedData: map[string][]byte{
+				"target/backups/backup-1/backup-1.tar.gz": []byte("contents"),
+			},
+			expectErr: true,
+		},
+		{
+			name:         "invalid backup name fails",
+			backup:       "../kopia",
+			expectedData: map[string][]byte{},
+			expectErr:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			source := newObjectBackupStoreTestHarness("source-bucket", "source")
+			target := newObjectBackupStoreTestHarness("target-bucket", "target")
+
+			for key, obj := range tc.sourceData {
+				_, err := source.objectStore.PutObject(source.bucket, key, bytes.NewReader(obj), osv2.PutObjectOptions{})
+				require.NoError(t, err)
+			}
+
+			err := CopyBackup(source.objectBackupStore, target.objectBackupStore, tc.backup, time.Time{})
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedData, target.objectStore.Data[target.bucket])
+		})
+	}
+}
+
+func TestCopyRepository(t *testing.T) {
+	source := newObjectBackupStoreTestHarness("source-bucket", "")
+	target := newObjectBackupStoreTestHarness("target-bucket", "velero")
+
+	sourceData := map[string][]byte{
+		"kopia/ns-1/kopia.repository":   []byte("format"),
+		"kopia/ns-1/p0123":              []byte("pack"),
+		"kopia/ns-10/p0123":             []byte("pack"),
+		"restic/ns-1/data/00/pack-1":    []byte("pack"),
+		"backups/backup-1/backup-1.tgz": []byte("contents"),
+	}
+	for key, obj := range sourceData {
+		_, err := source.objectStore.PutObject(source.bucket, key, bytes.NewReader(obj), osv2.PutObjectOptions{})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, CopyRepository(source.objectBackupStore, target.objectBackupStore, "kopia", "ns-1"))
+
+	assert.Equal(t, BucketData{
+		"velero/kopia/ns-1/kopia.repository": []byte("format"),
+		"velero/kopia/ns-1/p0123":            []byte("pack"),
+	}, target.objectStore.Data[target.bucket])
+}
+
+// fakeFileStore is a BackupStore which serves a single file, reports the given attributes of
+// it and records the files put into it.
+type fakeFileStore struct {
+	BackupStore
+	info      osv2.ObjectInfo
+	infoErr   error
+	data      string
+	readBack  string
+	put       map[string]string
+	headCalls int
+	getCalls  int
+}
+
+func (s *fakeFileStore) GetFileInfo(key string) (osv2.ObjectInfo, error) {
+	s.headCalls++
+	return s.info, s.infoErr
+}
+
+func (s *fakeFileStore) GetFile(key string) (io.ReadCloser, error) {
+	s.getCalls++
+	data := s.data
+	if s.readBack != "" {
+		data = s.readBack
+	} else if put, ok := s.put[key]; ok {
+		data = put
+	}
+	return io.NopCloser(strings.NewReader(data)), nil
+}
+
+func (s *fakeFileStore) PutFile(key string, body io.Reader, retainUntil time.Time) (osv2.Checksum, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return osv2.Checksum{}, err
+	}
+	if s.put == nil {
+		s.put = map[string]string{}
+	}
+	s.put[key] = string(data)
+	// the checksum returned by the put isn't used to verify the copy
+	return osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: "ignored"}, nil
+}
+
+func TestCopyFile(t *testing.T) {
+	sha256sum := func(data string) osv2.Checksum {
+		sum := sha256.Sum256([]byte(data))
+		return osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: hex.EncodeToString(sum[:])}
+	}
+	md5 := osv2.Checksum{Algorithm: "MD5", Value: "abc"}
+
+	tests := []struct {
+		name             string
+		target           *fakeFileStore
+		expectedGetCalls int
+		expectedErr      string
+	}{
+		{
+			name:   "copy is verified by the checksum in its attributes",
+			target: &fakeFileStore{info: osv2.ObjectInfo{Size: 4, Checksum: sha256sum("data")}},
+		},
+		{
+			name:        "copy with a different checksum in its attributes fails",
+			target:      &fakeFileStore{info: osv2.ObjectInfo{Size: 4, Checksum: sha256sum("date")}},
+			expectedErr: "error verifying the copy of file backups/backup-1/backup-1.tar.gz: SHA256 checksum is " + sha256sum("date").Value + ", expected " + sha256sum("data").Value,
+		},
+		{
+			name:        "copy with another size than the original fails",
+			target:      &fakeFileStore{info: osv2.ObjectInfo{Size: 3, Checksum: sha256sum("dat")}},
+			expectedErr: "error verifying the copy of file backups/backup-1/backup-1.tar.gz: size is 3 bytes, expected 4",
+		},
+		{
+			name:             "copy is read back when the target can't get its attributes",
+			target:           &fakeFileStore{infoErr: errors.Wrap(osv2.ErrNotSupported, "v1 plugin")},
+			expectedGetCalls: 1,
+		},
+		{
+			name:             "copy is read back when the target reports another checksum algorithm",
+			target:           &fakeFileStore{info: osv2.ObjectInfo{Size: 4, Checksum: md5}},
+			expectedGetCalls: 1,
+		},
+		{
+			name:             "copy read back with a different content fails",
+			target:           &fakeFileStore{infoErr: osv2.ErrNotSupported, readBack: "date"},
+			expectedGetCalls: 1,
+			expectedErr:      "error verifying the copy of file backups/backup-1/backup-1.tar.gz: SHA256 checksum is " + sha256sum("date").Value + ", expected " + sha256sum("data").Value,
+		},
+		{
+			name:        "copy fails when its attributes can't be got",
+			target:      &fakeFileStore{infoErr: errors.New("not found")},
+			expectedErr: "error getting the attributes of the copy of file backups/backup-1/backup-1.tar.gz: not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			source := &fakeFileStore{data: "data"}
+			err := copyFile(source, tc.target, "backups/backup-1/backup-1.tar.gz", time.Time{})
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, map[string]string{"backups/backup-1/backup-1.tar.gz": "data"}, tc.target.put)
+			assert.Equal(t, 0, source.headCalls)
+			assert.Equal(t, 1, source.getCalls)
+			assert.Equal(t, 1, tc.target.headCalls)
+			assert.Equal(t, tc.expectedGetCalls, tc.target.getCalls)
+		})
+	}
+}
+
+func TestGetFileKey(t *testing.T) {
+	layout := NewObjectStoreLayout("velero")
+
+	key, err := layout.getFileKey("kopia/ns-1/p0123")
+	require.NoError(t, err)
+	assert.Equal(t, "velero/kopia/ns-1/p0123", key)
+
+	for _, invalid := range []string{"", "foo/bar", "backups/../kopia", "backups//backup-1", "./backups"} {
+		_, err := layout.getFileKey(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -18,10 +18,14 @@ package persistence
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"io"
 	"strings"
 	"time"
+
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
 )
 
 type BucketData map[string][]byte
@@ -55,20 +59,20 @@ func (o *inMemoryObjectStore) Init(config map[string]string) error {
 	return nil
 }
 
-func (o *inMemoryObjectStore) PutObject(bucket, key string, body io.Reader) error {
+func (o *inMemoryObjectStore) PutObject(bucket, key string, body io.Reader, options osv2.PutObjectOptions) (osv2.Checksum, error) {
 	bucketData, ok := o.Data[bucket]
 	if !ok {
-		return errors.New("bucket not found")
+		return osv2.Checksum{}, errors.New("bucket not found")
 	}
 
 	obj, err := io.ReadAll(body)
 	if err != nil {
-		return err
+		return osv2.Checksum{}, err
 	}
 
 	bucketData[key] = obj
 
-	return nil
+	return checksumOf(obj), nil
 }
 
 func (o *inMemoryObjectStore) ObjectExists(bucket, key string) (bool, error) {
@@ -95,6 +99,45 @@ func (o *inMemoryObjectStore) GetObject(bucket, key string) (io.ReadCloser, erro
 	return io.NopCloser(bytes.NewReader(obj)), nil
 }
 
+func (o *inMemoryObjectStore) GetObjectRange(bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+
+	obj, ok := bucketData[key]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+
+	if offset > int64(len(obj)) {
+		offset = int64(len(obj))
+	}
+	obj = obj[offset:]
+	if length > 0 && length < int64(len(obj)) {
+		obj = obj[:length]
+	}
+
+	return io.NopCloser(bytes.NewReader(obj)), nil
+}
+
+func (o *inMemoryObjectStore) HeadObject(bucket, key string) (osv2.ObjectInfo, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return osv2.ObjectInfo{}, errors.New("bucket not found")
+	}
+
+	obj, ok := bucketData[key]
+	if !ok {
+		return osv2.ObjectInfo{}, errors.New("key not found")
+	}
+
+	return osv2.ObjectInfo{
+		Size:     int64(len(obj)),
+		Checksum: checksumOf(obj),
+	}, nil
+}
+
 func (o *inMemoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
 	keys, err := o.ListObjects(bucket, prefix)
 	if err != nil {
@@ -105,6 +148,7 @@ func (o *inMemoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter strin
 	// If not, skip it; if so, return the prefix of the key up to/including the delimiter.
 
 	var prefixes []string
+	seen := make(map[string]bool)
 	for _, key := range keys {
 		// everything after 'prefix'
 		afterPrefix := key[len(prefix):]
@@ -119,6 +163,11 @@ func (o *inMemoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter strin
 		// the delimiter, plus the delimiter
 		fullPrefix := prefix + afterPrefix[0:delimiterStart] + delimiter
 
+		if seen[fullPrefix] {
+			continue
+		}
+		seen[fullPrefix] = true
+
 		prefixes = append(prefixes, fullPrefix)
 	}
 
@@ -141,6 +190,22 @@ func (o *inMemoryObjectStore) ListObjects(bucket, prefix string) ([]string, erro
 	return objs, nil
 }
 
+func (o *inMemoryObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+
+	sizes := map[string]int64{}
+	for key, data := range bucketData {
+		if strings.HasPrefix(key, prefix) {
+			sizes[key] = int64(len(data))
+		}
+	}
+
+	return sizes, nil
+}
+
 func (o *inMemoryObjectStore) DeleteObject(bucket, key string) error {
 	bucketData, ok := o.Data[bucket]
 	if !ok {
@@ -152,6 +217,19 @@ func (o *inMemoryObjectStore) DeleteObject(bucket, key string) error {
 	return nil
 }
 
+func (o *inMemoryObjectStore) DeleteObjects(bucket string, keys []string) error {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return errors.New("bucket not found")
+	}
+
+	for _, key := range keys {
+		delete(bucketData, key)
+	}
+
+	return nil
+}
+
 func (o *inMemoryObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
 	bucketData, ok := o.Data[bucket]
 	if !ok {
@@ -177,3 +255,8 @@ func (o *inMemoryObjectStore) ClearBucket(bucket string) {
 
 	o.Data[bucket] = make(map[string][]byte)
 }
+
+func checksumOf(obj []byte) osv2.Checksum {
+	sum := sha256.Sum256(obj)
+	return osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: hex.EncodeToString(sum[:])}
+}
@@ -19,12 +19,18 @@ package mocks
 
 import (
 	io "io"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
+	archive "github.com/vmware-tanzu/velero/pkg/archive"
 	itemoperation "github.com/vmware-tanzu/velero/pkg/itemoperation"
 
+	objectstorev2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 
+	results "github.com/vmware-tanzu/velero/pkg/util/results"
+
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
@@ -109,6 +115,52 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupContentsArchive provides a mock function with given fields: name, _a1
+func (_m *BackupStore) GetBackupContentsArchive(name string, _a1 string) (io.ReadCloser, error) {
+	ret := _m.Called(name, _a1)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string, string) io.ReadCloser); ok {
+		r0 = rf(name, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupContentsIndex provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupContentsIndex(name string) (*archive.Index, error) {
+	ret := _m.Called(name)
+
+	var r0 *archive.Index
+	if rf, ok := ret.Get(0).(func(string) *archive.Index); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archive.Index)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupItemOperations provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
 	ret := _m.Called(name)
@@ -314,6 +366,29 @@ func (_m *BackupStore) GetRestoreItemOperations(name string) ([]*itemoperation.R
 	return r0, r1
 }
 
+// GetRestoreResults provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreResults(name string) (map[string]results.Result, error) {
+	ret := _m.Called(name)
+
+	var r0 map[string]results.Result
+	if rf, ok := ret.Get(0).(func(string) map[string]results.Result); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]results.Result)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValid provides a mock function with given fields:
 func (_m *BackupStore) IsValid() error {
 	ret := _m.Called()
@@ -328,6 +403,43 @@ func (_m *BackupStore) IsValid() error {
 	return r0
 }
 
+// PutHealthCheck provides a mock function with given fields:
+func (_m *BackupStore) PutHealthCheck() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetUsage provides a mock function with given fields:
+func (_m *BackupStore) GetUsage() (*persistence.Usage, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.Usage
+	if rf, ok := ret.Get(0).(func() *persistence.Usage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.Usage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBackups provides a mock function with given fields:
 func (_m *BackupStore) ListBackups() ([]string, error) {
 	ret := _m.Called()
@@ -365,13 +477,13 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
-// PutBackupContents provides a mock function with given fields: backup, backupContents
-func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
-	ret := _m.Called(backup, backupContents)
+// PutBackupContents provides a mock function with given fields: backup, backupContents, retainUntil
+func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(backup, backupContents, retainUntil)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
-		r0 = rf(backup, backupContents)
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(backup, backupContents, retainUntil)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -379,13 +491,13 @@ func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader
 	return r0
 }
 
-// PutBackupItemOperations provides a mock function with given fields: backup, backupItemOperations
-func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
-	ret := _m.Called(backup, backupItemOperations)
+// PutBackupContentsArchive provides a mock function with given fields: backup, _a1, contents, retainUntil
+func (_m *BackupStore) PutBackupContentsArchive(backup string, _a1 string, contents io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(backup, _a1, contents, retainUntil)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
-		r0 = rf(backup, backupItemOperations)
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, time.Time) error); ok {
+		r0 = rf(backup, _a1, contents, retainUntil)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -393,13 +505,41 @@ func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperatio
 	return r0
 }
 
-// PutBackupMetadata provides a mock function with given fields: backup, backupMetadata
-func (_m *BackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
-	ret := _m.Called(backup, backupMetadata)
+// PutBackupContentsIndex provides a mock function with given fields: backup, index, retainUntil
+func (_m *BackupStore) PutBackupContentsIndex(backup string, index io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(backup, index, retainUntil)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
-		r0 = rf(backup, backupMetadata)
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(backup, index, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupItemOperations provides a mock function with given fields: backup, backupItemOperations, retainUntil
+func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(backup, backupItemOperations, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(backup, backupItemOperations, retainUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupMetadata provides a mock function with given fields: backup, backupMetadata, retainUntil
+func (_m *BackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader, retainUntil time.Time) error {
+	ret := _m.Called(backup, backupMetadata, retainUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) error); ok {
+		r0 = rf(backup, backupMetadata, retainUntil)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -421,6 +561,34 @@ func (_m *BackupStore) PutRestoreItemOperations(restore string, restoreItemOpera
 	return r0
 }
 
+// PutRestoreItemStatus provides a mock function with given fields: restore, itemStatus
+func (_m *BackupStore) PutRestoreItemStatus(restore string, itemStatus io.Reader) error {
+	ret := _m.Called(restore, itemStatus)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, itemStatus)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreHookResults provides a mock function with given fields: restore, hookResults
+func (_m *BackupStore) PutRestoreHookResults(restore string, hookResults io.Reader) error {
+	ret := _m.Called(restore, hookResults)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, hookResults)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -463,6 +631,110 @@ func (_m *BackupStore) PutRestoredResourceList(restore string, results io.Reader
 	return r0
 }
 
+// ListFiles provides a mock function with given fields: dir
+func (_m *BackupStore) ListFiles(dir string) ([]string, error) {
+	ret := _m.Called(dir)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(dir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(dir)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFile provides a mock function with given fields: key
+func (_m *BackupStore) GetFile(key string) (io.ReadCloser, error) {
+	ret := _m.Called(key)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFileInfo provides a mock function with given fields: key
+func (_m *BackupStore) GetFileInfo(key string) (objectstorev2.ObjectInfo, error) {
+	ret := _m.Called(key)
+
+	var r0 objectstorev2.ObjectInfo
+	if rf, ok := ret.Get(0).(func(string) objectstorev2.ObjectInfo); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(objectstorev2.ObjectInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutFile provides a mock function with given fields: key, body, retainUntil
+func (_m *BackupStore) PutFile(key string, body io.Reader, retainUntil time.Time) (objectstorev2.Checksum, error) {
+	ret := _m.Called(key, body, retainUntil)
+
+	var r0 objectstorev2.Checksum
+	if rf, ok := ret.Get(0).(func(string, io.Reader, time.Time) objectstorev2.Checksum); ok {
+		r0 = rf(key, body, retainUntil)
+	} else {
+		r0 = ret.Get(0).(objectstorev2.Checksum)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, io.Reader, time.Time) error); ok {
+		r1 = rf(key, body, retainUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TestCompatibility provides a mock function with given fields: options
+func (_m *BackupStore) TestCompatibility(options persistence.CompatibilityTestOptions) []persistence.CompatibilityCheck {
+	ret := _m.Called(options)
+
+	var r0 []persistence.CompatibilityCheck
+	if rf, ok := ret.Get(0).(func(persistence.CompatibilityTestOptions) []persistence.CompatibilityCheck); ok {
+		r0 = rf(options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]persistence.CompatibilityCheck)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewBackupStore interface {
 	mock.TestingT
 	Cleanup(func())
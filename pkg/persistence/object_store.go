@@ -18,6 +18,7 @@ package persistence
 
 import (
 	"compress/gzip"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"strings"
@@ -31,18 +32,26 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
-	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/filesystem"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 type BackupInfo struct {
 	Name string
+	// RetainUntil is the time until which the objects of the backup are locked if object lock
+	// is enabled for the location, the objects aren't locked if it's zero.
+	RetainUntil time.Time
 	Metadata,
 	Contents,
+	ContentsIndex,
 	Log,
 	BackupResults,
+	BackupHookResults,
 	PodVolumeBackups,
 	VolumeSnapshots,
 	BackupItemOperations,
@@ -57,17 +66,34 @@ type BackupInfo struct {
 type BackupStore interface {
 	IsValid() error
 
+	// PutHealthCheck writes a small object to the backup store to check that it
+	// is writable.
+	PutHealthCheck() error
+
+	// GetUsage returns the size of the backup data and of the backup repositories
+	// stored in the backup store. It fails with an error wrapping osv2.ErrNotSupported
+	// if the object store can't list the sizes of the objects.
+	GetUsage() (*Usage, error)
+
 	ListBackups() ([]string, error)
 
+	// PutBackup and the other functions putting the objects of a backup lock them until
+	// retainUntil if object lock is enabled for the location, all the objects of a backup
+	// must be locked until the same time, which is the RetainUntil in the backup status.
 	PutBackup(info BackupInfo) error
-	PutBackupMetadata(backup string, backupMetadata io.Reader) error
-	PutBackupItemOperations(backup string, backupItemOperations io.Reader) error
-	PutBackupContents(backup string, backupContents io.Reader) error
+	PutBackupMetadata(backup string, backupMetadata io.Reader, retainUntil time.Time) error
+	PutBackupItemOperations(backup string, backupItemOperations io.Reader, retainUntil time.Time) error
+	PutBackupContents(backup string, backupContents io.Reader, retainUntil time.Time) error
+	// PutBackupContentsArchive puts an archive of a backup whose contents are split per namespace.
+	PutBackupContentsArchive(backup, archive string, contents io.Reader, retainUntil time.Time) error
+	PutBackupContentsIndex(backup string, index io.Reader, retainUntil time.Time) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
 	GetBackupContents(name string) (io.ReadCloser, error)
+	GetBackupContentsArchive(name, archive string) (io.ReadCloser, error)
+	GetBackupContentsIndex(name string) (*archive.Index, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1api.VolumeSnapshotContent, error)
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
@@ -80,27 +106,62 @@ type BackupStore interface {
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
 	PutRestoredResourceList(restore string, results io.Reader) error
+	PutRestoreItemStatus(restore string, itemStatus io.Reader) error
+	PutRestoreHookResults(restore string, hookResults io.Reader) error
 	PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error
 	GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error)
+	GetRestoreResults(name string) (map[string]results.Result, error)
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+
+	// ListFiles returns the keys, relative to the root of the backup store, of the
+	// files under dir, which must be in one of the top-level directories of the
+	// backup store, e.g. "backups/backup-1".
+	ListFiles(dir string) ([]string, error)
+	// GetFile retrieves the file with the key relative to the root of the backup store.
+	GetFile(key string) (io.ReadCloser, error)
+	// GetFileInfo gets the attributes of the file with the key relative to the root of
+	// the backup store. It fails with an error wrapping osv2.ErrNotSupported if the object
+	// store can't get them without reading the file, e.g. with the v1 object store plugins.
+	GetFileInfo(key string) (osv2.ObjectInfo, error)
+	// PutFile creates the file with the key relative to the root of the backup store,
+	// and returns the checksum of its content. The files of the backups are locked until
+	// retainUntil if object lock is enabled for the location.
+	PutFile(key string, body io.Reader, retainUntil time.Time) (osv2.Checksum, error)
+
+	// TestCompatibility runs a suite of checks of the operations Velero relies on against
+	// the object store, and returns the result of each check in the order they were run.
+	TestCompatibility(options CompatibilityTestOptions) []CompatibilityCheck
+}
+
+// Usage is the size of the data stored in a backup store.
+type Usage struct {
+	// BackupBytes is the size of the backups and restores data.
+	BackupBytes int64
+	// RepositoryBytes is the size of the backup repositories.
+	RepositoryBytes int64
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
 const DownloadURLTTL = 10 * time.Minute
 
 type objectBackupStore struct {
-	objectStore velero.ObjectStore
+	objectStore osv2.ObjectStore
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+	objectLock  *velerov1api.ObjectLock
 }
 
-// ObjectStoreGetter is a type that can get a velero.ObjectStore
+// MinObjectLockRetentionPeriod is the minimum object lock retention period of a backup storage
+// location, which is required by the backup repositories.
+const MinObjectLockRetentionPeriod = 24 * time.Hour
+
+// ObjectStoreGetter is a type that can get a v2 ObjectStore
 // from a provider name.
 type ObjectStoreGetter interface {
-	GetObjectStore(provider string) (velero.ObjectStore, error)
+	GetObjectStoreV2(provider string) (osv2.ObjectStore, error)
 }
 
 // ObjectBackupStoreGetter is a type that can get a velero.BackupStore for a
@@ -110,12 +171,15 @@ type ObjectBackupStoreGetter interface {
 }
 
 type objectBackupStoreGetter struct {
-	credentialStore credentials.FileStore
+	credentialStore      credentials.FileStore
+	filesystemSigningKey []byte
 }
 
 // NewObjectBackupStoreGetter returns a ObjectBackupStoreGetter that can get a velero.BackupStore.
-func NewObjectBackupStoreGetter(credentialStore credentials.FileStore) ObjectBackupStoreGetter {
-	return &objectBackupStoreGetter{credentialStore: credentialStore}
+// The filesystem signing key is passed to the built-in filesystem object store only, to sign the
+// download URLs served by the Velero server, it's nil where the URLs aren't served.
+func NewObjectBackupStoreGetter(credentialStore credentials.FileStore, filesystemSigningKey []byte) ObjectBackupStoreGetter {
+	return &objectBackupStoreGetter{credentialStore: credentialStore, filesystemSigningKey: filesystemSigningKey}
 }
 
 func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
@@ -127,6 +191,10 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		return nil, errors.New("object storage provider name must not be empty")
 	}
 
+	if location.Spec.ObjectLock != nil && location.Spec.ObjectLock.RetentionPeriod.Duration < MinObjectLockRetentionPeriod {
+		return nil, errors.Errorf("object lock retention period must be at least %s", MinObjectLockRetentionPeriod)
+	}
+
 	// trim off any leading/trailing slashes
 	bucket := strings.Trim(location.Spec.ObjectStorage.Bucket, "/")
 	prefix := strings.Trim(location.Spec.ObjectStorage.Prefix, "/")
@@ -159,6 +227,10 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStoreConfig["caCert"] = string(location.Spec.ObjectStorage.CACert)
 	}
 
+	if err := credentials.ValidateLocationCredential(location); err != nil {
+		return nil, err
+	}
+
 	// If the BSL specifies a credential, fetch its path on disk and pass to
 	// plugin via the config.
 	if location.Spec.Credential != nil {
@@ -170,7 +242,27 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStoreConfig["credentialsFile"] = credsFile
 	}
 
-	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
+	// If the BSL specifies a web identity, pass the plugin a shared config file
+	// assuming its role, so the plugin exchanges the web identity token for
+	// short-lived credentials and refreshes them.
+	if location.Spec.WebIdentity != nil {
+		if !supportsWebIdentity(location) {
+			return nil, errors.Errorf("web identity is not supported by provider %s", location.Spec.Provider)
+		}
+
+		configFile, err := b.credentialStore.WebIdentityPath(location.Spec.WebIdentity)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get web identity config")
+		}
+
+		objectStoreConfig["credentialsFile"] = configFile
+	}
+
+	if len(b.filesystemSigningKey) > 0 && filesystem.IsProvider(location.Spec.Provider) {
+		objectStoreConfig[filesystem.SigningKeyConfigKey] = hex.EncodeToString(b.filesystemSigningKey)
+	}
+
+	objectStore, err := objectStoreGetter.GetObjectStoreV2(location.Spec.Provider)
 	if err != nil {
 		return nil, err
 	}
@@ -189,9 +281,30 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		bucket:      bucket,
 		layout:      NewObjectStoreLayout(prefix),
 		logger:      log,
+		objectLock:  location.Spec.ObjectLock,
 	}, nil
 }
 
+// supportsWebIdentity returns true if the object store plugin of the location reads the AWS shared
+// config file assuming the role of the web identity. It's the same check as the AWS backend type of
+// the repositories, i.e. the AWS provider or an unknown provider of an S3-compatible object storage,
+// repoconfig.GetBackendType can't be used here since it depends on this package.
+func supportsWebIdentity(location *velerov1api.BackupStorageLocation) bool {
+	provider := location.Spec.Provider
+	if !strings.Contains(provider, "/") {
+		provider = "velero.io/" + provider
+	}
+
+	switch provider {
+	case "velero.io/aws":
+		return true
+	case "velero.io/azure", "velero.io/gcp", "velero.io/fs", filesystem.ProviderName:
+		return false
+	default:
+		return location.Spec.Config["s3Url"] != ""
+	}
+}
+
 func (s *objectBackupStore) IsValid() error {
 	dirs, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.rootPrefix, "/")
 	if err != nil {
@@ -217,6 +330,50 @@ func (s *objectBackupStore) IsValid() error {
 	return nil
 }
 
+func (s *objectBackupStore) PutHealthCheck() error {
+	body := strings.NewReader(time.Now().UTC().Format(time.RFC3339))
+	_, err := s.objectStore.PutObject(s.bucket, s.layout.getHealthCheckKey(), body, osv2.PutObjectOptions{})
+	return errors.WithStack(err)
+}
+
+func (s *objectBackupStore) GetUsage() (*Usage, error) {
+	usage := new(Usage)
+
+	for _, dir := range []string{"backups", "restores"} {
+		size, err := s.prefixSize(s.layout.subdirs[dir])
+		if err != nil {
+			return nil, err
+		}
+		usage.BackupBytes += size
+	}
+
+	for _, dir := range []string{"kopia", "restic"} {
+		size, err := s.prefixSize(s.layout.subdirs[dir])
+		if err != nil {
+			return nil, err
+		}
+		usage.RepositoryBytes += size
+	}
+
+	return usage, nil
+}
+
+// prefixSize returns the total size of the objects under prefix, from the listing of the
+// objects. It fails with osv2.ErrNotSupported if the object store can't list the sizes.
+func (s *objectBackupStore) prefixSize(prefix string) (int64, error) {
+	sizes, err := s.objectStore.ListObjectSizes(s.bucket, prefix)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error listing the sizes of the objects under %s", prefix)
+	}
+
+	var size int64
+	for _, objectSize := range sizes {
+		size += objectSize
+	}
+
+	return size, nil
+}
+
 func (s *objectBackupStore) ListBackups() ([]string, error) {
 	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["backups"], "/")
 	if err != nil {
@@ -242,18 +399,18 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	if err := s.seekAndPutBackupObject(s.layout.getBackupLogKey(info.Name), info.Log, info.RetainUntil); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
+	if err := s.seekAndPutBackupObject(s.layout.getBackupMetadataKey(info.Name), info.Metadata, info.RetainUntil); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.seekAndPutBackupObject(s.layout.getBackupContentsKey(info.Name), info.Contents, info.RetainUntil); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -265,14 +422,16 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
 		s.layout.getBackupItemOperationsKey(info.Name):      info.BackupItemOperations,
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
+		s.layout.getBackupContentsIndexKey(info.Name):       info.ContentsIndex,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
 		s.layout.getBackupResultsKey(info.Name):             info.BackupResults,
+		s.layout.getBackupHookResultsKey(info.Name):         info.BackupHookResults,
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
+		if err := s.seekAndPutBackupObject(key, reader, info.RetainUntil); err != nil {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
@@ -316,8 +475,8 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 	return backupObj, nil
 }
 
-func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata)
+func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupMetadataKey(backup), backupMetadata, retainUntil)
 }
 
 func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
@@ -383,9 +542,28 @@ func (s *objectBackupStore) GetRestoreItemOperations(name string) ([]*itemoperat
 	return restoreItemOperations, nil
 }
 
+func (s *objectBackupStore) GetRestoreResults(name string) (map[string]results.Result, error) {
+	// the results file doesn't exist until the restore has finished restoring the items
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreResultsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	restoreResults := make(map[string]results.Result)
+	if err := decode(res, &restoreResults); err != nil {
+		return nil, err
+	}
+
+	return restoreResults, nil
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
-func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
+func tryGet(objectStore osv2.ObjectStore, bucket, key string) (io.ReadCloser, error) {
 	exists, err := objectStore.ObjectExists(bucket, key)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -492,6 +670,25 @@ func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error
 	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
 }
 
+func (s *objectBackupStore) GetBackupContentsArchive(name, archive string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsArchiveKey(name, archive))
+}
+
+func (s *objectBackupStore) GetBackupContentsIndex(name string) (*archive.Index, error) {
+	res, err := s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsIndexKey(name))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	index := &archive.Index{}
+	if err := decode(res, index); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
@@ -501,18 +698,12 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 	if err != nil {
 		return err
 	}
-
-	var errs []error
-	for _, key := range objects {
-		s.logger.WithFields(logrus.Fields{
-			"key": key,
-		}).Debug("Trying to delete object")
-		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
-			errs = append(errs, err)
-		}
+	if len(objects) == 0 {
+		return nil
 	}
 
-	return errors.WithStack(kerrors.NewAggregate(errs))
+	s.logger.WithField("keys", objects).Debug("Trying to delete objects")
+	return errors.WithStack(s.objectStore.DeleteObjects(s.bucket, objects))
 }
 
 func (s *objectBackupStore) DeleteRestore(name string) error {
@@ -520,48 +711,70 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 	if err != nil {
 		return err
 	}
-
-	var errs []error
-	for _, key := range objects {
-		s.logger.WithFields(logrus.Fields{
-			"key": key,
-		}).Debug("Trying to delete object")
-		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
-			errs = append(errs, err)
-		}
+	if len(objects) == 0 {
+		return nil
 	}
 
-	return errors.WithStack(kerrors.NewAggregate(errs))
+	s.logger.WithField("keys", objects).Debug("Trying to delete objects")
+	return errors.WithStack(s.objectStore.DeleteObjects(s.bucket, objects))
 }
 
 func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
+	_, err := s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log, osv2.PutObjectOptions{})
+	return err
 }
 
 func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
+	_, err := s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results, osv2.PutObjectOptions{})
+	return err
 }
 
 func (s *objectBackupStore) PutRestoredResourceList(restore string, list io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResourceListKey(restore), list)
+	_, err := s.objectStore.PutObject(s.bucket, s.layout.getRestoreResourceListKey(restore), list, osv2.PutObjectOptions{})
+	return err
+}
+
+func (s *objectBackupStore) PutRestoreItemStatus(restore string, itemStatus io.Reader) error {
+	_, err := s.objectStore.PutObject(s.bucket, s.layout.getRestoreItemStatusKey(restore), itemStatus, osv2.PutObjectOptions{})
+	return err
+}
+
+func (s *objectBackupStore) PutRestoreHookResults(restore string, hookResults io.Reader) error {
+	_, err := s.objectStore.PutObject(s.bucket, s.layout.getRestoreHookResultsKey(restore), hookResults, osv2.PutObjectOptions{})
+	return err
 }
 
 func (s *objectBackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations)
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations, osv2.PutObjectOptions{})
+}
+
+func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupItemOperationsKey(backup), backupItemOperations, retainUntil)
 }
 
-func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
+func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupContentsKey(backup), backupContents, retainUntil)
 }
 
-func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(backup), backupContents)
+func (s *objectBackupStore) PutBackupContentsArchive(backup, archive string, contents io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupContentsArchiveKey(backup, archive), contents, retainUntil)
+}
+
+func (s *objectBackupStore) PutBackupContentsIndex(backup string, index io.Reader, retainUntil time.Time) error {
+	return s.seekAndPutBackupObject(s.layout.getBackupContentsIndexKey(backup), index, retainUntil)
 }
 
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupContentsIndex:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsIndexKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupContentsArchive:
+		if !archive.IsValidArchive(target.Archive) {
+			return "", errors.Errorf("invalid backup contents archive %q", target.Archive)
+		}
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsArchiveKey(target.Name, target.Archive), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
@@ -578,17 +791,74 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreItemStatus:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreItemStatusKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreHookResults:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreHookResultsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResults:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupHookResults:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupHookResultsKey(target.Name), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
 }
 
+func (s *objectBackupStore) ListFiles(dir string) ([]string, error) {
+	prefix, err := s.layout.getFileKey(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.objectStore.ListObjects(s.bucket, prefix+"/")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing objects under %s", prefix)
+	}
+
+	files := make([]string, 0, len(keys))
+	for _, key := range keys {
+		files = append(files, strings.TrimPrefix(key, s.layout.rootPrefix))
+	}
+
+	return files, nil
+}
+
+func (s *objectBackupStore) GetFile(key string) (io.ReadCloser, error) {
+	objectKey, err := s.layout.getFileKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.objectStore.GetObject(s.bucket, objectKey)
+}
+
+func (s *objectBackupStore) GetFileInfo(key string) (osv2.ObjectInfo, error) {
+	objectKey, err := s.layout.getFileKey(key)
+	if err != nil {
+		return osv2.ObjectInfo{}, err
+	}
+
+	return s.objectStore.HeadObject(s.bucket, objectKey)
+}
+
+func (s *objectBackupStore) PutFile(key string, body io.Reader, retainUntil time.Time) (osv2.Checksum, error) {
+	objectKey, err := s.layout.getFileKey(key)
+	if err != nil {
+		return osv2.Checksum{}, err
+	}
+
+	var options osv2.PutObjectOptions
+	if strings.HasPrefix(objectKey, s.layout.subdirs["backups"]) {
+		options = s.backupObjectOptions(retainUntil)
+	}
+
+	return s.objectStore.PutObject(s.bucket, objectKey, body, options)
+}
+
 func seekToBeginning(r io.Reader) error {
 	seeker, ok := r.(io.Seeker)
 	if !ok {
@@ -599,7 +869,27 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
-func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
+// seekAndPutBackupObject puts an object of a backup into the object storage, the object is
+// locked until retainUntil if object lock is enabled for the location.
+func (s *objectBackupStore) seekAndPutBackupObject(key string, file io.Reader, retainUntil time.Time) error {
+	return seekAndPutObject(s.objectStore, s.bucket, key, file, s.backupObjectOptions(retainUntil))
+}
+
+// backupObjectOptions returns the options to put an object of a backup, which lock the object
+// until retainUntil if object lock is enabled for the location and retainUntil isn't zero.
+// The lock isn't computed from the time each object is put, so that all the objects of the
+// backup are unlocked at the RetainUntil recorded in the backup status.
+func (s *objectBackupStore) backupObjectOptions(retainUntil time.Time) osv2.PutObjectOptions {
+	var options osv2.PutObjectOptions
+	if s.objectLock != nil && !retainUntil.IsZero() {
+		options.RetentionMode = strings.ToUpper(string(s.objectLock.Mode))
+		options.RetainUntil = retainUntil
+	}
+
+	return options
+}
+
+func seekAndPutObject(objectStore osv2.ObjectStore, bucket, key string, file io.Reader, options osv2.PutObjectOptions) error {
 	if file == nil {
 		return nil
 	}
@@ -608,5 +898,6 @@ func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file i
 		return errors.WithStack(err)
 	}
 
-	return objectStore.PutObject(bucket, key, file)
+	_, err := objectStore.PutObject(bucket, key, file, options)
+	return err
 }
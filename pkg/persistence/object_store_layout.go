@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -61,6 +63,28 @@ func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	return ok
 }
 
+// getFileKey returns the object key of a file given its key relative to the root of the
+// backup store, checking that it's in one of the top-level directories.
+func (l *ObjectStoreLayout) getFileKey(key string) (string, error) {
+	key = strings.Trim(key, "/")
+	for _, elem := range strings.Split(key, "/") {
+		if elem == "" || elem == "." || elem == ".." {
+			return "", errors.Errorf("invalid file key %q", key)
+		}
+	}
+
+	subdir, _, _ := strings.Cut(key, "/")
+	if !l.isValidSubdir(subdir) {
+		return "", errors.Errorf("file key %q isn't in a valid top-level directory", key)
+	}
+
+	return l.rootPrefix + key, nil
+}
+
+func (l *ObjectStoreLayout) getHealthCheckKey() string {
+	return path.Join(l.subdirs["metadata"], "health-check")
+}
+
 func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
@@ -77,6 +101,14 @@ func (l *ObjectStoreLayout) getBackupContentsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s.tar.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupContentsArchiveKey(backup, archive string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-contents", backup), fmt.Sprintf("%s.tar.gz", archive))
+}
+
+func (l *ObjectStoreLayout) getBackupContentsIndexKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-contents-index.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getBackupLogKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-logs.gz", backup))
 }
@@ -113,6 +145,14 @@ func (l *ObjectStoreLayout) getRestoreItemOperationsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-itemoperations.json.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreItemStatusKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-item-status.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestoreHookResultsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-hook-results.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -128,3 +168,7 @@ func (l *ObjectStoreLayout) getCSIVolumeSnapshotClassesKey(backup string) string
 func (l *ObjectStoreLayout) getBackupResultsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-results.gz", backup))
 }
+
+func (l *ObjectStoreLayout) getBackupHookResultsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-hook-results.json.gz", backup))
+}
@@ -26,20 +26,24 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
-	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	objectstoremocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/objectstore/v2"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -156,7 +160,8 @@ func TestIsValid(t *testing.T) {
 			harness := newObjectBackupStoreTestHarness("foo", tc.prefix)
 
 			for key, obj := range tc.storageData {
-				require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj)))
+				_, err := harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj), osv2.PutObjectOptions{})
+				require.NoError(t, err)
 			}
 
 			err := harness.IsValid()
@@ -201,7 +206,8 @@ func TestListBackups(t *testing.T) {
 			harness := newObjectBackupStoreTestHarness("foo", tc.prefix)
 
 			for key, obj := range tc.storageData {
-				require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj)))
+				_, err := harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj), osv2.PutObjectOptions{})
+				require.NoError(t, err)
 			}
 
 			res, err := harness.ListBackups()
@@ -216,6 +222,62 @@ func TestListBackups(t *testing.T) {
 	}
 }
 
+func TestPutHealthCheck(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("foo", "velero-backups")
+
+	require.NoError(t, harness.PutHealthCheck())
+
+	exists, err := harness.objectStore.ObjectExists(harness.bucket, "velero-backups/metadata/health-check")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// the health check object doesn't make the backup store invalid
+	assert.NoError(t, harness.IsValid())
+}
+
+func TestGetUsage(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefix        string
+		storageData   BucketData
+		expectedUsage *Usage
+	}{
+		{
+			name:          "empty backup store",
+			expectedUsage: &Usage{},
+		},
+		{
+			name:   "backups, restores and repositories",
+			prefix: "velero-backups/",
+			storageData: map[string][]byte{
+				"velero-backups/backups/backup-1/velero-backup.json":          []byte("12345"),
+				"velero-backups/backups/backup-1/backup-1.tar.gz":             []byte("1234567890"),
+				"velero-backups/restores/restore-1/restore-restore-1-logs.gz": []byte("123"),
+				"velero-backups/kopia/default/pack-1":                         []byte("1234567"),
+				"velero-backups/restic/default/data/00/pack-1":                []byte("12"),
+				"velero-backups/metadata/health-check":                        []byte("123456789"),
+				"other/backups/backup-2/backup-2.tar.gz":                      []byte("1234567890"),
+			},
+			expectedUsage: &Usage{BackupBytes: 18, RepositoryBytes: 9},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("foo", tc.prefix)
+
+			for key, obj := range tc.storageData {
+				_, err := harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj), osv2.PutObjectOptions{})
+				require.NoError(t, err)
+			}
+
+			usage, err := harness.GetUsage()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUsage, usage)
+		})
+	}
+}
+
 func TestPutBackup(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -357,6 +419,84 @@ func TestPutBackup(t *testing.T) {
 	}
 }
 
+func TestPutBackupWithObjectLock(t *testing.T) {
+	objectLock := &velerov1api.ObjectLock{
+		Mode:            velerov1api.ObjectLockModeCompliance,
+		RetentionPeriod: metav1.Duration{Duration: 30 * 24 * time.Hour},
+	}
+
+	retainUntil := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	backupInfo := BackupInfo{
+		Name:        "backup-1",
+		RetainUntil: retainUntil,
+		Metadata:    newStringReadSeeker("metadata"),
+		Contents:    newStringReadSeeker("contents"),
+		Log:         newStringReadSeeker("log"),
+	}
+
+	t.Run("backup objects are put with retention", func(t *testing.T) {
+		objectStore := new(objectstoremocks.ObjectStore)
+		objectStore.On("PutObject", "foo", mock.Anything, mock.Anything, mock.AnythingOfType("v2.PutObjectOptions")).Return(osv2.Checksum{}, nil)
+
+		store := &objectBackupStore{
+			objectStore: objectStore,
+			bucket:      "foo",
+			layout:      NewObjectStoreLayout(""),
+			logger:      velerotest.NewLogger(),
+			objectLock:  objectLock,
+		}
+
+		require.NoError(t, store.PutBackup(backupInfo))
+		require.NoError(t, store.PutBackupMetadata("backup-1", newStringReadSeeker("metadata"), retainUntil))
+
+		var keys []string
+		for _, call := range objectStore.Calls {
+			keys = append(keys, call.Arguments.String(1))
+
+			options := call.Arguments.Get(3).(osv2.PutObjectOptions)
+			assert.Equal(t, velero.ObjectLockModeCompliance, options.RetentionMode)
+			assert.Equal(t, retainUntil, options.RetainUntil)
+		}
+		assert.ElementsMatch(t, []string{
+			"backups/backup-1/backup-1-logs.gz",
+			"backups/backup-1/velero-backup.json",
+			"backups/backup-1/backup-1.tar.gz",
+			"backups/backup-1/velero-backup.json",
+		}, keys)
+	})
+
+	t.Run("backup objects aren't locked without retain until time", func(t *testing.T) {
+		objectStore := new(objectstoremocks.ObjectStore)
+		objectStore.On("PutObject", "foo", mock.Anything, mock.Anything, osv2.PutObjectOptions{}).Return(osv2.Checksum{}, nil)
+
+		store := &objectBackupStore{
+			objectStore: objectStore,
+			bucket:      "foo",
+			layout:      NewObjectStoreLayout(""),
+			logger:      velerotest.NewLogger(),
+			objectLock:  objectLock,
+		}
+
+		require.NoError(t, store.PutBackupMetadata("backup-1", newStringReadSeeker("metadata"), time.Time{}))
+		objectStore.AssertExpectations(t)
+	})
+
+	t.Run("backup fails if object store doesn't support object lock", func(t *testing.T) {
+		objectStore := new(objectstoremocks.ObjectStore)
+		objectStore.On("PutObject", "foo", mock.Anything, mock.Anything, mock.Anything).Return(osv2.Checksum{}, errors.New("object store doesn't support object lock"))
+
+		store := &objectBackupStore{
+			objectStore: objectStore,
+			bucket:      "foo",
+			layout:      NewObjectStoreLayout(""),
+			logger:      velerotest.NewLogger(),
+			objectLock:  objectLock,
+		}
+
+		require.EqualError(t, store.PutBackup(backupInfo), "object store doesn't support object lock")
+	})
+}
+
 func TestGetBackupMetadata(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -386,7 +526,8 @@ func TestGetBackupMetadata(t *testing.T) {
 				jsonBytes, err := json.Marshal(tc.obj)
 				require.NoError(t, err)
 
-				require.NoError(t, harness.objectStore.PutObject(harness.bucket, tc.key, bytes.NewReader(jsonBytes)))
+				_, err = harness.objectStore.PutObject(harness.bucket, tc.key, bytes.NewReader(jsonBytes), osv2.PutObjectOptions{})
+				require.NoError(t, err)
 			}
 
 			res, err := harness.GetBackupMetadata(tc.backupName)
@@ -406,13 +547,13 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
 	// volumesnapshots file not found should not error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	res, err := harness.GetBackupVolumeSnapshots("test-backup")
 	assert.NoError(t, err)
 	assert.Nil(t, res)
 
 	// volumesnapshots file containing invalid data should error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-volumesnapshots.json.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-volumesnapshots.json.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	_, err = harness.GetBackupVolumeSnapshots("test-backup")
 	assert.NotNil(t, err)
 
@@ -437,7 +578,8 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(snapshots))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-volumesnapshots.json.gz", obj))
+	_, err = harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-volumesnapshots.json.gz", obj, osv2.PutObjectOptions{})
+	require.NoError(t, err)
 
 	res, err = harness.GetBackupVolumeSnapshots("test-backup")
 	assert.NoError(t, err)
@@ -448,13 +590,13 @@ func TestGetBackupItemOperations(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
 	// itemoperations file not found should not error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	res, err := harness.GetBackupItemOperations("test-backup")
 	assert.NoError(t, err)
 	assert.Nil(t, res)
 
 	// itemoperations file containing invalid data should error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-itemoperations.json.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-itemoperations.json.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	_, err = harness.GetBackupItemOperations("test-backup")
 	assert.NotNil(t, err)
 
@@ -487,7 +629,8 @@ func TestGetBackupItemOperations(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(operations))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-itemoperations.json.gz", obj))
+	_, err = harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-itemoperations.json.gz", obj, osv2.PutObjectOptions{})
+	require.NoError(t, err)
 
 	res, err = harness.GetBackupItemOperations("test-backup")
 	assert.NoError(t, err)
@@ -503,7 +646,7 @@ func TestGetRestoreItemOperations(t *testing.T) {
 	assert.Nil(t, res)
 
 	// itemoperations file containing invalid data should error
-	harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-itemoperations.json.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-itemoperations.json.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	_, err = harness.GetRestoreItemOperations("test-restore")
 	assert.NotNil(t, err)
 
@@ -536,7 +679,8 @@ func TestGetRestoreItemOperations(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(operations))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-itemoperations.json.gz", obj))
+	_, err = harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-itemoperations.json.gz", obj, osv2.PutObjectOptions{})
+	require.NoError(t, err)
 
 	res, err = harness.GetRestoreItemOperations("test-restore")
 	assert.NoError(t, err)
@@ -546,7 +690,7 @@ func TestGetRestoreItemOperations(t *testing.T) {
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup.tar.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup.tar.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 
 	rc, err := harness.GetBackupContents("test-backup")
 	require.NoError(t, err)
@@ -557,12 +701,33 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestBackupContentsArchives(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	require.NoError(t, harness.PutBackupContentsArchive("test-backup", "namespaces/ns-1", newStringReadSeeker("foo"), time.Time{}))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-contents/namespaces/ns-1.tar.gz")
+
+	rc, err := harness.GetBackupContentsArchive("test-backup", "namespaces/ns-1")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(data))
+
+	index, errs := encode.ToJSONGzip(&archive.Index{Namespaces: []string{"ns-1"}}, "contents index")
+	require.Empty(t, errs)
+	require.NoError(t, harness.PutBackupContentsIndex("test-backup", bytes.NewReader(index.Bytes()), time.Time{}))
+
+	res, err := harness.GetBackupContentsIndex("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, &archive.Index{Namespaces: []string{"ns-1"}}, res)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
 		prefix           string
 		listObjectsError error
-		deleteErrors     []error
+		deleteError      error
 		expectedErr      string
 	}{
 		{
@@ -573,15 +738,15 @@ func TestDeleteBackup(t *testing.T) {
 			prefix: "velero-backups/",
 		},
 		{
-			name:         "some delete errors, do as much as we can",
-			deleteErrors: []error{errors.New("a"), nil, errors.New("c")},
-			expectedErr:  "[a, c]",
+			name:        "delete error",
+			deleteError: errors.New("a"),
+			expectedErr: "a",
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			objectStore := new(providermocks.ObjectStore)
+			objectStore := new(objectstoremocks.ObjectStore)
 			backupStore := &objectBackupStore{
 				objectStore: objectStore,
 				bucket:      "test-bucket",
@@ -593,14 +758,7 @@ func TestDeleteBackup(t *testing.T) {
 			objects := []string{test.prefix + "backups/bak/velero-backup.json", test.prefix + "backups/bak/bak.tar.gz", test.prefix + "backups/bak/bak.log.gz"}
 
 			objectStore.On("ListObjects", backupStore.bucket, test.prefix+"backups/bak/").Return(objects, test.listObjectsError)
-			for i, obj := range objects {
-				var err error
-				if i < len(test.deleteErrors) {
-					err = test.deleteErrors[i]
-				}
-
-				objectStore.On("DeleteObject", backupStore.bucket, obj).Return(err)
-			}
+			objectStore.On("DeleteObjects", backupStore.bucket, objects).Return(test.deleteError)
 
 			err := backupStore.DeleteBackup("bak")
 
@@ -614,7 +772,7 @@ func TestDeleteRestore(t *testing.T) {
 		name             string
 		prefix           string
 		listObjectsError error
-		deleteErrors     []error
+		deleteError      error
 		expectedErr      string
 	}{
 		{
@@ -625,15 +783,15 @@ func TestDeleteRestore(t *testing.T) {
 			prefix: "velero-backups/",
 		},
 		{
-			name:         "some delete errors, do as much as we can",
-			deleteErrors: []error{errors.New("a"), nil, errors.New("c")},
-			expectedErr:  "[a, c]",
+			name:        "delete error",
+			deleteError: errors.New("a"),
+			expectedErr: "a",
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			objectStore := new(providermocks.ObjectStore)
+			objectStore := new(objectstoremocks.ObjectStore)
 			backupStore := &objectBackupStore{
 				objectStore: objectStore,
 				bucket:      "test-bucket",
@@ -645,14 +803,7 @@ func TestDeleteRestore(t *testing.T) {
 			objects := []string{test.prefix + "restores/bak/velero-restore.json", test.prefix + "restores/bak/bak.tar.gz", test.prefix + "restores/bak/bak.log.gz"}
 
 			objectStore.On("ListObjects", backupStore.bucket, test.prefix+"restores/bak/").Return(objects, test.listObjectsError)
-			for i, obj := range objects {
-				var err error
-				if i < len(test.deleteErrors) {
-					err = test.deleteErrors[i]
-				}
-
-				objectStore.On("DeleteObject", backupStore.bucket, obj).Return(err)
-			}
+			objectStore.On("DeleteObjects", backupStore.bucket, objects).Return(test.deleteError)
 
 			err := backupStore.DeleteRestore("bak")
 
@@ -673,6 +824,7 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
 				velerov1api.DownloadTargetKindBackupContents:        "backups/my-backup/my-backup.tar.gz",
+				velerov1api.DownloadTargetKindBackupContentsIndex:   "backups/my-backup/my-backup-contents-index.json.gz",
 				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemOperations:  "backups/my-backup/my-backup-itemoperations.json.gz",
@@ -764,7 +916,8 @@ func TestGetDownloadURL(t *testing.T) {
 
 			for kind, expectedKey := range test.expectedKeyByKind {
 				t.Run(string(kind), func(t *testing.T) {
-					require.NoError(t, harness.objectStore.PutObject("test-bucket", expectedKey, newStringReadSeeker("foo")))
+					_, err := harness.objectStore.PutObject("test-bucket", expectedKey, newStringReadSeeker("foo"), osv2.PutObjectOptions{})
+					require.NoError(t, err)
 
 					url, err := harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: kind, Name: test.targetName})
 					require.NoError(t, err)
@@ -775,6 +928,19 @@ func TestGetDownloadURL(t *testing.T) {
 	}
 }
 
+func TestGetDownloadURLBackupContentsArchive(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	_, err := harness.objectStore.PutObject("test-bucket", "backups/my-backup/my-backup-contents/namespaces/ns-1.tar.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
+	require.NoError(t, err)
+
+	url, err := harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContentsArchive, Name: "my-backup", Archive: "namespaces/ns-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "a-url", url)
+
+	_, err = harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContentsArchive, Name: "my-backup", Archive: "../other-backup/cluster"})
+	assert.EqualError(t, err, `invalid backup contents archive "../other-backup/cluster"`)
+}
+
 func TestGetCSIVolumeSnapshotClasses(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -784,7 +950,7 @@ func TestGetCSIVolumeSnapshotClasses(t *testing.T) {
 	assert.Nil(t, res)
 
 	// file containing invalid data should error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotclasses.json.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotclasses.json.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	_, err = harness.GetCSIVolumeSnapshotClasses("test-backup")
 	assert.NotNil(t, err)
 
@@ -800,7 +966,8 @@ func TestGetCSIVolumeSnapshotClasses(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(classes))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotclasses.json.gz", obj))
+	_, err = harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotclasses.json.gz", obj, osv2.PutObjectOptions{})
+	require.NoError(t, err)
 
 	res, err = harness.GetCSIVolumeSnapshotClasses("test-backup")
 	assert.NoError(t, err)
@@ -816,7 +983,7 @@ func TestGetCSIVolumeSnapshots(t *testing.T) {
 	assert.Nil(t, res)
 
 	// file containing invalid data should error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshots.json.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshots.json.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	_, err = harness.GetCSIVolumeSnapshots("test-backup")
 	assert.NotNil(t, err)
 
@@ -836,7 +1003,8 @@ func TestGetCSIVolumeSnapshots(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(snapshots))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshots.json.gz", obj))
+	_, err = harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshots.json.gz", obj, osv2.PutObjectOptions{})
+	require.NoError(t, err)
 
 	res, err = harness.GetCSIVolumeSnapshots("test-backup")
 	assert.NoError(t, err)
@@ -852,7 +1020,7 @@ func TestGetCSIVolumeSnapshotContents(t *testing.T) {
 	assert.Nil(t, res)
 
 	// file containing invalid data should error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotcontents.json.gz", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotcontents.json.gz", newStringReadSeeker("foo"), osv2.PutObjectOptions{})
 	_, err = harness.GetCSIVolumeSnapshotContents("test-backup")
 	assert.NotNil(t, err)
 
@@ -870,16 +1038,17 @@ func TestGetCSIVolumeSnapshotContents(t *testing.T) {
 
 	require.NoError(t, json.NewEncoder(gzw).Encode(contents))
 	require.NoError(t, gzw.Close())
-	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotcontents.json.gz", obj))
+	_, err = harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-csi-volumesnapshotcontents.json.gz", obj, osv2.PutObjectOptions{})
+	require.NoError(t, err)
 
 	res, err = harness.GetCSIVolumeSnapshotContents("test-backup")
 	assert.NoError(t, err)
 	assert.EqualValues(t, contents, res)
 }
 
-type objectStoreGetter map[string]velero.ObjectStore
+type objectStoreGetter map[string]osv2.ObjectStore
 
-func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore, error) {
+func (osg objectStoreGetter) GetObjectStoreV2(provider string) (osv2.ObjectStore, error) {
 	res, ok := osg[provider]
 	if !ok {
 		return nil, errors.New("object store not found")
@@ -928,6 +1097,32 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 			credFileStore: velerotest.NewFakeCredentialsFileStore("", fmt.Errorf("secret does not exist")),
 			wantErr:       "unable to get credentials: secret does not exist",
 		},
+		{
+			name: "when the location has both a credential and a web identity, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "bsl").Provider("provider-1").Bucket("bucket").Credential(
+				builder.ForSecretKeySelector("secret", "key").Result(),
+			).WebIdentity(&velerov1api.WebIdentity{RoleARN: "role"}).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "backup storage location bsl can't define both a credential and a web identity",
+		},
+		{
+			name:          "when the provider doesn't support web identity, a backup store can't be retrieved",
+			location:      builder.ForBackupStorageLocation("", "bsl").Provider("velero.io/azure").Bucket("bucket").WebIdentity(&velerov1api.WebIdentity{RoleARN: "role"}).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("/tmp/credentials/web-identity", nil),
+			wantErr:       "web identity is not supported by provider velero.io/azure",
+		},
+		{
+			name:          "when a provider which isn't S3-compatible has a web identity, a backup store can't be retrieved",
+			location:      builder.ForBackupStorageLocation("", "bsl").Provider("provider-1").Bucket("bucket").WebIdentity(&velerov1api.WebIdentity{RoleARN: "role"}).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("/tmp/credentials/web-identity", nil),
+			wantErr:       "web identity is not supported by provider provider-1",
+		},
+		{
+			name:          "when the object lock retention period is less than 24 hours, a backup store can't be retrieved",
+			location:      builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").ObjectLock(velerov1api.ObjectLockModeGovernance, time.Hour).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "object lock retention period must be at least 24h0m0s",
+		},
 		{
 			name:     "when Bucket has a leading and trailing slash, they are both stripped",
 			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("/bucket/").Result(),
@@ -961,7 +1156,7 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			getter := NewObjectBackupStoreGetter(tc.credFileStore)
+			getter := NewObjectBackupStoreGetter(tc.credFileStore, nil)
 			res, err := getter.Get(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.EqualError(t, err, tc.wantErr)
@@ -994,7 +1189,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket but no prefix has config initialized with bucket and empty prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), nil),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -1003,7 +1198,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket and prefix has config initialized with bucket and prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Prefix("prefix").Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), nil),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "prefix",
@@ -1012,7 +1207,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with CACert is initialized with caCert",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).CACert([]byte("cacert-data")).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), nil),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -1024,19 +1219,63 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Credential(
 				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
 			).Result(),
-			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil)),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil), nil),
 			wantConfig: map[string]string{
 				"bucket":          "bucket",
 				"prefix":          "",
 				"credentialsFile": "/tmp/credentials/secret-file",
 			},
 		},
+		{
+			name:     "location with WebIdentity is initialized with path of web identity config",
+			location: builder.ForBackupStorageLocation("", "").Provider("aws").Bucket(bucket).WebIdentity(&velerov1api.WebIdentity{RoleARN: "role"}).Result(),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/web-identity", nil), nil),
+			wantConfig: map[string]string{
+				"bucket":          "bucket",
+				"prefix":          "",
+				"credentialsFile": "/tmp/credentials/web-identity",
+			},
+		},
+		{
+			name: "location of an S3-compatible provider with WebIdentity is initialized with path of web identity config",
+			location: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).WebIdentity(&velerov1api.WebIdentity{RoleARN: "role"}).Result()
+				location.Spec.Config = map[string]string{"s3Url": "https://minio.example.com"}
+				return location
+			}(),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/web-identity", nil), nil),
+			wantConfig: map[string]string{
+				"bucket":          "bucket",
+				"prefix":          "",
+				"s3Url":           "https://minio.example.com",
+				"credentialsFile": "/tmp/credentials/web-identity",
+			},
+		},
+		{
+			name:     "location of the filesystem provider is initialized with the signing key",
+			location: builder.ForBackupStorageLocation("", "").Provider("filesystem").Bucket(bucket).Result(),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), []byte{0x01, 0xab}),
+			wantConfig: map[string]string{
+				"bucket":     "bucket",
+				"prefix":     "",
+				"signingKey": "01ab",
+			},
+		},
+		{
+			name:     "location of other providers isn't initialized with the signing key",
+			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Result(),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), []byte{0x01, 0xab}),
+			wantConfig: map[string]string{
+				"bucket": "bucket",
+				"prefix": "",
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			objStore := newInMemoryObjectStore(bucket)
-			objStoreGetter := &objectStoreGetter{provider: objStore}
+			objStoreGetter := &objectStoreGetter{tc.location.Spec.Provider: objStore}
 
 			_, err := tc.getter.Get(tc.location, objStoreGetter, velerotest.NewLogger())
 			require.NoError(t, err)
@@ -26,6 +26,8 @@ import (
 
 	biav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v1"
 	biav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v2"
+	osv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v1"
+	osv2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	riav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v1"
 	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
@@ -34,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v1"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
 	riav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v1"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
@@ -44,6 +47,9 @@ type Manager interface {
 	// GetObjectStore returns the ObjectStore plugin for name.
 	GetObjectStore(name string) (velero.ObjectStore, error)
 
+	// GetObjectStoreV2 returns the v2 ObjectStore plugin for name (including those adapted from v1).
+	GetObjectStoreV2(name string) (osv2.ObjectStore, error)
+
 	// GetVolumeSnapshotter returns the VolumeSnapshotter plugin for name.
 	GetVolumeSnapshotter(name string) (vsv1.VolumeSnapshotter, error)
 
@@ -161,14 +167,36 @@ func (m *manager) getRestartableProcess(kind common.PluginKind, name string) (pr
 func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	name = sanitizeName(name)
 
-	restartableProcess, err := m.getRestartableProcess(common.PluginKindObjectStore, name)
-	if err != nil {
-		return nil, err
+	for _, adaptedObjectStore := range osv1cli.AdaptedObjectStores() {
+		restartableProcess, err := m.getRestartableProcess(adaptedObjectStore.Kind, name)
+		// Check if plugin was not found
+		if errors.As(err, &pluginNotFoundErrType) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return adaptedObjectStore.GetRestartable(name, restartableProcess), nil
 	}
+	return nil, fmt.Errorf("unable to get valid ObjectStore for %q", name)
+}
 
-	r := NewRestartableObjectStore(name, restartableProcess)
+// GetObjectStoreV2 returns a v2 restartableObjectStore for name.
+func (m *manager) GetObjectStoreV2(name string) (osv2.ObjectStore, error) {
+	name = sanitizeName(name)
 
-	return r, nil
+	for _, adaptedObjectStore := range osv2cli.AdaptedObjectStores() {
+		restartableProcess, err := m.getRestartableProcess(adaptedObjectStore.Kind, name)
+		// Check if plugin was not found
+		if errors.As(err, &pluginNotFoundErrType) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return adaptedObjectStore.GetRestartable(name, restartableProcess), nil
+	}
+	return nil, fmt.Errorf("unable to get valid ObjectStoreV2 for %q", name)
 }
 
 // GetVolumeSnapshotter returns a restartableVolumeSnapshotter for name.
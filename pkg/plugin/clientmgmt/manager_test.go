@@ -29,6 +29,8 @@ import (
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
 	biav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v1"
 	biav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v2"
+	osv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v1"
+	osv2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	riav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v1"
 	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
@@ -162,9 +164,26 @@ func TestGetObjectStore(t *testing.T) {
 			return m.GetObjectStore(name)
 		},
 		func(name string, sharedPluginProcess process.RestartableProcess) interface{} {
-			return &restartableObjectStore{
-				key:                 process.KindAndName{Kind: common.PluginKindObjectStore, Name: name},
-				sharedPluginProcess: sharedPluginProcess,
+			return &osv1cli.RestartableObjectStore{
+				Key:                 process.KindAndName{Kind: common.PluginKindObjectStore, Name: name},
+				SharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		true,
+	)
+}
+
+func TestGetObjectStoreV2(t *testing.T) {
+	getPluginTest(t,
+		common.PluginKindObjectStoreV2,
+		"velero.io/aws",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetObjectStoreV2(name)
+		},
+		func(name string, sharedPluginProcess process.RestartableProcess) interface{} {
+			return &osv2cli.RestartableObjectStore{
+				Key:                 process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name},
+				SharedPluginProcess: sharedPluginProcess,
 			}
 		},
 		true,
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package clientmgmt
+package v1
 
 import (
 	"io"
@@ -27,24 +27,43 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
-// restartableObjectStore is an object store for a given implementation (such as "aws"). It is associated with
+// AdaptedObjectStore is an object store adapted to the v1 ObjectStore API
+type AdaptedObjectStore struct {
+	Kind common.PluginKind
+
+	// Get returns a restartable ObjectStore for the given name and process, wrapping if necessary
+	GetRestartable func(name string, restartableProcess process.RestartableProcess) velero.ObjectStore
+}
+
+func AdaptedObjectStores() []AdaptedObjectStore {
+	return []AdaptedObjectStore{
+		{
+			Kind: common.PluginKindObjectStore,
+			GetRestartable: func(name string, restartableProcess process.RestartableProcess) velero.ObjectStore {
+				return NewRestartableObjectStore(name, restartableProcess)
+			},
+		},
+	}
+}
+
+// RestartableObjectStore is an object store for a given implementation (such as "aws"). It is associated with
 // a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
-// call, the restartableObjectStore asks its restartableProcess to restart itself if needed (e.g. if the
+// call, the RestartableObjectStore asks its restartableProcess to restart itself if needed (e.g. if the
 // process terminated for any reason), then it proceeds with the actual call.
-type restartableObjectStore struct {
-	key                 process.KindAndName
-	sharedPluginProcess process.RestartableProcess
+type RestartableObjectStore struct {
+	Key                 process.KindAndName
+	SharedPluginProcess process.RestartableProcess
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
 }
 
-// NewRestartableObjectStore returns a new restartableObjectStore.
-func NewRestartableObjectStore(name string, sharedPluginProcess process.RestartableProcess) *restartableObjectStore {
+// NewRestartableObjectStore returns a new RestartableObjectStore.
+func NewRestartableObjectStore(name string, sharedPluginProcess process.RestartableProcess) *RestartableObjectStore {
 	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
-	r := &restartableObjectStore{
-		key:                 key,
-		sharedPluginProcess: sharedPluginProcess,
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: sharedPluginProcess,
 	}
 
 	// Register our reinitializer so we can reinitialize after a restart with r.config.
@@ -54,7 +73,7 @@ func NewRestartableObjectStore(name string, sharedPluginProcess process.Restarta
 }
 
 // reinitialize reinitializes a re-dispensed plugin using the initial data passed to Init().
-func (r *restartableObjectStore) Reinitialize(dispensed interface{}) error {
+func (r *RestartableObjectStore) Reinitialize(dispensed interface{}) error {
 	objectStore, ok := dispensed.(velero.ObjectStore)
 	if !ok {
 		return errors.Errorf("plugin %T is not a ObjectStore", dispensed)
@@ -63,10 +82,10 @@ func (r *restartableObjectStore) Reinitialize(dispensed interface{}) error {
 	return r.init(objectStore, r.config)
 }
 
-// getObjectStore returns the object store for this restartableObjectStore. It does *not* restart the
+// getObjectStore returns the object store for this RestartableObjectStore. It does *not* restart the
 // plugin process.
-func (r *restartableObjectStore) getObjectStore() (velero.ObjectStore, error) {
-	plugin, err := r.sharedPluginProcess.GetByKindAndName(r.key)
+func (r *RestartableObjectStore) getObjectStore() (velero.ObjectStore, error) {
+	plugin, err := r.SharedPluginProcess.GetByKindAndName(r.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -79,9 +98,9 @@ func (r *restartableObjectStore) getObjectStore() (velero.ObjectStore, error) {
 	return objectStore, nil
 }
 
-// getDelegate restarts the plugin process (if needed) and returns the object store for this restartableObjectStore.
-func (r *restartableObjectStore) getDelegate() (velero.ObjectStore, error) {
-	if err := r.sharedPluginProcess.ResetIfNeeded(); err != nil {
+// getDelegate restarts the plugin process (if needed) and returns the object store for this RestartableObjectStore.
+func (r *RestartableObjectStore) getDelegate() (velero.ObjectStore, error) {
+	if err := r.SharedPluginProcess.ResetIfNeeded(); err != nil {
 		return nil, err
 	}
 
@@ -90,7 +109,7 @@ func (r *restartableObjectStore) getDelegate() (velero.ObjectStore, error) {
 
 // Init initializes the object store instance using config. If this is the first invocation, r stores config for future
 // reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
-func (r *restartableObjectStore) Init(config map[string]string) error {
+func (r *RestartableObjectStore) Init(config map[string]string) error {
 	if r.config != nil {
 		return errors.Errorf("already initialized")
 	}
@@ -108,12 +127,12 @@ func (r *restartableObjectStore) Init(config map[string]string) error {
 
 // init calls Init on objectStore with config. This is split out from Init() so that both Init() and reinitialize() may
 // call it using a specific ObjectStore.
-func (r *restartableObjectStore) init(objectStore velero.ObjectStore, config map[string]string) error {
+func (r *RestartableObjectStore) init(objectStore velero.ObjectStore, config map[string]string) error {
 	return objectStore.Init(config)
 }
 
 // PutObject restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) PutObject(bucket string, key string, body io.Reader) error {
+func (r *RestartableObjectStore) PutObject(bucket string, key string, body io.Reader) error {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return err
@@ -121,8 +140,22 @@ func (r *restartableObjectStore) PutObject(bucket string, key string, body io.Re
 	return delegate.PutObject(bucket, key, body)
 }
 
+// PutObjectWithRetention restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) PutObjectWithRetention(bucket string, key string, body io.Reader, mode string, retainUntil time.Time) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	retentionDelegate, ok := delegate.(velero.ObjectStoreWithRetention)
+	if !ok {
+		return errors.Errorf("object store plugin %s doesn't support object lock", r.Key.Name)
+	}
+	return retentionDelegate.PutObjectWithRetention(bucket, key, body, mode, retainUntil)
+}
+
 // ObjectExists restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) ObjectExists(bucket, key string) (bool, error) {
+func (r *RestartableObjectStore) ObjectExists(bucket, key string) (bool, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return false, err
@@ -131,7 +164,7 @@ func (r *restartableObjectStore) ObjectExists(bucket, key string) (bool, error)
 }
 
 // GetObject restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) GetObject(bucket string, key string) (io.ReadCloser, error) {
+func (r *RestartableObjectStore) GetObject(bucket string, key string) (io.ReadCloser, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, err
@@ -140,7 +173,7 @@ func (r *restartableObjectStore) GetObject(bucket string, key string) (io.ReadCl
 }
 
 // ListCommonPrefixes restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) ListCommonPrefixes(bucket string, prefix string, delimiter string) ([]string, error) {
+func (r *RestartableObjectStore) ListCommonPrefixes(bucket string, prefix string, delimiter string) ([]string, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, err
@@ -149,7 +182,7 @@ func (r *restartableObjectStore) ListCommonPrefixes(bucket string, prefix string
 }
 
 // ListObjects restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) ListObjects(bucket string, prefix string) ([]string, error) {
+func (r *RestartableObjectStore) ListObjects(bucket string, prefix string) ([]string, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, err
@@ -158,7 +191,7 @@ func (r *restartableObjectStore) ListObjects(bucket string, prefix string) ([]st
 }
 
 // DeleteObject restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) DeleteObject(bucket string, key string) error {
+func (r *RestartableObjectStore) DeleteObject(bucket string, key string) error {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return err
@@ -167,7 +200,7 @@ func (r *restartableObjectStore) DeleteObject(bucket string, key string) error {
 }
 
 // CreateSignedURL restarts the plugin's process if needed, then delegates the call.
-func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
+func (r *RestartableObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return "", err
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package clientmgmt
+package v1
 
 import (
 	"io"
@@ -29,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -65,9 +66,9 @@ func TestRestartableGetObjectStore(t *testing.T) {
 			key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
 			p.On("GetByKindAndName", key).Return(tc.plugin, tc.getError)
 
-			r := &restartableObjectStore{
-				key:                 key,
-				sharedPluginProcess: p,
+			r := &RestartableObjectStore{
+				Key:                 key,
+				SharedPluginProcess: p,
 			}
 			a, err := r.getObjectStore()
 			if tc.expectedError != "" {
@@ -88,9 +89,9 @@ func TestRestartableObjectStoreReinitialize(t *testing.T) {
 
 	name := "aws"
 	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
-	r := &restartableObjectStore{
-		key:                 key,
-		sharedPluginProcess: p,
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
 		config: map[string]string{
 			"color": "blue",
 		},
@@ -121,9 +122,9 @@ func TestRestartableObjectStoreGetDelegate(t *testing.T) {
 	p.On("ResetIfNeeded").Return(errors.Errorf("reset error")).Once()
 	name := "aws"
 	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
-	r := &restartableObjectStore{
-		key:                 key,
-		sharedPluginProcess: p,
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
 	}
 	a, err := r.getDelegate()
 	assert.Nil(t, a)
@@ -149,9 +150,9 @@ func TestRestartableObjectStoreInit(t *testing.T) {
 	// getObjectStore error
 	name := "aws"
 	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
-	r := &restartableObjectStore{
-		key:                 key,
-		sharedPluginProcess: p,
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
 	}
 	p.On("GetByKindAndName", key).Return(nil, errors.Errorf("GetByKindAndName error")).Once()
 
@@ -190,9 +191,9 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 		t,
 		common.PluginKindObjectStore,
 		func(key process.KindAndName, p process.RestartableProcess) interface{} {
-			return &restartableObjectStore{
-				key:                 key,
-				sharedPluginProcess: p,
+			return &RestartableObjectStore{
+				Key:                 key,
+				SharedPluginProcess: p,
 			}
 		},
 		func() restartabletest.Mockable {
@@ -236,3 +237,43 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 		},
 	)
 }
+
+func TestRestartableObjectStoreWithRetentionDelegatedFunctions(t *testing.T) {
+	retainUntil := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	restartabletest.RunRestartableDelegateTests(
+		t,
+		common.PluginKindObjectStore,
+		func(key process.KindAndName, p process.RestartableProcess) interface{} {
+			return &RestartableObjectStore{
+				Key:                 key,
+				SharedPluginProcess: p,
+			}
+		},
+		func() restartabletest.Mockable {
+			return new(providermocks.ObjectStoreWithRetention)
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "PutObjectWithRetention",
+			Inputs:                  []interface{}{"bucket", "key", strings.NewReader("body"), velero.ObjectLockModeCompliance, retainUntil},
+			ExpectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+	)
+}
+
+func TestRestartableObjectStorePutObjectWithRetentionNotSupported(t *testing.T) {
+	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: "aws"}
+	p := new(restartabletest.MockRestartableProcess)
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(new(providermocks.ObjectStore), nil)
+	defer p.AssertExpectations(t)
+
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
+	}
+
+	err := r.PutObjectWithRetention("bucket", "key", strings.NewReader("body"), velero.ObjectLockModeCompliance, time.Now())
+	assert.EqualError(t, err, "object store plugin aws doesn't support object lock")
+}
@@ -0,0 +1,369 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	osv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+// AdaptedObjectStore is an object store adapted to the v2 ObjectStore API
+type AdaptedObjectStore struct {
+	Kind common.PluginKind
+
+	// Get returns a restartable ObjectStore for the given name and process, wrapping if necessary
+	GetRestartable func(name string, restartableProcess process.RestartableProcess) osv2.ObjectStore
+}
+
+func AdaptedObjectStores() []AdaptedObjectStore {
+	return []AdaptedObjectStore{
+		{
+			Kind: common.PluginKindObjectStoreV2,
+			GetRestartable: func(name string, restartableProcess process.RestartableProcess) osv2.ObjectStore {
+				return NewRestartableObjectStore(name, restartableProcess)
+			},
+		},
+		{
+			Kind: common.PluginKindObjectStore,
+			GetRestartable: func(name string, restartableProcess process.RestartableProcess) osv2.ObjectStore {
+				return NewAdaptedV1RestartableObjectStore(osv1cli.NewRestartableObjectStore(name, restartableProcess))
+			},
+		},
+	}
+}
+
+// RestartableObjectStore is an object store for a given implementation (such as "aws"). It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the RestartableObjectStore asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type RestartableObjectStore struct {
+	Key                 process.KindAndName
+	SharedPluginProcess process.RestartableProcess
+	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
+	// sharedPluginProcess gets restarted.
+	config map[string]string
+}
+
+// NewRestartableObjectStore returns a new RestartableObjectStore.
+func NewRestartableObjectStore(name string, sharedPluginProcess process.RestartableProcess) *RestartableObjectStore {
+	key := process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name}
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: sharedPluginProcess,
+	}
+
+	// Register our reinitializer so we can reinitialize after a restart with r.config.
+	sharedPluginProcess.AddReinitializer(key, r)
+
+	return r
+}
+
+// Reinitialize reinitializes a re-dispensed plugin using the initial data passed to Init().
+func (r *RestartableObjectStore) Reinitialize(dispensed interface{}) error {
+	objectStore, ok := dispensed.(osv2.ObjectStore)
+	if !ok {
+		return errors.Errorf("plugin %T is not a ObjectStoreV2", dispensed)
+	}
+
+	return r.init(objectStore, r.config)
+}
+
+// getObjectStore returns the object store for this RestartableObjectStore. It does *not* restart the
+// plugin process.
+func (r *RestartableObjectStore) getObjectStore() (osv2.ObjectStore, error) {
+	plugin, err := r.SharedPluginProcess.GetByKindAndName(r.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStore, ok := plugin.(osv2.ObjectStore)
+	if !ok {
+		return nil, errors.Errorf("plugin %T is not a ObjectStoreV2", plugin)
+	}
+
+	return objectStore, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the object store for this RestartableObjectStore.
+func (r *RestartableObjectStore) getDelegate() (osv2.ObjectStore, error) {
+	if err := r.SharedPluginProcess.ResetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getObjectStore()
+}
+
+// Init initializes the object store instance using config. If this is the first invocation, r stores config for future
+// reinitialization needs. Init does NOT restart the shared plugin process. Init may only be called once.
+func (r *RestartableObjectStore) Init(config map[string]string) error {
+	if r.config != nil {
+		return errors.Errorf("already initialized")
+	}
+
+	// Not using getDelegate() to avoid possible infinite recursion
+	delegate, err := r.getObjectStore()
+	if err != nil {
+		return err
+	}
+
+	r.config = config
+
+	return r.init(delegate, config)
+}
+
+// init calls Init on objectStore with config. This is split out from Init() so that both Init() and reinitialize() may
+// call it using a specific ObjectStore.
+func (r *RestartableObjectStore) init(objectStore osv2.ObjectStore, config map[string]string) error {
+	return objectStore.Init(config)
+}
+
+// PutObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) PutObject(bucket string, key string, body io.Reader, options osv2.PutObjectOptions) (osv2.Checksum, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return osv2.Checksum{}, err
+	}
+	return delegate.PutObject(bucket, key, body, options)
+}
+
+// ObjectExists restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return false, err
+	}
+	return delegate.ObjectExists(bucket, key)
+}
+
+// GetObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) GetObject(bucket string, key string) (io.ReadCloser, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.GetObject(bucket, key)
+}
+
+// GetObjectRange restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) GetObjectRange(bucket string, key string, offset, length int64) (io.ReadCloser, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.GetObjectRange(bucket, key, offset, length)
+}
+
+// HeadObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) HeadObject(bucket string, key string) (osv2.ObjectInfo, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return osv2.ObjectInfo{}, err
+	}
+	return delegate.HeadObject(bucket, key)
+}
+
+// ListCommonPrefixes restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ListCommonPrefixes(bucket string, prefix string, delimiter string) ([]string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.ListCommonPrefixes(bucket, prefix, delimiter)
+}
+
+// ListObjects restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ListObjects(bucket string, prefix string) ([]string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.ListObjects(bucket, prefix)
+}
+
+// ListObjectSizes restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) ListObjectSizes(bucket string, prefix string) (map[string]int64, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	return delegate.ListObjectSizes(bucket, prefix)
+}
+
+// DeleteObject restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) DeleteObject(bucket string, key string) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.DeleteObject(bucket, key)
+}
+
+// DeleteObjects restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) DeleteObjects(bucket string, keys []string) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	return delegate.DeleteObjects(bucket, keys)
+}
+
+// CreateSignedURL restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+	return delegate.CreateSignedURL(bucket, key, ttl)
+}
+
+// AdaptedV1RestartableObjectStore is a v1 RestartableObjectStore adapted to implement the v2 API.
+// The operations the v1 API doesn't have are emulated with the v1 ones, so they work with any
+// v1 plugin, but without the efficiency of the native implementations.
+type AdaptedV1RestartableObjectStore struct {
+	V1Restartable *osv1cli.RestartableObjectStore
+}
+
+// NewAdaptedV1RestartableObjectStore returns a new v1 RestartableObjectStore adapted to v2
+func NewAdaptedV1RestartableObjectStore(v1Restartable *osv1cli.RestartableObjectStore) *AdaptedV1RestartableObjectStore {
+	r := &AdaptedV1RestartableObjectStore{
+		V1Restartable: v1Restartable,
+	}
+	return r
+}
+
+// Init delegates to the v1 Init call.
+func (r *AdaptedV1RestartableObjectStore) Init(config map[string]string) error {
+	return r.V1Restartable.Init(config)
+}
+
+// PutObject delegates to the v1 PutObject call, or to PutObjectWithRetention if the object
+// needs to be locked, and calculates the SHA256 checksum of body while it's uploaded. The
+// metadata and tags are dropped since v1 plugins can't store them.
+func (r *AdaptedV1RestartableObjectStore) PutObject(bucket string, key string, body io.Reader, options osv2.PutObjectOptions) (osv2.Checksum, error) {
+	hash := sha256.New()
+	body = io.TeeReader(body, hash)
+
+	var err error
+	if options.RetentionMode != "" {
+		err = r.V1Restartable.PutObjectWithRetention(bucket, key, body, options.RetentionMode, options.RetainUntil)
+	} else {
+		err = r.V1Restartable.PutObject(bucket, key, body)
+	}
+	if err != nil {
+		return osv2.Checksum{}, err
+	}
+
+	return osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+// ObjectExists delegates to the v1 ObjectExists call.
+func (r *AdaptedV1RestartableObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	return r.V1Restartable.ObjectExists(bucket, key)
+}
+
+// GetObject delegates to the v1 GetObject call.
+func (r *AdaptedV1RestartableObjectStore) GetObject(bucket string, key string) (io.ReadCloser, error) {
+	return r.V1Restartable.GetObject(bucket, key)
+}
+
+// GetObjectRange gets the whole object with the v1 GetObject call, and skips the data
+// before offset and after offset+length.
+func (r *AdaptedV1RestartableObjectStore) GetObjectRange(bucket string, key string, offset, length int64) (io.ReadCloser, error) {
+	rc, err := r.V1Restartable.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.CopyN(io.Discard, rc, offset); err != nil && err != io.EOF {
+		rc.Close()
+		return nil, errors.Wrapf(err, "error skipping to offset %d of object %s", offset, key)
+	}
+
+	if length <= 0 {
+		return rc, nil
+	}
+
+	return &readCloser{Reader: io.LimitReader(rc, length), Closer: rc}, nil
+}
+
+// HeadObject only checks the object exists with the v1 ObjectExists call, and fails with
+// osv2.ErrNotSupported since the v1 API can only get the attributes of an object by reading
+// all of it. The callers which need the size or the checksum of the object read it instead.
+func (r *AdaptedV1RestartableObjectStore) HeadObject(bucket string, key string) (osv2.ObjectInfo, error) {
+	exists, err := r.V1Restartable.ObjectExists(bucket, key)
+	if err != nil {
+		return osv2.ObjectInfo{}, err
+	}
+	if !exists {
+		return osv2.ObjectInfo{}, errors.Errorf("object %s not found", key)
+	}
+
+	return osv2.ObjectInfo{}, errors.Wrap(osv2.ErrNotSupported, "v1 object store plugins can't get the attributes of an object without reading it")
+}
+
+// ListCommonPrefixes delegates to the v1 ListCommonPrefixes call.
+func (r *AdaptedV1RestartableObjectStore) ListCommonPrefixes(bucket string, prefix string, delimiter string) ([]string, error) {
+	return r.V1Restartable.ListCommonPrefixes(bucket, prefix, delimiter)
+}
+
+// ListObjects delegates to the v1 ListObjects call.
+func (r *AdaptedV1RestartableObjectStore) ListObjects(bucket string, prefix string) ([]string, error) {
+	return r.V1Restartable.ListObjects(bucket, prefix)
+}
+
+// ListObjectSizes isn't supported, since the v1 API can only get the size of an object by
+// reading all of it.
+func (r *AdaptedV1RestartableObjectStore) ListObjectSizes(bucket string, prefix string) (map[string]int64, error) {
+	return nil, errors.Wrap(osv2.ErrNotSupported, "v1 object store plugins can't list the sizes of the objects")
+}
+
+// DeleteObject delegates to the v1 DeleteObject call.
+func (r *AdaptedV1RestartableObjectStore) DeleteObject(bucket string, key string) error {
+	return r.V1Restartable.DeleteObject(bucket, key)
+}
+
+// DeleteObjects deletes the objects one by one with the v1 DeleteObject call, and returns
+// the errors of all the failed deletions.
+func (r *AdaptedV1RestartableObjectStore) DeleteObjects(bucket string, keys []string) error {
+	var errs []error
+	for _, key := range keys {
+		if err := r.V1Restartable.DeleteObject(bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// CreateSignedURL delegates to the v1 CreateSignedURL call.
+func (r *AdaptedV1RestartableObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
+	return r.V1Restartable.CreateSignedURL(bucket, key, ttl)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,383 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/internal/restartabletest"
+	osv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/objectstore/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/objectstore/v2"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+func TestRestartableGetObjectStore(t *testing.T) {
+	tests := []struct {
+		name          string
+		plugin        interface{}
+		getError      error
+		expectedError string
+	}{
+		{
+			name:          "error getting by kind and name",
+			getError:      errors.Errorf("get error"),
+			expectedError: "get error",
+		},
+		{
+			name:          "wrong type",
+			plugin:        3,
+			expectedError: "plugin int is not a ObjectStoreV2",
+		},
+		{
+			name:   "happy path",
+			plugin: new(mocks.ObjectStore),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(restartabletest.MockRestartableProcess)
+			p.Test(t)
+			defer p.AssertExpectations(t)
+
+			name := "aws"
+			key := process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name}
+			p.On("GetByKindAndName", key).Return(tc.plugin, tc.getError)
+
+			r := &RestartableObjectStore{
+				Key:                 key,
+				SharedPluginProcess: p,
+			}
+			a, err := r.getObjectStore()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.plugin, a)
+		})
+	}
+}
+
+func TestRestartableObjectStoreReinitialize(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "aws"
+	key := process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name}
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
+		config: map[string]string{
+			"color": "blue",
+		},
+	}
+
+	err := r.Reinitialize(3)
+	assert.EqualError(t, err, "plugin int is not a ObjectStoreV2")
+
+	objectStore := new(mocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+
+	objectStore.On("Init", r.config).Return(errors.Errorf("init error")).Once()
+	err = r.Reinitialize(objectStore)
+	assert.EqualError(t, err, "init error")
+
+	objectStore.On("Init", r.config).Return(nil)
+	err = r.Reinitialize(objectStore)
+	assert.NoError(t, err)
+}
+
+func TestRestartableObjectStoreInit(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	// getObjectStore error
+	name := "aws"
+	key := process.KindAndName{Kind: common.PluginKindObjectStoreV2, Name: name}
+	r := &RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
+	}
+	p.On("GetByKindAndName", key).Return(nil, errors.Errorf("GetByKindAndName error")).Once()
+
+	config := map[string]string{
+		"color": "blue",
+	}
+	err := r.Init(config)
+	assert.EqualError(t, err, "GetByKindAndName error")
+
+	// Delegate returns error
+	objectStore := new(mocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+	p.On("GetByKindAndName", key).Return(objectStore, nil)
+	objectStore.On("Init", config).Return(errors.Errorf("Init error")).Once()
+
+	err = r.Init(config)
+	assert.EqualError(t, err, "Init error")
+
+	// wipe this out because the previous failed Init call set it
+	r.config = nil
+
+	// Happy path
+	objectStore.On("Init", config).Return(nil)
+	err = r.Init(config)
+	assert.NoError(t, err)
+	assert.Equal(t, config, r.config)
+
+	// Calling Init twice is forbidden
+	err = r.Init(config)
+	assert.EqualError(t, err, "already initialized")
+}
+
+func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
+	options := osv2.PutObjectOptions{Metadata: map[string]string{"a": "b"}}
+
+	restartabletest.RunRestartableDelegateTests(
+		t,
+		common.PluginKindObjectStoreV2,
+		func(key process.KindAndName, p process.RestartableProcess) interface{} {
+			return &RestartableObjectStore{
+				Key:                 key,
+				SharedPluginProcess: p,
+			}
+		},
+		func() restartabletest.Mockable {
+			return new(mocks.ObjectStore)
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "PutObject",
+			Inputs:                  []interface{}{"bucket", "key", strings.NewReader("body"), options},
+			ExpectedErrorOutputs:    []interface{}{osv2.Checksum{}, errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{osv2.Checksum{Algorithm: "MD5", Value: "abc"}, errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "GetObject",
+			Inputs:                  []interface{}{"bucket", "key"},
+			ExpectedErrorOutputs:    []interface{}{nil, errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{io.NopCloser(strings.NewReader("object")), errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "GetObjectRange",
+			Inputs:                  []interface{}{"bucket", "key", int64(1), int64(2)},
+			ExpectedErrorOutputs:    []interface{}{nil, errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{io.NopCloser(strings.NewReader("bj")), errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "HeadObject",
+			Inputs:                  []interface{}{"bucket", "key"},
+			ExpectedErrorOutputs:    []interface{}{osv2.ObjectInfo{}, errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{osv2.ObjectInfo{Size: 6}, errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "ListCommonPrefixes",
+			Inputs:                  []interface{}{"bucket", "prefix", "delimiter"},
+			ExpectedErrorOutputs:    []interface{}{([]string)(nil), errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{[]string{"a", "b"}, errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "ListObjects",
+			Inputs:                  []interface{}{"bucket", "prefix"},
+			ExpectedErrorOutputs:    []interface{}{([]string)(nil), errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{[]string{"a", "b"}, errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "ListObjectSizes",
+			Inputs:                  []interface{}{"bucket", "prefix"},
+			ExpectedErrorOutputs:    []interface{}{(map[string]int64)(nil), errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{map[string]int64{"a": 1}, errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "DeleteObject",
+			Inputs:                  []interface{}{"bucket", "key"},
+			ExpectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "DeleteObjects",
+			Inputs:                  []interface{}{"bucket", []string{"key1", "key2"}},
+			ExpectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartabletest.RestartableDelegateTest{
+			Function:                "CreateSignedURL",
+			Inputs:                  []interface{}{"bucket", "key", 30 * time.Minute},
+			ExpectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
+			ExpectedDelegateOutputs: []interface{}{"signedURL", errors.Errorf("delegate error")},
+		},
+	)
+}
+
+// newAdaptedV1ObjectStore returns an AdaptedV1RestartableObjectStore delegating to objectStore.
+func newAdaptedV1ObjectStore(t *testing.T, objectStore interface{}) *AdaptedV1RestartableObjectStore {
+	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: "aws"}
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(objectStore, nil)
+
+	return NewAdaptedV1RestartableObjectStore(&osv1cli.RestartableObjectStore{
+		Key:                 key,
+		SharedPluginProcess: p,
+	})
+}
+
+// readBody reads the whole body passed to the mocked PutObject calls like the real plugins.
+func readBody(args mock.Arguments) {
+	_, _ = io.ReadAll(args.Get(2).(io.Reader))
+}
+
+func TestAdaptedV1ObjectStorePutObject(t *testing.T) {
+	// sha256 of "body"
+	expected := osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: "230d8358dc8e8890b4c58deeb62912ee2f20357ae92a5cc861b98e68fe31acb5"}
+
+	t.Run("put without retention", func(t *testing.T) {
+		objectStore := new(providermocks.ObjectStore)
+		defer objectStore.AssertExpectations(t)
+		objectStore.On("PutObject", "bucket", "key", mock.Anything).Run(readBody).Return(nil)
+
+		checksum, err := newAdaptedV1ObjectStore(t, objectStore).PutObject("bucket", "key", strings.NewReader("body"), osv2.PutObjectOptions{
+			Metadata: map[string]string{"a": "b"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected, checksum)
+	})
+
+	t.Run("put with retention", func(t *testing.T) {
+		retainUntil := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		objectStore := new(providermocks.ObjectStoreWithRetention)
+		defer objectStore.AssertExpectations(t)
+		objectStore.On("PutObjectWithRetention", "bucket", "key", mock.Anything, velero.ObjectLockModeGovernance, retainUntil).Run(readBody).Return(nil)
+
+		checksum, err := newAdaptedV1ObjectStore(t, objectStore).PutObject("bucket", "key", strings.NewReader("body"), osv2.PutObjectOptions{
+			RetentionMode: velero.ObjectLockModeGovernance,
+			RetainUntil:   retainUntil,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected, checksum)
+	})
+
+	t.Run("put error", func(t *testing.T) {
+		objectStore := new(providermocks.ObjectStore)
+		defer objectStore.AssertExpectations(t)
+		objectStore.On("PutObject", "bucket", "key", mock.Anything).Return(errors.New("put error"))
+
+		_, err := newAdaptedV1ObjectStore(t, objectStore).PutObject("bucket", "key", strings.NewReader("body"), osv2.PutObjectOptions{})
+		assert.EqualError(t, err, "put error")
+	})
+}
+
+func TestAdaptedV1ObjectStoreGetObjectRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		offset   int64
+		length   int64
+		expected string
+	}{
+		{
+			name:     "range in the middle",
+			offset:   2,
+			length:   3,
+			expected: "234",
+		},
+		{
+			name:     "zero length reads to the end",
+			offset:   7,
+			expected: "789",
+		},
+		{
+			name:     "length past the end",
+			offset:   8,
+			length:   10,
+			expected: "89",
+		},
+		{
+			name:   "offset past the end",
+			offset: 20,
+			length: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objectStore := new(providermocks.ObjectStore)
+			defer objectStore.AssertExpectations(t)
+			objectStore.On("GetObject", "bucket", "key").Return(io.NopCloser(strings.NewReader("0123456789")), nil)
+
+			rc, err := newAdaptedV1ObjectStore(t, objectStore).GetObjectRange("bucket", "key", tc.offset, tc.length)
+			require.NoError(t, err)
+			defer rc.Close()
+
+			data, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(data))
+		})
+	}
+}
+
+func TestAdaptedV1ObjectStoreHeadObject(t *testing.T) {
+	objectStore := new(providermocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+	objectStore.On("ObjectExists", "bucket", "key").Return(true, nil)
+	objectStore.On("ObjectExists", "bucket", "missing").Return(false, nil)
+	adapted := newAdaptedV1ObjectStore(t, objectStore)
+
+	// the object isn't read to get its attributes
+	_, err := adapted.HeadObject("bucket", "key")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, osv2.ErrNotSupported))
+
+	_, err = adapted.HeadObject("bucket", "missing")
+	assert.EqualError(t, err, "object missing not found")
+}
+
+func TestAdaptedV1ObjectStoreListObjectSizes(t *testing.T) {
+	objectStore := new(providermocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+
+	_, err := newAdaptedV1ObjectStore(t, objectStore).ListObjectSizes("bucket", "prefix")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, osv2.ErrNotSupported))
+}
+
+func TestAdaptedV1ObjectStoreDeleteObjects(t *testing.T) {
+	objectStore := new(providermocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+	objectStore.On("DeleteObject", "bucket", "key1").Return(nil)
+	objectStore.On("DeleteObject", "bucket", "key2").Return(errors.New("delete error"))
+	objectStore.On("DeleteObject", "bucket", "key3").Return(nil)
+
+	err := newAdaptedV1ObjectStore(t, objectStore).DeleteObjects("bucket", []string{"key1", "key2", "key3"})
+	assert.EqualError(t, err, "delete error")
+}
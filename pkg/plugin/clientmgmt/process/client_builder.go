@@ -29,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/objectstore/v2"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
 )
 
@@ -74,6 +75,7 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 			string(common.PluginKindBackupItemActionV2):  biav2.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindObjectStore):         framework.NewObjectStorePlugin(common.ClientLogger(b.clientLogger)),
+			string(common.PluginKindObjectStoreV2):       osv2.NewObjectStorePlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindPluginLister):        &framework.PluginListerPlugin{},
 			string(common.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindRestoreItemActionV2): riav2.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger)),
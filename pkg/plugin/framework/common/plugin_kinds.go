@@ -29,6 +29,9 @@ const (
 	// PluginKindObjectStore represents an object store plugin.
 	PluginKindObjectStore PluginKind = "ObjectStore"
 
+	// PluginKindObjectStoreV2 represents a v2 object store plugin.
+	PluginKindObjectStoreV2 PluginKind = "ObjectStoreV2"
+
 	// PluginKindVolumeSnapshotter represents a volume snapshotter plugin.
 	PluginKindVolumeSnapshotter PluginKind = "VolumeSnapshotter"
 
@@ -55,6 +58,7 @@ const (
 // The older (adaptable) version is the key, and the value is the full list of newer
 // plugin kinds that are capable of adapting it.
 var PluginKindsAdaptableTo = map[PluginKind][]PluginKind{
+	PluginKindObjectStore:       {PluginKindObjectStoreV2},
 	PluginKindBackupItemAction:  {PluginKindBackupItemActionV2},
 	PluginKindRestoreItemAction: {PluginKindRestoreItemActionV2},
 }
@@ -64,6 +68,7 @@ var PluginKindsAdaptableTo = map[PluginKind][]PluginKind{
 func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds := make(map[string]PluginKind)
 	allPluginKinds[PluginKindObjectStore.String()] = PluginKindObjectStore
+	allPluginKinds[PluginKindObjectStoreV2.String()] = PluginKindObjectStoreV2
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
 	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
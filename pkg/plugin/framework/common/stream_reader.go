@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package framework
+package common
 
 import (
 	"bytes"
@@ -39,6 +39,12 @@ type StreamReadCloser struct {
 	close   CloseFunc
 }
 
+// NewStreamReadCloser returns a StreamReadCloser that reads the data
+// from receive and calls close when it's closed.
+func NewStreamReadCloser(receive ReceiveFunc, close CloseFunc) *StreamReadCloser {
+	return &StreamReadCloser{receive: receive, close: close}
+}
+
 func (s *StreamReadCloser) Read(p []byte) (n int, err error) {
 	for {
 		// if buf exists and holds at least as much as we're trying to read,
@@ -23,6 +23,8 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -99,6 +101,60 @@ func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader) er
 	}
 }
 
+// PutObjectWithRetention creates a new object using the data in body within the specified
+// object storage bucket with the given key, and locks it in the given mode until retainUntil.
+// It fails if the plugin doesn't support object lock.
+func (c *ObjectStoreGRPCClient) PutObjectWithRetention(bucket, key string, body io.Reader, mode string, retainUntil time.Time) error {
+	stream, err := c.grpcClient.PutObjectWithRetention(context.Background())
+	if err != nil {
+		return common.FromGRPCError(err)
+	}
+
+	closeAndRecv := func() error {
+		if _, err := stream.CloseAndRecv(); err != nil {
+			// the plugins built against the Velero versions without object lock support
+			// don't implement the method
+			if status.Code(err) == codes.Unimplemented {
+				return errors.Errorf("object store plugin %s doesn't support object lock", c.Plugin)
+			}
+			return common.FromGRPCError(err)
+		}
+		return nil
+	}
+
+	// read from the provider io.Reader into chunks, and send each one over
+	// the gRPC stream
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := body.Read(chunk)
+		if err == io.EOF {
+			return closeAndRecv()
+		}
+		if err != nil {
+			if err := stream.CloseSend(); err != nil {
+				return common.FromGRPCError(err)
+			}
+			return errors.WithStack(err)
+		}
+
+		req := &proto.PutObjectWithRetentionRequest{
+			Plugin:        c.Plugin,
+			Bucket:        bucket,
+			Key:           key,
+			Body:          chunk[0:n],
+			RetentionMode: mode,
+			RetainUntil:   retainUntil.Unix(),
+		}
+		if err := stream.Send(req); err != nil {
+			// the server closes the stream on error, the error is returned by CloseAndRecv
+			if err == io.EOF {
+				return closeAndRecv()
+			}
+			return common.FromGRPCError(err)
+		}
+	}
+}
+
 // ObjectExists checks if there is an object with the given key in the object storage bucket.
 func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
 	req := &proto.ObjectExistsRequest{
@@ -151,7 +207,7 @@ func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, er
 		return nil
 	}
 
-	return &StreamReadCloser{receive: receive, close: close}, nil
+	return common.NewStreamReadCloser(receive, close), nil
 }
 
 // ListCommonPrefixes gets a list of all object key prefixes that come
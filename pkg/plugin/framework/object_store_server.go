@@ -118,7 +118,74 @@ func (s *ObjectStoreGRPCServer) PutObject(stream proto.ObjectStore_PutObjectServ
 		return nil
 	}
 
-	if err := impl.PutObject(bucket, key, &StreamReadCloser{receive: receive, close: close}); err != nil {
+	if err := impl.PutObject(bucket, key, common.NewStreamReadCloser(receive, close)); err != nil {
+		return common.NewGRPCError(err)
+	}
+
+	if err := stream.SendAndClose(&proto.Empty{}); err != nil {
+		return common.NewGRPCError(errors.WithStack(err))
+	}
+
+	return nil
+}
+
+// PutObjectWithRetention creates a new object using the data in body within the specified
+// object storage bucket with the given key, and locks it in the given mode until the retain
+// until date. It fails if the implementation doesn't support object lock.
+func (s *ObjectStoreGRPCServer) PutObjectWithRetention(stream proto.ObjectStore_PutObjectWithRetentionServer) (err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	// we need to read the first chunk ahead of time to get the bucket, key and retention;
+	// in our receive method, we'll use `first` on the first call
+	firstChunk, err := stream.Recv()
+	if err != nil {
+		return common.NewGRPCError(errors.WithStack(err))
+	}
+
+	impl, err := s.getImpl(firstChunk.Plugin)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+
+	retentionImpl, ok := impl.(velero.ObjectStoreWithRetention)
+	if !ok {
+		return common.NewGRPCError(errors.Errorf("object store plugin %s doesn't support object lock", firstChunk.Plugin))
+	}
+
+	bucket := firstChunk.Bucket
+	key := firstChunk.Key
+	mode := firstChunk.RetentionMode
+	retainUntil := time.Unix(firstChunk.RetainUntil, 0)
+
+	receive := func() ([]byte, error) {
+		if firstChunk != nil {
+			res := firstChunk.Body
+			firstChunk = nil
+			return res, nil
+		}
+
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return data.Body, nil
+	}
+
+	close := func() error {
+		return nil
+	}
+
+	if err := retentionImpl.PutObjectWithRetention(bucket, key, common.NewStreamReadCloser(receive, close), mode, retainUntil); err != nil {
 		return common.NewGRPCError(err)
 	}
 
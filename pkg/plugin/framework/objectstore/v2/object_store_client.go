@@ -0,0 +1,369 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protoosv2 "github.com/vmware-tanzu/velero/pkg/plugin/generated/objectstore/v2"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+const byteChunkSize = 16384
+
+// NewObjectStorePlugin constructs an ObjectStorePlugin.
+func NewObjectStorePlugin(options ...common.PluginOption) *ObjectStorePlugin {
+	return &ObjectStorePlugin{
+		PluginBase: common.NewPluginBase(options...),
+	}
+}
+
+// ObjectStoreGRPCClient implements the v2 ObjectStore interface and uses a
+// gRPC client to make calls to the plugin server.
+type ObjectStoreGRPCClient struct {
+	*common.ClientBase
+	grpcClient protoosv2.ObjectStoreClient
+}
+
+func newObjectStoreGRPCClient(base *common.ClientBase, clientConn *grpc.ClientConn) interface{} {
+	return &ObjectStoreGRPCClient{
+		ClientBase: base,
+		grpcClient: protoosv2.NewObjectStoreClient(clientConn),
+	}
+}
+
+// Init prepares the ObjectStore for usage using the provided map of
+// configuration key-value pairs. It returns an error if the ObjectStore
+// cannot be initialized from the provided config.
+func (c *ObjectStoreGRPCClient) Init(config map[string]string) error {
+	req := &protoosv2.ObjectStoreInitRequest{
+		Plugin: c.Plugin,
+		Config: config,
+	}
+
+	if _, err := c.grpcClient.Init(context.Background(), req); err != nil {
+		return common.FromGRPCError(err)
+	}
+
+	return nil
+}
+
+// PutObject creates a new object using the data in body within the specified
+// object storage bucket with the given key, and returns the checksum of its content.
+func (c *ObjectStoreGRPCClient) PutObject(bucket, key string, body io.Reader, options osv2.PutObjectOptions) (osv2.Checksum, error) {
+	stream, err := c.grpcClient.PutObject(context.Background())
+	if err != nil {
+		return osv2.Checksum{}, common.FromGRPCError(err)
+	}
+
+	// the options are only sent with the first chunk, which is sent even if
+	// the body is empty so that the server always gets the bucket and key
+	req := &protoosv2.PutObjectRequest{
+		Plugin:        c.Plugin,
+		Bucket:        bucket,
+		Key:           key,
+		Metadata:      options.Metadata,
+		Tags:          options.Tags,
+		RetentionMode: options.RetentionMode,
+		RetainUntil:   toTimestamp(options.RetainUntil),
+	}
+
+	closeAndRecv := func() (osv2.Checksum, error) {
+		res, err := stream.CloseAndRecv()
+		if err != nil {
+			return osv2.Checksum{}, common.FromGRPCError(err)
+		}
+		return fromProtoChecksum(res.Checksum), nil
+	}
+
+	// read from the provider io.Reader into chunks, and send each one over
+	// the gRPC stream
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := body.Read(chunk)
+		if err != nil && err != io.EOF {
+			if err := stream.CloseSend(); err != nil {
+				return osv2.Checksum{}, common.FromGRPCError(err)
+			}
+			return osv2.Checksum{}, errors.WithStack(err)
+		}
+
+		if n > 0 || req != nil {
+			if req == nil {
+				req = &protoosv2.PutObjectRequest{Plugin: c.Plugin, Bucket: bucket, Key: key}
+			}
+			req.Body = chunk[0:n]
+			if err := stream.Send(req); err != nil {
+				// the server closes the stream on error, the error is returned by CloseAndRecv
+				if err == io.EOF {
+					return closeAndRecv()
+				}
+				return osv2.Checksum{}, common.FromGRPCError(err)
+			}
+			req = nil
+		}
+
+		if err == io.EOF {
+			return closeAndRecv()
+		}
+	}
+}
+
+// ObjectExists checks if there is an object with the given key in the object storage bucket.
+func (c *ObjectStoreGRPCClient) ObjectExists(bucket, key string) (bool, error) {
+	req := &protoosv2.ObjectExistsRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	res, err := c.grpcClient.ObjectExists(context.Background(), req)
+	if err != nil {
+		return false, common.FromGRPCError(err)
+	}
+
+	return res.Exists, nil
+}
+
+// GetObject retrieves the object with the given key from the specified
+// bucket in object storage.
+func (c *ObjectStoreGRPCClient) GetObject(bucket, key string) (io.ReadCloser, error) {
+	req := &protoosv2.GetObjectRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	stream, err := c.grpcClient.GetObject(context.Background(), req)
+	if err != nil {
+		return nil, common.FromGRPCError(err)
+	}
+
+	return newBytesStreamReadCloser(stream), nil
+}
+
+// GetObjectRange retrieves length bytes of the object with the given key from the
+// specified bucket in object storage, starting at offset.
+func (c *ObjectStoreGRPCClient) GetObjectRange(bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	req := &protoosv2.GetObjectRangeRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+		Offset: offset,
+		Length: length,
+	}
+
+	stream, err := c.grpcClient.GetObjectRange(context.Background(), req)
+	if err != nil {
+		return nil, common.FromGRPCError(err)
+	}
+
+	return newBytesStreamReadCloser(stream), nil
+}
+
+// bytesStream is the client side of the gRPC streams returning the content of an object.
+type bytesStream interface {
+	Recv() (*protoosv2.Bytes, error)
+	CloseSend() error
+}
+
+func newBytesStreamReadCloser(stream bytesStream) io.ReadCloser {
+	receive := func() ([]byte, error) {
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, common.FromGRPCError(err)
+		}
+
+		return data.Data, nil
+	}
+
+	close := func() error {
+		if err := stream.CloseSend(); err != nil {
+			return common.FromGRPCError(err)
+		}
+		return nil
+	}
+
+	return common.NewStreamReadCloser(receive, close)
+}
+
+// HeadObject gets the attributes of the object with the given key in the specified
+// bucket in object storage.
+func (c *ObjectStoreGRPCClient) HeadObject(bucket, key string) (osv2.ObjectInfo, error) {
+	req := &protoosv2.HeadObjectRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	res, err := c.grpcClient.HeadObject(context.Background(), req)
+	if err != nil {
+		return osv2.ObjectInfo{}, common.FromGRPCError(err)
+	}
+
+	info := osv2.ObjectInfo{
+		Size:     res.Size,
+		Checksum: fromProtoChecksum(res.Checksum),
+		Metadata: res.Metadata,
+		Tags:     res.Tags,
+	}
+	if res.LastModified != nil {
+		info.LastModified = res.LastModified.AsTime()
+	}
+
+	return info, nil
+}
+
+// ListCommonPrefixes gets a list of all object key prefixes that come
+// after the provided prefix and before the provided delimiter (this is
+// often used to simulate a directory hierarchy in object storage).
+func (c *ObjectStoreGRPCClient) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	req := &protoosv2.ListCommonPrefixesRequest{
+		Plugin:    c.Plugin,
+		Bucket:    bucket,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+	}
+
+	res, err := c.grpcClient.ListCommonPrefixes(context.Background(), req)
+	if err != nil {
+		return nil, common.FromGRPCError(err)
+	}
+
+	return res.Prefixes, nil
+}
+
+// ListObjects gets a list of all objects in bucket that have the same prefix.
+func (c *ObjectStoreGRPCClient) ListObjects(bucket, prefix string) ([]string, error) {
+	req := &protoosv2.ListObjectsRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Prefix: prefix,
+	}
+
+	res, err := c.grpcClient.ListObjects(context.Background(), req)
+	if err != nil {
+		return nil, common.FromGRPCError(err)
+	}
+
+	return res.Keys, nil
+}
+
+// ListObjectSizes gets the sizes of all objects in bucket that have the same prefix.
+func (c *ObjectStoreGRPCClient) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	req := &protoosv2.ListObjectsRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Prefix: prefix,
+	}
+
+	res, err := c.grpcClient.ListObjectSizes(context.Background(), req)
+	if err != nil {
+		// the plugins built against the Velero versions without the method don't implement it
+		if status.Code(err) == codes.Unimplemented {
+			return nil, errors.Wrapf(osv2.ErrNotSupported, "object store plugin %s can't list the sizes of the objects", c.Plugin)
+		}
+		return nil, common.FromGRPCError(err)
+	}
+
+	return res.Sizes, nil
+}
+
+// DeleteObject removes object with the specified key from the given
+// bucket.
+func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
+	req := &protoosv2.DeleteObjectRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	if _, err := c.grpcClient.DeleteObject(context.Background(), req); err != nil {
+		return common.FromGRPCError(err)
+	}
+
+	return nil
+}
+
+// DeleteObjects removes the objects with the specified keys from the given
+// bucket.
+func (c *ObjectStoreGRPCClient) DeleteObjects(bucket string, keys []string) error {
+	req := &protoosv2.DeleteObjectsRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Keys:   keys,
+	}
+
+	if _, err := c.grpcClient.DeleteObjects(context.Background(), req); err != nil {
+		return common.FromGRPCError(err)
+	}
+
+	return nil
+}
+
+// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
+func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	req := &protoosv2.CreateSignedURLRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+		Ttl:    int64(ttl),
+	}
+
+	res, err := c.grpcClient.CreateSignedURL(context.Background(), req)
+	if err != nil {
+		return "", common.FromGRPCError(err)
+	}
+
+	return res.Url, nil
+}
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromProtoChecksum(checksum *protoosv2.Checksum) osv2.Checksum {
+	if checksum == nil {
+		return osv2.Checksum{}
+	}
+	return osv2.Checksum{Algorithm: checksum.Algorithm, Value: checksum.Value}
+}
+
+func toProtoChecksum(checksum osv2.Checksum) *protoosv2.Checksum {
+	if checksum == (osv2.Checksum{}) {
+		return nil
+	}
+	return &protoosv2.Checksum{Algorithm: checksum.Algorithm, Value: checksum.Value}
+}
@@ -0,0 +1,381 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protoosv2 "github.com/vmware-tanzu/velero/pkg/plugin/generated/objectstore/v2"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+)
+
+// ObjectStoreGRPCServer implements the proto-generated ObjectStoreServer interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type ObjectStoreGRPCServer struct {
+	mux *common.ServerMux
+}
+
+func (s *ObjectStoreGRPCServer) getImpl(name string) (osv2.ObjectStore, error) {
+	impl, err := s.mux.GetHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStore, ok := impl.(osv2.ObjectStore)
+	if !ok {
+		return nil, errors.Errorf("%T is not an object store", impl)
+	}
+
+	return objectStore, nil
+}
+
+// Init prepares the ObjectStore for usage using the provided map of
+// configuration key-value pairs. It returns an error if the ObjectStore
+// cannot be initialized from the provided config.
+func (s *ObjectStoreGRPCServer) Init(ctx context.Context, req *protoosv2.ObjectStoreInitRequest) (response *emptypb.Empty, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	if err := impl.Init(req.Config); err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// PutObject creates a new object using the data in body within the specified
+// object storage bucket with the given key.
+func (s *ObjectStoreGRPCServer) PutObject(stream protoosv2.ObjectStore_PutObjectServer) (err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	// we need to read the first chunk ahead of time to get the bucket, key and options;
+	// in our receive method, we'll use `first` on the first call
+	firstChunk, err := stream.Recv()
+	if err != nil {
+		return common.NewGRPCError(errors.WithStack(err))
+	}
+
+	impl, err := s.getImpl(firstChunk.Plugin)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+
+	bucket := firstChunk.Bucket
+	key := firstChunk.Key
+	options := osv2.PutObjectOptions{
+		Metadata:      firstChunk.Metadata,
+		Tags:          firstChunk.Tags,
+		RetentionMode: firstChunk.RetentionMode,
+	}
+	if firstChunk.RetainUntil != nil {
+		options.RetainUntil = firstChunk.RetainUntil.AsTime()
+	}
+
+	receive := func() ([]byte, error) {
+		if firstChunk != nil {
+			res := firstChunk.Body
+			firstChunk = nil
+			return res, nil
+		}
+
+		data, err := stream.Recv()
+		if err == io.EOF {
+			// we need to return io.EOF errors unwrapped so that
+			// calling code sees them as io.EOF and knows to stop
+			// reading.
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return data.Body, nil
+	}
+
+	close := func() error {
+		return nil
+	}
+
+	checksum, err := impl.PutObject(bucket, key, common.NewStreamReadCloser(receive, close), options)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+
+	if err := stream.SendAndClose(&protoosv2.PutObjectResponse{Checksum: toProtoChecksum(checksum)}); err != nil {
+		return common.NewGRPCError(errors.WithStack(err))
+	}
+
+	return nil
+}
+
+// ObjectExists checks if there is an object with the given key in the object storage bucket.
+func (s *ObjectStoreGRPCServer) ObjectExists(ctx context.Context, req *protoosv2.ObjectExistsRequest) (response *protoosv2.ObjectExistsResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	exists, err := impl.ObjectExists(req.Bucket, req.Key)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protoosv2.ObjectExistsResponse{Exists: exists}, nil
+}
+
+// GetObject retrieves the object with the given key from the specified
+// bucket in object storage.
+func (s *ObjectStoreGRPCServer) GetObject(req *protoosv2.GetObjectRequest, stream protoosv2.ObjectStore_GetObjectServer) (err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+
+	rdr, err := impl.GetObject(req.Bucket, req.Key)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+	defer rdr.Close()
+
+	return sendObject(rdr, stream)
+}
+
+// GetObjectRange retrieves length bytes of the object with the given key from the
+// specified bucket in object storage, starting at offset.
+func (s *ObjectStoreGRPCServer) GetObjectRange(req *protoosv2.GetObjectRangeRequest, stream protoosv2.ObjectStore_GetObjectRangeServer) (err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+
+	rdr, err := impl.GetObjectRange(req.Bucket, req.Key, req.Offset, req.Length)
+	if err != nil {
+		return common.NewGRPCError(err)
+	}
+	defer rdr.Close()
+
+	return sendObject(rdr, stream)
+}
+
+// sendObject sends the content read from rdr over the gRPC stream in chunks.
+func sendObject(rdr io.Reader, stream interface{ Send(*protoosv2.Bytes) error }) error {
+	chunk := make([]byte, byteChunkSize)
+	for {
+		n, err := rdr.Read(chunk)
+		if err != nil && err != io.EOF {
+			return common.NewGRPCError(errors.WithStack(err))
+		}
+		if n == 0 {
+			return nil
+		}
+
+		if err := stream.Send(&protoosv2.Bytes{Data: chunk[0:n]}); err != nil {
+			return common.NewGRPCError(errors.WithStack(err))
+		}
+	}
+}
+
+// HeadObject gets the attributes of the object with the given key in the specified
+// bucket in object storage.
+func (s *ObjectStoreGRPCServer) HeadObject(ctx context.Context, req *protoosv2.HeadObjectRequest) (response *protoosv2.HeadObjectResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	info, err := impl.HeadObject(req.Bucket, req.Key)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protoosv2.HeadObjectResponse{
+		Size:         info.Size,
+		LastModified: toTimestamp(info.LastModified),
+		Checksum:     toProtoChecksum(info.Checksum),
+		Metadata:     info.Metadata,
+		Tags:         info.Tags,
+	}, nil
+}
+
+// ListCommonPrefixes gets a list of all object key prefixes that start with
+// the specified prefix and stop at the next instance of the provided delimiter
+// (this is often used to simulate a directory hierarchy in object storage).
+func (s *ObjectStoreGRPCServer) ListCommonPrefixes(ctx context.Context, req *protoosv2.ListCommonPrefixesRequest) (response *protoosv2.ListCommonPrefixesResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	prefixes, err := impl.ListCommonPrefixes(req.Bucket, req.Prefix, req.Delimiter)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protoosv2.ListCommonPrefixesResponse{Prefixes: prefixes}, nil
+}
+
+// ListObjects gets a list of all objects in bucket that have the same prefix.
+func (s *ObjectStoreGRPCServer) ListObjects(ctx context.Context, req *protoosv2.ListObjectsRequest) (response *protoosv2.ListObjectsResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	keys, err := impl.ListObjects(req.Bucket, req.Prefix)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protoosv2.ListObjectsResponse{Keys: keys}, nil
+}
+
+// ListObjectSizes gets the sizes of all objects in bucket that have the same prefix.
+func (s *ObjectStoreGRPCServer) ListObjectSizes(ctx context.Context, req *protoosv2.ListObjectsRequest) (response *protoosv2.ListObjectSizesResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	sizes, err := impl.ListObjectSizes(req.Bucket, req.Prefix)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protoosv2.ListObjectSizesResponse{Sizes: sizes}, nil
+}
+
+// DeleteObject removes object with the specified key from the given
+// bucket.
+func (s *ObjectStoreGRPCServer) DeleteObject(ctx context.Context, req *protoosv2.DeleteObjectRequest) (response *emptypb.Empty, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	if err := impl.DeleteObject(req.Bucket, req.Key); err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteObjects removes the objects with the specified keys from the given
+// bucket.
+func (s *ObjectStoreGRPCServer) DeleteObjects(ctx context.Context, req *protoosv2.DeleteObjectsRequest) (response *emptypb.Empty, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	if err := impl.DeleteObjects(req.Bucket, req.Keys); err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
+func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *protoosv2.CreateSignedURLRequest) (response *protoosv2.CreateSignedURLResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	url, err := impl.CreateSignedURL(req.Bucket, req.Key, time.Duration(req.Ttl))
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &protoosv2.CreateSignedURLResponse{Url: url}, nil
+}
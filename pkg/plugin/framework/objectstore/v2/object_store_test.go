@@ -0,0 +1,213 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protoosv2 "github.com/vmware-tanzu/velero/pkg/plugin/generated/objectstore/v2"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/objectstore/v2"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// newTestClient returns an ObjectStoreGRPCClient connected to an ObjectStoreGRPCServer
+// which serves objectStore.
+func newTestClient(t *testing.T, objectStore osv2.ObjectStore) *ObjectStoreGRPCClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	protoosv2.RegisterObjectStoreServer(server, &ObjectStoreGRPCServer{mux: &common.ServerMux{
+		ServerLog: velerotest.NewLogger(),
+		Handlers: map[string]interface{}{
+			"xyz": objectStore,
+		},
+	}})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return newObjectStoreGRPCClient(&common.ClientBase{Plugin: "xyz", Logger: velerotest.NewLogger()}, conn).(*ObjectStoreGRPCClient)
+}
+
+func TestObjectStoreGRPCPutObject(t *testing.T) {
+	// bigger than a chunk so that the body is sent in several messages
+	body := bytes.Repeat([]byte("0123456789"), byteChunkSize/5)
+	retainUntil := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	options := osv2.PutObjectOptions{
+		Metadata:      map[string]string{"velero.io/backup": "backup-1"},
+		Tags:          map[string]string{"team": "a"},
+		RetentionMode: velero.ObjectLockModeCompliance,
+		RetainUntil:   retainUntil,
+	}
+	checksum := osv2.Checksum{Algorithm: "CRC32C", Value: "abcd"}
+
+	tests := []struct {
+		name        string
+		body        []byte
+		implError   error
+		expectError string
+	}{
+		{
+			name: "multiple chunks",
+			body: body,
+		},
+		{
+			name: "empty body",
+			body: []byte{},
+		},
+		{
+			name:        "impl error",
+			body:        body,
+			implError:   errors.New("put error"),
+			expectError: "put error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objectStore := new(mocks.ObjectStore)
+			defer objectStore.AssertExpectations(t)
+
+			var received []byte
+			objectStore.On("PutObject", "bucket", "key", mock.Anything, options).Run(func(args mock.Arguments) {
+				received, _ = io.ReadAll(args.Get(2).(io.Reader))
+			}).Return(checksum, tc.implError)
+
+			res, err := newTestClient(t, objectStore).PutObject("bucket", "key", bytes.NewReader(tc.body), options)
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, checksum, res)
+			assert.Equal(t, tc.body, received)
+		})
+	}
+}
+
+func TestObjectStoreGRPCGetObjectRange(t *testing.T) {
+	objectStore := new(mocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+	objectStore.On("GetObjectRange", "bucket", "key", int64(10), int64(5)).Return(io.NopCloser(strings.NewReader("01234")), nil)
+
+	rc, err := newTestClient(t, objectStore).GetObjectRange("bucket", "key", 10, 5)
+	require.NoError(t, err)
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "01234", string(data))
+}
+
+func TestObjectStoreGRPCHeadObject(t *testing.T) {
+	info := osv2.ObjectInfo{
+		Size:         10,
+		LastModified: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Checksum:     osv2.Checksum{Algorithm: osv2.ChecksumAlgorithmSHA256, Value: "abcd"},
+		Metadata:     map[string]string{"a": "b"},
+		Tags:         map[string]string{"c": "d"},
+	}
+
+	objectStore := new(mocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+	objectStore.On("HeadObject", "bucket", "key").Return(info, nil)
+	objectStore.On("HeadObject", "bucket", "empty").Return(osv2.ObjectInfo{}, nil)
+	objectStore.On("HeadObject", "bucket", "missing").Return(osv2.ObjectInfo{}, errors.New("not found"))
+	client := newTestClient(t, objectStore)
+
+	res, err := client.HeadObject("bucket", "key")
+	require.NoError(t, err)
+	assert.Equal(t, info, res)
+
+	res, err = client.HeadObject("bucket", "empty")
+	require.NoError(t, err)
+	assert.Equal(t, osv2.ObjectInfo{}, res)
+
+	_, err = client.HeadObject("bucket", "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestObjectStoreGRPCListObjectSizes(t *testing.T) {
+	objectStore := new(mocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+	objectStore.On("ListObjectSizes", "bucket", "prefix/").Return(map[string]int64{"prefix/a": 1, "prefix/b": 2}, nil)
+	objectStore.On("ListObjectSizes", "bucket", "error/").Return(nil, errors.New("list error"))
+	client := newTestClient(t, objectStore)
+
+	sizes, err := client.ListObjectSizes("bucket", "prefix/")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"prefix/a": 1, "prefix/b": 2}, sizes)
+
+	_, err = client.ListObjectSizes("bucket", "error/")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "list error")
+	assert.False(t, errors.Is(err, osv2.ErrNotSupported))
+}
+
+func TestObjectStoreGRPCListObjectSizesUnimplemented(t *testing.T) {
+	// a server without the method, as served by the plugins built against older Velero versions
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	protoosv2.RegisterObjectStoreServer(server, &protoosv2.UnimplementedObjectStoreServer{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := newObjectStoreGRPCClient(&common.ClientBase{Plugin: "xyz", Logger: velerotest.NewLogger()}, conn).(*ObjectStoreGRPCClient)
+	_, err = client.ListObjectSizes("bucket", "prefix/")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, osv2.ErrNotSupported))
+}
+
+func TestObjectStoreGRPCDeleteObjects(t *testing.T) {
+	objectStore := new(mocks.ObjectStore)
+	defer objectStore.AssertExpectations(t)
+	objectStore.On("DeleteObjects", "bucket", []string{"key1", "key2"}).Return(nil)
+
+	err := newTestClient(t, objectStore).DeleteObjects("bucket", []string{"key1", "key2"})
+	assert.NoError(t, err)
+}
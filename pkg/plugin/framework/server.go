@@ -27,6 +27,7 @@ import (
 
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	osv2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/objectstore/v2"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
@@ -69,6 +70,13 @@ type Server interface {
 	// RegisterObjectStores registers multiple object stores.
 	RegisterObjectStores(map[string]common.HandlerInitializer) Server
 
+	// RegisterObjectStoreV2 registers a v2 object store. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterObjectStoreV2(pluginName string, initializer common.HandlerInitializer) Server
+
+	// RegisterObjectStoresV2 registers multiple v2 object stores.
+	RegisterObjectStoresV2(map[string]common.HandlerInitializer) Server
+
 	// RegisterRestoreItemAction registers a restore item action. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterRestoreItemAction(pluginName string, initializer common.HandlerInitializer) Server
@@ -103,6 +111,7 @@ type server struct {
 	backupItemActionV2  *biav2.BackupItemActionPlugin
 	volumeSnapshotter   *VolumeSnapshotterPlugin
 	objectStore         *ObjectStorePlugin
+	objectStoreV2       *osv2.ObjectStorePlugin
 	restoreItemAction   *RestoreItemActionPlugin
 	restoreItemActionV2 *riav2.RestoreItemActionPlugin
 	deleteItemAction    *DeleteItemActionPlugin
@@ -119,6 +128,7 @@ func NewServer() Server {
 		backupItemActionV2:  biav2.NewBackupItemActionPlugin(common.ServerLogger(log)),
 		volumeSnapshotter:   NewVolumeSnapshotterPlugin(common.ServerLogger(log)),
 		objectStore:         NewObjectStorePlugin(common.ServerLogger(log)),
+		objectStoreV2:       osv2.NewObjectStorePlugin(common.ServerLogger(log)),
 		restoreItemAction:   NewRestoreItemActionPlugin(common.ServerLogger(log)),
 		restoreItemActionV2: riav2.NewRestoreItemActionPlugin(common.ServerLogger(log)),
 		deleteItemAction:    NewDeleteItemActionPlugin(common.ServerLogger(log)),
@@ -181,6 +191,18 @@ func (s *server) RegisterObjectStores(m map[string]common.HandlerInitializer) Se
 	return s
 }
 
+func (s *server) RegisterObjectStoreV2(name string, initializer common.HandlerInitializer) Server {
+	s.objectStoreV2.Register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterObjectStoresV2(m map[string]common.HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterObjectStoreV2(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterRestoreItemAction(name string, initializer common.HandlerInitializer) Server {
 	s.restoreItemAction.Register(name, initializer)
 	return s
@@ -248,6 +270,7 @@ func (s *server) Serve() {
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindBackupItemActionV2, s.backupItemActionV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindObjectStore, s.objectStore)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindObjectStoreV2, s.objectStoreV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemActionV2, s.restoreItemActionV2)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindDeleteItemAction, s.deleteItemAction)...)
@@ -261,6 +284,7 @@ func (s *server) Serve() {
 			string(common.PluginKindBackupItemActionV2):  s.backupItemActionV2,
 			string(common.PluginKindVolumeSnapshotter):   s.volumeSnapshotter,
 			string(common.PluginKindObjectStore):         s.objectStore,
+			string(common.PluginKindObjectStoreV2):       s.objectStoreV2,
 			string(common.PluginKindPluginLister):        NewPluginListerPlugin(pluginLister),
 			string(common.PluginKindRestoreItemAction):   s.restoreItemAction,
 			string(common.PluginKindRestoreItemActionV2): s.restoreItemActionV2,
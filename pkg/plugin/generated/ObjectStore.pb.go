@@ -784,6 +784,93 @@ func (x *ObjectStoreInitRequest) GetConfig() map[string]string {
 	return nil
 }
 
+type PutObjectWithRetentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin        string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Bucket        string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Body          []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	RetentionMode string `protobuf:"bytes,5,opt,name=retentionMode,proto3" json:"retentionMode,omitempty"`
+	RetainUntil   int64  `protobuf:"varint,6,opt,name=retainUntil,proto3" json:"retainUntil,omitempty"`
+}
+
+func (x *PutObjectWithRetentionRequest) Reset() {
+	*x = PutObjectWithRetentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ObjectStore_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectWithRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectWithRetentionRequest) ProtoMessage() {}
+
+func (x *PutObjectWithRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ObjectStore_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectWithRetentionRequest.ProtoReflect.Descriptor instead.
+func (*PutObjectWithRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_ObjectStore_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PutObjectWithRetentionRequest) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *PutObjectWithRetentionRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *PutObjectWithRetentionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutObjectWithRetentionRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *PutObjectWithRetentionRequest) GetRetentionMode() string {
+	if x != nil {
+		return x.RetentionMode
+	}
+	return ""
+}
+
+func (x *PutObjectWithRetentionRequest) GetRetainUntil() int64 {
+	if x != nil {
+		return x.RetainUntil
+	}
+	return 0
+}
+
 var File_ObjectStore_proto protoreflect.FileDescriptor
 
 var file_ObjectStore_proto_rawDesc = []byte{
@@ -859,7 +946,19 @@ var file_ObjectStore_proto_rawDesc = []byte{
 	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x32, 0xe4, 0x04, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x38, 0x01, 0x22, 0xbd, 0x01, 0x0a, 0x1d, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x32, 0xbc, 0x05, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
 	0x72, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x65, 0x6e,
 	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f,
 	0x72, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
@@ -897,11 +996,16 @@ var file_ObjectStore_proto_rawDesc = []byte{
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
 	0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52,
-	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x2d, 0x74,
-	0x61, 0x6e, 0x7a, 0x75, 0x2f, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x16, 0x50, 0x75, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e,
+	0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28,
+	0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x76, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x2d, 0x74, 0x61, 0x6e, 0x7a, 0x75, 0x2f, 0x76, 0x65, 0x6c,
+	0x65, 0x72, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -916,26 +1020,27 @@ func file_ObjectStore_proto_rawDescGZIP() []byte {
 	return file_ObjectStore_proto_rawDescData
 }
 
-var file_ObjectStore_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_ObjectStore_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_ObjectStore_proto_goTypes = []interface{}{
-	(*PutObjectRequest)(nil),           // 0: generated.PutObjectRequest
-	(*ObjectExistsRequest)(nil),        // 1: generated.ObjectExistsRequest
-	(*ObjectExistsResponse)(nil),       // 2: generated.ObjectExistsResponse
-	(*GetObjectRequest)(nil),           // 3: generated.GetObjectRequest
-	(*Bytes)(nil),                      // 4: generated.Bytes
-	(*ListCommonPrefixesRequest)(nil),  // 5: generated.ListCommonPrefixesRequest
-	(*ListCommonPrefixesResponse)(nil), // 6: generated.ListCommonPrefixesResponse
-	(*ListObjectsRequest)(nil),         // 7: generated.ListObjectsRequest
-	(*ListObjectsResponse)(nil),        // 8: generated.ListObjectsResponse
-	(*DeleteObjectRequest)(nil),        // 9: generated.DeleteObjectRequest
-	(*CreateSignedURLRequest)(nil),     // 10: generated.CreateSignedURLRequest
-	(*CreateSignedURLResponse)(nil),    // 11: generated.CreateSignedURLResponse
-	(*ObjectStoreInitRequest)(nil),     // 12: generated.ObjectStoreInitRequest
-	nil,                                // 13: generated.ObjectStoreInitRequest.ConfigEntry
-	(*Empty)(nil),                      // 14: generated.Empty
+	(*PutObjectRequest)(nil),              // 0: generated.PutObjectRequest
+	(*ObjectExistsRequest)(nil),           // 1: generated.ObjectExistsRequest
+	(*ObjectExistsResponse)(nil),          // 2: generated.ObjectExistsResponse
+	(*GetObjectRequest)(nil),              // 3: generated.GetObjectRequest
+	(*Bytes)(nil),                         // 4: generated.Bytes
+	(*ListCommonPrefixesRequest)(nil),     // 5: generated.ListCommonPrefixesRequest
+	(*ListCommonPrefixesResponse)(nil),    // 6: generated.ListCommonPrefixesResponse
+	(*ListObjectsRequest)(nil),            // 7: generated.ListObjectsRequest
+	(*ListObjectsResponse)(nil),           // 8: generated.ListObjectsResponse
+	(*DeleteObjectRequest)(nil),           // 9: generated.DeleteObjectRequest
+	(*CreateSignedURLRequest)(nil),        // 10: generated.CreateSignedURLRequest
+	(*CreateSignedURLResponse)(nil),       // 11: generated.CreateSignedURLResponse
+	(*ObjectStoreInitRequest)(nil),        // 12: generated.ObjectStoreInitRequest
+	(*PutObjectWithRetentionRequest)(nil), // 13: generated.PutObjectWithRetentionRequest
+	nil,                                   // 14: generated.ObjectStoreInitRequest.ConfigEntry
+	(*Empty)(nil),                         // 15: generated.Empty
 }
 var file_ObjectStore_proto_depIdxs = []int32{
-	13, // 0: generated.ObjectStoreInitRequest.config:type_name -> generated.ObjectStoreInitRequest.ConfigEntry
+	14, // 0: generated.ObjectStoreInitRequest.config:type_name -> generated.ObjectStoreInitRequest.ConfigEntry
 	12, // 1: generated.ObjectStore.Init:input_type -> generated.ObjectStoreInitRequest
 	0,  // 2: generated.ObjectStore.PutObject:input_type -> generated.PutObjectRequest
 	1,  // 3: generated.ObjectStore.ObjectExists:input_type -> generated.ObjectExistsRequest
@@ -944,16 +1049,18 @@ var file_ObjectStore_proto_depIdxs = []int32{
 	7,  // 6: generated.ObjectStore.ListObjects:input_type -> generated.ListObjectsRequest
 	9,  // 7: generated.ObjectStore.DeleteObject:input_type -> generated.DeleteObjectRequest
 	10, // 8: generated.ObjectStore.CreateSignedURL:input_type -> generated.CreateSignedURLRequest
-	14, // 9: generated.ObjectStore.Init:output_type -> generated.Empty
-	14, // 10: generated.ObjectStore.PutObject:output_type -> generated.Empty
-	2,  // 11: generated.ObjectStore.ObjectExists:output_type -> generated.ObjectExistsResponse
-	4,  // 12: generated.ObjectStore.GetObject:output_type -> generated.Bytes
-	6,  // 13: generated.ObjectStore.ListCommonPrefixes:output_type -> generated.ListCommonPrefixesResponse
-	8,  // 14: generated.ObjectStore.ListObjects:output_type -> generated.ListObjectsResponse
-	14, // 15: generated.ObjectStore.DeleteObject:output_type -> generated.Empty
-	11, // 16: generated.ObjectStore.CreateSignedURL:output_type -> generated.CreateSignedURLResponse
-	9,  // [9:17] is the sub-list for method output_type
-	1,  // [1:9] is the sub-list for method input_type
+	13, // 9: generated.ObjectStore.PutObjectWithRetention:input_type -> generated.PutObjectWithRetentionRequest
+	15, // 10: generated.ObjectStore.Init:output_type -> generated.Empty
+	15, // 11: generated.ObjectStore.PutObject:output_type -> generated.Empty
+	2,  // 12: generated.ObjectStore.ObjectExists:output_type -> generated.ObjectExistsResponse
+	4,  // 13: generated.ObjectStore.GetObject:output_type -> generated.Bytes
+	6,  // 14: generated.ObjectStore.ListCommonPrefixes:output_type -> generated.ListCommonPrefixesResponse
+	8,  // 15: generated.ObjectStore.ListObjects:output_type -> generated.ListObjectsResponse
+	15, // 16: generated.ObjectStore.DeleteObject:output_type -> generated.Empty
+	11, // 17: generated.ObjectStore.CreateSignedURL:output_type -> generated.CreateSignedURLResponse
+	15, // 18: generated.ObjectStore.PutObjectWithRetention:output_type -> generated.Empty
+	10, // [10:19] is the sub-list for method output_type
+	1,  // [1:10] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
 	1,  // [1:1] is the sub-list for extension extendee
 	0,  // [0:1] is the sub-list for field type_name
@@ -1122,6 +1229,18 @@ func file_ObjectStore_proto_init() {
 				return nil
 			}
 		}
+		file_ObjectStore_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectWithRetentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1129,7 +1248,7 @@ func file_ObjectStore_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ObjectStore_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1163,6 +1282,7 @@ type ObjectStoreClient interface {
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	PutObjectWithRetention(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectWithRetentionClient, error)
 }
 
 type objectStoreClient struct {
@@ -1293,6 +1413,40 @@ func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *CreateSigne
 	return out, nil
 }
 
+func (c *objectStoreClient) PutObjectWithRetention(ctx context.Context, opts ...grpc.CallOption) (ObjectStore_PutObjectWithRetentionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectStore_serviceDesc.Streams[2], "/generated.ObjectStore/PutObjectWithRetention", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectStorePutObjectWithRetentionClient{stream}
+	return x, nil
+}
+
+type ObjectStore_PutObjectWithRetentionClient interface {
+	Send(*PutObjectWithRetentionRequest) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type objectStorePutObjectWithRetentionClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectStorePutObjectWithRetentionClient) Send(m *PutObjectWithRetentionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectWithRetentionClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ObjectStoreServer is the server API for ObjectStore service.
 type ObjectStoreServer interface {
 	Init(context.Context, *ObjectStoreInitRequest) (*Empty, error)
@@ -1303,6 +1457,7 @@ type ObjectStoreServer interface {
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	DeleteObject(context.Context, *DeleteObjectRequest) (*Empty, error)
 	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	PutObjectWithRetention(ObjectStore_PutObjectWithRetentionServer) error
 }
 
 // UnimplementedObjectStoreServer can be embedded to have forward compatible implementations.
@@ -1333,6 +1488,9 @@ func (*UnimplementedObjectStoreServer) DeleteObject(context.Context, *DeleteObje
 func (*UnimplementedObjectStoreServer) CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSignedURL not implemented")
 }
+func (*UnimplementedObjectStoreServer) PutObjectWithRetention(ObjectStore_PutObjectWithRetentionServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutObjectWithRetention not implemented")
+}
 
 func RegisterObjectStoreServer(s *grpc.Server, srv ObjectStoreServer) {
 	s.RegisterService(&_ObjectStore_serviceDesc, srv)
@@ -1493,6 +1651,32 @@ func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStore_PutObjectWithRetention_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStoreServer).PutObjectWithRetention(&objectStorePutObjectWithRetentionServer{stream})
+}
+
+type ObjectStore_PutObjectWithRetentionServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*PutObjectWithRetentionRequest, error)
+	grpc.ServerStream
+}
+
+type objectStorePutObjectWithRetentionServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectStorePutObjectWithRetentionServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectStorePutObjectWithRetentionServer) Recv() (*PutObjectWithRetentionRequest, error) {
+	m := new(PutObjectWithRetentionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.ObjectStore",
 	HandlerType: (*ObjectStoreServer)(nil),
@@ -1533,6 +1717,11 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ObjectStore_GetObject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PutObjectWithRetention",
+			Handler:       _ObjectStore_PutObjectWithRetention_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "ObjectStore.proto",
 }
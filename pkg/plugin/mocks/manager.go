@@ -12,6 +12,8 @@ import (
 
 	v2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 
+	objectstorev2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/objectstore/v2"
+
 	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 	volumesnapshotterv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
@@ -188,6 +190,29 @@ func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	return r0, r1
 }
 
+// GetObjectStoreV2 provides a mock function with given fields: name
+func (_m *Manager) GetObjectStoreV2(name string) (objectstorev2.ObjectStore, error) {
+	ret := _m.Called(name)
+
+	var r0 objectstorev2.ObjectStore
+	if rf, ok := ret.Get(0).(func(string) objectstorev2.ObjectStore); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(objectstorev2.ObjectStore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemAction provides a mock function with given fields: name
 func (_m *Manager) GetRestoreItemAction(name string) (restoreitemactionv1.RestoreItemAction, error) {
 	ret := _m.Called(name)
@@ -63,3 +63,25 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+const (
+	// ObjectLockModeGovernance is the object lock mode in which the locked objects can be overwritten
+	// or deleted by the users with special permissions before the retention expires.
+	ObjectLockModeGovernance = "GOVERNANCE"
+
+	// ObjectLockModeCompliance is the object lock mode in which the locked objects can't be overwritten
+	// or deleted by any user before the retention expires.
+	ObjectLockModeCompliance = "COMPLIANCE"
+)
+
+// ObjectStoreWithRetention is an optional interface that an ObjectStore can implement if the
+// object storage supports object lock, e.g. S3 Object Lock, to keep the objects from being
+// overwritten or deleted before their retention expires.
+type ObjectStoreWithRetention interface {
+	ObjectStore
+
+	// PutObjectWithRetention creates a new object using the data in body within the specified
+	// object storage bucket with the given key, and locks it in the given mode, either
+	// ObjectLockModeGovernance or ObjectLockModeCompliance, until retainUntil.
+	PutObjectWithRetention(bucket, key string, body io.Reader, mode string, retainUntil time.Time) error
+}
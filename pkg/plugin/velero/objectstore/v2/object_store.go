@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotSupported is returned by the operations the object store can't run, e.g. the
+// operations of the v1 plugins adapted to the v2 API which can't be emulated efficiently.
+var ErrNotSupported = errors.New("operation not supported by the object store")
+
+// ObjectStore exposes the object-storage operations required by Velero. Compared with
+// the v1 ObjectStore, it supports object metadata and tags, content checksums, ranged
+// reads, getting the attributes of an object and deleting objects in batch.
+type ObjectStore interface {
+	// Init prepares the ObjectStore for usage using the provided map of
+	// configuration key-value pairs. It returns an error if the ObjectStore
+	// cannot be initialized from the provided config.
+	Init(config map[string]string) error
+
+	// PutObject creates a new object using the data in body within the specified
+	// object storage bucket with the given key, and returns the checksum of its content.
+	// If options.RetentionMode is set, the object is locked until options.RetainUntil,
+	// and an error is returned if the object storage doesn't support object lock.
+	PutObject(bucket, key string, body io.Reader, options PutObjectOptions) (Checksum, error)
+
+	// ObjectExists checks if there is an object with the given key in the object storage bucket.
+	ObjectExists(bucket, key string) (bool, error)
+
+	// GetObject retrieves the object with the given key from the specified
+	// bucket in object storage.
+	GetObject(bucket, key string) (io.ReadCloser, error)
+
+	// GetObjectRange retrieves length bytes of the object with the given key from the
+	// specified bucket in object storage, starting at offset. A length less than or equal
+	// to zero reads the object to its end.
+	GetObjectRange(bucket, key string, offset, length int64) (io.ReadCloser, error)
+
+	// HeadObject gets the attributes of the object with the given key in the specified
+	// bucket in object storage without retrieving its content. It fails with ErrNotSupported
+	// if the object store can't get them without retrieving the content.
+	HeadObject(bucket, key string) (ObjectInfo, error)
+
+	// ListCommonPrefixes gets a list of all object key prefixes that start with
+	// the specified prefix and stop at the next instance of the provided delimiter.
+	//
+	// For example, if the bucket contains the following keys:
+	//		a-prefix/foo-1/bar
+	// 		a-prefix/foo-1/baz
+	//		a-prefix/foo-2/baz
+	// 		some-other-prefix/foo-3/bar
+	// and the provided prefix arg is "a-prefix/", and the delimiter is "/",
+	// this will return the slice {"a-prefix/foo-1/", "a-prefix/foo-2/"}.
+	ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error)
+
+	// ListObjects gets a list of all keys in the specified bucket
+	// that have the given prefix.
+	ListObjects(bucket, prefix string) ([]string, error)
+
+	// ListObjectSizes gets the sizes, by key, of all the objects in the specified bucket
+	// that have the given prefix. The sizes are taken from the listing of the objects
+	// rather than by getting the attributes of each object.
+	ListObjectSizes(bucket, prefix string) (map[string]int64, error)
+
+	// DeleteObject removes the object with the specified key from the given
+	// bucket.
+	DeleteObject(bucket, key string) error
+
+	// DeleteObjects removes the objects with the specified keys from the given
+	// bucket, using as few requests as the object storage allows.
+	DeleteObjects(bucket string, keys []string) error
+
+	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
+	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
+}
+
+// PutObjectOptions are the optional settings of an object created by PutObject.
+type PutObjectOptions struct {
+	// Metadata is the user-defined metadata stored with the object.
+	Metadata map[string]string
+
+	// Tags are the tags set on the object, e.g. for cost allocation.
+	Tags map[string]string
+
+	// RetentionMode is the object lock mode, either velero.ObjectLockModeGovernance or
+	// velero.ObjectLockModeCompliance. The object isn't locked if it's empty.
+	RetentionMode string
+
+	// RetainUntil is the time until which the object is locked.
+	RetainUntil time.Time
+}
+
+// ChecksumAlgorithmSHA256 is the algorithm of the checksums that Velero calculates itself
+// for the object stores which don't return one.
+const ChecksumAlgorithmSHA256 = "SHA256"
+
+// Checksum is the checksum of the content of an object.
+type Checksum struct {
+	// Algorithm is the algorithm the checksum is calculated with, e.g. "SHA256", "CRC32C" or "MD5".
+	Algorithm string
+
+	// Value is the hex encoded checksum.
+	Value string
+}
+
+// ObjectInfo contains the attributes of an object.
+type ObjectInfo struct {
+	// Size is the size of the object in bytes.
+	Size int64
+
+	// LastModified is the time the object was last modified. It's zero if the
+	// object store doesn't report it.
+	LastModified time.Time
+
+	// Checksum is the checksum of the content of the object.
+	Checksum Checksum
+
+	// Metadata is the user-defined metadata stored with the object.
+	Metadata map[string]string
+
+	// Tags are the tags set on the object.
+	Tags map[string]string
+}
@@ -28,12 +28,27 @@ import (
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 const defaultTimeout = 30 * time.Second
 
+// ExecResult is the outcome of a command executed in a container in a pod.
+type ExecResult struct {
+	// Container is the container the command was executed in.
+	Container string
+
+	// Stdout and Stderr hold the output of the command.
+	Stdout string
+	Stderr string
+
+	// ExitCode is the exit code of the command, or -1 if it isn't known,
+	// e.g. because the command timed out or couldn't be started.
+	ExitCode int
+}
+
 // PodCommandExecutor is capable of executing a command in a container in a pod.
 type PodCommandExecutor interface {
 	// ExecutePodCommand executes a command in a container in a pod. If the command takes longer than
@@ -41,6 +56,17 @@ type PodCommandExecutor interface {
 	ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error
 }
 
+// ResultPodCommandExecutor is a PodCommandExecutor that also returns the result of the commands it
+// executes. Callers check for it with a type assertion, so that existing PodCommandExecutor
+// implementations keep working.
+type ResultPodCommandExecutor interface {
+	PodCommandExecutor
+
+	// ExecutePodCommandWithResult executes a command like ExecutePodCommand and returns its result.
+	// The result is nil if the command could not be executed at all, e.g. because the hook is invalid.
+	ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*ExecResult, error)
+}
+
 type poster interface {
 	Post() *rest.Request
 }
@@ -62,44 +88,53 @@ func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) Pod
 	}
 }
 
+var _ ResultPodCommandExecutor = &defaultPodCommandExecutor{}
+
 // ExecutePodCommand uses the pod exec API to execute a command in a container in a pod. If the
 // command takes longer than the specified timeout, an error is returned (NOTE: it is not currently
 // possible to ensure the command is terminated when the timeout occurs, so it may continue to run
 // in the background).
 func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	_, err := e.ExecutePodCommandWithResult(log, item, namespace, name, hookName, hook)
+	return err
+}
+
+// ExecutePodCommandWithResult executes a command like ExecutePodCommand and returns its container,
+// output and exit code.
+func (e *defaultPodCommandExecutor) ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*ExecResult, error) {
 	if item == nil {
-		return errors.New("item is required")
+		return nil, errors.New("item is required")
 	}
 	if namespace == "" {
-		return errors.New("namespace is required")
+		return nil, errors.New("namespace is required")
 	}
 	if name == "" {
-		return errors.New("name is required")
+		return nil, errors.New("name is required")
 	}
 	if hookName == "" {
-		return errors.New("hookName is required")
+		return nil, errors.New("hookName is required")
 	}
 	if hook == nil {
-		return errors.New("hook is required")
+		return nil, errors.New("hook is required")
 	}
 
 	localHook := *hook
 
 	pod := new(corev1api.Pod)
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	if localHook.Container == "" {
 		if err := setDefaultHookContainer(pod, &localHook); err != nil {
-			return err
+			return nil, err
 		}
 	} else if err := ensureContainerExists(pod, localHook.Container); err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(localHook.Command) == 0 {
-		return errors.New("command is required")
+		return nil, errors.New("command is required")
 	}
 
 	switch localHook.OnError {
@@ -140,7 +175,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 
 	executor, err := e.streamExecutorFactory.NewSPDYExecutor(e.restClientConfig, "POST", req.URL())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var stdout, stderr bytes.Buffer
@@ -164,16 +199,30 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 		timeoutCh = timer.C
 	}
 
+	result := &ExecResult{
+		Container: localHook.Container,
+		ExitCode:  -1,
+	}
+
 	select {
 	case err = <-errCh:
 	case <-timeoutCh:
-		return errors.Errorf("timed out after %v", localHook.Timeout.Duration)
+		return result, errors.Errorf("timed out after %v", localHook.Timeout.Duration)
 	}
 
 	hookLog.Infof("stdout: %s", stdout.String())
 	hookLog.Infof("stderr: %s", stderr.String())
 
-	return err
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+	var exitErr utilexec.ExitError
+	if err == nil {
+		result.ExitCode = 0
+	} else if errors.As(err, &exitErr) {
+		result.ExitCode = exitErr.ExitStatus()
+	}
+
+	return result, err
 }
 
 func ensureContainerExists(pod *corev1api.Pod, container string) error {
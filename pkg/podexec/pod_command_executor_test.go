@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -148,6 +149,7 @@ func TestExecutePodCommand(t *testing.T) {
 		expectedTimeout       time.Duration
 		hookError             error
 		expectedError         string
+		expectedExitCode      int
 	}{
 		{
 			name:                  "validate defaults",
@@ -174,6 +176,17 @@ func TestExecutePodCommand(t *testing.T) {
 			expectedTimeout:       30 * time.Second,
 			hookError:             errors.New("hook error"),
 			expectedError:         "hook error",
+			expectedExitCode:      -1,
+		},
+		{
+			name:                  "command exits with non-zero code",
+			command:               []string{"some", "command"},
+			expectedContainerName: "foo",
+			expectedErrorMode:     v1.HookErrorModeFail,
+			expectedTimeout:       30 * time.Second,
+			hookError:             utilexec.CodeExitError{Err: errors.New("command terminated with exit code 3"), Code: 3},
+			expectedError:         "command terminated with exit code 3",
+			expectedExitCode:      3,
 		},
 	}
 
@@ -233,7 +246,10 @@ func TestExecutePodCommand(t *testing.T) {
 			}
 			streamExecutor.On("Stream", expectedStreamOptions).Return(test.hookError)
 
-			err = podCommandExecutor.ExecutePodCommand(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
+			result, err := podCommandExecutor.ExecutePodCommandWithResult(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
+			require.NotNil(t, result)
+			assert.Equal(t, test.expectedContainerName, result.Container)
+			assert.Equal(t, test.expectedExitCode, result.ExitCode)
 			if test.expectedError != "" {
 				assert.EqualError(t, err, test.expectedError)
 				return
@@ -245,13 +245,21 @@ ForEachVolume:
 	for i, count := 0, numVolumeSnapshots; i < count; i++ {
 		select {
 		case <-b.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			// a canceled backup doesn't wait for its PodVolumeBackups, which is not an error
+			if b.ctx.Err() == context.Canceled {
+				log.Info("Backup is canceled, not waiting for the remaining PodVolumeBackups")
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
-			switch res.Status.Phase {
-			case velerov1api.PodVolumeBackupPhaseCompleted:
+			switch {
+			case res.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted:
+				podVolumeBackups = append(podVolumeBackups, res)
+			case IsPodVolumeBackupCanceled(res):
+				log.Infof("Pod volume backup %s is canceled", res.Name)
 				podVolumeBackups = append(podVolumeBackups, res)
-			case velerov1api.PodVolumeBackupPhaseFailed:
+			case res.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed:
 				errs = append(errs, errors.Errorf("pod volume backup failed: %s", res.Status.Message))
 				podVolumeBackups = append(podVolumeBackups, res)
 			}
@@ -328,6 +328,8 @@ func TestBackupPodVolumes(t *testing.T) {
 
 	failedPVB := createPVBObj(true, false, 1, "")
 	completedPVB := createPVBObj(false, false, 1, "")
+	canceledPVB := createPVBObj(true, false, 1, "")
+	canceledPVB.Status.Message = velerov1api.PodVolumeBackupCanceledMessage
 
 	tests := []struct {
 		name            string
@@ -552,9 +554,6 @@ func TestBackupPodVolumes(t *testing.T) {
 			runtimeScheme: scheme,
 			uploaderType:  "kopia",
 			bsl:           "fake-bsl",
-			errs: []string{
-				"timed out waiting for all PodVolumeBackups to complete",
-			},
 		},
 		{
 			name: "return failed pvbs",
@@ -583,6 +582,30 @@ func TestBackupPodVolumes(t *testing.T) {
 				"pod volume backup failed: fake-message",
 			},
 		},
+		{
+			name: "return canceled pvbs",
+			volumes: []string{
+				"fake-volume-1",
+			},
+			sourcePod: createPodObj(true, true, true, 1),
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+				createPVCObj(1),
+				createPVObj(1, false),
+			},
+			ctlClientObj: []runtime.Object{
+				createBackupRepoObj(),
+			},
+			runtimeScheme: scheme,
+			uploaderType:  "kopia",
+			bsl:           "fake-bsl",
+			retPVBs: []*velerov1api.PodVolumeBackup{
+				canceledPVB,
+			},
+			pvbs: []*velerov1api.PodVolumeBackup{
+				canceledPVB,
+			},
+		},
 		{
 			name: "return completed pvbs",
 			volumes: []string{
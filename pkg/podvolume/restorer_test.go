@@ -319,7 +319,7 @@ func TestRestorePodVolumes(t *testing.T) {
 			runtimeScheme:   scheme,
 			errs: []expectError{
 				{
-					err: "timed out waiting for all PodVolumeRestores to complete",
+					err: "restore is canceled while waiting for all PodVolumeRestores to complete",
 				},
 			},
 		},
@@ -73,6 +73,12 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 	return volumes
 }
 
+// IsPodVolumeBackupCanceled returns true if the PodVolumeBackup is failed because it is canceled,
+// as the backup it belongs to is canceled.
+func IsPodVolumeBackupCanceled(pvb *velerov1api.PodVolumeBackup) bool {
+	return pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed && pvb.Status.Message == velerov1api.PodVolumeBackupCanceledMessage
+}
+
 // GetPvbRepositoryType returns the repositoryType according to the PVB information
 func GetPvbRepositoryType(pvb *velerov1api.PodVolumeBackup) string {
 	return getRepositoryType(pvb.Spec.UploaderType)
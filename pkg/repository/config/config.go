@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 )
 
@@ -98,6 +99,12 @@ func GetBackendType(provider string, config map[string]string) BackendType {
 		provider = "velero.io/" + provider
 	}
 
+	// the buckets of the built-in filesystem object store are directories
+	// which the repositories can be stored in too
+	if provider == filesystem.ProviderName {
+		return FSBackend
+	}
+
 	bt := BackendType(provider)
 	if IsBackendTypeValid(bt) {
 		return bt
@@ -0,0 +1,234 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const (
+	// maintenanceJobContainer is the name of the container of the repository maintenance jobs
+	maintenanceJobContainer = "velero-repo-maintenance"
+
+	// veleroServerContainer is the name of the container of the Velero server, the repository
+	// maintenance jobs inherit its image, environments and volumes
+	veleroServerContainer = "velero"
+
+	// DefaultKeepLatestMaintenanceJobs is the default number of the latest maintenance jobs,
+	// and the history of them, kept for each repository
+	DefaultKeepLatestMaintenanceJobs = 3
+)
+
+// MaintenanceConfig is the config of the repository maintenance jobs
+type MaintenanceConfig struct {
+	// Resources is the resource requirements of the maintenance jobs
+	Resources corev1api.ResourceRequirements
+
+	// NodeSelector selects the nodes that the maintenance jobs run in
+	NodeSelector map[string]string
+
+	// KeepLatestJobs is the number of the latest maintenance jobs, and the history of them,
+	// kept for each repository
+	KeepLatestJobs int
+
+	// LogLevel and LogFormat are the log settings passed to the maintenance jobs
+	LogLevel  string
+	LogFormat string
+}
+
+// BuildMaintenanceJob builds a job that runs the maintenance of the repo, the job inherits the
+// image, environments, volumes and service account of the Velero server deployment
+func BuildMaintenanceJob(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, config MaintenanceConfig) (*batchv1api.Job, error) {
+	deployment, err := getVeleroServerDeployment(ctx, cli, repo.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var veleroContainer *corev1api.Container
+	for i := range deployment.Spec.Template.Spec.Containers {
+		if deployment.Spec.Template.Spec.Containers[i].Name == veleroServerContainer {
+			veleroContainer = &deployment.Spec.Template.Spec.Containers[i]
+			break
+		}
+	}
+
+	args := []string{
+		"repo-maintenance",
+		fmt.Sprintf("--repo-name=%s", repo.Name),
+	}
+	if config.LogLevel != "" {
+		args = append(args, fmt.Sprintf("--log-level=%s", config.LogLevel))
+	}
+	if config.LogFormat != "" {
+		args = append(args, fmt.Sprintf("--log-format=%s", config.LogFormat))
+	}
+
+	podSpec := deployment.Spec.Template.Spec
+	backoffLimit := int32(0)
+
+	job := &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      label.GetValidName(fmt.Sprintf("%s-maintain-job-%d", repo.Name, time.Now().Unix())),
+			Namespace: repo.Namespace,
+			Labels: map[string]string{
+				velerov1api.RepositoryNameLabel: label.GetValidName(repo.Name),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "BackupRepository",
+					Name:       repo.Name,
+					UID:        repo.UID,
+					Controller: boolptr.True(),
+				},
+			},
+		},
+		Spec: batchv1api.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						velerov1api.RepositoryNameLabel: label.GetValidName(repo.Name),
+					},
+				},
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name:                     maintenanceJobContainer,
+							Image:                    veleroContainer.Image,
+							ImagePullPolicy:          veleroContainer.ImagePullPolicy,
+							Command:                  veleroContainer.Command,
+							Args:                     args,
+							Env:                      veleroContainer.Env,
+							EnvFrom:                  veleroContainer.EnvFrom,
+							VolumeMounts:             veleroContainer.VolumeMounts,
+							SecurityContext:          veleroContainer.SecurityContext,
+							Resources:                config.Resources,
+							TerminationMessagePolicy: corev1api.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+					RestartPolicy:      corev1api.RestartPolicyNever,
+					Volumes:            podSpec.Volumes,
+					ServiceAccountName: podSpec.ServiceAccountName,
+					ImagePullSecrets:   podSpec.ImagePullSecrets,
+					SecurityContext:    podSpec.SecurityContext,
+					Tolerations:        podSpec.Tolerations,
+					NodeSelector:       config.NodeSelector,
+				},
+			},
+		},
+	}
+
+	return job, nil
+}
+
+// getVeleroServerDeployment returns the deployment of the Velero server, selected with the
+// labels and the container name, the same way as the plugin commands do
+func getVeleroServerDeployment(ctx context.Context, cli client.Client, namespace string) (*appsv1api.Deployment, error) {
+	deployList := &appsv1api.DeploymentList{}
+	if err := cli.List(ctx, deployList, client.InNamespace(namespace), client.MatchingLabels(install.Labels())); err != nil {
+		return nil, errors.Wrap(err, "error to list Velero deployments")
+	}
+
+	for i := range deployList.Items {
+		for _, container := range deployList.Items[i].Spec.Template.Spec.Containers {
+			if container.Name == veleroServerContainer {
+				return &deployList.Items[i], nil
+			}
+		}
+	}
+
+	return nil, errors.Errorf("Velero deployment not found in namespace %s", namespace)
+}
+
+// GetMaintenanceJobs returns the maintenance jobs of the repo, ordered by their creation time
+func GetMaintenanceJobs(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository) ([]batchv1api.Job, error) {
+	jobList := &batchv1api.JobList{}
+	if err := cli.List(ctx, jobList, client.InNamespace(repo.Namespace), client.MatchingLabels{
+		velerov1api.RepositoryNameLabel: label.GetValidName(repo.Name),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "error to list maintenance jobs of repo %s", repo.Name)
+	}
+
+	jobs := jobList.Items
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreationTimestamp.Before(&jobs[j].CreationTimestamp)
+	})
+
+	return jobs, nil
+}
+
+// IsMaintenanceJobFinished returns whether the maintenance job has finished, either succeeded or failed
+func IsMaintenanceJobFinished(job *batchv1api.Job) bool {
+	return job.Status.Succeeded > 0 || job.Status.Failed > 0
+}
+
+// GetMaintenanceStatusFromJob returns the status of the maintenance run of a finished maintenance job,
+// the message of a failed run is the termination message of the job's pod
+func GetMaintenanceStatusFromJob(ctx context.Context, cli client.Client, job *batchv1api.Job) (velerov1api.BackupRepositoryMaintenanceStatus, error) {
+	status := velerov1api.BackupRepositoryMaintenanceStatus{
+		StartTimestamp:    job.Status.StartTime,
+		CompleteTimestamp: job.Status.CompletionTime,
+	}
+	if status.StartTimestamp == nil {
+		status.StartTimestamp = job.CreationTimestamp.DeepCopy()
+	}
+
+	if job.Status.Succeeded > 0 {
+		status.Result = velerov1api.BackupRepositoryMaintenanceSucceeded
+		return status, nil
+	}
+
+	status.Result = velerov1api.BackupRepositoryMaintenanceFailed
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1api.JobFailed && cond.Status == corev1api.ConditionTrue {
+			status.CompleteTimestamp = cond.LastTransitionTime.DeepCopy()
+			status.Message = cond.Message
+		}
+	}
+
+	podList := &corev1api.PodList{}
+	if err := cli.List(ctx, podList, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return status, errors.Wrapf(err, "error to list pods of maintenance job %s", job.Name)
+	}
+
+	for _, pod := range podList.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name == maintenanceJobContainer && containerStatus.State.Terminated != nil &&
+				containerStatus.State.Terminated.Message != "" {
+				status.Message = containerStatus.State.Terminated.Message
+			}
+		}
+	}
+
+	return status, nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/install"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBuildMaintenanceJob(t *testing.T) {
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "fake-repo",
+			UID:       "fake-uid",
+		},
+	}
+
+	config := MaintenanceConfig{
+		Resources: corev1api.ResourceRequirements{
+			Limits: corev1api.ResourceList{
+				corev1api.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+		NodeSelector: map[string]string{"fake-key": "fake-value"},
+		LogLevel:     "debug",
+		LogFormat:    "json",
+	}
+
+	tests := []struct {
+		name        string
+		objs        []runtime.Object
+		expectedErr string
+	}{
+		{
+			name:        "Velero deployment not found",
+			expectedErr: "Velero deployment not found in namespace velero",
+		},
+		{
+			name: "succeed",
+			objs: []runtime.Object{install.Deployment(velerov1api.DefaultNamespace, install.WithImage("velero/velero:test"))},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t, test.objs...)
+
+			job, err := BuildMaintenanceJob(context.Background(), cli, repo, config)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "fake-repo", job.Labels[velerov1api.RepositoryNameLabel])
+			assert.Equal(t, repo.UID, job.OwnerReferences[0].UID)
+			assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+
+			podSpec := job.Spec.Template.Spec
+			assert.Equal(t, corev1api.RestartPolicyNever, podSpec.RestartPolicy)
+			assert.Equal(t, config.NodeSelector, podSpec.NodeSelector)
+			require.Len(t, podSpec.Containers, 1)
+			assert.Equal(t, "velero/velero:test", podSpec.Containers[0].Image)
+			assert.Equal(t, []string{"/velero"}, podSpec.Containers[0].Command)
+			assert.Equal(t, []string{"repo-maintenance", "--repo-name=fake-repo", "--log-level=debug", "--log-format=json"}, podSpec.Containers[0].Args)
+			assert.Equal(t, config.Resources, podSpec.Containers[0].Resources)
+			assert.NotEmpty(t, podSpec.Containers[0].Env)
+		})
+	}
+}
+
+func TestGetMaintenanceStatusFromJob(t *testing.T) {
+	start := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	complete := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	failedPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "failed-job-pod",
+			Labels:    map[string]string{"job-name": "failed-job"},
+		},
+		Status: corev1api.PodStatus{
+			ContainerStatuses: []corev1api.ContainerStatus{
+				{
+					Name: maintenanceJobContainer,
+					State: corev1api.ContainerState{
+						Terminated: &corev1api.ContainerStateTerminated{
+							Message: "fake-error",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		job      *batchv1api.Job
+		expected velerov1api.BackupRepositoryMaintenanceStatus
+	}{
+		{
+			name: "succeeded job",
+			job: &batchv1api.Job{
+				ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "succeeded-job"},
+				Status: batchv1api.JobStatus{
+					StartTime:      &start,
+					CompletionTime: &complete,
+					Succeeded:      1,
+				},
+			},
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Result:            velerov1api.BackupRepositoryMaintenanceSucceeded,
+				StartTimestamp:    &start,
+				CompleteTimestamp: &complete,
+			},
+		},
+		{
+			name: "failed job",
+			job: &batchv1api.Job{
+				ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "failed-job"},
+				Status: batchv1api.JobStatus{
+					StartTime: &start,
+					Failed:    1,
+					Conditions: []batchv1api.JobCondition{
+						{
+							Type:               batchv1api.JobFailed,
+							Status:             corev1api.ConditionTrue,
+							LastTransitionTime: complete,
+							Message:            "Job has reached the specified backoff limit",
+						},
+					},
+				},
+			},
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+				StartTimestamp:    &start,
+				CompleteTimestamp: &complete,
+				Message:           "fake-error",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t, failedPod)
+
+			status, err := GetMaintenanceStatusFromJob(context.Background(), cli, test.job)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, status)
+		})
+	}
+}
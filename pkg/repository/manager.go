@@ -28,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -72,6 +73,15 @@ type Manager interface {
 	// Forget removes a snapshot from the list of
 	// available snapshots in a repo.
 	Forget(context.Context, SnapshotIdentifier) error
+
+	// ListSnapshots returns the metadata of all the snapshots in a repo, the labels of the
+	// metadata include the tags of the snapshots.
+	ListSnapshots(repo *velerov1api.BackupRepository) ([]*udmrepo.ManifestEntryMetadata, error)
+
+	// GetStatistics collects the statistics of the data and the snapshots in a repo,
+	// the repo is opened read-only.
+	GetStatistics(repo *velerov1api.BackupRepository) (*udmrepo.RepoStatistics, error)
+
 	// DefaultMaintenanceFrequency returns the default maintenance frequency from the specific repo
 	DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error)
 }
@@ -220,6 +230,42 @@ func (m *manager) Forget(ctx context.Context, snapshot SnapshotIdentifier) error
 	return prd.Forget(context.Background(), snapshot.SnapshotID, param)
 }
 
+func (m *manager) ListSnapshots(repo *velerov1api.BackupRepository) ([]*udmrepo.ManifestEntryMetadata, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := prd.BoostRepoConnect(context.Background(), param); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return prd.ListSnapshots(context.Background(), param)
+}
+
+func (m *manager) GetStatistics(repo *velerov1api.BackupRepository) (*udmrepo.RepoStatistics, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return prd.GetRepoStatistics(context.Background(), param)
+}
+
 func (m *manager) DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error) {
 	prd, err := m.getRepositoryProvider(repo)
 	if err != nil {
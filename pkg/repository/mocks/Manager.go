@@ -10,6 +10,8 @@ import (
 
 	time "time"
 
+	udmrepo "github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
@@ -67,6 +69,29 @@ func (_m *Manager) Forget(_a0 context.Context, _a1 repository.SnapshotIdentifier
 	return r0
 }
 
+// GetStatistics provides a mock function with given fields: repo
+func (_m *Manager) GetStatistics(repo *v1.BackupRepository) (*udmrepo.RepoStatistics, error) {
+	ret := _m.Called(repo)
+
+	var r0 *udmrepo.RepoStatistics
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) *udmrepo.RepoStatistics); ok {
+		r0 = rf(repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*udmrepo.RepoStatistics)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository) error); ok {
+		r1 = rf(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InitRepo provides a mock function with given fields: repo
 func (_m *Manager) InitRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
@@ -81,6 +106,29 @@ func (_m *Manager) InitRepo(repo *v1.BackupRepository) error {
 	return r0
 }
 
+// ListSnapshots provides a mock function with given fields: repo
+func (_m *Manager) ListSnapshots(repo *v1.BackupRepository) ([]*udmrepo.ManifestEntryMetadata, error) {
+	ret := _m.Called(repo)
+
+	var r0 []*udmrepo.ManifestEntryMetadata
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) []*udmrepo.ManifestEntryMetadata); ok {
+		r0 = rf(repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*udmrepo.ManifestEntryMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository) error); ok {
+		r1 = rf(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PrepareRepo provides a mock function with given fields: repo
 func (_m *Manager) PrepareRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
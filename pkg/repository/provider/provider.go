@@ -21,6 +21,7 @@ import (
 	"time"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 )
 
 // RepoParam includes the parameters to manipulate a backup repository
@@ -57,6 +58,14 @@ type Provider interface {
 	// Forget is to delete a snapshot from the repository
 	Forget(ctx context.Context, snapshotID string, param RepoParam) error
 
+	// ListSnapshots returns the metadata of all the snapshots in the repository,
+	// the labels of the metadata include the tags of the snapshots
+	ListSnapshots(ctx context.Context, param RepoParam) ([]*udmrepo.ManifestEntryMetadata, error)
+
+	// GetRepoStatistics connects to the repository read-only and collects
+	// the statistics of the data and the snapshots in it
+	GetRepoStatistics(ctx context.Context, param RepoParam) (*udmrepo.RepoStatistics, error)
+
 	// DefaultMaintenanceFrequency returns the default frequency to run maintenance
 	DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration
 }
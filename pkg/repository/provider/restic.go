@@ -21,10 +21,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/repository/restic"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -78,6 +80,14 @@ func (r *resticRepositoryProvider) Forget(ctx context.Context, snapshotID string
 	return r.svc.Forget(param.BackupLocation, param.BackupRepo, snapshotID)
 }
 
+func (r *resticRepositoryProvider) ListSnapshots(ctx context.Context, param RepoParam) ([]*udmrepo.ManifestEntryMetadata, error) {
+	return nil, errors.New("listing snapshots is not supported for restic repositories")
+}
+
+func (r *resticRepositoryProvider) GetRepoStatistics(ctx context.Context, param RepoParam) (*udmrepo.RepoStatistics, error) {
+	return nil, errors.New("repository statistics are not supported for restic repositories")
+}
+
 func (r *resticRepositoryProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return r.svc.DefaultMaintenanceFrequency()
 }
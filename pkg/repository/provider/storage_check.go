@@ -0,0 +1,60 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib"
+)
+
+// CheckUnifiedRepoStorage runs the checks of the storage operations the Unified Repo relies on
+// against the storage of the backup storage location. The checks use a directory named after
+// checkID beside the repositories, the dot in its name prevents it from colliding with the
+// repository of a namespace.
+func CheckUnifiedRepoStorage(ctx context.Context, backupLocation *velerov1api.BackupStorageLocation,
+	credentialsFileStore credentials.FileStore, checkID string) ([]kopialib.StorageCheck, error) {
+	storageType := getStorageType(backupLocation)
+	if storageType == "" {
+		return nil, errors.New("invalid storage provider")
+	}
+
+	storeVar, err := getStorageVariables(backupLocation, velerov1api.BackupRepositoryTypeKopia, "velero-check."+checkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get storage variables")
+	}
+
+	storeCred, err := getStorageCredentials(backupLocation, credentialsFileStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo credentials")
+	}
+
+	storeOptions := make(map[string]string)
+	for k, v := range storeVar {
+		storeOptions[k] = v
+	}
+
+	for k, v := range storeCred {
+		storeOptions[k] = v
+	}
+
+	return kopialib.CheckStorage(ctx, storageType, storeOptions), nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/filesystem"
 	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
 	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
@@ -66,6 +68,12 @@ var funcTable = localFuncTable{
 const (
 	repoOpDescMaintain = "repo maintenance"
 	repoOpDescForget   = "forget"
+	repoOpDescStats    = "statistics"
+	repoOpDescList     = "list snapshots"
+
+	// readOnlyConfigSuffix is appended to the name of the config file of the read-only
+	// connections, so that they don't overwrite the config of the regular connection
+	readOnlyConfigSuffix = "-readonly"
 
 	repoConnectDesc = "unfied repo"
 )
@@ -99,12 +107,7 @@ func (urp *unifiedRepoProvider) InitRepo(ctx context.Context, param RepoParam) e
 	repoOption, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(urp, param),
 		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
-		udmrepo.WithGenOptions(
-			map[string]string{
-				udmrepo.GenOptionOwnerName:   udmrepo.GetRepoUser(),
-				udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
-			},
-		),
+		udmrepo.WithGenOptions(getInitRepoGenOptions(param.BackupLocation)),
 		udmrepo.WithStoreOptions(urp, param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
@@ -307,6 +310,103 @@ func (urp *unifiedRepoProvider) Forget(ctx context.Context, snapshotID string, p
 	return nil
 }
 
+func (urp *unifiedRepoProvider) ListSnapshots(ctx context.Context, param RepoParam) ([]*udmrepo.ManifestEntryMetadata, error) {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":  param.BackupLocation.Name,
+		"repo name": param.BackupRepo.Name,
+		"repo UID":  param.BackupRepo.UID,
+	})
+
+	log.Debug("Start to list snapshots")
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescList),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo options")
+	}
+
+	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to open backup repo")
+	}
+
+	defer func() {
+		c := bkRepo.Close(ctx)
+		if c != nil {
+			log.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	snapshots, err := bkRepo.FindManifests(ctx, udmrepo.ManifestFilter{
+		Labels: map[string]string{udmrepo.ManifestTypeLabel: udmrepo.ManifestTypeSnapshot},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to find snapshots")
+	}
+
+	log.Debugf("List snapshots complete, %d snapshots found", len(snapshots))
+
+	return snapshots, nil
+}
+
+func (urp *unifiedRepoProvider) GetRepoStatistics(ctx context.Context, param RepoParam) (*udmrepo.RepoStatistics, error) {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":  param.BackupLocation.Name,
+		"repo name": param.BackupRepo.Name,
+		"repo UID":  param.BackupRepo.UID,
+	})
+
+	log.Debug("Start to get repo statistics")
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)+readOnlyConfigSuffix),
+		udmrepo.WithGenOptions(
+			map[string]string{
+				udmrepo.GenOptionOwnerName:     udmrepo.GetRepoUser(),
+				udmrepo.GenOptionOwnerDomain:   udmrepo.GetRepoDomain(),
+				udmrepo.StoreOptionGenReadOnly: strconv.FormatBool(true),
+			},
+		),
+		udmrepo.WithStoreOptions(urp, param),
+		udmrepo.WithDescription(repoOpDescStats),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo options")
+	}
+
+	err = urp.repoService.Init(ctx, *repoOption, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to connect backup repo")
+	}
+
+	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to open backup repo")
+	}
+
+	defer func() {
+		c := bkRepo.Close(ctx)
+		if c != nil {
+			log.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	stats, err := bkRepo.Statistics(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo statistics")
+	}
+
+	log.Debug("Get repo statistics complete")
+
+	return stats, nil
+}
+
 func (urp *unifiedRepoProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return urp.repoService.DefaultMaintenanceFrequency()
 }
@@ -362,6 +462,22 @@ func (urp *unifiedRepoProvider) GetStoreOptions(param interface{}) (map[string]s
 	return storeOptions, nil
 }
 
+// getInitRepoGenOptions returns the general options to create a repo, the blobs of the repo are
+// locked by the object lock of the storage if it's enabled for the BSL
+func getInitRepoGenOptions(backupLocation *velerov1api.BackupStorageLocation) map[string]string {
+	genOptions := map[string]string{
+		udmrepo.GenOptionOwnerName:   udmrepo.GetRepoUser(),
+		udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
+	}
+
+	if objectLock := backupLocation.Spec.ObjectLock; objectLock != nil {
+		genOptions[udmrepo.StoreOptionGenRetentionMode] = strings.ToUpper(string(objectLock.Mode))
+		genOptions[udmrepo.StoreOptionGenRetentionPeriod] = objectLock.RetentionPeriod.Duration.String()
+	}
+
+	return genOptions
+}
+
 func getRepoPassword(secretStore credentials.SecretStore) (string, error) {
 	if secretStore == nil {
 		return "", errors.New("invalid credentials interface")
@@ -410,6 +526,23 @@ func getStorageCredentials(backupLocation *velerov1api.BackupStorageLocation, cr
 		config = map[string]string{}
 	}
 
+	if err := credentials.ValidateLocationCredential(backupLocation); err != nil {
+		return map[string]string{}, err
+	}
+
+	if backupLocation.Spec.WebIdentity != nil {
+		if backendType != repoconfig.AWSBackend {
+			return map[string]string{}, errors.Errorf("web identity is not supported by provider %s", backupLocation.Spec.Provider)
+		}
+
+		// the backend exchanges the web identity token for short-lived credentials
+		// and refreshes them, so no static credential is passed
+		result[udmrepo.StoreOptionS3RoleARN] = backupLocation.Spec.WebIdentity.RoleARN
+		result[udmrepo.StoreOptionS3WebIdentityTokenFile] = credentials.WebIdentityTokenFile(backupLocation.Spec.WebIdentity)
+		result[udmrepo.StoreOptionS3SessionDuration] = credentials.WebIdentitySessionDuration(backupLocation.Spec.WebIdentity).String()
+		return result, nil
+	}
+
 	if backupLocation.Spec.Credential != nil {
 		config[repoconfig.CredentialsFileKey], err = credentialsFileStore.Path(backupLocation.Spec.Credential)
 		if err != nil {
@@ -517,6 +650,15 @@ func getStorageVariables(backupLocation *velerov1api.BackupStorageLocation, repo
 	result[udmrepo.StoreOptionOssRegion] = strings.Trim(region, "/")
 	result[udmrepo.StoreOptionFsPath] = config["fspath"]
 
+	if backendType == repoconfig.FSBackend && result[udmrepo.StoreOptionFsPath] == "" {
+		// the repository is in the bucket directory of the filesystem object store
+		root := config["root"]
+		if root == "" {
+			root = filesystem.DefaultRoot
+		}
+		result[udmrepo.StoreOptionFsPath] = filepath.Join(root, bucket)
+	}
+
 	return result, nil
 }
 
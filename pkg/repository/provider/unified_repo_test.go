@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerocredentials "github.com/vmware-tanzu/velero/internal/credentials"
 	credmock "github.com/vmware-tanzu/velero/internal/credentials/mocks"
@@ -79,6 +81,36 @@ func TestGetStorageCredentials(t *testing.T) {
 			expected:       map[string]string{},
 			expectedErr:    "error get credential file in bsl: fake error",
 		},
+		{
+			name: "aws, WebIdentity section exists in BSL",
+			backupLocation: velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider: "velero.io/aws",
+					WebIdentity: &velerov1api.WebIdentity{
+						RoleARN:         "fake-role",
+						SessionDuration: &metav1.Duration{Duration: 2 * time.Hour},
+					},
+				},
+			},
+			credFileStore: new(credmock.FileStore),
+			expected: map[string]string{
+				"roleARN":              "fake-role",
+				"webIdentityTokenFile": "/var/run/secrets/velero/serviceaccount/token",
+				"sessionDuration":      "2h0m0s",
+			},
+		},
+		{
+			name: "azure, WebIdentity section exists in BSL",
+			backupLocation: velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider:    "velero.io/azure",
+					WebIdentity: &velerov1api.WebIdentity{RoleARN: "fake-role"},
+				},
+			},
+			credFileStore: new(credmock.FileStore),
+			expected:      map[string]string{},
+			expectedErr:   "web identity is not supported by provider velero.io/azure",
+		},
 		{
 			name: "aws, Credential section not exists in BSL",
 			backupLocation: velerov1api.BackupStorageLocation{
@@ -519,6 +551,50 @@ func TestGetStorageVariables(t *testing.T) {
 				"region": "",
 			},
 		},
+		{
+			name: "filesystem object store",
+			backupLocation: velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider: "velero.io/filesystem",
+					StorageType: velerov1api.StorageType{
+						ObjectStorage: &velerov1api.ObjectStorageLocation{
+							Bucket: "fake-bucket",
+							Prefix: "fake-prefix",
+						},
+					},
+				},
+			},
+			repoBackend: "fake-repo-type",
+			expected: map[string]string{
+				"fspath": "/velero-filesystem/fake-bucket",
+				"bucket": "fake-bucket",
+				"prefix": "fake-prefix/fake-repo-type/",
+				"region": "",
+			},
+		},
+		{
+			name: "filesystem object store with root",
+			backupLocation: velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider: "velero.io/filesystem",
+					Config: map[string]string{
+						"root": "/mnt/nfs",
+					},
+					StorageType: velerov1api.StorageType{
+						ObjectStorage: &velerov1api.ObjectStorageLocation{
+							Bucket: "fake-bucket",
+						},
+					},
+				},
+			},
+			repoBackend: "fake-repo-type",
+			expected: map[string]string{
+				"fspath": "/mnt/nfs/fake-bucket",
+				"bucket": "fake-bucket",
+				"prefix": "fake-repo-type/",
+				"region": "",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -888,6 +964,140 @@ func TestForget(t *testing.T) {
 	}
 }
 
+func TestGetRepoStatistics(t *testing.T) {
+	var backupRepo *reposervicenmocks.BackupRepo
+
+	funcTableSucceed := localFuncTable{
+		getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+		getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		repoService   *reposervicenmocks.BackupRepoService
+		backupRepo    *reposervicenmocks.BackupRepo
+		retFuncInit   interface{}
+		retFuncOpen   []interface{}
+		retStatistics []interface{}
+		expected      *udmrepo.RepoStatistics
+		expectedErr   string
+	}{
+		{
+			name:        "connect fail",
+			repoService: new(reposervicenmocks.BackupRepoService),
+			retFuncInit: func(ctx context.Context, repoOption udmrepo.RepoOptions, createNew bool) error {
+				return errors.New("fake-error-1")
+			},
+			expectedErr: "error to connect backup repo: fake-error-1",
+		},
+		{
+			name:        "repo open fail",
+			repoService: new(reposervicenmocks.BackupRepoService),
+			retFuncInit: func(ctx context.Context, repoOption udmrepo.RepoOptions, createNew bool) error {
+				return nil
+			},
+			retFuncOpen: []interface{}{
+				func(context.Context, udmrepo.RepoOptions) udmrepo.BackupRepo {
+					return backupRepo
+				},
+
+				func(context.Context, udmrepo.RepoOptions) error {
+					return errors.New("fake-error-2")
+				},
+			},
+			expectedErr: "error to open backup repo: fake-error-2",
+		},
+		{
+			name:        "statistics fail",
+			repoService: new(reposervicenmocks.BackupRepoService),
+			backupRepo:  new(reposervicenmocks.BackupRepo),
+			retFuncInit: func(ctx context.Context, repoOption udmrepo.RepoOptions, createNew bool) error {
+				return nil
+			},
+			retFuncOpen: []interface{}{
+				func(context.Context, udmrepo.RepoOptions) udmrepo.BackupRepo {
+					return backupRepo
+				},
+
+				func(context.Context, udmrepo.RepoOptions) error {
+					return nil
+				},
+			},
+			retStatistics: []interface{}{nil, errors.New("fake-error-3")},
+			expectedErr:   "error to get repo statistics: fake-error-3",
+		},
+		{
+			name:        "succeed",
+			repoService: new(reposervicenmocks.BackupRepoService),
+			backupRepo:  new(reposervicenmocks.BackupRepo),
+			retFuncInit: func(ctx context.Context, repoOption udmrepo.RepoOptions, createNew bool) error {
+				return nil
+			},
+			retFuncOpen: []interface{}{
+				func(context.Context, udmrepo.RepoOptions) udmrepo.BackupRepo {
+					return backupRepo
+				},
+
+				func(context.Context, udmrepo.RepoOptions) error {
+					return nil
+				},
+			},
+			retStatistics: []interface{}{&udmrepo.RepoStatistics{BlobCount: 1}, nil},
+			expected:      &udmrepo.RepoStatistics{BlobCount: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			funcTable = funcTableSucceed
+
+			getter := new(credmock.SecretStore)
+			getter.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: getter,
+				},
+				repoService: tc.repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			backupRepo = tc.backupRepo
+
+			// the repo must be connected read-only and not be created
+			tc.repoService.On("Init", mock.Anything, mock.MatchedBy(func(opt udmrepo.RepoOptions) bool {
+				return opt.GeneralOptions[udmrepo.StoreOptionGenReadOnly] == "true"
+			}), false).Return(tc.retFuncInit)
+
+			if tc.retFuncOpen != nil {
+				tc.repoService.On("Open", mock.Anything, mock.Anything).Return(tc.retFuncOpen[0], tc.retFuncOpen[1])
+			}
+
+			if tc.backupRepo != nil {
+				backupRepo.On("Statistics", mock.Anything).Return(tc.retStatistics...)
+				backupRepo.On("Close", mock.Anything).Return(nil)
+			}
+
+			stats, err := urp.GetRepoStatistics(context.Background(), RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			})
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, stats)
+				backupRepo.AssertCalled(t, "Close", mock.Anything)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestInitRepo(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -976,6 +1186,46 @@ func TestInitRepo(t *testing.T) {
 	}
 }
 
+func TestGetInitRepoGenOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		expected map[string]string
+	}{
+		{
+			name:     "without object lock",
+			location: &velerov1api.BackupStorageLocation{},
+			expected: map[string]string{
+				udmrepo.GenOptionOwnerName:   udmrepo.GetRepoUser(),
+				udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
+			},
+		},
+		{
+			name: "with object lock",
+			location: &velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					ObjectLock: &velerov1api.ObjectLock{
+						Mode:            velerov1api.ObjectLockModeGovernance,
+						RetentionPeriod: metav1.Duration{Duration: 30 * 24 * time.Hour},
+					},
+				},
+			},
+			expected: map[string]string{
+				udmrepo.GenOptionOwnerName:            udmrepo.GetRepoUser(),
+				udmrepo.GenOptionOwnerDomain:          udmrepo.GetRepoDomain(),
+				udmrepo.StoreOptionGenRetentionMode:   "GOVERNANCE",
+				udmrepo.StoreOptionGenRetentionPeriod: "720h0m0s",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getInitRepoGenOptions(tc.location))
+		})
+	}
+}
+
 func TestConnectToRepo(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -0,0 +1,91 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+const (
+	// SnapshotTagBackup and SnapshotTagBackupUID are the tags that the pod volume backups and the data uploads
+	// save with the snapshots to identify the backups they belong to
+	SnapshotTagBackup    = "backup"
+	SnapshotTagBackupUID = "backup-uid"
+
+	// OrphanedSnapshotGracePeriod is the time a snapshot is kept from being treated as orphaned after it is
+	// saved, so that the snapshots of the backups not yet synced into the cluster are not treated as orphaned
+	OrphanedSnapshotGracePeriod = time.Hour
+)
+
+// SnapshotOwners is the backups that could own the snapshots in a backup repository
+type SnapshotOwners struct {
+	// clusterBackups maps the names, and the label values of the names, of the backups in the cluster to their UIDs
+	clusterBackups map[string]string
+
+	// storeBackups is the names, and the label values of the names, of the backups in the backup storage location
+	storeBackups map[string]bool
+}
+
+// NewSnapshotOwners creates the owners of the snapshots from the backups in the cluster and the names of the
+// backups in the backup storage location
+func NewSnapshotOwners(clusterBackups []velerov1api.Backup, storeBackups []string) *SnapshotOwners {
+	owners := &SnapshotOwners{
+		clusterBackups: map[string]string{},
+		storeBackups:   map[string]bool{},
+	}
+
+	// the snapshots are tagged with either the backup name or the label value of it
+	for _, backup := range clusterBackups {
+		owners.clusterBackups[backup.Name] = string(backup.UID)
+		owners.clusterBackups[label.GetValidName(backup.Name)] = string(backup.UID)
+	}
+
+	for _, backup := range storeBackups {
+		owners.storeBackups[backup] = true
+		owners.storeBackups[label.GetValidName(backup)] = true
+	}
+
+	return owners
+}
+
+// IsOrphaned returns true if the snapshot with the given tags, saved at the given time, is saved before the grace
+// period and its backup exists neither in the cluster nor in the backup storage. If a backup with the same name
+// exists in the cluster, its UID must match the one tagged, since the backup may have been deleted and created
+// again. The snapshots without the backup tag aren't created by Velero backups and are never treated as orphaned.
+func (o *SnapshotOwners) IsOrphaned(tags map[string]string, savedAt time.Time, now time.Time) bool {
+	backup := tags[SnapshotTagBackup]
+	if backup == "" {
+		return false
+	}
+
+	if savedAt.After(now.Add(-OrphanedSnapshotGracePeriod)) {
+		return false
+	}
+
+	if uid, found := o.clusterBackups[backup]; found {
+		if tagged := tags[SnapshotTagBackupUID]; tagged == "" || tagged == uid {
+			return false
+		}
+	} else if o.storeBackups[backup] {
+		return false
+	}
+
+	return true
+}
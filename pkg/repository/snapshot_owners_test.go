@@ -0,0 +1,98 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+func TestSnapshotOwnersIsOrphaned(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-2 * OrphanedSnapshotGracePeriod)
+
+	longName := "backup-with-a-name-that-is-longer-than-the-sixty-three-characters-of-a-label-value"
+	owners := NewSnapshotOwners(
+		[]velerov1api.Backup{
+			*builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").ObjectMeta(builder.WithUID("uid-1")).Result(),
+			*builder.ForBackup(velerov1api.DefaultNamespace, longName).ObjectMeta(builder.WithUID("uid-long")).Result(),
+		},
+		[]string{"backup-1", "backup-2"},
+	)
+
+	tests := []struct {
+		name     string
+		tags     map[string]string
+		savedAt  time.Time
+		expected bool
+	}{
+		{
+			name:    "untagged",
+			tags:    map[string]string{},
+			savedAt: old,
+		},
+		{
+			name:    "backup in the cluster",
+			tags:    map[string]string{SnapshotTagBackup: "backup-1", SnapshotTagBackupUID: "uid-1"},
+			savedAt: old,
+		},
+		{
+			name:    "backup in the cluster without the UID tag",
+			tags:    map[string]string{SnapshotTagBackup: "backup-1"},
+			savedAt: old,
+		},
+		{
+			name:     "backup in the cluster is created again",
+			tags:     map[string]string{SnapshotTagBackup: "backup-1", SnapshotTagBackupUID: "uid-0"},
+			savedAt:  old,
+			expected: true,
+		},
+		{
+			name:    "backup in the cluster tagged with the label value of the name",
+			tags:    map[string]string{SnapshotTagBackup: label.GetValidName(longName), SnapshotTagBackupUID: "uid-long"},
+			savedAt: old,
+		},
+		{
+			name:    "backup only in the backup storage",
+			tags:    map[string]string{SnapshotTagBackup: "backup-2", SnapshotTagBackupUID: "uid-2"},
+			savedAt: old,
+		},
+		{
+			name:     "backup doesn't exist",
+			tags:     map[string]string{SnapshotTagBackup: "backup-3"},
+			savedAt:  old,
+			expected: true,
+		},
+		{
+			name:    "backup doesn't exist but the snapshot is saved recently",
+			tags:    map[string]string{SnapshotTagBackup: "backup-3"},
+			savedAt: now,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, owners.IsOrphaned(test.tags, test.savedAt, now))
+		})
+	}
+}
@@ -18,15 +18,20 @@ package backend
 
 import (
 	"context"
+	"time"
 
 	"github.com/kopia/kopia/repo/blob"
 	"github.com/kopia/kopia/repo/blob/s3"
+	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 )
 
 type S3Backend struct {
-	options s3.Options
+	options              s3.Options
+	roleARN              string
+	webIdentityTokenFile string
+	sessionDuration      time.Duration
 }
 
 func (c *S3Backend) Setup(ctx context.Context, flags map[string]string) error {
@@ -48,9 +53,26 @@ func (c *S3Backend) Setup(ctx context.Context, flags map[string]string) error {
 
 	c.options.Limits = setupLimits(ctx, flags)
 
+	c.roleARN = optionalHaveString(udmrepo.StoreOptionS3RoleARN, flags)
+	c.webIdentityTokenFile = optionalHaveString(udmrepo.StoreOptionS3WebIdentityTokenFile, flags)
+	c.sessionDuration = optionalHaveDuration(ctx, udmrepo.StoreOptionS3SessionDuration, flags)
+
+	if c.roleARN != "" && c.webIdentityTokenFile == "" {
+		return errors.Errorf("key %s is required with key %s", udmrepo.StoreOptionS3WebIdentityTokenFile, udmrepo.StoreOptionS3RoleARN)
+	}
+
 	return nil
 }
 
 func (c *S3Backend) Connect(ctx context.Context, isCreate bool) (blob.Storage, error) {
+	if c.roleARN != "" {
+		return newWebIdentityS3Storage(ctx, &WebIdentityS3Options{
+			Options:              c.options,
+			RoleARN:              c.roleARN,
+			WebIdentityTokenFile: c.webIdentityTokenFile,
+			SessionDuration:      c.sessionDuration,
+		}, isCreate)
+	}
+
 	return s3.New(ctx, &c.options, false)
 }
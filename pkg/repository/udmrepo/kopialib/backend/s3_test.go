@@ -68,6 +68,14 @@ func TestS3Setup(t *testing.T) {
 				SessionToken:    "fake-token",
 			},
 		},
+		{
+			name: "with role but no web identity token file",
+			flags: map[string]string{
+				udmrepo.StoreOptionOssBucket: "fake-bucket",
+				udmrepo.StoreOptionS3RoleARN: "fake-role",
+			},
+			expectedErr: "key " + udmrepo.StoreOptionS3WebIdentityTokenFile + " is required with key " + udmrepo.StoreOptionS3RoleARN,
+		},
 		{
 			name: "with wrong tls",
 			flags: map[string]string{
@@ -0,0 +1,245 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/repo/blob/s3"
+	"github.com/pkg/errors"
+
+	velerocredentials "github.com/vmware-tanzu/velero/internal/credentials"
+)
+
+const (
+	webIdentityS3StorageType = "velero-s3-web-identity"
+
+	// webIdentityExpiryWindow is how long before the short-lived credentials
+	// expire they are refreshed, so that the requests in flight complete with
+	// valid credentials
+	webIdentityExpiryWindow = 5 * time.Minute
+
+	defaultSTSRegion = "us-east-1"
+)
+
+// WebIdentityS3Options are the options of an S3 storage accessed with short-lived
+// credentials obtained by exchanging a web identity token for the credentials of a role.
+type WebIdentityS3Options struct {
+	s3.Options
+
+	RoleARN              string        `json:"roleARN"`
+	WebIdentityTokenFile string        `json:"webIdentityTokenFile"`
+	SessionDuration      time.Duration `json:"sessionDuration,omitempty"`
+}
+
+// webIdentityCredentials provides the short-lived credentials and their expiration.
+type webIdentityCredentials interface {
+	GetWithContext(ctx credentials.Context) (credentials.Value, error)
+	ExpiresAt() (time.Time, error)
+}
+
+var newWebIdentityCredentials = func(opt *WebIdentityS3Options) (webIdentityCredentials, error) {
+	region := opt.Region
+	if region == "" {
+		region = defaultSTSRegion
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:              aws.String(region),
+		STSRegionalEndpoint: endpoints.RegionalSTSEndpoint,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to create STS session")
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithOptions(sts.New(sess), opt.RoleARN, velerocredentials.WebIdentitySessionName,
+		stscreds.FetchTokenPath(opt.WebIdentityTokenFile), func(p *stscreds.WebIdentityRoleProvider) {
+			p.Duration = opt.SessionDuration
+			p.ExpiryWindow = webIdentityExpiryWindow
+		})
+
+	return credentials.NewCredentials(provider), nil
+}
+
+var newS3Storage = s3.New
+
+// webIdentityS3Storage is an S3 storage which re-creates the underlying storage with
+// fresh short-lived credentials before the current ones expire, so that long running
+// uploads don't fail with expired credentials. Its connection info doesn't contain
+// any credential, so the repository re-created from its config file also refreshes them.
+type webIdentityS3Storage struct {
+	options     WebIdentityS3Options
+	credentials webIdentityCredentials
+
+	lock      sync.RWMutex
+	storage   blob.Storage
+	expiresAt time.Time
+}
+
+func newWebIdentityS3Storage(ctx context.Context, opt *WebIdentityS3Options, isCreate bool) (blob.Storage, error) {
+	creds, err := newWebIdentityCredentials(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &webIdentityS3Storage{
+		options:     *opt,
+		credentials: creds,
+	}
+
+	if _, err := s.current(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// current returns the underlying storage, which is re-created if its credentials are about to expire.
+func (s *webIdentityS3Storage) current(ctx context.Context) (blob.Storage, error) {
+	s.lock.RLock()
+	storage, expiresAt := s.storage, s.expiresAt
+	s.lock.RUnlock()
+
+	if storage != nil && time.Now().Before(expiresAt) {
+		return storage, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.storage != nil && time.Now().Before(s.expiresAt) {
+		return s.storage, nil
+	}
+
+	value, err := s.credentials.GetWithContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get credentials with web identity")
+	}
+
+	// the expiration already has the expiry window subtracted
+	expiresAt, err = s.credentials.ExpiresAt()
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get expiration of credentials")
+	}
+
+	options := s.options.Options
+	options.AccessKeyID = value.AccessKeyID
+	options.SecretAccessKey = value.SecretAccessKey
+	options.SessionToken = value.SessionToken
+
+	storage, err = newS3Storage(ctx, &options, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// the previous storage isn't closed since requests in flight may still use it,
+	// closing an S3 storage doesn't release anything anyway
+	s.storage = storage
+	s.expiresAt = expiresAt
+
+	return storage, nil
+}
+
+func (s *webIdentityS3Storage) GetCapacity(ctx context.Context) (blob.Capacity, error) {
+	storage, err := s.current(ctx)
+	if err != nil {
+		return blob.Capacity{}, err
+	}
+	return storage.GetCapacity(ctx)
+}
+
+func (s *webIdentityS3Storage) GetBlob(ctx context.Context, blobID blob.ID, offset, length int64, output blob.OutputBuffer) error {
+	storage, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+	return storage.GetBlob(ctx, blobID, offset, length, output)
+}
+
+func (s *webIdentityS3Storage) GetMetadata(ctx context.Context, blobID blob.ID) (blob.Metadata, error) {
+	storage, err := s.current(ctx)
+	if err != nil {
+		return blob.Metadata{}, err
+	}
+	return storage.GetMetadata(ctx, blobID)
+}
+
+func (s *webIdentityS3Storage) ListBlobs(ctx context.Context, blobIDPrefix blob.ID, cb func(bm blob.Metadata) error) error {
+	storage, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+	return storage.ListBlobs(ctx, blobIDPrefix, cb)
+}
+
+func (s *webIdentityS3Storage) PutBlob(ctx context.Context, blobID blob.ID, data blob.Bytes, opts blob.PutOptions) error {
+	storage, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+	return storage.PutBlob(ctx, blobID, data, opts)
+}
+
+func (s *webIdentityS3Storage) DeleteBlob(ctx context.Context, blobID blob.ID) error {
+	storage, err := s.current(ctx)
+	if err != nil {
+		return err
+	}
+	return storage.DeleteBlob(ctx, blobID)
+}
+
+func (s *webIdentityS3Storage) ConnectionInfo() blob.ConnectionInfo {
+	options := s.options
+	return blob.ConnectionInfo{
+		Type:   webIdentityS3StorageType,
+		Config: &options,
+	}
+}
+
+func (s *webIdentityS3Storage) DisplayName() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.storage.DisplayName()
+}
+
+func (s *webIdentityS3Storage) Close(ctx context.Context) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.storage.Close(ctx)
+}
+
+func (s *webIdentityS3Storage) FlushCaches(ctx context.Context) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.storage.FlushCaches(ctx)
+}
+
+func init() {
+	blob.AddSupportedStorage(webIdentityS3StorageType, WebIdentityS3Options{}, newWebIdentityS3Storage)
+}
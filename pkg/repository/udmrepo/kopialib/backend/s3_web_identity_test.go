@@ -0,0 +1,139 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/repo/blob/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWebIdentityCredentials struct {
+	validFor time.Duration
+	err      error
+	gets     int
+}
+
+func (f *fakeWebIdentityCredentials) GetWithContext(credentials.Context) (credentials.Value, error) {
+	if f.err != nil {
+		return credentials.Value{}, f.err
+	}
+
+	f.gets++
+	return credentials.Value{
+		AccessKeyID:     fmt.Sprintf("key-%d", f.gets),
+		SecretAccessKey: fmt.Sprintf("secret-%d", f.gets),
+		SessionToken:    fmt.Sprintf("token-%d", f.gets),
+	}, nil
+}
+
+func (f *fakeWebIdentityCredentials) ExpiresAt() (time.Time, error) {
+	return time.Now().Add(f.validFor), nil
+}
+
+type fakeS3Storage struct {
+	blob.Storage
+	options s3.Options
+}
+
+func (f *fakeS3Storage) GetCapacity(context.Context) (blob.Capacity, error) {
+	return blob.Capacity{}, nil
+}
+
+func TestWebIdentityS3Storage(t *testing.T) {
+	defaultNewWebIdentityCredentials := newWebIdentityCredentials
+
+	tests := []struct {
+		name         string
+		credentials  *fakeWebIdentityCredentials
+		calls        int
+		expectedGets int
+		expectedErr  string
+	}{
+		{
+			name:         "credentials are reused until they expire",
+			credentials:  &fakeWebIdentityCredentials{validFor: time.Hour},
+			calls:        3,
+			expectedGets: 1,
+		},
+		{
+			name:         "expired credentials are refreshed",
+			credentials:  &fakeWebIdentityCredentials{validFor: -time.Second},
+			calls:        3,
+			expectedGets: 4,
+		},
+		{
+			name:        "error getting the credentials",
+			credentials: &fakeWebIdentityCredentials{err: errors.New("fake-error")},
+			expectedErr: "error to get credentials with web identity: fake-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newWebIdentityCredentials = func(*WebIdentityS3Options) (webIdentityCredentials, error) {
+				return test.credentials, nil
+			}
+			var storages []*fakeS3Storage
+			newS3Storage = func(ctx context.Context, opt *s3.Options, isCreate bool) (blob.Storage, error) {
+				storage := &fakeS3Storage{options: *opt}
+				storages = append(storages, storage)
+				return storage, nil
+			}
+			defer func() {
+				newWebIdentityCredentials = defaultNewWebIdentityCredentials
+				newS3Storage = s3.New
+			}()
+
+			options := &WebIdentityS3Options{
+				Options:              s3.Options{BucketName: "fake-bucket"},
+				RoleARN:              "fake-role",
+				WebIdentityTokenFile: "fake-token-file",
+			}
+			storage, err := newWebIdentityS3Storage(context.Background(), options, false)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for i := 0; i < test.calls; i++ {
+				_, err := storage.GetCapacity(context.Background())
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedGets, test.credentials.gets)
+			require.Len(t, storages, test.expectedGets)
+			last := storages[len(storages)-1].options
+			assert.Equal(t, fmt.Sprintf("key-%d", test.expectedGets), last.AccessKeyID)
+			assert.Equal(t, fmt.Sprintf("secret-%d", test.expectedGets), last.SecretAccessKey)
+			assert.Equal(t, fmt.Sprintf("token-%d", test.expectedGets), last.SessionToken)
+
+			info := storage.ConnectionInfo()
+			assert.Equal(t, webIdentityS3StorageType, info.Type)
+			assert.Equal(t, options, info.Config)
+		})
+	}
+}
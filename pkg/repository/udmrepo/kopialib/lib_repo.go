@@ -24,11 +24,14 @@ import (
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
 	"github.com/kopia/kopia/repo/compression"
+	"github.com/kopia/kopia/repo/content"
 	"github.com/kopia/kopia/repo/content/index"
 	"github.com/kopia/kopia/repo/maintenance"
 	"github.com/kopia/kopia/repo/manifest"
 	"github.com/kopia/kopia/repo/object"
+	"github.com/kopia/kopia/snapshot"
 	"github.com/kopia/kopia/snapshot/snapshotmaintenance"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -284,6 +287,75 @@ func (kr *kopiaRepository) Time() time.Time {
 	return kr.rawRepo.Time()
 }
 
+func (kr *kopiaRepository) Statistics(ctx context.Context) (*udmrepo.RepoStatistics, error) {
+	if kr.rawRepo == nil {
+		return nil, errors.New("repo is closed or not open")
+	}
+
+	dr, ok := kr.rawRepo.(repo.DirectRepository)
+	if !ok {
+		return nil, errors.New("repo doesn't support statistics")
+	}
+
+	repoCtx := logging.SetupKopiaLog(ctx, kr.logger)
+	stats := &udmrepo.RepoStatistics{}
+
+	err := dr.BlobReader().ListBlobs(repoCtx, "", func(bm blob.Metadata) error {
+		stats.BlobCount++
+		stats.BlobSize += bm.Length
+
+		if isPackBlob(bm.BlobID) {
+			stats.PackCount++
+			stats.PackSize += bm.Length
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list blobs")
+	}
+
+	err = dr.ContentReader().IterateContents(repoCtx, content.IterateOptions{}, func(ci content.Info) error {
+		stats.ContentCount++
+		stats.ContentSize += int64(ci.GetOriginalLength())
+		stats.ContentStoredSize += int64(ci.GetPackedLength())
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to iterate contents")
+	}
+
+	manifestIDs, err := snapshot.ListSnapshotManifests(repoCtx, kr.rawRepo, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list snapshots")
+	}
+
+	snapshots, err := snapshot.LoadSnapshots(repoCtx, kr.rawRepo, manifestIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to load snapshots")
+	}
+
+	for _, snap := range snapshots {
+		stats.Snapshots = append(stats.Snapshots, udmrepo.SnapshotStatistics{
+			ID:          udmrepo.ID(snap.ID),
+			Source:      snap.Source.Path,
+			Tags:        snap.Tags,
+			StartTime:   snap.StartTime.ToTime(),
+			EndTime:     snap.EndTime.ToTime(),
+			LogicalSize: snap.Stats.TotalFileSize,
+			Incomplete:  snap.IncompleteReason != "",
+		})
+	}
+
+	return stats, nil
+}
+
+func isPackBlob(id blob.ID) bool {
+	return strings.HasPrefix(string(id), string(content.PackBlobIDPrefixRegular)) ||
+		strings.HasPrefix(string(id), string(content.PackBlobIDPrefixSpecial))
+}
+
 func (kr *kopiaRepository) Close(ctx context.Context) error {
 	if kr.rawWriter != nil {
 		err := kr.rawWriter.Close(logging.SetupKopiaLog(ctx, kr.logger))
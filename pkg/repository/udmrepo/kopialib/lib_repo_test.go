@@ -538,6 +538,55 @@ func TestFindManifests(t *testing.T) {
 	}
 }
 
+func TestStatistics(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rawRepo     *repomocks.DirectRepository
+		storage     *repomocks.Storage
+		retErr      error
+		expectedErr string
+	}{
+		{
+			name:        "raw repo is nil",
+			expectedErr: "repo is closed or not open",
+		},
+		{
+			name:        "list blobs fail",
+			rawRepo:     repomocks.NewDirectRepository(t),
+			storage:     repomocks.NewStorage(t),
+			retErr:      errors.New("fake-list-error"),
+			expectedErr: "error to list blobs: fake-list-error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kr := &kopiaRepository{}
+
+			if tc.rawRepo != nil {
+				tc.storage.On("ListBlobs", mock.Anything, mock.Anything, mock.Anything).Return(tc.retErr)
+				tc.rawRepo.On("BlobReader").Return(tc.storage)
+				kr.rawRepo = tc.rawRepo
+			}
+
+			_, err := kr.Statistics(context.Background())
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestIsPackBlob(t *testing.T) {
+	assert.True(t, isPackBlob("p0123456789"))
+	assert.True(t, isPackBlob("q0123456789"))
+	assert.False(t, isPackBlob("xn0_0123456789"))
+	assert.False(t, isPackBlob("kopia.repository"))
+}
+
 func TestClose(t *testing.T) {
 	testCases := []struct {
 		name            string
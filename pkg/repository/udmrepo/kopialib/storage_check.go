@@ -0,0 +1,257 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/pkg/errors"
+)
+
+// StorageCheck is the result of a check of the storage operations the backup
+// repositories rely on.
+type StorageCheck struct {
+	// Name is the name of the check.
+	Name string
+	// Duration is how long the check took.
+	Duration time.Duration
+	// Err is why the check failed, it's nil if the check passed.
+	Err error
+}
+
+// storageCheck runs the checks of the storage operations against a storage, each check
+// relying on the blobs written by the previous ones.
+type storageCheck struct {
+	ctx     context.Context
+	st      blob.Storage
+	content []byte
+	checks  []StorageCheck
+}
+
+// CheckStorage runs a suite of checks of the storage operations the backup repositories
+// rely on against the storage, and returns the result of each check in the order they
+// were run. The storage should be dedicated to the checks, the blobs written are deleted
+// once the checks complete.
+func CheckStorage(ctx context.Context, storageType string, storageOptions map[string]string) []StorageCheck {
+	c := &storageCheck{
+		ctx:     ctx,
+		content: []byte("velero storage check of the backup repositories"),
+	}
+
+	c.run("Connect", func() error {
+		backendStore, err := setupBackendStore(ctx, storageType, storageOptions)
+		if err != nil {
+			return errors.Wrap(err, "error to setup backend storage")
+		}
+
+		c.st, err = backendStore.store.Connect(ctx, true)
+		return errors.Wrap(err, "error to connect to storage")
+	})
+	if c.st == nil {
+		return c.checks
+	}
+	defer c.close()
+
+	c.run("PutBlob", c.checkPutBlob)
+	c.run("GetBlob", c.checkGetBlob)
+	c.run("GetBlobRange", c.checkGetBlobRange)
+	c.run("GetMetadata", c.checkGetMetadata)
+	c.run("ListBlobs", c.checkListBlobs)
+	c.run("DeleteBlob", c.checkDeleteBlob)
+	c.run("BlobNotFound", c.checkBlobNotFound)
+
+	return c.checks
+}
+
+func (c *storageCheck) run(name string, check func() error) {
+	start := time.Now()
+	err := check()
+	c.checks = append(c.checks, StorageCheck{
+		Name:     name,
+		Duration: time.Since(start),
+		Err:      err,
+	})
+}
+
+// close deletes the blobs left behind by the failed checks and closes the storage.
+func (c *storageCheck) close() {
+	var ids []blob.ID
+	if err := c.st.ListBlobs(c.ctx, "", func(bm blob.Metadata) error {
+		ids = append(ids, bm.BlobID)
+		return nil
+	}); err == nil {
+		for _, id := range ids {
+			_ = c.st.DeleteBlob(c.ctx, id)
+		}
+	}
+
+	_ = c.st.Close(c.ctx)
+}
+
+func (c *storageCheck) get(id blob.ID, offset, length int64) ([]byte, error) {
+	var output storageCheckBuffer
+	if err := c.st.GetBlob(c.ctx, id, offset, length, &output); err != nil {
+		return nil, errors.Wrapf(err, "error to get blob %s", id)
+	}
+
+	return output.Bytes(), nil
+}
+
+func (c *storageCheck) checkPutBlob() error {
+	err := c.st.PutBlob(c.ctx, "velero-check", storageCheckBytes(c.content), blob.PutOptions{})
+	return errors.Wrap(err, "error to put blob velero-check")
+}
+
+func (c *storageCheck) checkGetBlob() error {
+	content, err := c.get("velero-check", 0, -1)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(content, c.content) {
+		return errors.Errorf("got content %q, expected %q", content, c.content)
+	}
+
+	return nil
+}
+
+func (c *storageCheck) checkGetBlobRange() error {
+	var offset, length int64 = 7, 13
+	content, err := c.get("velero-check", offset, length)
+	if err != nil {
+		return err
+	}
+
+	if expected := c.content[offset : offset+length]; !bytes.Equal(content, expected) {
+		return errors.Errorf("got content %q, expected %q", content, expected)
+	}
+
+	return nil
+}
+
+func (c *storageCheck) checkGetMetadata() error {
+	bm, err := c.st.GetMetadata(c.ctx, "velero-check")
+	if err != nil {
+		return errors.Wrap(err, "error to get metadata of blob velero-check")
+	}
+
+	if bm.Length != int64(len(c.content)) {
+		return errors.Errorf("got length %d, expected %d", bm.Length, len(c.content))
+	}
+
+	return nil
+}
+
+func (c *storageCheck) checkListBlobs() error {
+	for _, id := range []blob.ID{"velero-list-a", "velero-list-b", "velero-other"} {
+		if err := c.st.PutBlob(c.ctx, id, storageCheckBytes(id), blob.PutOptions{}); err != nil {
+			return errors.Wrapf(err, "error to put blob %s", id)
+		}
+	}
+
+	var ids []string
+	if err := c.st.ListBlobs(c.ctx, "velero-list-", func(bm blob.Metadata) error {
+		if bm.Length != int64(len(bm.BlobID)) {
+			return errors.Errorf("listed blob %s with length %d, expected %d", bm.BlobID, bm.Length, len(bm.BlobID))
+		}
+		ids = append(ids, string(bm.BlobID))
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "error to list blobs")
+	}
+	sort.Strings(ids)
+
+	if len(ids) != 2 || ids[0] != "velero-list-a" || ids[1] != "velero-list-b" {
+		return errors.Errorf("listed blobs %v, expected [velero-list-a velero-list-b]", ids)
+	}
+
+	return nil
+}
+
+func (c *storageCheck) checkDeleteBlob() error {
+	for _, id := range []blob.ID{"velero-check", "velero-list-a", "velero-list-b", "velero-other"} {
+		if err := c.st.DeleteBlob(c.ctx, id); err != nil {
+			return errors.Wrapf(err, "error to delete blob %s", id)
+		}
+	}
+
+	var ids []blob.ID
+	if err := c.st.ListBlobs(c.ctx, "velero-", func(bm blob.Metadata) error {
+		ids = append(ids, bm.BlobID)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "error to list blobs")
+	}
+
+	if len(ids) > 0 {
+		return errors.Errorf("listed blobs %v after deleting them", ids)
+	}
+
+	return nil
+}
+
+// checkBlobNotFound checks that reading a missing blob returns blob.ErrBlobNotFound, as
+// the repository tells a missing blob from a failure by it.
+func (c *storageCheck) checkBlobNotFound() error {
+	if _, err := c.get("velero-check", 0, -1); !errors.Is(err, blob.ErrBlobNotFound) {
+		return errors.Errorf("got error %v getting a deleted blob, expected %v", err, blob.ErrBlobNotFound)
+	}
+
+	if _, err := c.st.GetMetadata(c.ctx, "velero-check"); !errors.Is(err, blob.ErrBlobNotFound) {
+		return errors.Errorf("got error %v getting metadata of a deleted blob, expected %v", err, blob.ErrBlobNotFound)
+	}
+
+	return nil
+}
+
+// storageCheckBytes is the content of a blob put by the checks.
+type storageCheckBytes []byte
+
+func (b storageCheckBytes) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+func (b storageCheckBytes) Length() int {
+	return len(b)
+}
+
+func (b storageCheckBytes) Reader() io.ReadSeekCloser {
+	return storageCheckReader{bytes.NewReader(b)}
+}
+
+type storageCheckReader struct {
+	*bytes.Reader
+}
+
+func (storageCheckReader) Close() error {
+	return nil
+}
+
+// storageCheckBuffer receives the content of a blob got by the checks.
+type storageCheckBuffer struct {
+	bytes.Buffer
+}
+
+func (b *storageCheckBuffer) Length() int {
+	return b.Len()
+}
@@ -0,0 +1,81 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib/backend"
+)
+
+func TestCheckStorage(t *testing.T) {
+	defaultBackendStores := backendStores
+	backendStores = []kopiaBackendStore{
+		{udmrepo.StorageTypeFs, "a filesystem", &backend.FsBackend{}},
+	}
+	defer func() {
+		backendStores = defaultBackendStores
+	}()
+
+	t.Run("unknown storage type", func(t *testing.T) {
+		checks := CheckStorage(context.Background(), "fake-type", nil)
+		require.Len(t, checks, 1)
+		assert.Equal(t, "Connect", checks[0].Name)
+		assert.EqualError(t, checks[0].Err, "error to setup backend storage: error to find storage type")
+	})
+
+	t.Run("filesystem storage", func(t *testing.T) {
+		path := t.TempDir()
+		checks := CheckStorage(context.Background(), udmrepo.StorageTypeFs, map[string]string{
+			udmrepo.StoreOptionFsPath: path,
+			udmrepo.StoreOptionPrefix: "velero-check",
+		})
+
+		var names []string
+		for _, check := range checks {
+			names = append(names, check.Name)
+			assert.NoError(t, check.Err, check.Name)
+		}
+		assert.Equal(t, []string{
+			"Connect",
+			"PutBlob",
+			"GetBlob",
+			"GetBlobRange",
+			"GetMetadata",
+			"ListBlobs",
+			"DeleteBlob",
+			"BlobNotFound",
+		}, names)
+
+		// the blobs of the checks are deleted, only the sharding config of the storage is left
+		var files []string
+		require.NoError(t, filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && info.Name() != ".shards" {
+				files = append(files, path)
+			}
+			return err
+		}))
+		assert.Empty(t, files)
+	})
+}
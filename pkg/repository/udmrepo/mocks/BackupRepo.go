@@ -155,6 +155,29 @@ func (_m *BackupRepo) PutManifest(ctx context.Context, mani udmrepo.RepoManifest
 	return r0, r1
 }
 
+// Statistics provides a mock function with given fields: ctx
+func (_m *BackupRepo) Statistics(ctx context.Context) (*udmrepo.RepoStatistics, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *udmrepo.RepoStatistics
+	if rf, ok := ret.Get(0).(func(context.Context) *udmrepo.RepoStatistics); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*udmrepo.RepoStatistics)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Time provides a mock function with given fields:
 func (_m *BackupRepo) Time() time.Time {
 	ret := _m.Called()
@@ -41,6 +41,13 @@ type ManifestFilter struct {
 	Labels map[string]string
 }
 
+const (
+	// ManifestTypeLabel is the label of the manifests describing the type of the manifest data,
+	// the manifests of the snapshots saved by the uploader are labeled with ManifestTypeSnapshot
+	ManifestTypeLabel    = "type"
+	ManifestTypeSnapshot = "snapshot"
+)
+
 const (
 	// Below consts descrbe the data type of one object.
 	// Metadata: This type describes how the data is organized.
@@ -119,10 +126,37 @@ type BackupRepo interface {
 	// Time returns the local time of the backup repository. It may be different from the time of the caller
 	Time() time.Time
 
+	// Statistics collects the statistics of the data and the snapshots stored in the backup repository.
+	// It iterates all the blobs and contents, so it may take long for a large repository.
+	Statistics(ctx context.Context) (*RepoStatistics, error)
+
 	// Close closes the backup repository
 	Close(ctx context.Context) error
 }
 
+// RepoStatistics is the statistics of the data stored in a backup repository
+type RepoStatistics struct {
+	BlobCount         int64                // The number of all the blobs in the backup storage
+	BlobSize          int64                // The total size of all the blobs in the backup storage
+	PackCount         int64                // The number of the pack blobs that keep the contents
+	PackSize          int64                // The total size of the pack blobs
+	ContentCount      int64                // The number of the deduplicated contents
+	ContentSize       int64                // The logical size of the deduplicated contents, before compression
+	ContentStoredSize int64                // The size of the deduplicated contents after compression and encryption
+	Snapshots         []SnapshotStatistics // The snapshots in the backup repository
+}
+
+// SnapshotStatistics is the statistics of one snapshot in a backup repository
+type SnapshotStatistics struct {
+	ID          ID                // The ID of the snapshot
+	Source      string            // The source that the snapshot is taken from
+	Tags        map[string]string // The tags saved together with the snapshot
+	StartTime   time.Time         // The time the snapshot started
+	EndTime     time.Time         // The time the snapshot completed
+	LogicalSize int64             // The total size of the files in the snapshot, before deduplication
+	Incomplete  bool              // Whether the snapshot is incomplete, e.g. a checkpoint
+}
+
 type ObjectReader interface {
 	io.ReadCloser
 	io.Seeker
@@ -44,6 +44,10 @@ const (
 	StoreOptionS3DisableTLSVerify = "skipTLSVerify"
 	StoreOptionS3CustomCA         = "customCA"
 
+	StoreOptionS3RoleARN              = "roleARN"
+	StoreOptionS3WebIdentityTokenFile = "webIdentityTokenFile"
+	StoreOptionS3SessionDuration      = "sessionDuration"
+
 	StoreOptionAzureKey            = "storageKey"
 	StoreOptionAzureDomain         = "storageDomain"
 	StoreOptionAzureStorageAccount = "storageAccount"
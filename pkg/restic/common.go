@@ -44,6 +44,11 @@ const (
 	// resticInsecureTLSFlag is the flag for Restic command line to indicate
 	// skip TLS verify on https connection.
 	resticInsecureTLSFlag = "--insecure-tls"
+
+	// the environment variables the AWS SDKs read the web identity from
+	awsRoleARNEnvVar              = "AWS_ROLE_ARN"
+	awsWebIdentityTokenFileEnvVar = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	awsRoleSessionNameEnvVar      = "AWS_ROLE_SESSION_NAME"
 )
 
 // TempCACertFile creates a temp file containing a CA bundle
@@ -84,6 +89,10 @@ func CmdEnv(backupLocation *velerov1api.BackupStorageLocation, credentialFileSto
 		config = map[string]string{}
 	}
 
+	if err := credentials.ValidateLocationCredential(backupLocation); err != nil {
+		return []string{}, err
+	}
+
 	if backupLocation.Spec.Credential != nil {
 		credsFile, err := credentialFileStore.Path(backupLocation.Spec.Credential)
 		if err != nil {
@@ -93,6 +102,9 @@ func CmdEnv(backupLocation *velerov1api.BackupStorageLocation, credentialFileSto
 	}
 
 	backendType := repoconfig.GetBackendType(backupLocation.Spec.Provider, backupLocation.Spec.Config)
+	if backupLocation.Spec.WebIdentity != nil && backendType != repoconfig.AWSBackend {
+		return []string{}, errors.Errorf("web identity is not supported by provider %s", backupLocation.Spec.Provider)
+	}
 
 	switch backendType {
 	case repoconfig.AWSBackend:
@@ -112,6 +124,14 @@ func CmdEnv(backupLocation *velerov1api.BackupStorageLocation, credentialFileSto
 		}
 	}
 
+	// restic exchanges the web identity token for short-lived credentials
+	// and refreshes them by itself when the role and token are in its env
+	if backupLocation.Spec.WebIdentity != nil {
+		customEnv[awsRoleARNEnvVar] = backupLocation.Spec.WebIdentity.RoleARN
+		customEnv[awsWebIdentityTokenFileEnvVar] = credentials.WebIdentityTokenFile(backupLocation.Spec.WebIdentity)
+		customEnv[awsRoleSessionNameEnvVar] = credentials.WebIdentitySessionName
+	}
+
 	for k, v := range customEnv {
 		env = append(env, fmt.Sprintf("%s=%s", k, v))
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -139,3 +140,50 @@ func TestGetInsecureSkipTLSVerifyFromBSL(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdEnvWebIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		location    *velerov1api.BackupStorageLocation
+		expectedEnv []string
+		expectedErr string
+	}{
+		{
+			name: "the web identity of an AWS location is in the env",
+			location: builder.ForBackupStorageLocation("velero", "default").Provider("aws").WebIdentity(&velerov1api.WebIdentity{
+				RoleARN: "arn:aws:iam::123456789012:role/velero",
+			}).Result(),
+			expectedEnv: []string{
+				"AWS_ROLE_ARN=arn:aws:iam::123456789012:role/velero",
+				"AWS_WEB_IDENTITY_TOKEN_FILE=/var/run/secrets/velero/serviceaccount/token",
+				"AWS_ROLE_SESSION_NAME=velero",
+			},
+		},
+		{
+			name: "the web identity of a location of another provider isn't supported",
+			location: builder.ForBackupStorageLocation("velero", "default").Provider("azure").WebIdentity(&velerov1api.WebIdentity{
+				RoleARN: "role",
+			}).Result(),
+			expectedErr: "web identity is not supported by provider azure",
+		},
+		{
+			name: "a location can't have both a credential and a web identity",
+			location: builder.ForBackupStorageLocation("velero", "default").Provider("aws").Credential(
+				builder.ForSecretKeySelector("secret", "key").Result(),
+			).WebIdentity(&velerov1api.WebIdentity{RoleARN: "role"}).Result(),
+			expectedErr: "backup storage location default can't define both a credential and a web identity",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env, err := CmdEnv(test.location, velerotest.NewFakeCredentialsFileStore("", nil))
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Subset(t, env, test.expectedEnv)
+		})
+	}
+}
@@ -17,15 +17,19 @@ limitations under the License.
 package restore
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"sort"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -56,12 +60,40 @@ type Request struct {
 	VolumeSnapshots    []*volume.Snapshot
 	BackupReader       io.Reader
 	RestoredItems      map[itemKey]restoredItemStatus
+	FilteredItems      map[itemKey]string
 	itemOperationsList *[]*itemoperation.RestoreOperation
+	hookTracker        *hook.HookTracker
+	ctxOnce            sync.Once
+	ctx                context.Context
+	cancelFunc         context.CancelFunc
 }
 
 type restoredItemStatus struct {
 	action     string
 	itemExists bool
+	// outcome is the detailed result of the item, it's reported in the
+	// restore's item status list.
+	outcome    results.ItemOutcome
+	reason     string
+	modifiedBy []string
+}
+
+// getOutcome returns the outcome of the item, deriving it from the action
+// if it wasn't set explicitly.
+func (s restoredItemStatus) getOutcome() results.ItemOutcome {
+	if s.outcome != "" {
+		return s.outcome
+	}
+	switch s.action {
+	case itemRestoreResultCreated:
+		return results.ItemOutcomeCreated
+	case itemRestoreResultUpdated:
+		return results.ItemOutcomeUpdated
+	case itemRestoreResultSkipped:
+		return results.ItemOutcomeSkippedFiltered
+	default:
+		return results.ItemOutcomeFailed
+	}
 }
 
 // GetItemOperationsList returns ItemOperationsList, initializing it if necessary
@@ -73,6 +105,35 @@ func (r *Request) GetItemOperationsList() *[]*itemoperation.RestoreOperation {
 	return r.itemOperationsList
 }
 
+// GetHookTracker returns the tracker of the restore's exec hook executions, initializing it if necessary
+func (r *Request) GetHookTracker() *hook.HookTracker {
+	if r.hookTracker == nil {
+		r.hookTracker = hook.NewHookTracker()
+	}
+	return r.hookTracker
+}
+
+// Context returns the context of the restore, it is done once the restore is canceled
+func (r *Request) Context() context.Context {
+	r.ctxOnce.Do(r.initContext)
+	return r.ctx
+}
+
+// Cancel requests to cancel the restore, it is safe to be called from other goroutines
+func (r *Request) Cancel() {
+	r.ctxOnce.Do(r.initContext)
+	r.cancelFunc()
+}
+
+// IsCancelled returns whether the restore is canceled
+func (r *Request) IsCancelled() bool {
+	return r.Context().Err() != nil
+}
+
+func (r *Request) initContext() {
+	r.ctx, r.cancelFunc = context.WithCancel(context.Background())
+}
+
 // RestoredResourceList returns the list of restored resources grouped by the API
 // Version and Kind
 func (r *Request) RestoredResourceList() map[string][]string {
@@ -93,3 +154,46 @@ func (r *Request) RestoredResourceList() map[string][]string {
 
 	return resources
 }
+
+// RestoredItemStatusList returns the outcome of every item the restore
+// processed, including the items filtered out, sorted by resource,
+// namespace and name.
+func (r *Request) RestoredItemStatusList() []results.ItemStatus {
+	list := make([]results.ItemStatus, 0, len(r.RestoredItems)+len(r.FilteredItems))
+	for i, item := range r.RestoredItems {
+		list = append(list, results.ItemStatus{
+			Resource:   i.resource,
+			Namespace:  i.namespace,
+			Name:       i.name,
+			Outcome:    item.getOutcome(),
+			Reason:     item.reason,
+			ModifiedBy: item.modifiedBy,
+		})
+	}
+	for i, reason := range r.FilteredItems {
+		// an item filtered out at one point could still be restored
+		// later, e.g. as an additional item of a restore item action
+		if _, exists := r.RestoredItems[i]; exists {
+			continue
+		}
+		list = append(list, results.ItemStatus{
+			Resource:  i.resource,
+			Namespace: i.namespace,
+			Name:      i.name,
+			Outcome:   results.ItemOutcomeSkippedFiltered,
+			Reason:    reason,
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Resource != list[j].Resource {
+			return list[i].Resource < list[j].Resource
+		}
+		if list[i].Namespace != list[j].Namespace {
+			return list[i].Namespace < list[j].Namespace
+		}
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	coreV1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 func TestResourceKey(t *testing.T) {
@@ -65,3 +67,77 @@ func TestRestoredResourceList(t *testing.T) {
 
 	assert.EqualValues(t, expected, request.RestoredResourceList())
 }
+
+func TestRestoredItemStatusList(t *testing.T) {
+	request := &Request{
+		RestoredItems: map[itemKey]restoredItemStatus{
+			{
+				resource:  "v1/Namespace",
+				namespace: "",
+				name:      "default",
+			}: {action: "created"},
+			{
+				resource:  "v1/ConfigMap",
+				namespace: "default",
+				name:      "cm-1",
+			}: {action: "failed", outcome: results.ItemOutcomeFailed, reason: "error restoring cm-1"},
+			{
+				resource:  "v1/ConfigMap",
+				namespace: "default",
+				name:      "cm-2",
+			}: {action: "updated", modifiedBy: []string{"velero.io/change-image-name"}},
+		},
+		FilteredItems: map[itemKey]string{
+			{
+				resource:  "v1/ConfigMap",
+				namespace: "default",
+				name:      "cm-3",
+			}: "label selector doesn't match",
+			{
+				resource:  "v1/ConfigMap",
+				namespace: "default",
+				name:      "cm-1",
+			}: "namespace is excluded",
+		},
+	}
+
+	expected := []results.ItemStatus{
+		{
+			Resource:  "v1/ConfigMap",
+			Namespace: "default",
+			Name:      "cm-1",
+			Outcome:   results.ItemOutcomeFailed,
+			Reason:    "error restoring cm-1",
+		},
+		{
+			Resource:   "v1/ConfigMap",
+			Namespace:  "default",
+			Name:       "cm-2",
+			Outcome:    results.ItemOutcomeUpdated,
+			ModifiedBy: []string{"velero.io/change-image-name"},
+		},
+		{
+			Resource:  "v1/ConfigMap",
+			Namespace: "default",
+			Name:      "cm-3",
+			Outcome:   results.ItemOutcomeSkippedFiltered,
+			Reason:    "label selector doesn't match",
+		},
+		{
+			Resource: "v1/Namespace",
+			Name:     "default",
+			Outcome:  results.ItemOutcomeCreated,
+		},
+	}
+
+	assert.Equal(t, expected, request.RestoredItemStatusList())
+}
+
+func TestRequestCancel(t *testing.T) {
+	req := &Request{}
+	assert.False(t, req.IsCancelled())
+
+	req.Cancel()
+	assert.True(t, req.IsCancelled())
+	assert.Error(t, req.Context().Err())
+}
@@ -232,7 +232,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	ctx, cancelFunc := go_context.WithTimeout(req.Context(), podVolumeTimeout)
 	defer cancelFunc()
 
 	var podVolumeRestorer podvolume.Restorer
@@ -253,6 +253,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
+		HookTracker: req.GetHookTracker(),
 	}
 
 	pvRestorer := &pvRestorer{
@@ -267,6 +268,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	}
 
 	req.RestoredItems = make(map[itemKey]restoredItemStatus)
+	req.FilteredItems = make(map[itemKey]string)
 
 	restoreCtx := &restoreContext{
 		backup:                         req.Backup,
@@ -294,6 +296,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		resourceTimeout:                kr.resourceTimeout,
 		resourceClients:                make(map[resourceClientKey]client.Dynamic),
 		restoredItems:                  req.RestoredItems,
+		filteredItems:                  req.FilteredItems,
 		renamedPVs:                     make(map[string]string),
 		pvRenamer:                      kr.pvRenamer,
 		discoveryHelper:                kr.discoveryHelper,
@@ -305,6 +308,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		hooksCancelFunc:                hooksCancelFunc,
 		kbClient:                       kr.kbClient,
 		itemOperationsList:             req.GetItemOperationsList(),
+		cancelContext:                  req.Context(),
 	}
 
 	return restoreCtx.execute()
@@ -338,6 +342,7 @@ type restoreContext struct {
 	resourceTimeout                time.Duration
 	resourceClients                map[resourceClientKey]client.Dynamic
 	restoredItems                  map[itemKey]restoredItemStatus
+	filteredItems                  map[itemKey]string
 	renamedPVs                     map[string]string
 	pvRenamer                      func(string) (string, error)
 	discoveryHelper                discovery.Helper
@@ -350,6 +355,7 @@ type restoreContext struct {
 	hooksCancelFunc                go_context.CancelFunc
 	kbClient                       crclient.Client
 	itemOperationsList             *[]*itemoperation.RestoreOperation
+	cancelContext                  go_context.Context
 }
 
 type resourceClientKey struct {
@@ -601,6 +607,11 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	return warnings, errs
 }
 
+// isCancelled returns whether the restore is requested to be canceled.
+func (ctx *restoreContext) isCancelled() bool {
+	return ctx.cancelContext != nil && ctx.cancelContext.Err() != nil
+}
+
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
 // in the expected total restore count.
@@ -616,6 +627,11 @@ func (ctx *restoreContext) processSelectedResource(
 
 	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
 		for _, selectedItem := range selectedItems {
+			if ctx.isCancelled() {
+				ctx.log.Infof("Restore is canceled, skipping the remaining items of %s", groupResource.String())
+				return processedItems, warnings, errs
+			}
+
 			// If we don't know whether this namespace exists yet, attempt to create
 			// it in order to ensure it exists. Try to get it from the backup tarball
 			// (in order to get any backed-up metadata), but if we don't find it there,
@@ -961,6 +977,19 @@ func getResourceID(groupResource schema.GroupResource, namespace, name string) s
 	return fmt.Sprintf("%s/%s/%s", groupResource.String(), namespace, name)
 }
 
+// addFilteredItem records an item that isn't restored because it's filtered
+// out, along with the reason, for the restore's item status list.
+func (ctx *restoreContext) addFilteredItem(obj *unstructured.Unstructured, namespace, reason string) {
+	if ctx.filteredItems == nil {
+		return
+	}
+	ctx.filteredItems[itemKey{
+		resource:  resourceKey(obj),
+		namespace: namespace,
+		name:      obj.GetName(),
+	}] = reason
+}
+
 func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (results.Result, results.Result, bool) {
 	warnings, errs := results.Result{}, results.Result{}
 	// itemExists bool is used to determine whether to include this item in the "wait for additional items" list
@@ -976,6 +1005,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			"name":          obj.GetName(),
 			"groupResource": groupResource.String(),
 		}).Info("Not restoring item because resource is excluded")
+		ctx.addFilteredItem(obj, namespace, "resource is excluded")
 		return warnings, errs, itemExists
 	}
 
@@ -992,6 +1022,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				"name":          obj.GetName(),
 				"groupResource": groupResource.String(),
 			}).Info("Not restoring item because namespace is excluded")
+			ctx.addFilteredItem(obj, namespace, "namespace is excluded")
 			return warnings, errs, itemExists
 		}
 
@@ -1020,6 +1051,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				"name":          obj.GetName(),
 				"groupResource": groupResource.String(),
 			}).Info("Not restoring item because it's cluster-scoped")
+			ctx.addFilteredItem(obj, namespace, "cluster-scoped resources are excluded")
 			return warnings, errs, itemExists
 		}
 	}
@@ -1035,6 +1067,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 	if complete {
 		ctx.log.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		ctx.addFilteredItem(obj, namespace, "item is complete")
 		return warnings, errs, itemExists
 	}
 
@@ -1052,20 +1085,49 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 	ctx.restoredItems[itemKey] = restoredItemStatus{itemExists: itemExists}
+
+	// skipReason explains why the item isn't restored without it being an
+	// error, existsInCluster records that the item wasn't restored because
+	// it already exists, and modifiedBy the restore item actions that
+	// modified the item. They are all reported in the item status list.
+	var (
+		skipReason      string
+		existsInCluster bool
+		modifiedBy      []string
+	)
 	defer func() {
 		itemStatus := ctx.restoredItems[itemKey]
-		// the action field is set explicitly
-		if len(itemStatus.action) > 0 {
-			return
+		itemStatus.modifiedBy = modifiedBy
+		// the action field isn't set explicitly
+		if len(itemStatus.action) == 0 {
+			if errs.IsEmpty() && warnings.IsEmpty() {
+				// no action specified, and no warnings and errors
+				itemStatus.action = itemRestoreResultSkipped
+			} else {
+				// others are all failed
+				itemStatus.action = itemRestoreResultFailed
+			}
 		}
-		// no action specified, and no warnings and errors
-		if errs.IsEmpty() && warnings.IsEmpty() {
-			itemStatus.action = itemRestoreResultSkipped
-			ctx.restoredItems[itemKey] = itemStatus
-			return
+
+		switch {
+		case itemStatus.action == itemRestoreResultFailed && errs.IsEmpty() && existsInCluster:
+			// the in-cluster version differs from the backed-up one and is
+			// kept, which is reported as a warning
+			itemStatus.outcome = results.ItemOutcomeSkippedExists
+			itemStatus.reason = warnings.FirstMessage()
+		case itemStatus.action == itemRestoreResultFailed:
+			itemStatus.outcome = results.ItemOutcomeFailed
+			itemStatus.reason = errs.FirstMessage()
+			if itemStatus.reason == "" {
+				itemStatus.reason = warnings.FirstMessage()
+			}
+		case itemStatus.action == itemRestoreResultSkipped && existsInCluster:
+			itemStatus.outcome = results.ItemOutcomeSkippedExists
+			itemStatus.reason = skipReason
+		case itemStatus.action == itemRestoreResultSkipped:
+			itemStatus.outcome = results.ItemOutcomeSkippedFiltered
+			itemStatus.reason = skipReason
 		}
-		// others are all failed
-		itemStatus.action = itemRestoreResultFailed
 		ctx.restoredItems[itemKey] = itemStatus
 	}()
 
@@ -1073,6 +1135,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// to the interface.
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
+		skipReason = "pod is a mirror pod"
 		return warnings, errs, itemExists
 	}
 
@@ -1167,6 +1230,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasPodVolumeBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
+			skipReason = "persistent volume is dynamically re-provisioned to restore its pod volume backup"
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1175,6 +1239,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasDeleteReclaimPolicy(obj.Object):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
 			ctx.pvsToProvision.Insert(name)
+			skipReason = "persistent volume is dynamically re-provisioned because it has no snapshot and its reclaim policy is Delete"
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1216,6 +1281,8 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 
 		ctx.log.Infof("Executing item action for %v", &groupResource)
+		// in-process actions may modify the item in place, keep a copy to detect modifications
+		itemBeforeAction := obj.DeepCopy()
 		executeOutput, err := action.RestoreItemAction.Execute(&velero.RestoreItemActionExecuteInput{
 			Item:           obj,
 			ItemFromBackup: itemFromBackup,
@@ -1252,6 +1319,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			skipReason = fmt.Sprintf("discarded by restore item action %s", action.RestoreItemAction.Name())
 			return warnings, errs, itemExists
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1260,6 +1328,9 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs, itemExists
 		}
 
+		if !equality.Semantic.DeepEqual(itemBeforeAction, unstructuredObj) {
+			modifiedBy = append(modifiedBy, action.RestoreItemAction.Name())
+		}
 		obj = unstructuredObj
 
 		var filteredAdditionalItems []velero.ResourceIdentifier
@@ -1386,6 +1457,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	if fromCluster != nil {
 		itemExists = true
+		existsInCluster = true
 		itemStatus := ctx.restoredItems[itemKey]
 		itemStatus.itemExists = itemExists
 		ctx.restoredItems[itemKey] = itemStatus
@@ -1423,6 +1495,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if patchBytes == nil {
 					// In-cluster and desired state are the same, so move on to
 					// the next item.
+					skipReason = "already exists in the cluster and is the same as the backed up version"
 					return warnings, errs, itemExists
 				}
 
@@ -1488,6 +1561,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 
 		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+		skipReason = "already exists in the cluster and is the same as the backed up version"
 		return warnings, errs, itemExists
 	}
 
@@ -2082,6 +2156,7 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 		}
 
 		if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+			ctx.addFilteredItem(obj, targetNamespace, "label selector doesn't match")
 			continue
 		}
 
@@ -2103,6 +2178,7 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 
 		if skipItem {
 			ctx.log.Infof("restore orSelector labels did not match, skipping restore of item: %s", skipItem, item)
+			ctx.addFilteredItem(obj, targetNamespace, "none of the orLabelSelectors match")
 			continue
 		}
 
@@ -1003,8 +1003,13 @@ func TestRestoreItems(t *testing.T) {
 				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
 			},
 			expectedRestoreItems: map[itemKey]restoredItemStatus{
-				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:          {action: "created", itemExists: true},
-				{resource: "v1/ServiceAccount", namespace: "ns-1", name: "sa-1"}: {action: "skipped", itemExists: true},
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}: {action: "created", itemExists: true},
+				{resource: "v1/ServiceAccount", namespace: "ns-1", name: "sa-1"}: {
+					action:     "skipped",
+					itemExists: true,
+					outcome:    ItemOutcomeSkippedExists,
+					reason:     "already exists in the cluster and is the same as the backed up version",
+				},
 			},
 		},
 		{
@@ -18,6 +18,8 @@ package test
 
 import (
 	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // FileStore defines operations for interacting with credentials
@@ -26,6 +28,11 @@ type FileStore interface {
 	// Path returns a path on disk where the secret key defined by
 	// the given selector is serialized.
 	Path(selector *corev1api.SecretKeySelector) (string, error)
+
+	// WebIdentityPath returns a path on disk where an AWS shared config
+	// file is serialized, whose default profile assumes the role of the
+	// given web identity with its token.
+	WebIdentityPath(webIdentity *velerov1api.WebIdentity) (string, error)
 }
 
 type fakeCredentialsFileStore struct {
@@ -39,6 +46,13 @@ func (f *fakeCredentialsFileStore) Path(*corev1api.SecretKeySelector) (string, e
 	return f.path, f.err
 }
 
+// WebIdentityPath returns a path on disk where an AWS shared config
+// file is serialized, whose default profile assumes the role of the
+// given web identity with its token.
+func (f *fakeCredentialsFileStore) WebIdentityPath(*velerov1api.WebIdentity) (string, error) {
+	return f.path, f.err
+}
+
 // NewFakeCredentialFileStore creates a FileStore which will return the given path
 // and error when Path is called.
 func NewFakeCredentialsFileStore(path string, err error) FileStore {
@@ -0,0 +1,278 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/restore"
+	"github.com/pkg/errors"
+)
+
+// blockDeviceBufferSize is the size of the buffer used to copy data to a block device,
+// it is also the granularity at which zero blocks are detected during restore
+const blockDeviceBufferSize = 1 << 20
+
+// blockDeviceEntry represents a block device as a single kopia file, so that the whole
+// device is uploaded as one object. Kopia splits the object into content-defined chunks
+// and only uploads the chunks that are not in the repository yet, so the blocks that
+// haven't changed since the parent snapshot and the zero-filled regions of the device
+// are not uploaded again. There is no change tracking for block devices, so the whole
+// device is read on every backup; kopia doesn't reuse the entry of a previous snapshot
+// when the snapshot root is a single file.
+type blockDeviceEntry struct {
+	path    string
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+var _ fs.File = &blockDeviceEntry{}
+
+func (e *blockDeviceEntry) Name() string {
+	return e.name
+}
+
+func (e *blockDeviceEntry) IsDir() bool {
+	return false
+}
+
+func (e *blockDeviceEntry) Mode() os.FileMode {
+	return 0644
+}
+
+func (e *blockDeviceEntry) ModTime() time.Time {
+	return e.modTime
+}
+
+func (e *blockDeviceEntry) Size() int64 {
+	return e.size
+}
+
+func (e *blockDeviceEntry) Sys() interface{} {
+	return nil
+}
+
+func (e *blockDeviceEntry) Owner() fs.OwnerInfo {
+	return fs.OwnerInfo{}
+}
+
+func (e *blockDeviceEntry) Device() fs.DeviceInfo {
+	return fs.DeviceInfo{}
+}
+
+func (e *blockDeviceEntry) LocalFilesystemPath() string {
+	return e.path
+}
+
+func (e *blockDeviceEntry) Close() {}
+
+func (e *blockDeviceEntry) Open(ctx context.Context) (fs.Reader, error) {
+	file, err := os.Open(e.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open block device %s", e.path)
+	}
+
+	return &blockDeviceReader{File: file, entry: e}, nil
+}
+
+type blockDeviceReader struct {
+	*os.File
+	entry *blockDeviceEntry
+}
+
+func (r *blockDeviceReader) Entry() (fs.Entry, error) {
+	return r.entry, nil
+}
+
+// getLocalBlockEntry returns a kopia file entry for the block device at the given path
+func getLocalBlockEntry(path0 string) (fs.Entry, error) {
+	path, err := resolveSymlink(path0)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolveSymlink")
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to stat block device %s", path)
+	}
+
+	if st.Mode()&os.ModeDevice == 0 || st.Mode()&os.ModeCharDevice != 0 {
+		return nil, errors.Errorf("path %s is not a block device", path)
+	}
+
+	size, err := getBlockDeviceSize(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockDeviceEntry{
+		path:    path,
+		name:    filepath.Base(path0),
+		size:    size,
+		modTime: st.ModTime(),
+	}, nil
+}
+
+func getBlockDeviceSize(path string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to open block device %s", path)
+	}
+	defer file.Close()
+
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to get size of block device %s", path)
+	}
+
+	return size, nil
+}
+
+// BlockOutput restores the single file of a block mode snapshot to a block device
+type BlockOutput struct {
+	targetDevice string
+	progress     func(int64)
+}
+
+var _ restore.Output = &BlockOutput{}
+
+// newBlockOutput creates a BlockOutput that writes to the block device at targetDevice,
+// progress is called with the number of bytes processed so far
+func newBlockOutput(targetDevice string, progress func(int64)) *BlockOutput {
+	return &BlockOutput{
+		targetDevice: targetDevice,
+		progress:     progress,
+	}
+}
+
+// Parallelizable returns false as there is only one file to restore
+func (o *BlockOutput) Parallelizable() bool {
+	return false
+}
+
+// BeginDirectory fails as a block mode snapshot never contains a directory
+func (o *BlockOutput) BeginDirectory(ctx context.Context, relativePath string, e fs.Directory) error {
+	return errors.Errorf("unexpected directory %s in block mode snapshot", relativePath)
+}
+
+// WriteDirEntry fails as a block mode snapshot never contains a directory
+func (o *BlockOutput) WriteDirEntry(ctx context.Context, relativePath string, de *snapshot.DirEntry, e fs.Directory) error {
+	return errors.Errorf("unexpected directory entry %s in block mode snapshot", relativePath)
+}
+
+// FinishDirectory fails as a block mode snapshot never contains a directory
+func (o *BlockOutput) FinishDirectory(ctx context.Context, relativePath string, e fs.Directory) error {
+	return errors.Errorf("unexpected directory %s in block mode snapshot", relativePath)
+}
+
+// CreateSymlink fails as a block mode snapshot never contains a symlink
+func (o *BlockOutput) CreateSymlink(ctx context.Context, relativePath string, e fs.Symlink) error {
+	return errors.Errorf("unexpected symlink %s in block mode snapshot", relativePath)
+}
+
+// SymlinkExists always returns false as a block mode snapshot never contains a symlink
+func (o *BlockOutput) SymlinkExists(ctx context.Context, relativePath string, e fs.Symlink) bool {
+	return false
+}
+
+// Close does nothing as the block device is closed after it's written
+func (o *BlockOutput) Close(ctx context.Context) error {
+	return nil
+}
+
+// FileExists always returns false so that the block device is always written
+func (o *BlockOutput) FileExists(ctx context.Context, relativePath string, e fs.File) bool {
+	return false
+}
+
+// WriteFile writes the content of the snapshot file to the block device. The regions
+// that are zero in the snapshot are only written if they are not zero in the device
+// already, so that thin provisioned devices stay sparse.
+func (o *BlockOutput) WriteFile(ctx context.Context, relativePath string, remoteFile fs.File) error {
+	remoteReader, err := remoteFile.Open(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open remote file %s", remoteFile.Name())
+	}
+	defer remoteReader.Close()
+
+	targetFile, err := os.OpenFile(o.targetDevice, os.O_RDWR, 0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open block device %s", o.targetDevice)
+	}
+	defer targetFile.Close()
+
+	buffer := make([]byte, blockDeviceBufferSize)
+	existing := make([]byte, blockDeviceBufferSize)
+
+	var offset int64
+	for {
+		n, readErr := io.ReadFull(remoteReader, buffer)
+		if n > 0 {
+			if err := writeBlock(targetFile, buffer[:n], existing[:n], offset); err != nil {
+				return errors.Wrapf(err, "failed to write block device %s at offset %d", o.targetDevice, offset)
+			}
+
+			offset += int64(n)
+			if o.progress != nil {
+				o.progress(offset)
+			}
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		} else if readErr != nil {
+			return errors.Wrapf(readErr, "failed to read remote file %s", remoteFile.Name())
+		}
+	}
+
+	if err := targetFile.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to sync block device %s", o.targetDevice)
+	}
+
+	return nil
+}
+
+// writeBlock writes data to the target at offset, existing is a scratch buffer of the same size as data
+func writeBlock(target *os.File, data []byte, existing []byte, offset int64) error {
+	if isZero(data) {
+		n, err := target.ReadAt(existing, offset)
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if n == len(existing) && isZero(existing) {
+			return nil
+		}
+	}
+
+	_, err := target.WriteAt(data, offset)
+	return err
+}
+
+var zeroBlock = make([]byte, blockDeviceBufferSize)
+
+func isZero(data []byte) bool {
+	return bytes.Equal(data, zeroBlock[:len(data)])
+}
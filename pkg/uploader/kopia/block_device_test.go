@@ -0,0 +1,85 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLocalBlockEntry(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "fake-file")
+	require.NoError(t, os.WriteFile(file, []byte("fake-data"), 0644))
+
+	_, err := getLocalBlockEntry(file)
+	assert.EqualError(t, err, "path "+file+" is not a block device")
+
+	_, err = getLocalBlockEntry(filepath.Join(t.TempDir(), "not-exist"))
+	assert.Error(t, err)
+}
+
+func TestBlockOutputWriteFile(t *testing.T) {
+	dir := t.TempDir()
+
+	// the source has one data block, one zero block and a partial data block
+	source := bytes.Repeat([]byte{1}, blockDeviceBufferSize)
+	source = append(source, make([]byte, blockDeviceBufferSize)...)
+	source = append(source, []byte("tail")...)
+	sourceFile := filepath.Join(dir, "source")
+	require.NoError(t, os.WriteFile(sourceFile, source, 0644))
+
+	remoteFile := &blockDeviceEntry{path: sourceFile, name: "source", size: int64(len(source))}
+
+	tests := []struct {
+		name   string
+		target []byte
+	}{
+		{
+			name:   "zero target",
+			target: make([]byte, len(source)),
+		},
+		{
+			name:   "dirty target",
+			target: bytes.Repeat([]byte{2}, len(source)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			targetFile := filepath.Join(dir, "target")
+			require.NoError(t, os.WriteFile(targetFile, test.target, 0644))
+
+			var progress []int64
+			output := newBlockOutput(targetFile, func(written int64) {
+				progress = append(progress, written)
+			})
+
+			require.NoError(t, output.WriteFile(context.Background(), "", remoteFile))
+
+			restored, err := os.ReadFile(targetFile)
+			require.NoError(t, err)
+			assert.Equal(t, source, restored)
+			assert.Equal(t, []int64{blockDeviceBufferSize, 2 * blockDeviceBufferSize, int64(len(source))}, progress)
+		})
+	}
+}
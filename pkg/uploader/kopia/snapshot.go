@@ -43,6 +43,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// MaxCheckpointInterval is the longest interval between the checkpoints of a backup that kopia accepts
+const MaxCheckpointInterval = snapshotfs.DefaultCheckpointInterval
+
 // All function mainly used to make testing more convenient
 var applyRetentionPolicyFunc = policy.ApplyRetentionPolicy
 var saveSnapshotFunc = snapshot.SaveSnapshot
@@ -88,24 +91,16 @@ func setupDefaultPolicy() *policy.Tree {
 
 // Backup backup specific sourcePath and update progress
 func Backup(ctx context.Context, fsUploader SnapshotUploader, repoWriter repo.RepositoryWriter, sourcePath string, realSource string,
-	forceFull bool, parentSnapshot string, tags map[string]string, log logrus.FieldLogger) (*uploader.SnapshotInfo, bool, error) {
+	forceFull bool, parentSnapshot string, volMode uploader.PersistentVolumeMode, tags map[string]string, log logrus.FieldLogger) (*uploader.SnapshotInfo, bool, error) {
 	if fsUploader == nil {
 		return nil, false, errors.New("get empty kopia uploader")
 	}
-	dir, err := filepath.Abs(sourcePath)
+	source, err := filepath.Abs(sourcePath)
 	if err != nil {
 		return nil, false, errors.Wrapf(err, "Invalid source path '%s'", sourcePath)
 	}
 
-	// to be consistent with restic when backup empty dir returns one error for upper logic handle
-	dirs, err := os.ReadDir(dir)
-	if err != nil {
-		return nil, false, errors.Wrapf(err, "Unable to read dir in path %s", dir)
-	} else if len(dirs) == 0 {
-		return nil, true, nil
-	}
-
-	dir = filepath.Clean(dir)
+	source = filepath.Clean(source)
 
 	sourceInfo := snapshot.SourceInfo{
 		UserName: udmrepo.GetRepoUser(),
@@ -113,16 +108,32 @@ func Backup(ctx context.Context, fsUploader SnapshotUploader, repoWriter repo.Re
 		Path:     filepath.Clean(realSource),
 	}
 	if sourceInfo.Path == "" {
-		sourceInfo.Path = dir
+		sourceInfo.Path = source
 	}
 
-	rootDir, err := getLocalFSEntry(dir)
-	if err != nil {
-		return nil, false, errors.Wrap(err, "Unable to get local filesystem entry")
+	var rootEntry fs.Entry
+	if volMode == uploader.PersistentVolumeBlock {
+		rootEntry, err = getLocalBlockEntry(source)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "Unable to get local block device entry")
+		}
+	} else {
+		// to be consistent with restic when backup empty dir returns one error for upper logic handle
+		dirs, err := os.ReadDir(source)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "Unable to read dir in path %s", source)
+		} else if len(dirs) == 0 {
+			return nil, true, nil
+		}
+
+		rootEntry, err = getLocalFSEntry(source)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "Unable to get local filesystem entry")
+		}
 	}
 
 	kopiaCtx := logging.SetupKopiaLog(ctx, log)
-	snapID, snapshotSize, err := SnapshotSource(kopiaCtx, repoWriter, fsUploader, sourceInfo, rootDir, forceFull, parentSnapshot, tags, log, "Kopia Uploader")
+	snapID, snapshotSize, err := SnapshotSource(kopiaCtx, repoWriter, fsUploader, sourceInfo, rootEntry, forceFull, parentSnapshot, tags, log, "Kopia Uploader")
 	if err != nil {
 		return nil, false, err
 	}
@@ -179,6 +190,9 @@ func SnapshotSource(
 	log.Info("Start to snapshot...")
 	snapshotStartTime := time.Now()
 
+	// the context may be canceled when the snapshot finishes, the checkpoints are deleted with a new one
+	defer deleteCheckpointManifests(logging.SetupKopiaLog(context.Background(), log), rep, sourceInfo, snapshotTags, log)
+
 	var previous []*snapshot.Manifest
 	if !forceFull {
 		if parentSnapshot != "" {
@@ -204,6 +218,16 @@ func SnapshotSource(
 		log.Info("Forcing full snapshot")
 	}
 
+	checkpoint, err := findCheckpointManifest(ctx, rep, sourceInfo, snapshotTags, log)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "Failed to find kopia checkpoint manifests for si %v", sourceInfo)
+	}
+
+	if checkpoint != nil {
+		log.Infof("Resuming from checkpoint %s, created time %v", checkpoint.ID, checkpoint.EndTime.ToTime())
+		previous = append(previous, checkpoint)
+	}
+
 	for i := range previous {
 		log.Infof("Using parent snapshot %s, start time %v, end time %v, description %s", previous[i].ID, previous[i].StartTime.ToTime(), previous[i].EndTime.ToTime(), previous[i].Description)
 	}
@@ -302,8 +326,82 @@ func findPreviousSnapshotManifest(ctx context.Context, rep repo.Repository, sour
 	return result, nil
 }
 
+// findCheckpointManifest returns the latest checkpoint of the same request for a given source, which is left by
+// an interrupted backup, so that the backup could be resumed from it instead of reading all the data again.
+func findCheckpointManifest(ctx context.Context, rep repo.Repository, sourceInfo snapshot.SourceInfo, snapshotTags map[string]string, log logrus.FieldLogger) (*snapshot.Manifest, error) {
+	checkpoints, err := listCheckpointManifests(ctx, rep, sourceInfo, snapshotTags)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint *snapshot.Manifest
+	for _, p := range checkpoints {
+		log.Debugf("Found one checkpoint %s, start time %v", p.ID, p.StartTime.ToTime())
+
+		if checkpoint == nil || p.StartTime.After(checkpoint.StartTime) {
+			checkpoint = p
+		}
+	}
+
+	return checkpoint, nil
+}
+
+// deleteCheckpointManifests deletes the checkpoints of the same request for a given source when the backup of the
+// request finishes, no matter whether it succeeds, fails or is canceled, since the request is never resumed afterwards
+func deleteCheckpointManifests(ctx context.Context, rep repo.RepositoryWriter, sourceInfo snapshot.SourceInfo, snapshotTags map[string]string, log logrus.FieldLogger) {
+	checkpoints, err := listCheckpointManifests(ctx, rep, sourceInfo, snapshotTags)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to list kopia checkpoint manifests for si %v", sourceInfo)
+		return
+	}
+
+	if len(checkpoints) == 0 {
+		return
+	}
+
+	for _, p := range checkpoints {
+		if err := rep.DeleteManifest(ctx, p.ID); err != nil {
+			log.WithError(err).Warnf("Failed to delete checkpoint %s", p.ID)
+		} else {
+			log.Debugf("Checkpoint %s is deleted", p.ID)
+		}
+	}
+
+	if err := rep.Flush(ctx); err != nil {
+		log.WithError(err).Warn("Failed to flush kopia repository after deleting the checkpoints")
+	}
+}
+
+// listCheckpointManifests returns the checkpoints of the request in the snapshot tags for a given source
+func listCheckpointManifests(ctx context.Context, rep repo.Repository, sourceInfo snapshot.SourceInfo, snapshotTags map[string]string) ([]*snapshot.Manifest, error) {
+	request := snapshotTags[uploader.SnapshotRequestTag]
+	if request == "" {
+		return nil, nil
+	}
+
+	man, err := listSnapshotsFunc(ctx, rep, sourceInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []*snapshot.Manifest
+	for _, p := range man {
+		if p.IncompleteReason != snapshotfs.IncompleteReasonCheckpoint {
+			continue
+		}
+
+		if p.Tags[uploader.SnapshotRequestTag] != request {
+			continue
+		}
+
+		checkpoints = append(checkpoints, p)
+	}
+
+	return checkpoints, nil
+}
+
 // Restore restore specific sourcePath with given snapshotID and update progress
-func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress, snapshotID, dest string, log logrus.FieldLogger, cancleCh chan struct{}) (int64, int32, error) {
+func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress, snapshotID, dest string, volMode uploader.PersistentVolumeMode, log logrus.FieldLogger, cancleCh chan struct{}) (int64, int32, error) {
 	log.Info("Start to restore...")
 
 	kopiaCtx := logging.SetupKopiaLog(ctx, log)
@@ -325,17 +423,31 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 		return 0, 0, errors.Wrapf(err, "Unable to resolve path %v", dest)
 	}
 
-	output := &restore.FilesystemOutput{
-		TargetPath:             path,
-		OverwriteDirectories:   true,
-		OverwriteFiles:         true,
-		OverwriteSymlinks:      true,
-		IgnorePermissionErrors: true,
-	}
+	var output restore.Output
+	if volMode == uploader.PersistentVolumeBlock {
+		if rootEntry.IsDir() {
+			return 0, 0, errors.Errorf("snapshot %v is not a block mode snapshot", snapshotID)
+		}
 
-	err = output.Init(ctx)
-	if err != nil {
-		return 0, 0, errors.Wrap(err, "error to init output")
+		totalSize := rootEntry.Size()
+		output = newBlockOutput(path, func(written int64) {
+			progress.ProgressBytes(written, totalSize)
+		})
+	} else {
+		fsOutput := &restore.FilesystemOutput{
+			TargetPath:             path,
+			OverwriteDirectories:   true,
+			OverwriteFiles:         true,
+			OverwriteSymlinks:      true,
+			IgnorePermissionErrors: true,
+		}
+
+		err = fsOutput.Init(ctx)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "error to init output")
+		}
+
+		output = fsOutput
 	}
 
 	stat, err := restoreEntryFunc(kopiaCtx, rep, output, rootEntry, restore.Options{
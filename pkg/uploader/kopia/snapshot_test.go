@@ -553,12 +553,133 @@ func TestFindPreviousSnapshotManifest(t *testing.T) {
 	}
 }
 
+func TestFindCheckpointManifest(t *testing.T) {
+	sourceInfo := snapshot.SourceInfo{
+		UserName: "user1",
+		Host:     "host1",
+		Path:     "/path/to/dir1",
+	}
+	now := time.Now()
+	newManifest := func(id string, incompleteReason string, request string, startTime time.Time) *snapshot.Manifest {
+		return &snapshot.Manifest{
+			ID:               manifest.ID(id),
+			IncompleteReason: incompleteReason,
+			StartTime:        fs.UTCTimestampFromTime(startTime),
+			Tags:             map[string]string{uploader.SnapshotRequestTag: request},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		snapshotTags      map[string]string
+		listSnapshotsFunc func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error)
+		expected          manifest.ID
+		expectedError     string
+	}{
+		{
+			name:         "no request tag",
+			snapshotTags: map[string]string{},
+		},
+		{
+			name:         "error listing snapshots",
+			snapshotTags: map[string]string{uploader.SnapshotRequestTag: "request1"},
+			listSnapshotsFunc: func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return nil, errors.New("fake-error")
+			},
+			expectedError: "fake-error",
+		},
+		{
+			name:         "latest checkpoint of the request",
+			snapshotTags: map[string]string{uploader.SnapshotRequestTag: "request1"},
+			listSnapshotsFunc: func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return []*snapshot.Manifest{
+					newManifest("complete", "", "request1", now.Add(time.Hour)),
+					newManifest("other-request", snapshotfs.IncompleteReasonCheckpoint, "request2", now.Add(time.Hour)),
+					newManifest("canceled", snapshotfs.IncompleteReasonCanceled, "request1", now.Add(time.Hour)),
+					newManifest("checkpoint1", snapshotfs.IncompleteReasonCheckpoint, "request1", now),
+					newManifest("checkpoint2", snapshotfs.IncompleteReasonCheckpoint, "request1", now.Add(time.Minute)),
+				}, nil
+			},
+			expected: "checkpoint2",
+		},
+		{
+			name:         "no checkpoint of the request",
+			snapshotTags: map[string]string{uploader.SnapshotRequestTag: "request1"},
+			listSnapshotsFunc: func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return []*snapshot.Manifest{
+					newManifest("other-request", snapshotfs.IncompleteReasonCheckpoint, "request2", now),
+				}, nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var repo repo.Repository
+			listSnapshotsFunc = tc.listSnapshotsFunc
+			checkpoint, err := findCheckpointManifest(context.Background(), repo, sourceInfo, tc.snapshotTags, logrus.New())
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tc.expected == "" {
+				assert.Nil(t, checkpoint)
+			} else {
+				assert.Equal(t, tc.expected, checkpoint.ID)
+			}
+		})
+	}
+}
+
+func TestDeleteCheckpointManifests(t *testing.T) {
+	sourceInfo := snapshot.SourceInfo{
+		UserName: "user1",
+		Host:     "host1",
+		Path:     "/path/to/dir1",
+	}
+	newManifest := func(id string, incompleteReason string, request string) *snapshot.Manifest {
+		return &snapshot.Manifest{
+			ID:               manifest.ID(id),
+			IncompleteReason: incompleteReason,
+			Tags:             map[string]string{uploader.SnapshotRequestTag: request},
+		}
+	}
+
+	listSnapshotsFunc = func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+		return []*snapshot.Manifest{
+			newManifest("complete", "", "request1"),
+			newManifest("other-request", snapshotfs.IncompleteReasonCheckpoint, "request2"),
+			newManifest("checkpoint1", snapshotfs.IncompleteReasonCheckpoint, "request1"),
+			newManifest("checkpoint2", snapshotfs.IncompleteReasonCheckpoint, "request1"),
+		}, nil
+	}
+	defer func() {
+		listSnapshotsFunc = snapshot.ListSnapshots
+	}()
+
+	repoWriterMock := &repomocks.RepositoryWriter{}
+	repoWriterMock.On("DeleteManifest", mock.Anything, manifest.ID("checkpoint1")).Return(nil)
+	repoWriterMock.On("DeleteManifest", mock.Anything, manifest.ID("checkpoint2")).Return(errors.New("fake-error"))
+	repoWriterMock.On("Flush", mock.Anything).Return(nil)
+
+	deleteCheckpointManifests(context.Background(), repoWriterMock, sourceInfo, map[string]string{uploader.SnapshotRequestTag: "request1"}, logrus.New())
+	repoWriterMock.AssertNumberOfCalls(t, "DeleteManifest", 2)
+	repoWriterMock.AssertNumberOfCalls(t, "Flush", 1)
+
+	// nothing is deleted for a snapshot without the request tag
+	deleteCheckpointManifests(context.Background(), repoWriterMock, sourceInfo, map[string]string{}, logrus.New())
+	repoWriterMock.AssertNumberOfCalls(t, "DeleteManifest", 2)
+}
+
 func TestBackup(t *testing.T) {
 	type testCase struct {
 		name                  string
 		sourcePath            string
 		forceFull             bool
 		parentSnapshot        string
+		volMode               uploader.PersistentVolumeMode
 		tags                  map[string]string
 		isEmptyUploader       bool
 		isSnapshotSourceError bool
@@ -590,6 +711,13 @@ func TestBackup(t *testing.T) {
 			tags:          nil,
 			expectedError: errors.New("Unable to read dir"),
 		},
+		{
+			name:          "Source is not a block device",
+			sourcePath:    "/",
+			volMode:       uploader.PersistentVolumeBlock,
+			tags:          nil,
+			expectedError: errors.New("Unable to get local block device entry"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -616,9 +744,9 @@ func TestBackup(t *testing.T) {
 			var snapshotInfo *uploader.SnapshotInfo
 			var err error
 			if tc.isEmptyUploader {
-				snapshotInfo, isSnapshotEmpty, err = Backup(context.Background(), nil, s.repoWriterMock, tc.sourcePath, "", tc.forceFull, tc.parentSnapshot, tc.tags, &logrus.Logger{})
+				snapshotInfo, isSnapshotEmpty, err = Backup(context.Background(), nil, s.repoWriterMock, tc.sourcePath, "", tc.forceFull, tc.parentSnapshot, tc.volMode, tc.tags, &logrus.Logger{})
 			} else {
-				snapshotInfo, isSnapshotEmpty, err = Backup(context.Background(), s.uploderMock, s.repoWriterMock, tc.sourcePath, "", tc.forceFull, tc.parentSnapshot, tc.tags, &logrus.Logger{})
+				snapshotInfo, isSnapshotEmpty, err = Backup(context.Background(), s.uploderMock, s.repoWriterMock, tc.sourcePath, "", tc.forceFull, tc.parentSnapshot, tc.volMode, tc.tags, &logrus.Logger{})
 			}
 			// Check if the returned error matches the expected error
 			if tc.expectedError != nil {
@@ -644,6 +772,7 @@ func TestRestore(t *testing.T) {
 		filesystemEntryFunc func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error)
 		restoreEntryFunc    func(ctx context.Context, rep repo.Repository, output restore.Output, rootEntry fs.Entry, options restore.Options) (restore.Stats, error)
 		dest                string
+		volMode             uploader.PersistentVolumeMode
 		expectedBytes       int64
 		expectedCount       int32
 		expectedError       error
@@ -684,6 +813,31 @@ func TestRestore(t *testing.T) {
 			snapshotID:    "snapshot-123",
 			expectedError: nil,
 		},
+		{
+			name: "Block mode restore from a directory snapshot",
+			filesystemEntryFunc: func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error) {
+				return snapshotfs.EntryFromDirEntry(rep, &snapshot.DirEntry{Type: snapshot.EntryTypeDirectory}), nil
+			},
+			snapshotID:    "snapshot-123",
+			volMode:       uploader.PersistentVolumeBlock,
+			expectedError: errors.New("snapshot snapshot-123 is not a block mode snapshot"),
+		},
+		{
+			name: "Expect successful block mode restore",
+			filesystemEntryFunc: func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error) {
+				return snapshotfs.EntryFromDirEntry(rep, &snapshot.DirEntry{Type: snapshot.EntryTypeFile}), nil
+			},
+			restoreEntryFunc: func(ctx context.Context, rep repo.Repository, output restore.Output, rootEntry fs.Entry, options restore.Options) (restore.Stats, error) {
+				if _, ok := output.(*BlockOutput); !ok {
+					return restore.Stats{}, errors.New("unexpected output")
+				}
+				return restore.Stats{RestoredTotalFileSize: 1024, RestoredFileCount: 1}, nil
+			},
+			snapshotID:    "snapshot-123",
+			volMode:       uploader.PersistentVolumeBlock,
+			expectedBytes: 1024,
+			expectedCount: 1,
+		},
 	}
 
 	em := &manifest.EntryMetadata{
@@ -712,7 +866,7 @@ func TestRestore(t *testing.T) {
 			repoWriterMock.On("OpenObject", mock.Anything, mock.Anything).Return(em, nil)
 
 			progress := new(Progress)
-			bytesRestored, fileCount, err := Restore(context.Background(), repoWriterMock, progress, tc.snapshotID, tc.dest, logrus.New(), nil)
+			bytesRestored, fileCount, err := Restore(context.Background(), repoWriterMock, progress, tc.snapshotID, tc.dest, tc.volMode, logrus.New(), nil)
 
 			// Check if the returned error matches the expected error
 			if tc.expectedError != nil {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/kopia/kopia/snapshot/snapshotfs"
 	"github.com/pkg/errors"
@@ -43,11 +44,12 @@ var BackupRepoServiceCreateFunc = service.Create
 
 // kopiaProvider recorded info related with kopiaProvider
 type kopiaProvider struct {
-	requestorType string
-	bkRepo        udmrepo.BackupRepo
-	credGetter    *credentials.CredentialGetter
-	log           logrus.FieldLogger
-	canceling     int32
+	requestorType      string
+	bkRepo             udmrepo.BackupRepo
+	credGetter         *credentials.CredentialGetter
+	checkpointInterval time.Duration
+	log                logrus.FieldLogger
+	canceling          int32
 }
 
 // NewKopiaUploaderProvider initialized with open or create a repository
@@ -56,12 +58,14 @@ func NewKopiaUploaderProvider(
 	ctx context.Context,
 	credGetter *credentials.CredentialGetter,
 	backupRepo *velerov1api.BackupRepository,
+	checkpointInterval time.Duration,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	kp := &kopiaProvider{
-		requestorType: requestorType,
-		log:           log,
-		credGetter:    credGetter,
+		requestorType:      requestorType,
+		log:                log,
+		credGetter:         credGetter,
+		checkpointInterval: checkpointInterval,
 	}
 	//repoUID which is used to generate kopia repository config with unique directory path
 	repoUID := string(backupRepo.GetUID())
@@ -118,6 +122,7 @@ func (kp *kopiaProvider) RunBackup(
 	tags map[string]string,
 	forceFull bool,
 	parentSnapshot string,
+	volMode uploader.PersistentVolumeMode,
 	updater uploader.ProgressUpdater) (string, bool, error) {
 	if updater == nil {
 		return "", false, errors.New("Need to initial backup progress updater first")
@@ -131,6 +136,7 @@ func (kp *kopiaProvider) RunBackup(
 		"path":           path,
 		"realSource":     realSource,
 		"parentSnapshot": parentSnapshot,
+		"volumeMode":     volMode,
 	})
 	repoWriter := kopia.NewShimRepo(kp.bkRepo)
 	kpUploader := snapshotfs.NewUploader(repoWriter)
@@ -153,7 +159,13 @@ func (kp *kopiaProvider) RunBackup(
 	tags[uploader.SnapshotRequesterTag] = kp.requestorType
 	tags[uploader.SnapshotUploaderTag] = uploader.KopiaType
 
-	snapshotInfo, isSnapshotEmpty, err := BackupFunc(ctx, kpUploader, repoWriter, path, realSource, forceFull, parentSnapshot, tags, log)
+	// the checkpoints have the same tags as the snapshot so that they could be found if the backup is resumed
+	kpUploader.CheckpointLabels = tags
+	if kp.checkpointInterval > 0 {
+		kpUploader.CheckpointInterval = kp.checkpointInterval
+	}
+
+	snapshotInfo, isSnapshotEmpty, err := BackupFunc(ctx, kpUploader, repoWriter, path, realSource, forceFull, parentSnapshot, volMode, tags, log)
 	if err != nil {
 		if kpUploader.IsCanceled() {
 			log.Error("Kopia backup is canceled")
@@ -197,10 +209,12 @@ func (kp *kopiaProvider) RunRestore(
 	ctx context.Context,
 	snapshotID string,
 	volumePath string,
+	volMode uploader.PersistentVolumeMode,
 	updater uploader.ProgressUpdater) error {
 	log := kp.log.WithFields(logrus.Fields{
 		"snapshotID": snapshotID,
 		"volumePath": volumePath,
+		"volumeMode": volMode,
 	})
 	repoWriter := kopia.NewShimRepo(kp.bkRepo)
 	progress := new(kopia.Progress)
@@ -219,7 +233,7 @@ func (kp *kopiaProvider) RunRestore(
 	// We use the cancel channel to control the restore cancel, so don't pass a context with cancel to Kopia restore.
 	// Otherwise, Kopia restore will not response to the cancel control but return an arbitrary error.
 	// Kopia restore cancel is not designed as well as Kopia backup which uses the context to control backup cancel all the way.
-	size, fileCount, err := RestoreFunc(context.Background(), repoWriter, progress, snapshotID, volumePath, log, restoreCancel)
+	size, fileCount, err := RestoreFunc(context.Background(), repoWriter, progress, snapshotID, volumePath, volMode, log, restoreCancel)
 
 	if err != nil {
 		return errors.Wrapf(err, "Failed to run kopia restore")
@@ -48,6 +48,7 @@ type Provider interface {
 		tags map[string]string,
 		forceFull bool,
 		parentSnapshot string,
+		volMode uploader.PersistentVolumeMode,
 		updater uploader.ProgressUpdater) (string, bool, error)
 	// RunRestore which will do restore for one specific volume with given snapshot id and return error
 	// updater is used for updating backup progress which implement by third-party
@@ -55,12 +56,14 @@ type Provider interface {
 		ctx context.Context,
 		snapshotID string,
 		volumePath string,
+		volMode uploader.PersistentVolumeMode,
 		updater uploader.ProgressUpdater) error
 	// Close which will close related repository
 	Close(ctx context.Context) error
 }
 
-// NewUploaderProvider initialize provider with specific uploaderType
+// NewUploaderProvider initialize provider with specific uploaderType, checkpointInterval is how often the kopia
+// uploader saves the checkpoints of a backup, the default interval of kopia is used if it is 0
 func NewUploaderProvider(
 	ctx context.Context,
 	client client.Client,
@@ -71,6 +74,7 @@ func NewUploaderProvider(
 	backupRepo *velerov1api.BackupRepository,
 	credGetter *credentials.CredentialGetter,
 	repoKeySelector *v1.SecretKeySelector,
+	checkpointInterval time.Duration,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	if requesterType == "" {
@@ -81,7 +85,7 @@ func NewUploaderProvider(
 		return nil, errors.New("uninitialized FileStore credential is not supported")
 	}
 	if uploaderType == uploader.KopiaType {
-		return NewKopiaUploaderProvider(requesterType, ctx, credGetter, backupRepo, log)
+		return NewKopiaUploaderProvider(requesterType, ctx, credGetter, backupRepo, checkpointInterval, log)
 	} else {
 		return NewResticUploaderProvider(repoIdentifier, bsl, credGetter, repoKeySelector, log)
 	}
@@ -87,7 +87,7 @@ func TestNewUploaderProvider(t *testing.T) {
 				credGetter.FromFile = mockFileGetter
 
 			}
-			_, err := NewUploaderProvider(ctx, client, testCase.UploaderType, testCase.RequestorType, repoIdentifier, bsl, backupRepo, credGetter, repoKeySelector, log)
+			_, err := NewUploaderProvider(ctx, client, testCase.UploaderType, testCase.RequestorType, repoIdentifier, bsl, backupRepo, credGetter, repoKeySelector, 0, log)
 			if testCase.ExpectedError == "" {
 				assert.Nil(t, err)
 			} else {
@@ -138,9 +138,9 @@ func TestResticRunBackup(t *testing.T) {
 			}
 			if !tc.nilUpdater {
 				updater := FakeBackupProgressUpdater{PodVolumeBackup: &velerov1api.PodVolumeBackup{}, Log: tc.rp.log, Ctx: context.Background(), Cli: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()}
-				_, _, err = tc.rp.RunBackup(context.Background(), "var", "", map[string]string{}, false, parentSnapshot, &updater)
+				_, _, err = tc.rp.RunBackup(context.Background(), "var", "", map[string]string{}, false, parentSnapshot, uploader.PersistentVolumeFilesystem, &updater)
 			} else {
-				_, _, err = tc.rp.RunBackup(context.Background(), "var", "", map[string]string{}, false, parentSnapshot, nil)
+				_, _, err = tc.rp.RunBackup(context.Background(), "var", "", map[string]string{}, false, parentSnapshot, uploader.PersistentVolumeFilesystem, nil)
 			}
 
 			tc.rp.log.Infof("test name %v error %v", tc.name, err)
@@ -196,9 +196,9 @@ func TestResticRunRestore(t *testing.T) {
 			var err error
 			if !tc.nilUpdater {
 				updater := FakeBackupProgressUpdater{PodVolumeBackup: &velerov1api.PodVolumeBackup{}, Log: tc.rp.log, Ctx: context.Background(), Cli: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()}
-				err = tc.rp.RunRestore(context.Background(), "", "var", &updater)
+				err = tc.rp.RunRestore(context.Background(), "", "var", uploader.PersistentVolumeFilesystem, &updater)
 			} else {
-				err = tc.rp.RunRestore(context.Background(), "", "var", nil)
+				err = tc.rp.RunRestore(context.Background(), "", "var", uploader.PersistentVolumeFilesystem, nil)
 			}
 
 			tc.rp.log.Infof("test name %v error %v", tc.name, err)
@@ -271,6 +271,11 @@ func (m *MockCredentialGetter) Path(selector *v1.SecretKeySelector) (string, err
 	return args.Get(0).(string), args.Error(1)
 }
 
+func (m *MockCredentialGetter) WebIdentityPath(webIdentity *velerov1api.WebIdentity) (string, error) {
+	args := m.Called(webIdentity)
+	return args.Get(0).(string), args.Error(1)
+}
+
 func TestNewResticUploaderProvider(t *testing.T) {
 	testCases := []struct {
 		name                     string
@@ -26,6 +26,19 @@ const (
 	KopiaType            = "kopia"
 	SnapshotRequesterTag = "snapshot-requester"
 	SnapshotUploaderTag  = "snapshot-uploader"
+	// SnapshotRequestTag identifies the request, i.e. the PodVolumeBackup or the DataUpload, that a snapshot is
+	// taken for, so that the checkpoints of an interrupted request could be found when the request is resumed
+	SnapshotRequestTag = "snapshot-request"
+)
+
+// PersistentVolumeMode defines how the data of a volume is accessed by the uploader
+type PersistentVolumeMode string
+
+const (
+	// PersistentVolumeFilesystem means the volume is accessed as a directory
+	PersistentVolumeFilesystem PersistentVolumeMode = "Filesystem"
+	// PersistentVolumeBlock means the volume is accessed as a raw block device
+	PersistentVolumeBlock PersistentVolumeMode = "Block"
 )
 
 // ValidateUploaderType validates if the input param is a valid uploader type.
@@ -151,6 +151,11 @@ func GetVolumeDirectory(ctx context.Context, log logrus.FieldLogger, pod *corev1
 		return "", errors.WithStack(err)
 	}
 
+	// Block volumes are mapped into the pod's volumeDevices directory by the PV name
+	if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+		return pvc.Spec.VolumeName, nil
+	}
+
 	pv := &corev1api.PersistentVolume{}
 	err = cli.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, pv)
 	if err != nil {
@@ -169,6 +174,39 @@ func GetVolumeDirectory(ctx context.Context, log logrus.FieldLogger, pod *corev1
 	return pvc.Spec.VolumeName, nil
 }
 
+// GetVolumeMode gets the volume mode of a pod volume, volumes that are not backed by
+// a PVC are always in filesystem mode
+func GetVolumeMode(ctx context.Context, log logrus.FieldLogger, pod *corev1api.Pod, volumeName string, cli client.Client) (corev1api.PersistentVolumeMode, error) {
+	var volume *corev1api.Volume
+
+	for i := range pod.Spec.Volumes {
+		if pod.Spec.Volumes[i].Name == volumeName {
+			volume = &pod.Spec.Volumes[i]
+			break
+		}
+	}
+
+	if volume == nil {
+		return "", errors.New("volume not found in pod")
+	}
+
+	if volume.VolumeSource.PersistentVolumeClaim == nil {
+		return corev1api.PersistentVolumeFilesystem, nil
+	}
+
+	pvc := &corev1api.PersistentVolumeClaim{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: volume.VolumeSource.PersistentVolumeClaim.ClaimName}, pvc)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+		return corev1api.PersistentVolumeBlock, nil
+	}
+
+	return corev1api.PersistentVolumeFilesystem, nil
+}
+
 // isProvisionedByCSI function checks whether this is a CSI PV by annotation.
 // Either "pv.kubernetes.io/provisioned-by" or "pv.kubernetes.io/migrated-to" indicates
 // PV is provisioned by CSI.
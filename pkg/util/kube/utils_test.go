@@ -188,6 +188,13 @@ func TestGetVolumeDirectorySuccess(t *testing.T) {
 			pv:   builder.ForPersistentVolume("a-pv").ObjectMeta(builder.WithAnnotations(KubeAnnMigratedTo, "csi.test.com")).Result(),
 			want: "a-pv/mount",
 		},
+		{
+			name: "Block mode CSI volume with a PVC/PV returns the volume's name",
+			pod:  builder.ForPod("ns-1", "my-pod").Volumes(builder.ForVolume("my-vol").PersistentVolumeClaimSource("my-pvc").Result()).Result(),
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "my-pvc").VolumeName("a-pv").VolumeMode(corev1.PersistentVolumeBlock).Result(),
+			pv:   builder.ForPersistentVolume("a-pv").CSI("csi.test.com", "provider-volume-id").Result(),
+			want: "a-pv",
+		},
 	}
 
 	csiDriver := storagev1api.CSIDriver{
@@ -211,6 +218,47 @@ func TestGetVolumeDirectorySuccess(t *testing.T) {
 	}
 }
 
+func TestGetVolumeMode(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		pvc  *corev1.PersistentVolumeClaim
+		want corev1.PersistentVolumeMode
+	}{
+		{
+			name: "Volume without a PVC is in filesystem mode",
+			pod:  builder.ForPod("ns-1", "my-pod").Volumes(builder.ForVolume("my-vol").Result()).Result(),
+			want: corev1.PersistentVolumeFilesystem,
+		},
+		{
+			name: "PVC without volume mode is in filesystem mode",
+			pod:  builder.ForPod("ns-1", "my-pod").Volumes(builder.ForVolume("my-vol").PersistentVolumeClaimSource("my-pvc").Result()).Result(),
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "my-pvc").VolumeName("a-pv").Result(),
+			want: corev1.PersistentVolumeFilesystem,
+		},
+		{
+			name: "PVC in block mode",
+			pod:  builder.ForPod("ns-1", "my-pod").Volumes(builder.ForVolume("my-vol").PersistentVolumeClaimSource("my-pvc").Result()).Result(),
+			pvc:  builder.ForPersistentVolumeClaim("ns-1", "my-pvc").VolumeName("a-pv").VolumeMode(corev1.PersistentVolumeBlock).Result(),
+			want: corev1.PersistentVolumeBlock,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientBuilder := fake.NewClientBuilder()
+			if tc.pvc != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.pvc)
+			}
+
+			mode, err := GetVolumeMode(context.Background(), logrus.StandardLogger(), tc.pod, tc.pod.Spec.Volumes[0].Name, clientBuilder.Build())
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, mode)
+		})
+	}
+}
+
 func TestIsV1Beta1CRDReady(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,67 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HookExecution records a single execution of an exec hook in a pod
+// during a backup or restore.
+type HookExecution struct {
+	// HookName is the name of the hook, or "<from-annotation>" for hooks
+	// specified as pod annotations.
+	HookName string `json:"hookName"`
+
+	// HookSource is where the hook was specified, e.g. "annotation" or "backupSpec".
+	HookSource string `json:"hookSource"`
+
+	// Phase is the hook phase, e.g. "pre" or "post".
+	Phase string `json:"phase"`
+
+	// Namespace and Pod identify the pod the hook ran in.
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+
+	// Container is the container the hook ran in.
+	Container string `json:"container,omitempty"`
+
+	// Command is the command of the hook.
+	Command []string `json:"command"`
+
+	// ExitCode is the exit code of the command, or -1 if it isn't known.
+	ExitCode int `json:"exitCode"`
+
+	// Error is the reason the hook failed. It is empty if the hook succeeded.
+	Error string `json:"error,omitempty"`
+
+	// StartTimestamp is when the hook started.
+	StartTimestamp metav1.Time `json:"startTimestamp"`
+
+	// Duration is how long the hook ran.
+	Duration metav1.Duration `json:"duration"`
+
+	// Stdout and Stderr hold the output of the command, truncated to the
+	// last few kilobytes.
+	Stdout string `json:"stdout,omitempty"`
+	Stderr string `json:"stderr,omitempty"`
+}
+
+// Failed returns true if the hook execution failed.
+func (e *HookExecution) Failed() bool {
+	return e.Error != ""
+}
@@ -0,0 +1,126 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ItemOutcome is the result of restoring a single item.
+type ItemOutcome string
+
+const (
+	// ItemOutcomeCreated means the item was created in the cluster.
+	ItemOutcomeCreated ItemOutcome = "created"
+
+	// ItemOutcomeUpdated means the item already existed in the cluster
+	// and was updated, e.g. because of the "update" existing resource policy.
+	ItemOutcomeUpdated ItemOutcome = "updated"
+
+	// ItemOutcomeSkippedExists means the item was not restored because
+	// it already existed in the cluster.
+	ItemOutcomeSkippedExists ItemOutcome = "skipped-exists"
+
+	// ItemOutcomeSkippedFiltered means the item was not restored because
+	// it was filtered out by the restore spec, a label selector or a plugin.
+	ItemOutcomeSkippedFiltered ItemOutcome = "skipped-filtered"
+
+	// ItemOutcomeFailed means the item could not be restored.
+	ItemOutcomeFailed ItemOutcome = "failed"
+)
+
+// ItemOutcomes are all the outcomes of restoring an item.
+var ItemOutcomes = []ItemOutcome{
+	ItemOutcomeCreated,
+	ItemOutcomeUpdated,
+	ItemOutcomeSkippedExists,
+	ItemOutcomeSkippedFiltered,
+	ItemOutcomeFailed,
+}
+
+// ValidateItemOutcomes returns an error if any of the outcomes isn't
+// one of ItemOutcomes.
+func ValidateItemOutcomes(outcomes []string) error {
+	for _, outcome := range outcomes {
+		valid := false
+		for _, itemOutcome := range ItemOutcomes {
+			if outcome == string(itemOutcome) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			names := make([]string, 0, len(ItemOutcomes))
+			for _, itemOutcome := range ItemOutcomes {
+				names = append(names, string(itemOutcome))
+			}
+			return errors.Errorf("invalid item outcome %q, valid outcomes are %s", outcome, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// ItemStatus records what a restore did with a single item.
+type ItemStatus struct {
+	// Resource is the API version and kind of the item, e.g. "v1/ConfigMap".
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item was restored into. It is empty for
+	// cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Outcome is the result of restoring the item.
+	Outcome ItemOutcome `json:"outcome"`
+
+	// Reason explains why the item was skipped or failed.
+	Reason string `json:"reason,omitempty"`
+
+	// ModifiedBy lists the restore item actions that modified the item
+	// before it was restored.
+	ModifiedBy []string `json:"modifiedBy,omitempty"`
+}
+
+// FirstMessage returns the first message of the Result, looking at the
+// Velero, cluster and namespace messages in that order. The namespaces
+// are looked at in alphabetical order, so that the same message is
+// returned every time. It returns an empty string if the Result is empty.
+func (r *Result) FirstMessage() string {
+	if len(r.Velero) > 0 {
+		return r.Velero[0]
+	}
+	if len(r.Cluster) > 0 {
+		return r.Cluster[0]
+	}
+
+	namespaces := make([]string, 0, len(r.Namespaces))
+	for namespace := range r.Namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		if messages := r.Namespaces[namespace]; len(messages) > 0 {
+			return messages[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *Result
+		want   string
+	}{
+		{
+			name:   "empty result",
+			result: &Result{},
+			want:   "",
+		},
+		{
+			name: "velero messages come first",
+			result: &Result{
+				Velero:     []string{"velero"},
+				Cluster:    []string{"cluster"},
+				Namespaces: map[string][]string{"ns-1": {"ns-1"}},
+			},
+			want: "velero",
+		},
+		{
+			name: "cluster messages come before namespace messages",
+			result: &Result{
+				Cluster:    []string{"cluster"},
+				Namespaces: map[string][]string{"ns-1": {"ns-1"}},
+			},
+			want: "cluster",
+		},
+		{
+			name: "namespaces are looked at in alphabetical order",
+			result: &Result{
+				Namespaces: map[string][]string{
+					"ns-d": {"ns-d"},
+					"ns-b": {"ns-b"},
+					"ns-a": {},
+					"ns-c": {"ns-c"},
+				},
+			},
+			want: "ns-b",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// map iteration order is random, so check that the same message is returned every time
+			for i := 0; i < 20; i++ {
+				assert.Equal(t, tc.want, tc.result.FirstMessage())
+			}
+		})
+	}
+}
+
+func TestValidateItemOutcomes(t *testing.T) {
+	assert.NoError(t, ValidateItemOutcomes(nil))
+	assert.NoError(t, ValidateItemOutcomes([]string{"created", "skipped-exists", "failed"}))
+	assert.EqualError(t, ValidateItemOutcomes([]string{"created", "deleted"}),
+		`invalid item outcome "deleted", valid outcomes are created, updated, skipped-exists, skipped-filtered, failed`)
+}